@@ -3,6 +3,7 @@
 package policy
 
 import (
+	"context"
 	"fmt"
 
 	"gitlab.qredo.com/edmund/blackbird/verifier/golang/protobuf"
@@ -29,7 +30,7 @@ func (p *AnyInGroupPolicy) AddressToParticipant(addr string) (string, error) {
 	return "", fmt.Errorf("address not a participant of this policy")
 }
 
-func (p *AnyInGroupPolicy) Verify(approvers ApproverSet, _ PolicyPayload, _ map[string][]byte) error {
+func (p *AnyInGroupPolicy) Verify(_ context.Context, approvers ApproverSet, _ PolicyPayload, _ map[string][]byte) error {
 	policyBz, err := proto.Marshal(p.policy)
 	if err != nil {
 		return err
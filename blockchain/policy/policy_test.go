@@ -3,7 +3,10 @@
 package policy
 
 import (
+	"context"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -52,7 +55,7 @@ func Test_NewPolicyAnyInGroup(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			p := NewAnyInGroupPolicy(tt.group)
-			err := p.Verify(tt.signaturesMap, EmptyPolicyPayload(), nil)
+			err := p.Verify(context.Background(), tt.signaturesMap, EmptyPolicyPayload(), nil)
 			if tt.expectedErr {
 				require.Error(t, err)
 			} else {
@@ -61,3 +64,73 @@ func Test_NewPolicyAnyInGroup(t *testing.T) {
 		})
 	}
 }
+
+// resolverPolicy is a policy whose verification waits for an external
+// resolver (e.g. an oracle), simulated by result.
+type resolverPolicy struct {
+	result chan error
+}
+
+func (*resolverPolicy) Validate() error { return nil }
+
+func (*resolverPolicy) AddressToParticipant(addr string) (string, error) {
+	return "", fmt.Errorf("address not a participant of this policy")
+}
+
+func (p *resolverPolicy) Verify(ctx context.Context, _ ApproverSet, _ PolicyPayload, _ map[string][]byte) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case err := <-p.result:
+		return err
+	}
+}
+
+func Test_Verify_Context(t *testing.T) {
+	var p Policy = &resolverPolicy{result: make(chan error)}
+
+	t.Run("cancelled before verification", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		err := p.Verify(ctx, BuildApproverSet(nil), EmptyPolicyPayload(), nil)
+		require.ErrorIs(t, err, context.Canceled)
+	})
+
+	t.Run("deadline exceeded", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		err := p.Verify(ctx, BuildApproverSet(nil), EmptyPolicyPayload(), nil)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	t.Run("resolved", func(t *testing.T) {
+		resolver := &resolverPolicy{result: make(chan error, 1)}
+		resolver.result <- nil
+
+		require.NoError(t, resolver.Verify(context.Background(), BuildApproverSet(nil), EmptyPolicyPayload(), nil))
+	})
+}
+
+func Test_BuildApproverSet(t *testing.T) {
+	orders := [][]string{
+		{"foo", "bar", "baz"},
+		{"baz", "foo", "bar"},
+		{"bar", "baz", "foo", "bar"},
+	}
+
+	p := NewAnyInGroupPolicy([]string{"bar"})
+	first := BuildApproverSet(orders[0])
+	for _, order := range orders {
+		set := BuildApproverSet(order)
+		require.Equal(t, first, set)
+		require.Equal(t, []string{"bar", "baz", "foo"}, set.Sorted())
+		require.Equal(t, p.Verify(context.Background(), first, EmptyPolicyPayload(), nil), p.Verify(context.Background(), set, EmptyPolicyPayload(), nil))
+	}
+}
+
+func Test_ApproverSet_Sorted(t *testing.T) {
+	require.Empty(t, BuildApproverSet(nil).Sorted())
+	require.Equal(t, []string{"a"}, ApproverSet{"a": true, "b": false}.Sorted())
+}
@@ -0,0 +1,81 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package policy
+
+import (
+	"crypto/ed25519"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// SignatureScheme verifies the signatures made by participants with a type
+// of key, for policies whose payload carries signatures of the participants
+// (rather than relying on the signers of the approval transactions).
+type SignatureScheme interface {
+	// Name returns the name of the scheme, e.g. "secp256k1".
+	Name() string
+
+	// ValidatePublicKey returns an error if pubkey isn't a public key of the
+	// scheme, in its canonical encoding.
+	ValidatePublicKey(pubkey []byte) error
+
+	// Verify reports whether sig is a valid signature of hash by pubkey.
+	Verify(pubkey, hash, sig []byte) bool
+}
+
+var (
+	// Secp256k1 is ECDSA over secp256k1 with compressed public keys and
+	// signatures in the 64 bytes [R || S] format. Signatures with high S
+	// values are rejected.
+	Secp256k1 SignatureScheme = secp256k1Scheme{}
+
+	// Ed25519 is EdDSA over Curve25519 with 32 bytes public keys, signing
+	// the hash as the message.
+	Ed25519 SignatureScheme = ed25519Scheme{}
+)
+
+type secp256k1Scheme struct{}
+
+func (secp256k1Scheme) Name() string { return "secp256k1" }
+
+func (secp256k1Scheme) ValidatePublicKey(pubkey []byte) error {
+	if len(pubkey) != 33 {
+		return fmt.Errorf("invalid secp256k1 public key length: %d", len(pubkey))
+	}
+	_, err := crypto.DecompressPubkey(pubkey)
+	return err
+}
+
+// secp256k1HalfN is half the order of the secp256k1 curve.
+var secp256k1HalfN = new(big.Int).Rsh(crypto.S256().Params().N, 1)
+
+func (secp256k1Scheme) Verify(pubkey, hash, sig []byte) bool {
+	return len(sig) == 64 && IsLowS(sig) && crypto.VerifySignature(pubkey, hash, sig)
+}
+
+// IsLowS reports whether the S value of a secp256k1 signature in the
+// [R || S] format is at most half the curve order, as required by EIP-2.
+// For every valid signature (R, S), (R, N - S) is also valid for the same
+// hash and key, so accepting both would let anyone turn an approval into a
+// different, equally valid, one.
+func IsLowS(sig []byte) bool {
+	return len(sig) >= 64 && new(big.Int).SetBytes(sig[32:64]).Cmp(secp256k1HalfN) <= 0
+}
+
+type ed25519Scheme struct{}
+
+func (ed25519Scheme) Name() string { return "ed25519" }
+
+func (ed25519Scheme) ValidatePublicKey(pubkey []byte) error {
+	if len(pubkey) != ed25519.PublicKeySize {
+		return fmt.Errorf("invalid ed25519 public key length: %d", len(pubkey))
+	}
+	return nil
+}
+
+func (ed25519Scheme) Verify(pubkey, hash, sig []byte) bool {
+	return len(pubkey) == ed25519.PublicKeySize && len(sig) == ed25519.SignatureSize &&
+		ed25519.Verify(pubkey, hash, sig)
+}
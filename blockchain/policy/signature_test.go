@@ -0,0 +1,84 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package policy
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_SignatureScheme(t *testing.T) {
+	hash := crypto.Keccak256([]byte("action"))
+	otherHash := crypto.Keccak256([]byte("other action"))
+
+	secpKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	secpPubkey := crypto.CompressPubkey(&secpKey.PublicKey)
+	secpSig, err := crypto.Sign(hash, secpKey)
+	require.NoError(t, err)
+	secpSig = secpSig[:64]
+
+	edPubkey, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	edSig := ed25519.Sign(edKey, hash)
+
+	t.Run("secp256k1", func(t *testing.T) {
+		require.NoError(t, Secp256k1.ValidatePublicKey(secpPubkey))
+		require.Error(t, Secp256k1.ValidatePublicKey(crypto.FromECDSAPub(&secpKey.PublicKey)))
+		require.Error(t, Secp256k1.ValidatePublicKey(edPubkey))
+
+		require.True(t, Secp256k1.Verify(secpPubkey, hash, secpSig))
+		require.False(t, Secp256k1.Verify(secpPubkey, otherHash, secpSig))
+		require.False(t, Secp256k1.Verify(secpPubkey, hash, append(secpSig, 0)))
+		require.False(t, Secp256k1.Verify(secpPubkey, hash, edSig))
+	})
+
+	t.Run("ed25519", func(t *testing.T) {
+		require.NoError(t, Ed25519.ValidatePublicKey(edPubkey))
+		require.Error(t, Ed25519.ValidatePublicKey(secpPubkey))
+
+		require.True(t, Ed25519.Verify(edPubkey, hash, edSig))
+		require.False(t, Ed25519.Verify(edPubkey, otherHash, edSig))
+		require.False(t, Ed25519.Verify(edPubkey, hash, secpSig[:63]))
+		require.False(t, Ed25519.Verify(secpPubkey, hash, edSig))
+	})
+}
+
+func Test_Secp256k1_HighS(t *testing.T) {
+	hash := crypto.Keccak256([]byte("action"))
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	pubkey := crypto.CompressPubkey(&key.PublicKey)
+
+	sig, err := crypto.Sign(hash, key)
+	require.NoError(t, err)
+	require.True(t, IsLowS(sig[:64]))
+
+	// (R, N - S) with the opposite recovery ID is the malleated signature
+	n := crypto.S256().Params().N
+	highS := new(big.Int).Sub(n, new(big.Int).SetBytes(sig[32:64]))
+	malleated := append(append([]byte{}, sig[:32]...), highS.FillBytes(make([]byte, 32))...)
+	malleated = append(malleated, sig[64]^1)
+	require.False(t, IsLowS(malleated[:64]))
+
+	// both signatures recover the same signer
+	signer, err := crypto.Ecrecover(hash, sig)
+	require.NoError(t, err)
+	malleatedSigner, err := crypto.Ecrecover(hash, malleated)
+	require.NoError(t, err)
+	require.Equal(t, signer, malleatedSigner)
+	require.Equal(t, crypto.FromECDSAPub(&key.PublicKey), signer)
+
+	require.True(t, Secp256k1.Verify(pubkey, hash, sig[:64]))
+	require.False(t, Secp256k1.Verify(pubkey, hash, malleated[:64]))
+
+	// S equal to half the order is still low
+	halfN := new(big.Int).Rsh(n, 1)
+	require.True(t, IsLowS(append(make([]byte, 32), halfN.FillBytes(make([]byte, 32))...)))
+	require.False(t, IsLowS(append(make([]byte, 32), new(big.Int).Add(halfN, big.NewInt(1)).FillBytes(make([]byte, 32))...)))
+}
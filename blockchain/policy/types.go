@@ -3,15 +3,34 @@
 package policy
 
 import (
+	"context"
 	"fmt"
+	"sort"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/gogoproto/proto"
 )
 
+// ApproverSet is the set of participants (by abbreviation) that approved an
+// action.
+//
+// Approvals are collected from transactions (e.g. MsgApproveAction), whose
+// signatures are checked once by the ante handler before reaching the policy
+// module, so building an ApproverSet costs a map lookup per approver.
+//
+// Some policies also take signatures in their payload (e.g. the keys of the
+// participants of a rotating key policy, or an oracle attestation), which
+// are checked with a SignatureScheme one at a time: ECDSA over secp256k1 has
+// no batch verification.
+//
+// The set is order-independent: the iteration order of the map is random,
+// so policies must not depend on it and should iterate over Sorted instead.
+// Otherwise nodes could reach different results for the same approvals.
 type ApproverSet map[string]bool
 
+// BuildApproverSet returns the set of the given approvers, dropping
+// duplicates. Sets built from the same approvers in any order are equal.
 func BuildApproverSet(approvers []string) ApproverSet {
 	approverSet := make(ApproverSet, len(approvers))
 	for _, a := range approvers {
@@ -20,9 +39,38 @@ func BuildApproverSet(approvers []string) ApproverSet {
 	return approverSet
 }
 
+// Sorted returns the approvers in the set in lexicographic order. It's the
+// canonical form of the set, e.g. for serialization.
+func (s ApproverSet) Sorted() []string {
+	sorted := make([]string, 0, len(s))
+	for a, approved := range s {
+		if approved {
+			sorted = append(sorted, a)
+		}
+	}
+	sort.Strings(sorted)
+	return sorted
+}
+
 type PolicyPayload struct {
 	cdc codec.BinaryCodec
 	any *cdctypes.Any
+
+	blockHeight uint64
+
+	lastTransferHeight uint64
+
+	windowStart     uint64
+	windowTransfers uint64
+
+	internalAddresses InternalAddressResolver
+}
+
+// InternalAddressResolver reports whether an address is controlled by one
+// of the wallets of the chain, e.g. the keys of the treasury module.
+// Addresses are encoded as the destination of transfers in the policy data.
+type InternalAddressResolver interface {
+	IsInternalAddress(addr []byte) bool
 }
 
 type PolicyPayloadI any
@@ -34,13 +82,74 @@ func NewPolicyPayload(cdc codec.BinaryCodec, any *cdctypes.Any) PolicyPayload {
 	}
 }
 
+// Any returns the wrapped payload, it can be nil.
+func (p PolicyPayload) Any() *cdctypes.Any {
+	return p.any
+}
+
+// WithBlockHeight returns a copy of p verified at the given block height.
+// The height comes from consensus, so policies can use it deterministically.
+func (p PolicyPayload) WithBlockHeight(height uint64) PolicyPayload {
+	p.blockHeight = height
+	return p
+}
+
+// BlockHeight returns the height of the block in which the policy is being
+// verified, or zero if unknown.
+func (p PolicyPayload) BlockHeight() uint64 {
+	return p.blockHeight
+}
+
+// WithLastTransferHeight returns a copy of p recording the height of the
+// last transfer approved by the policy, for policies limiting the rate of
+// transfers. The height comes from the keeper state.
+func (p PolicyPayload) WithLastTransferHeight(height uint64) PolicyPayload {
+	p.lastTransferHeight = height
+	return p
+}
+
+// LastTransferHeight returns the height of the block of the last transfer
+// approved by the policy, or zero if there was none or it's unknown.
+func (p PolicyPayload) LastTransferHeight() uint64 {
+	return p.lastTransferHeight
+}
+
+// WithTransferWindow returns a copy of p recording the current window of
+// transfers approved by the policy, i.e. the height of its first transfer
+// and the number of transfers, for policies limiting the number of
+// transfers. The window comes from the keeper state.
+func (p PolicyPayload) WithTransferWindow(start, count uint64) PolicyPayload {
+	p.windowStart = start
+	p.windowTransfers = count
+	return p
+}
+
+// TransferWindow returns the height of the first transfer of the current
+// window and the number of transfers approved in it, or zeros if there was
+// none or it's unknown.
+func (p PolicyPayload) TransferWindow() (start, count uint64) {
+	return p.windowStart, p.windowTransfers
+}
+
+// WithInternalAddresses returns a copy of p resolving the internal
+// addresses with resolver, for policies restricting the destinations of
+// transfers. The resolver is bound to the keeper state.
+func (p PolicyPayload) WithInternalAddresses(resolver InternalAddressResolver) PolicyPayload {
+	p.internalAddresses = resolver
+	return p
+}
+
+// InternalAddresses returns the resolver of the internal addresses, or nil
+// if unknown.
+func (p PolicyPayload) InternalAddresses() InternalAddressResolver {
+	return p.internalAddresses
+}
+
 func EmptyPolicyPayload() PolicyPayload {
 	return NewPolicyPayload(nil, nil)
 }
 
 func UnpackPayload[P PolicyPayloadI](p PolicyPayload) (*P, error) {
-	var payload P
-
 	if p.any != nil && p.cdc == nil {
 		return nil, fmt.Errorf("codec is nil")
 	}
@@ -49,12 +158,23 @@ func UnpackPayload[P PolicyPayloadI](p PolicyPayload) (*P, error) {
 		return nil, nil
 	}
 
+	// implementations are registered for the PolicyPayloadI interface, so
+	// unpack into it before asserting the concrete type
+	var payload PolicyPayloadI
 	err := p.cdc.UnpackAny(p.any, &payload)
 	if err != nil {
 		return nil, err
 	}
+	if payload == nil {
+		return nil, nil
+	}
+
+	typed, ok := payload.(*P)
+	if !ok {
+		return nil, fmt.Errorf("unexpected payload type %T", payload)
+	}
 
-	return &payload, nil
+	return typed, nil
 }
 
 type Policy interface {
@@ -70,7 +190,12 @@ type Policy interface {
 
 	// Verify tries to verify the current policy. The returned error is nil if
 	// the policy is valid.
-	Verify(approvers ApproverSet, payload PolicyPayload, policyData map[string][]byte) error
+	//
+	// Implementations that can take long or do I/O (e.g. calling external
+	// resolvers) must stop and return ctx.Err() once ctx is done, the others
+	// can ignore ctx. In transactions ctx has no deadline, so that the result
+	// is deterministic.
+	Verify(ctx context.Context, approvers ApproverSet, payload PolicyPayload, policyData map[string][]byte) error
 }
 
 type PolicyMetadata interface {
@@ -27,6 +27,7 @@ func PolicyKeeper(t testing.TB, db cbftdb.DB, stateStore storetypes.CommitMultiS
 	require.NoError(t, stateStore.LoadLatestVersion())
 
 	registry := codectypes.NewInterfaceRegistry()
+	types.RegisterInterfaces(registry)
 	cdc := codec.NewProtoCodec(registry)
 
 	ctx := sdk.NewContext(stateStore, cbftproto.Header{}, false, log.NewNopLogger())
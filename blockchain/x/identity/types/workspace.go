@@ -80,6 +80,18 @@ func (w *Workspace) PolicyNewSignTransactionRequest() policy.Policy {
 	return w.AnyOwnerPolicy()
 }
 
+func (w *Workspace) PolicyRotateWalletKey() policy.Policy {
+	return w.AnyOwnerPolicy()
+}
+
+func (w *Workspace) PolicyResetWalletNonce() policy.Policy {
+	return w.AnyOwnerPolicy()
+}
+
+func (w *Workspace) PolicyImportWalletPolicies() policy.Policy {
+	return w.AnyOwnerPolicy()
+}
+
 func (w *Workspace) PolicyUpdateWorkspace() policy.Policy {
 	return w.AnyOwnerPolicy()
 }
@@ -32,7 +32,10 @@ func GetQueryCmd(_ string) *cobra.Command {
 
 	cmd.AddCommand(CmdPolicies())
 	cmd.AddCommand(CmdPolicyById())
+	cmd.AddCommand(CmdDescribePolicy())
 	cmd.AddCommand(CmdActionsByAddress())
+	cmd.AddCommand(CmdPoliciesByParticipant())
+	cmd.AddCommand(CmdSimulatePolicy())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
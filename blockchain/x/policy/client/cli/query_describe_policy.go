@@ -0,0 +1,48 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package cli
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/qredo/fusionchain/x/policy/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdDescribePolicy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "describe-policy [id]",
+		Short: "Describe a policy in human-readable form",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			policyID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryDescribePolicyRequest{
+				Id: policyID,
+			}
+
+			res, err := queryClient.DescribePolicy(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
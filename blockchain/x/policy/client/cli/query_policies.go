@@ -13,6 +13,8 @@ import (
 
 var _ = strconv.Itoa(0)
 
+const flagPolicyType = "type"
+
 func CmdPolicies() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "policies",
@@ -29,10 +31,16 @@ func CmdPolicies() *cobra.Command {
 				return err
 			}
 
+			policyType, err := cmd.Flags().GetString(flagPolicyType)
+			if err != nil {
+				return err
+			}
+
 			queryClient := types.NewQueryClient(clientCtx)
 
 			params := &types.QueryPoliciesRequest{
-				Pagination: pageReq,
+				Pagination:    pageReq,
+				PolicyTypeUrl: policyType,
 			}
 
 			res, err := queryClient.Policies(cmd.Context(), params)
@@ -44,6 +52,8 @@ func CmdPolicies() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(flagPolicyType, "", "only return policies of the given type URL (e.g. /fusionchain.policy.BlackbirdPolicy)")
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
 	flags.AddQueryFlagsToCmd(cmd)
 
 	return cmd
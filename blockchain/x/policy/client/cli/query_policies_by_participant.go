@@ -0,0 +1,48 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/qredo/fusionchain/x/policy/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdPoliciesByParticipant() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "policies-by-participant [address]",
+		Short: "Query the policies in which an address is a participant",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryPoliciesByParticipantRequest{
+				Pagination: pageReq,
+				Address:    args[0],
+			}
+
+			res, err := queryClient.PoliciesByParticipant(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,151 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/qredo/fusionchain/policy"
+	"github.com/qredo/fusionchain/x/policy/types"
+	"github.com/spf13/cobra"
+)
+
+// simulatedTransfer is the transfer a policy is simulated against. Its
+// fields are passed to the policy as the treasury module does for sign
+// transaction requests.
+type simulatedTransfer struct {
+	Value  string `json:"value"`
+	Coin   string `json:"coin"`
+	MaxFee string `json:"max_fee"`
+}
+
+func (t *simulatedTransfer) policyData() map[string][]byte {
+	if t == nil {
+		return nil
+	}
+	policyData := map[string][]byte{
+		"TXVALUE": []byte(t.Value),
+		"TXCOIN":  []byte(t.Coin),
+	}
+	if t.MaxFee != "" {
+		policyData["TXMAXFEE"] = []byte(t.MaxFee)
+	}
+	return policyData
+}
+
+func CmdSimulatePolicy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate-policy [policy] [approvers]",
+		Short: "Verify a policy locally against a list of approvers",
+		Long: `Verify a policy locally against a comma-separated list of approvers,
+without connecting to a node.
+
+The policy is the hex-encoded data of a blackbird policy, or with --file the
+path of a JSON file containing any policy, e.g.
+{"@type":"/fusionchain.policy.BlackbirdPolicy","data":"..."}.
+
+A transfer can be given with --transfer, e.g.
+{"value":"1000","coin":"ETH","max_fee":"21000"}.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			registry := codectypes.NewInterfaceRegistry()
+			types.RegisterInterfaces(registry)
+			cdc := codec.NewProtoCodec(registry)
+
+			fromFile, err := cmd.Flags().GetBool("file")
+			if err != nil {
+				return err
+			}
+			p, err := simulatedPolicy(cdc, args[0], fromFile)
+			if err != nil {
+				return err
+			}
+
+			var approvers []string
+			if args[1] != "" {
+				approvers = strings.Split(args[1], ",")
+			}
+
+			rawTransfer, err := cmd.Flags().GetString("transfer")
+			if err != nil {
+				return err
+			}
+			var transfer *simulatedTransfer
+			if rawTransfer != "" {
+				transfer = new(simulatedTransfer)
+				if err := json.Unmarshal([]byte(rawTransfer), transfer); err != nil {
+					return fmt.Errorf("invalid transfer: %w", err)
+				}
+			}
+
+			out := cmd.OutOrStdout()
+			verifyErr := p.Verify(context.Background(), policy.BuildApproverSet(approvers), policy.EmptyPolicyPayload(), transfer.policyData())
+			if verifyErr == nil {
+				fmt.Fprintln(out, "satisfied: true")
+				return nil
+			}
+			fmt.Fprintln(out, "satisfied: false")
+			fmt.Fprintf(out, "reason: %s\n", verifyErr)
+
+			missing := types.MissingApprovers(p, policy.BuildApproverSet(approvers))
+			if len(missing) > 0 {
+				fmt.Fprintf(out, "missing approvers: %s\n", strings.Join(missing, ","))
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().Bool("file", false, "Read the policy from a JSON file")
+	cmd.Flags().String("transfer", "", "Transfer to verify the policy against, as JSON")
+
+	return cmd
+}
+
+// simulatedPolicy decodes the policy argument of CmdSimulatePolicy. The
+// policy is encoded and decoded again before being unpacked, so that it goes
+// through UnpackPolicy as it does on chain.
+func simulatedPolicy(cdc *codec.ProtoCodec, arg string, fromFile bool) (policy.Policy, error) {
+	var p policy.Policy
+	if fromFile {
+		bz, err := os.ReadFile(arg)
+		if err != nil {
+			return nil, err
+		}
+		if err := cdc.UnmarshalInterfaceJSON(bz, &p); err != nil {
+			return nil, fmt.Errorf("invalid policy: %w", err)
+		}
+	} else {
+		data, err := hexutil.Decode(arg)
+		if err != nil {
+			return nil, fmt.Errorf("invalid policy data: %w", err)
+		}
+		p = &types.BlackbirdPolicy{Data: data}
+	}
+
+	msg, ok := p.(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("invalid policy type %T", p)
+	}
+	policyAny, err := codectypes.NewAnyWithValue(msg)
+	if err != nil {
+		return nil, err
+	}
+	bz, err := cdc.Marshal(&types.Policy{Policy: policyAny})
+	if err != nil {
+		return nil, err
+	}
+	var policyPb types.Policy
+	if err := cdc.Unmarshal(bz, &policyPb); err != nil {
+		return nil, err
+	}
+
+	return types.UnpackPolicy(cdc, &policyPb)
+}
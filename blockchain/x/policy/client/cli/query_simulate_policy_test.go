@@ -0,0 +1,106 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package cli
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// testPolicyData is the blackbird policy of types.TestPolicy: any of foo
+// and bar.
+const testPolicyData = "0x080210011a0708032203666f6f1a0708032203626172"
+
+func runSimulatePolicy(t *testing.T, args ...string) (string, error) {
+	t.Helper()
+	cmd := CmdSimulatePolicy()
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetErr(&out)
+	cmd.SetArgs(args)
+	err := cmd.Execute()
+	return out.String(), err
+}
+
+func TestCmdSimulatePolicy(t *testing.T) {
+	maxFeePolicy := filepath.Join(t.TempDir(), "policy.json")
+	require.NoError(t, os.WriteFile(maxFeePolicy, []byte(`{
+		"@type": "/fusionchain.policy.MaxFeePolicy",
+		"ceilings": [{"symbol": "ETH", "max_fee": "1000"}],
+		"participants": [{"abbreviation": "foo", "address": "qredo1foo"}]
+	}`), 0o600))
+
+	tests := []struct {
+		name    string
+		args    []string
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "satisfied",
+			args: []string{testPolicyData, "foo"},
+			want: "satisfied: true\n",
+		},
+		{
+			name: "satisfied by the other approver",
+			args: []string{testPolicyData, "baz,bar"},
+			want: "satisfied: true\n",
+		},
+		{
+			name: "not satisfied",
+			args: []string{testPolicyData, "baz"},
+			want: "satisfied: false\nreason: Supplied witness is not a valid witness for the relevant policy.\nmissing approvers: bar\n",
+		},
+		{
+			name: "no approvers",
+			args: []string{testPolicyData, ""},
+			want: "satisfied: false\nreason: Supplied witness is not a valid witness for the relevant policy.\nmissing approvers: bar\n",
+		},
+		{
+			name: "transfer within the fee ceiling",
+			args: []string{maxFeePolicy, "foo", "--file", "--transfer", `{"value":"1","coin":"ETH","max_fee":"900"}`},
+			want: "satisfied: true\n",
+		},
+		{
+			name: "transfer above the fee ceiling",
+			args: []string{maxFeePolicy, "foo", "--file", "--transfer", `{"value":"1","coin":"ETH","max_fee":"1100"}`},
+			want: "satisfied: false\nreason: transaction fee 1100 exceeds the ceiling of 1000 for ETH\n",
+		},
+		{
+			name: "file policy without approvers",
+			args: []string{maxFeePolicy, "", "--file"},
+			want: "satisfied: false\nreason: no approvers\nmissing approvers: foo\n",
+		},
+		{
+			name:    "invalid hex",
+			args:    []string{"0xzz", "foo"},
+			wantErr: true,
+		},
+		{
+			name:    "missing file",
+			args:    []string{filepath.Join(t.TempDir(), "missing.json"), "foo", "--file"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid transfer",
+			args:    []string{testPolicyData, "foo", "--transfer", "{"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := runSimulatePolicy(t, tt.args...)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, out)
+		})
+	}
+}
@@ -28,6 +28,7 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdApproveAction())
 	cmd.AddCommand(CmdNewPolicy())
 	cmd.AddCommand(CmdRevokeAction())
+	cmd.AddCommand(CmdUpdatePolicy())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
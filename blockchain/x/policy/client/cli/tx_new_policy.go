@@ -31,21 +31,14 @@ func CmdNewPolicy() *cobra.Command {
 
 			name := args[0]
 
-			rawParticipants, err := cmd.Flags().GetStringSlice("participants")
+			participants, err := participantsFromFlags(cmd)
 			if err != nil {
 				return err
 			}
 
-			participants := make([]*types.PolicyParticipant, 0, len(rawParticipants))
-			for _, rawParticipant := range rawParticipants {
-				split := strings.Split(rawParticipant, ":")
-				if len(split) != 2 {
-					return fmt.Errorf("invalid participant: %s", rawParticipant)
-				}
-				participants = append(participants, &types.PolicyParticipant{
-					Abbreviation: split[0],
-					Address:      split[1],
-				})
+			adminPolicyID, err := cmd.Flags().GetUint64("admin-policy-id")
+			if err != nil {
+				return err
 			}
 
 			bbirdWrap := &types.BoolparserPolicy{
@@ -62,6 +55,7 @@ func CmdNewPolicy() *cobra.Command {
 				name,
 				policyPayload,
 			)
+			msg.AdminPolicyId = adminPolicyID
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -70,11 +64,34 @@ func CmdNewPolicy() *cobra.Command {
 	}
 
 	cmd.Flags().StringSliceP("participants", "p", []string{}, "List of participants (e.g. -p foo:qredo123,bar:qredo456)")
+	cmd.Flags().Uint64("admin-policy-id", 0, "ID of the policy that must approve updates to the new policy")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
 
+// participantsFromFlags parses the participants flag, in the form
+// abbreviation:address.
+func participantsFromFlags(cmd *cobra.Command) ([]*types.PolicyParticipant, error) {
+	rawParticipants, err := cmd.Flags().GetStringSlice("participants")
+	if err != nil {
+		return nil, err
+	}
+
+	participants := make([]*types.PolicyParticipant, 0, len(rawParticipants))
+	for _, rawParticipant := range rawParticipants {
+		split := strings.Split(rawParticipant, ":")
+		if len(split) != 2 {
+			return nil, fmt.Errorf("invalid participant: %s", rawParticipant)
+		}
+		participants = append(participants, &types.PolicyParticipant{
+			Abbreviation: split[0],
+			Address:      split[1],
+		})
+	}
+	return participants, nil
+}
+
 // Compile is a simple blackbird compiler that only implements a really small subset of the language, useful for local testing.
 func Compile(s string) (*protobuf.Policy, error) {
 	tokens := tokenize(s)
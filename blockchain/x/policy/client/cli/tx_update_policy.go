@@ -0,0 +1,69 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package cli
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/qredo/fusionchain/x/policy/types"
+	"github.com/spf13/cobra"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdUpdatePolicy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-policy [policy-id] [policy definition] [btl]",
+		Short: "Broadcast message update-policy",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			policyID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			btl, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			participants, err := participantsFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			policyPayload, err := codectypes.NewAnyWithValue(&types.BoolparserPolicy{
+				Definition:   args[1],
+				Participants: participants,
+			})
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdatePolicy(
+				clientCtx.GetFromAddress().String(),
+				policyID,
+				policyPayload,
+				btl,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().StringSliceP("participants", "p", []string{}, "List of participants (e.g. -p foo:qredo123,bar:qredo456)")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -5,6 +5,7 @@ package keeper
 import (
 	"fmt"
 	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -84,11 +85,32 @@ func TryExecuteAction[ReqT sdk.Msg, ResT any](
 		return nil, err
 	}
 
+	if act.Status != types.ActionStatus_ACTION_STATUS_PENDING {
+		return nil, fmt.Errorf("action %d is not pending", act.Id)
+	}
+
 	signersSet := policy.BuildApproverSet(act.Approvers)
 
-	if err := pol.Verify(signersSet, policy.NewPolicyPayload(cdc, payload), act.GetPolicyDataMap()); err == nil {
+	if err := k.checkPolicyNonce(ctx, act); err != nil {
+		return nil, err
+	}
+
+	policyData := act.GetPolicyDataMap()
+	recordTransfer := act.PolicyId != 0 && recordsTransfers(pol) && types.IsTransferData(policyData)
+	policyPayload := policy.NewPolicyPayload(cdc, payload).
+		WithBlockHeight(uint64(ctx.BlockHeight())).
+		WithInternalAddresses(k.internalAddresses(ctx, policyData))
+	if recordTransfer {
+		policyPayload = k.withTransferState(ctx, act.PolicyId, pol, policyPayload)
+	}
+	verifyErr := pol.Verify(ctx, signersSet, policyPayload, policyData)
+	emitVerificationEvent(ctx, pol, act, verifyErr)
+	if verifyErr == nil {
 		act.Status = types.ActionStatus_ACTION_STATUS_COMPLETED
 		k.SetAction(ctx, act)
+		if recordTransfer {
+			k.recordTransfer(ctx, act.PolicyId, pol)
+		}
 		return handlerFn(ctx, msg)
 	}
 
@@ -96,6 +118,28 @@ func TryExecuteAction[ReqT sdk.Msg, ResT any](
 	return &res, nil
 }
 
+// emitVerificationEvent emits a policy_verified or policy_rejected event with
+// the outcome of the verification of the policy of an action.
+func emitVerificationEvent(ctx sdk.Context, pol policy.Policy, act *types.Action, verifyErr error) {
+	missing := types.MissingApprovers(pol, policy.BuildApproverSet(act.Approvers))
+
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeyPolicyID, strconv.FormatUint(act.PolicyId, 10)),
+		sdk.NewAttribute(types.AttributeKeyActionID, strconv.FormatUint(act.Id, 10)),
+		sdk.NewAttribute(types.AttributeKeyActionType, act.Msg.GetTypeUrl()),
+		sdk.NewAttribute(types.AttributeKeyApprovers, strings.Join(act.Approvers, ",")),
+		sdk.NewAttribute(types.AttributeKeyMissing, strings.Join(missing, ",")),
+	}
+
+	eventType := types.EventTypePolicyVerified
+	if verifyErr != nil {
+		eventType = types.EventTypePolicyRejected
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeKeyReason, verifyErr.Error()))
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(eventType, attributes...))
+}
+
 func PolicyForAction(ctx sdk.Context, k *Keeper, act *types.Action) (policy.Policy, error) {
 	var (
 		pol policy.Policy
@@ -114,12 +158,7 @@ func PolicyForAction(ctx sdk.Context, k *Keeper, act *types.Action) (policy.Poli
 			return nil, err
 		}
 	} else {
-		p, ok := k.PolicyRepo().Get(ctx, act.PolicyId)
-		if !ok {
-			return nil, fmt.Errorf("policy not found: %d", act.PolicyId)
-		}
-
-		pol, err = types.UnpackPolicy(k.cdc, p)
+		pol, err = k.GetPolicy(ctx, act.PolicyId)
 		if err != nil {
 			return nil, err
 		}
@@ -128,6 +167,24 @@ func PolicyForAction(ctx sdk.Context, k *Keeper, act *types.Action) (policy.Poli
 	return pol, nil
 }
 
+// checkPolicyNonce returns an error if the nonce of the policy of the action
+// changed since the action was created, i.e. if the policy has been updated
+// in the meantime.
+func (k *Keeper) checkPolicyNonce(ctx sdk.Context, act *types.Action) error {
+	if act.PolicyId == 0 {
+		return nil
+	}
+
+	p, found := k.PolicyRepo().Get(ctx, act.PolicyId)
+	if !found {
+		return fmt.Errorf("policy not found: %d", act.PolicyId)
+	}
+	if p.Nonce != act.PolicyNonce {
+		return fmt.Errorf("policy nonce changed since the action was created (expected %d, got %d)", act.PolicyNonce, p.Nonce)
+	}
+	return nil
+}
+
 // AddAction creates a new action for the provided message with initial approvers.
 // Who calls this function should also immediately check if the action can be
 // executed with the provided initialApprovers, by calling TryExecuteAction.
@@ -155,6 +212,11 @@ func (k Keeper) AddAction(ctx sdk.Context, creator string, msg sdk.Msg, policyID
 		return nil, err
 	}
 
+	if policyID != 0 {
+		p, _ := k.PolicyRepo().Get(ctx, policyID)
+		act.PolicyNonce = p.Nonce
+	}
+
 	creatorAbbr, err := pol.AddressToParticipant(creator)
 	if err != nil {
 		return nil, err
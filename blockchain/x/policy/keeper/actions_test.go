@@ -0,0 +1,268 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/qredo/fusionchain/testutil/keeper"
+	"github.com/qredo/fusionchain/x/policy/keeper"
+	"github.com/qredo/fusionchain/x/policy/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTryExecuteAction_PolicyNonce(t *testing.T) {
+	keepers := keepertest.NewTest(t)
+	pk := keepers.PolicyKeeper
+	ctx := keepers.Ctx
+
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	types.RegisterInterfaces(interfaceRegistry)
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+
+	wrapped, err := codectypes.NewAnyWithValue(&types.BoolparserPolicy{
+		Definition: "t1 + t2 > 1",
+		Participants: []*types.PolicyParticipant{
+			{Abbreviation: "t1", Address: "qredo1alice"},
+			{Abbreviation: "t2", Address: "qredo1bob"},
+		},
+	})
+	require.NoError(t, err)
+	policyID := pk.PolicyRepo().Append(ctx, &types.Policy{Name: "t1 and t2", Policy: wrapped})
+
+	executed := 0
+	handler := func(sdk.Context, *types.MsgNewPolicy) (*types.MsgNewPolicyResponse, error) {
+		executed++
+		return &types.MsgNewPolicyResponse{}, nil
+	}
+	msg := &types.MsgNewPolicy{Creator: "qredo1alice", Name: "new policy"}
+
+	// two concurrent actions on the same policy
+	first, err := pk.AddAction(ctx, "qredo1alice", msg, policyID, 0, nil)
+	require.NoError(t, err)
+	second, err := pk.AddAction(ctx, "qredo1alice", msg, policyID, 0, nil)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), first.PolicyNonce)
+	require.Equal(t, uint64(0), second.PolicyNonce)
+
+	require.NoError(t, first.AddApprover("t2"))
+	_, err = keeper.TryExecuteAction(pk, cdc, ctx, first, nil, handler)
+	require.NoError(t, err)
+	require.Equal(t, types.ActionStatus_ACTION_STATUS_COMPLETED, first.Status)
+	require.Equal(t, 1, executed)
+
+	// completing the first action doesn't invalidate the second one
+	require.NoError(t, second.AddApprover("t2"))
+	_, err = keeper.TryExecuteAction(pk, cdc, ctx, second, nil, handler)
+	require.NoError(t, err)
+	require.Equal(t, types.ActionStatus_ACTION_STATUS_COMPLETED, second.Status)
+	require.Equal(t, 2, executed)
+
+	// the approvals of a completed action can't be used again
+	_, err = keeper.TryExecuteAction(pk, cdc, ctx, first, nil, handler)
+	require.Error(t, err)
+	require.Equal(t, 2, executed)
+
+	// updating the policy invalidates the pending actions
+	third, err := pk.AddAction(ctx, "qredo1alice", msg, policyID, 0, nil)
+	require.NoError(t, err)
+	p, found := pk.PolicyRepo().Get(ctx, policyID)
+	require.True(t, found)
+	p.Nonce++
+	pk.PolicyRepo().Set(ctx, p)
+	require.NoError(t, third.AddApprover("t2"))
+	_, err = keeper.TryExecuteAction(pk, cdc, ctx, third, nil, handler)
+	require.Error(t, err)
+	require.Equal(t, types.ActionStatus_ACTION_STATUS_PENDING, third.Status)
+	require.Equal(t, 2, executed)
+
+	// a new action picks up the current nonce
+	fourth, err := pk.AddAction(ctx, "qredo1alice", msg, policyID, 0, nil)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), fourth.PolicyNonce)
+	require.NoError(t, fourth.AddApprover("t2"))
+	_, err = keeper.TryExecuteAction(pk, cdc, ctx, fourth, nil, handler)
+	require.NoError(t, err)
+	require.Equal(t, 3, executed)
+}
+
+func TestTryExecuteAction_Events(t *testing.T) {
+	keepers := keepertest.NewTest(t)
+	pk := keepers.PolicyKeeper
+	ctx := keepers.Ctx
+
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	types.RegisterInterfaces(interfaceRegistry)
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+
+	wrapped, err := codectypes.NewAnyWithValue(&types.BoolparserPolicy{
+		Definition: "t1 + t2 + t3 > 1",
+		Participants: []*types.PolicyParticipant{
+			{Abbreviation: "t1", Address: "qredo1alice"},
+			{Abbreviation: "t2", Address: "qredo1bob"},
+			{Abbreviation: "t3", Address: "qredo1carol"},
+		},
+	})
+	require.NoError(t, err)
+	policyID := pk.PolicyRepo().Append(ctx, &types.Policy{Name: "2 of 3", Policy: wrapped})
+
+	handler := func(sdk.Context, *types.MsgNewPolicy) (*types.MsgNewPolicyResponse, error) {
+		return &types.MsgNewPolicyResponse{}, nil
+	}
+	act, err := pk.AddAction(ctx, "qredo1alice", &types.MsgNewPolicy{Creator: "qredo1alice"}, policyID, 0, nil)
+	require.NoError(t, err)
+
+	attributes := func(event sdk.Event) map[string]string {
+		m := make(map[string]string, len(event.Attributes))
+		for _, attr := range event.Attributes {
+			m[attr.Key] = attr.Value
+		}
+		return m
+	}
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err = keeper.TryExecuteAction(pk, cdc, ctx, act, nil, handler)
+	require.NoError(t, err)
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, types.EventTypePolicyRejected, events[0].Type)
+	attrs := attributes(events[0])
+	require.Equal(t, "1", attrs[types.AttributeKeyPolicyID])
+	require.Equal(t, "0", attrs[types.AttributeKeyActionID])
+	require.Equal(t, "/fusionchain.policy.MsgNewPolicy", attrs[types.AttributeKeyActionType])
+	require.Equal(t, "t1", attrs[types.AttributeKeyApprovers])
+	require.Equal(t, "t2,t3", attrs[types.AttributeKeyMissing])
+	require.NotEmpty(t, attrs[types.AttributeKeyReason])
+
+	require.NoError(t, act.AddApprover("t3"))
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err = keeper.TryExecuteAction(pk, cdc, ctx, act, nil, handler)
+	require.NoError(t, err)
+	events = ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, types.EventTypePolicyVerified, events[0].Type)
+	attrs = attributes(events[0])
+	require.Equal(t, "t1,t3", attrs[types.AttributeKeyApprovers])
+	require.Equal(t, "t2", attrs[types.AttributeKeyMissing])
+	require.NotContains(t, attrs, types.AttributeKeyReason)
+}
+
+func TestTryExecuteAction_Cooldown(t *testing.T) {
+	keepers := keepertest.NewTest(t)
+	pk := keepers.PolicyKeeper
+	ctx := keepers.Ctx.WithBlockHeight(100)
+
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	types.RegisterInterfaces(interfaceRegistry)
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+
+	wrapped, err := codectypes.NewAnyWithValue(&types.CooldownPolicy{
+		CooldownBlocks: 10,
+		Participants:   []*types.PolicyParticipant{{Abbreviation: "t1", Address: "qredo1alice"}},
+	})
+	require.NoError(t, err)
+	policyID := pk.PolicyRepo().Append(ctx, &types.Policy{Name: "cooldown", Policy: wrapped})
+
+	executed := 0
+	handler := func(sdk.Context, *types.MsgNewPolicy) (*types.MsgNewPolicyResponse, error) {
+		executed++
+		return &types.MsgNewPolicyResponse{}, nil
+	}
+	transfer := func(ctx sdk.Context) *types.Action {
+		act, err := pk.AddAction(ctx, "qredo1alice", &types.MsgNewPolicy{Creator: "qredo1alice"}, policyID, 0,
+			map[string][]byte{"TXCOIN": []byte("ETH"), "TXVALUE": []byte("1")})
+		require.NoError(t, err)
+		_, err = keeper.TryExecuteAction(pk, cdc, ctx, act, nil, handler)
+		require.NoError(t, err)
+		return act
+	}
+
+	require.Equal(t, uint64(0), pk.GetLastTransferHeight(ctx, policyID))
+	first := transfer(ctx)
+	require.Equal(t, types.ActionStatus_ACTION_STATUS_COMPLETED, first.Status)
+	require.Equal(t, uint64(100), pk.GetLastTransferHeight(ctx, policyID))
+
+	// a second transfer inside the window is rejected
+	second := transfer(ctx.WithBlockHeight(109))
+	require.Equal(t, types.ActionStatus_ACTION_STATUS_PENDING, second.Status)
+	require.Equal(t, 1, executed)
+	require.Equal(t, uint64(100), pk.GetLastTransferHeight(ctx, policyID))
+
+	// actions that are not transfers aren't limited
+	act, err := pk.AddAction(ctx, "qredo1alice", &types.MsgNewPolicy{Creator: "qredo1alice"}, policyID, 0, nil)
+	require.NoError(t, err)
+	_, err = keeper.TryExecuteAction(pk, cdc, ctx.WithBlockHeight(105), act, nil, handler)
+	require.NoError(t, err)
+	require.Equal(t, 2, executed)
+	require.Equal(t, uint64(100), pk.GetLastTransferHeight(ctx, policyID))
+
+	// once the cooldown has elapsed the next transfer is accepted
+	third := transfer(ctx.WithBlockHeight(110))
+	require.Equal(t, types.ActionStatus_ACTION_STATUS_COMPLETED, third.Status)
+	require.Equal(t, 3, executed)
+	require.Equal(t, uint64(110), pk.GetLastTransferHeight(ctx, policyID))
+}
+
+func TestTryExecuteAction_TxCountLimit(t *testing.T) {
+	keepers := keepertest.NewTest(t)
+	pk := keepers.PolicyKeeper
+	ctx := keepers.Ctx.WithBlockHeight(100)
+
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	types.RegisterInterfaces(interfaceRegistry)
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+
+	wrapped, err := codectypes.NewAnyWithValue(&types.TxCountLimitPolicy{
+		MaxTransfers: 2,
+		WindowBlocks: 10,
+		Participants: []*types.PolicyParticipant{{Abbreviation: "t1", Address: "qredo1alice"}},
+	})
+	require.NoError(t, err)
+	policyID := pk.PolicyRepo().Append(ctx, &types.Policy{Name: "count", Policy: wrapped})
+
+	executed := 0
+	handler := func(sdk.Context, *types.MsgNewPolicy) (*types.MsgNewPolicyResponse, error) {
+		executed++
+		return &types.MsgNewPolicyResponse{}, nil
+	}
+	transfer := func(ctx sdk.Context) *types.Action {
+		act, err := pk.AddAction(ctx, "qredo1alice", &types.MsgNewPolicy{Creator: "qredo1alice"}, policyID, 0,
+			map[string][]byte{"TXCOIN": []byte("ETH"), "TXVALUE": []byte("1")})
+		require.NoError(t, err)
+		_, err = keeper.TryExecuteAction(pk, cdc, ctx, act, nil, handler)
+		require.NoError(t, err)
+		return act
+	}
+	window := func() []uint64 {
+		start, count := pk.GetTransferWindow(ctx, policyID)
+		return []uint64{start, count}
+	}
+
+	require.Equal(t, []uint64{0, 0}, window())
+	require.Equal(t, types.ActionStatus_ACTION_STATUS_COMPLETED, transfer(ctx).Status)
+	require.Equal(t, types.ActionStatus_ACTION_STATUS_COMPLETED, transfer(ctx.WithBlockHeight(105)).Status)
+	require.Equal(t, []uint64{100, 2}, window())
+
+	// a third transfer inside the window is rejected
+	third := transfer(ctx.WithBlockHeight(109))
+	require.Equal(t, types.ActionStatus_ACTION_STATUS_PENDING, third.Status)
+	require.Equal(t, 2, executed)
+	require.Equal(t, []uint64{100, 2}, window())
+
+	// actions that are not transfers aren't limited or counted
+	act, err := pk.AddAction(ctx, "qredo1alice", &types.MsgNewPolicy{Creator: "qredo1alice"}, policyID, 0, nil)
+	require.NoError(t, err)
+	_, err = keeper.TryExecuteAction(pk, cdc, ctx.WithBlockHeight(109), act, nil, handler)
+	require.NoError(t, err)
+	require.Equal(t, 3, executed)
+	require.Equal(t, []uint64{100, 2}, window())
+
+	// once the window has ended a new one starts
+	fourth := transfer(ctx.WithBlockHeight(110))
+	require.Equal(t, types.ActionStatus_ACTION_STATUS_COMPLETED, fourth.Status)
+	require.Equal(t, 4, executed)
+	require.Equal(t, []uint64{110, 1}, window())
+}
@@ -0,0 +1,42 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/qredo/fusionchain/policy"
+	"github.com/qredo/fusionchain/x/policy/types"
+)
+
+// GetPolicy returns the unpacked policy with the given ID.
+func (k Keeper) GetPolicy(ctx sdk.Context, id uint64) (policy.Policy, error) {
+	p, ok := k.PolicyRepo().Get(ctx, id)
+	if !ok {
+		return nil, fmt.Errorf("policy not found: %d", id)
+	}
+	return types.UnpackPolicy(k.cdc, p)
+}
+
+// EvaluatePolicy verifies pol, the policy with the given ID or 0 for
+// generated policies, as TryExecuteAction would for an action with the
+// given policy data approved by the given addresses, without creating or
+// executing any action. Addresses that aren't participants of the policy
+// are ignored.
+func (k Keeper) EvaluatePolicy(ctx sdk.Context, policyID uint64, pol policy.Policy, approvers []string, policyData map[string][]byte) error {
+	abbreviations := make([]string, 0, len(approvers))
+	for _, addr := range approvers {
+		if abbreviation, err := pol.AddressToParticipant(addr); err == nil {
+			abbreviations = append(abbreviations, abbreviation)
+		}
+	}
+
+	payload := policy.EmptyPolicyPayload().
+		WithBlockHeight(uint64(ctx.BlockHeight())).
+		WithInternalAddresses(k.internalAddresses(ctx, policyData))
+	if policyID != 0 && recordsTransfers(pol) && types.IsTransferData(policyData) {
+		payload = k.withTransferState(ctx, policyID, pol, payload)
+	}
+	return pol.Verify(ctx, policy.BuildApproverSet(abbreviations), payload, policyData)
+}
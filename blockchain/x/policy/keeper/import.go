@@ -0,0 +1,59 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/qredo/fusionchain/x/policy/types"
+)
+
+// ExportPolicy returns the name and the canonical bytes (see
+// types.CanonicalBytes) of the policy with the given ID, which can be
+// stored on another chain with ImportPolicy.
+func (k Keeper) ExportPolicy(ctx sdk.Context, id uint64) (string, []byte, error) {
+	policyPb, found := k.PolicyRepo().Get(ctx, id)
+	if !found {
+		return "", nil, fmt.Errorf("policy not found: %d", id)
+	}
+	p, err := types.UnpackPolicy(k.cdc, policyPb)
+	if err != nil {
+		return "", nil, err
+	}
+	b, err := types.CanonicalBytes(p)
+	if err != nil {
+		return "", nil, err
+	}
+	return policyPb.Name, b, nil
+}
+
+// ImportPolicy stores a new policy with the given name from its canonical
+// bytes, as returned by ExportPolicy, and returns its ID. The policy is
+// unpacked and validated as for MsgNewPolicy, and must be satisfiable by its
+// participants as for MsgUpdatePolicy, since it can replace the admin policy
+// of a workspace.
+func (k Keeper) ImportPolicy(ctx sdk.Context, name string, canonical []byte) (uint64, error) {
+	var wrapped cdctypes.Any
+	if err := wrapped.Unmarshal(canonical); err != nil {
+		return 0, errorsmod.Wrap(types.ErrInvalidPolicyEncoding, err.Error())
+	}
+	policyPb, err := types.IngestPolicy(k.cdc, &types.Policy{Name: name, Policy: &wrapped})
+	if err != nil {
+		return 0, err
+	}
+	p, err := types.UnpackPolicy(k.cdc, policyPb)
+	if err != nil {
+		return 0, errorsmod.Wrap(types.ErrInvalidPolicyEncoding, err.Error())
+	}
+	if err := k.enforceMaxThreshold(ctx, p); err != nil {
+		return 0, err
+	}
+	if err := types.CheckSatisfiable(p); err != nil {
+		return 0, err
+	}
+	return k.PolicyRepo().Append(ctx, policyPb), nil
+}
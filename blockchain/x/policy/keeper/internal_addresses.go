@@ -0,0 +1,60 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package keeper
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/qredo/fusionchain/policy"
+)
+
+// RegisterInternalAddressResolver registers the resolver of the internal
+// addresses of a module (e.g. the wallets of the treasury), used by the
+// policies restricting the destinations of transfers (e.g.
+// InternalOnlyPolicy). resolverFn binds the resolver to the context and the
+// policy data of the action for which the policy is verified, so that
+// modules can restrict the internal addresses to the ones related to the
+// action (e.g. the wallets of the same workspace).
+func RegisterInternalAddressResolver(k *Keeper, module string, resolverFn func(sdk.Context, map[string][]byte) policy.InternalAddressResolver) {
+	if _, ok := k.internalAddressResolvers[module]; ok {
+		// As for the action handlers, this is called twice by the Cosmos
+		// SDK, the second call is ignored.
+		return
+	}
+	k.internalAddressResolvers[module] = resolverFn
+}
+
+// internalAddresses returns the resolver of the addresses of all the
+// registered modules in ctx for an action with the given policy data, or nil
+// if none is registered.
+func (k Keeper) internalAddresses(ctx sdk.Context, policyData map[string][]byte) policy.InternalAddressResolver {
+	if len(k.internalAddressResolvers) == 0 {
+		return nil
+	}
+	modules := make([]string, 0, len(k.internalAddressResolvers))
+	for module := range k.internalAddressResolvers {
+		modules = append(modules, module)
+	}
+	sort.Strings(modules)
+
+	resolvers := make(internalAddressResolvers, 0, len(modules))
+	for _, module := range modules {
+		resolvers = append(resolvers, k.internalAddressResolvers[module](ctx, policyData))
+	}
+	return resolvers
+}
+
+// internalAddressResolvers resolves an address as internal if any of the
+// resolvers does.
+type internalAddressResolvers []policy.InternalAddressResolver
+
+func (r internalAddressResolvers) IsInternalAddress(addr []byte) bool {
+	for _, resolver := range r {
+		if resolver.IsInternalAddress(addr) {
+			return true
+		}
+	}
+	return false
+}
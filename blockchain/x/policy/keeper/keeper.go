@@ -25,6 +25,10 @@ type (
 		paramstore              paramtypes.Subspace
 		actionHandlers          map[string]func(sdk.Context, *types.Action, *cdctypes.Any) (any, error)
 		policyGeneratorHandlers map[string]func(sdk.Context, *cdctypes.Any) (policy.Policy, error)
+
+		// internalAddressResolvers are the resolvers of the internal
+		// addresses by module, see RegisterInternalAddressResolver.
+		internalAddressResolvers map[string]func(sdk.Context, map[string][]byte) policy.InternalAddressResolver
 	}
 )
 
@@ -46,8 +50,9 @@ func NewKeeper(
 		memKey:     memKey,
 		paramstore: ps,
 
-		actionHandlers:          make(map[string]func(sdk.Context, *types.Action, *cdctypes.Any) (any, error)),
-		policyGeneratorHandlers: make(map[string]func(sdk.Context, *cdctypes.Any) (policy.Policy, error)),
+		actionHandlers:           make(map[string]func(sdk.Context, *types.Action, *cdctypes.Any) (any, error)),
+		policyGeneratorHandlers:  make(map[string]func(sdk.Context, *cdctypes.Any) (policy.Policy, error)),
+		internalAddressResolvers: make(map[string]func(sdk.Context, map[string][]byte) policy.InternalAddressResolver),
 	}
 }
 
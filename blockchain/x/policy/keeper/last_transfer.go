@@ -0,0 +1,83 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/qredo/fusionchain/policy"
+	"github.com/qredo/fusionchain/x/policy/types"
+)
+
+// GetLastTransferHeight returns the height of the block of the last transfer
+// approved by the policy, or zero if there was none. Only the transfers of
+// policies limiting the rate of transfers (e.g. CooldownPolicy) are
+// recorded.
+func (k Keeper) GetLastTransferHeight(ctx sdk.Context, policyID uint64) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.LastTransferKey))
+	bz := store.Get(sdk.Uint64ToBigEndian(policyID))
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) SetLastTransferHeight(ctx sdk.Context, policyID, height uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.LastTransferKey))
+	store.Set(sdk.Uint64ToBigEndian(policyID), sdk.Uint64ToBigEndian(height))
+}
+
+// GetTransferWindow returns the height of the first transfer of the current
+// window of a TxCountLimitPolicy and the number of transfers approved in
+// it, or zeros if there was none.
+func (k Keeper) GetTransferWindow(ctx sdk.Context, policyID uint64) (start, count uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TransferWindowKey))
+	bz := store.Get(sdk.Uint64ToBigEndian(policyID))
+	if len(bz) != 16 {
+		return 0, 0
+	}
+	return sdk.BigEndianToUint64(bz[:8]), sdk.BigEndianToUint64(bz[8:])
+}
+
+func (k Keeper) SetTransferWindow(ctx sdk.Context, policyID, start, count uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TransferWindowKey))
+	store.Set(sdk.Uint64ToBigEndian(policyID), append(sdk.Uint64ToBigEndian(start), sdk.Uint64ToBigEndian(count)...))
+}
+
+// recordsTransfers reports whether the transfers approved by the policy
+// must be recorded, see GetLastTransferHeight and GetTransferWindow.
+func recordsTransfers(pol policy.Policy) bool {
+	switch pol.(type) {
+	case *types.CooldownPolicy, *types.TxCountLimitPolicy:
+		return true
+	default:
+		return false
+	}
+}
+
+// withTransferState returns a copy of payload with the recorded transfers
+// of the policy needed to verify it.
+func (k Keeper) withTransferState(ctx sdk.Context, policyID uint64, pol policy.Policy, payload policy.PolicyPayload) policy.PolicyPayload {
+	switch pol.(type) {
+	case *types.CooldownPolicy:
+		return payload.WithLastTransferHeight(k.GetLastTransferHeight(ctx, policyID))
+	case *types.TxCountLimitPolicy:
+		return payload.WithTransferWindow(k.GetTransferWindow(ctx, policyID))
+	default:
+		return payload
+	}
+}
+
+// recordTransfer records a transfer approved by the policy in the current
+// block.
+func (k Keeper) recordTransfer(ctx sdk.Context, policyID uint64, pol policy.Policy) {
+	height := uint64(ctx.BlockHeight())
+	switch p := pol.(type) {
+	case *types.CooldownPolicy:
+		k.SetLastTransferHeight(ctx, policyID, height)
+	case *types.TxCountLimitPolicy:
+		start, count := k.GetTransferWindow(ctx, policyID)
+		start, count = p.CountTransfer(start, count, height)
+		k.SetTransferWindow(ctx, policyID, start, count)
+	}
+}
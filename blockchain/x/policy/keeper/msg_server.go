@@ -13,7 +13,15 @@ type msgServer struct {
 // NewMsgServerImpl returns an implementation of the MsgServer interface
 // for the provided Keeper.
 func NewMsgServerImpl(keeper Keeper) types.MsgServer {
-	return &msgServer{Keeper: keeper}
+	s := &msgServer{Keeper: keeper}
+
+	RegisterActionHandler(
+		&s.Keeper,
+		"/fusionchain.policy.MsgUpdatePolicy",
+		s.UpdatePolicyActionHandler,
+	)
+
+	return s
 }
 
 var _ types.MsgServer = msgServer{}
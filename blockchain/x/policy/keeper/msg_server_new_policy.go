@@ -4,6 +4,7 @@ package keeper
 
 import (
 	"context"
+	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/qredo/fusionchain/policy"
@@ -13,21 +14,44 @@ import (
 func (k msgServer) NewPolicy(goCtx context.Context, msg *types.MsgNewPolicy) (*types.MsgNewPolicyResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	var p policy.Policy
-	if err := k.cdc.UnpackAny(msg.Policy, &p); err != nil {
+	policyPb, err := types.IngestPolicy(k.cdc, &types.Policy{
+		Name:          msg.Name,
+		Policy:        msg.Policy,
+		AdminPolicyId: msg.AdminPolicyId,
+	})
+	if err != nil {
 		return nil, err
 	}
-	if err := p.Validate(); err != nil {
+	p, err := types.UnpackPolicy(k.cdc, policyPb)
+	if err != nil {
 		return nil, err
 	}
-
-	policyPb := &types.Policy{
-		Name:   msg.Name,
-		Policy: msg.Policy,
+	if err := k.enforceMaxThreshold(ctx, p); err != nil {
+		return nil, err
+	}
+	if msg.AdminPolicyId != 0 {
+		if _, found := k.PolicyRepo().Get(ctx, msg.AdminPolicyId); !found {
+			return nil, fmt.Errorf("admin policy not found: %d", msg.AdminPolicyId)
+		}
 	}
+
 	id := k.PolicyRepo().Append(ctx, policyPb)
 
 	return &types.MsgNewPolicyResponse{
 		Id: id,
 	}, nil
 }
+
+// enforceMaxThreshold checks the policy against the maximum threshold set by
+// governance, if any.
+func (k Keeper) enforceMaxThreshold(ctx sdk.Context, p policy.Policy) error {
+	maxThreshold := k.GetParams(ctx).MaxPolicyThreshold
+	if maxThreshold == 0 {
+		return nil
+	}
+
+	if bp, ok := p.(*types.BlackbirdPolicy); ok {
+		return bp.EnforceMaxThreshold(int(maxThreshold))
+	}
+	return nil
+}
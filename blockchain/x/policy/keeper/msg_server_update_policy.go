@@ -0,0 +1,75 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/qredo/fusionchain/x/policy/types"
+)
+
+func (k msgServer) UpdatePolicy(goCtx context.Context, msg *types.MsgUpdatePolicy) (*types.MsgUpdatePolicyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	current, found := k.PolicyRepo().Get(ctx, msg.PolicyId)
+	if !found {
+		return nil, fmt.Errorf("policy not found: %d", msg.PolicyId)
+	}
+
+	ingested, err := types.IngestPolicy(k.cdc, &types.Policy{Policy: msg.Policy})
+	if err != nil {
+		return nil, err
+	}
+	p, err := types.UnpackPolicy(k.cdc, ingested)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.enforceMaxThreshold(ctx, p); err != nil {
+		return nil, err
+	}
+	if err := types.CheckSatisfiable(p); err != nil {
+		return nil, err
+	}
+
+	policyData, err := types.PolicyUpdateData(p)
+	if err != nil {
+		return nil, err
+	}
+
+	act, err := k.AddAction(ctx, msg.Creator, msg, current.GoverningPolicyID(), msg.Btl, policyData)
+	if err != nil {
+		return nil, err
+	}
+	return k.UpdatePolicyActionHandler(ctx, act, &cdctypes.Any{})
+}
+
+func (k msgServer) UpdatePolicyActionHandler(ctx sdk.Context, act *types.Action, payload *cdctypes.Any) (*types.MsgUpdatePolicyResponse, error) {
+	return TryExecuteAction(
+		&k.Keeper,
+		k.cdc,
+		ctx,
+		act,
+		payload,
+		func(ctx sdk.Context, msg *types.MsgUpdatePolicy) (*types.MsgUpdatePolicyResponse, error) {
+			p, found := k.PolicyRepo().Get(ctx, msg.PolicyId)
+			if !found {
+				return nil, fmt.Errorf("policy not found: %d", msg.PolicyId)
+			}
+
+			p.Policy = msg.Policy
+			p, err := types.IngestPolicy(k.cdc, p)
+			if err != nil {
+				return nil, err
+			}
+			// approvals collected for the old definition must not be used
+			// with the new one
+			p.Nonce++
+			k.PolicyRepo().Set(ctx, p)
+
+			return &types.MsgUpdatePolicyResponse{}, nil
+		},
+	)
+}
@@ -0,0 +1,165 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package keeper_test
+
+import (
+	"testing"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/qredo/fusionchain/testutil/keeper"
+	"github.com/qredo/fusionchain/x/policy/keeper"
+	"github.com/qredo/fusionchain/x/policy/types"
+	"github.com/stretchr/testify/require"
+)
+
+const updatePolicyActionType = "/fusionchain.policy.MsgUpdatePolicy"
+
+func boolparserPolicy(t *testing.T, definition string, participants ...*types.PolicyParticipant) *codectypes.Any {
+	t.Helper()
+	wrapped, err := codectypes.NewAnyWithValue(&types.BoolparserPolicy{
+		Definition:   definition,
+		Participants: participants,
+	})
+	require.NoError(t, err)
+	return wrapped
+}
+
+func TestMsgServerUpdatePolicy(t *testing.T) {
+	alice := &types.PolicyParticipant{Abbreviation: "a", Address: "qredo1alice"}
+	bob := &types.PolicyParticipant{Abbreviation: "b", Address: "qredo1bob"}
+	carol := &types.PolicyParticipant{Abbreviation: "c", Address: "qredo1carol"}
+
+	t.Run("self governed", func(t *testing.T) {
+		keepers := keepertest.NewTest(t)
+		pk := keepers.PolicyKeeper
+		ms := keeper.NewMsgServerImpl(*pk)
+		wctx := sdk.WrapSDKContext(keepers.Ctx)
+
+		res, err := ms.NewPolicy(wctx, &types.MsgNewPolicy{
+			Creator: "qredo1alice",
+			Name:    "a and b",
+			Policy:  boolparserPolicy(t, "a + b > 1", alice, bob),
+		})
+		require.NoError(t, err)
+
+		newPolicy := boolparserPolicy(t, "a + b + c > 1", alice, bob, carol)
+
+		// carol isn't a participant of the current policy
+		_, err = ms.UpdatePolicy(wctx, types.NewMsgUpdatePolicy("qredo1carol", res.Id, newPolicy, 0))
+		require.Error(t, err)
+
+		// alice alone can't satisfy the current policy
+		_, err = ms.UpdatePolicy(wctx, types.NewMsgUpdatePolicy("qredo1alice", res.Id, newPolicy, 0))
+		require.NoError(t, err)
+		p, found := pk.PolicyRepo().Get(keepers.Ctx, res.Id)
+		require.True(t, found)
+		require.Equal(t, "a + b > 1", unpackBoolparser(t, p).Definition)
+
+		act, found := pk.GetAction(keepers.Ctx, updatePolicyActionType, 0)
+		require.True(t, found)
+		require.Equal(t, res.Id, act.PolicyId)
+		wantData, err := types.PolicyUpdateData(unpackBoolparser(t, &types.Policy{Policy: newPolicy}))
+		require.NoError(t, err)
+		require.Equal(t, wantData, act.GetPolicyDataMap())
+
+		// bob approves, the update is applied
+		approveRes, err := ms.ApproveAction(wctx, &types.MsgApproveAction{
+			Creator:    "qredo1bob",
+			ActionType: updatePolicyActionType,
+			ActionId:   act.Id,
+		})
+		require.NoError(t, err)
+		require.Equal(t, types.ActionStatus_ACTION_STATUS_COMPLETED.String(), approveRes.Status)
+
+		p, found = pk.PolicyRepo().Get(keepers.Ctx, res.Id)
+		require.True(t, found)
+		require.Equal(t, "a + b + c > 1", unpackBoolparser(t, p).Definition)
+		require.Equal(t, uint64(1), p.Nonce)
+	})
+
+	t.Run("admin policy", func(t *testing.T) {
+		keepers := keepertest.NewTest(t)
+		pk := keepers.PolicyKeeper
+		ms := keeper.NewMsgServerImpl(*pk)
+		wctx := sdk.WrapSDKContext(keepers.Ctx)
+
+		admin, err := ms.NewPolicy(wctx, &types.MsgNewPolicy{
+			Creator: "qredo1carol",
+			Name:    "admin",
+			Policy:  boolparserPolicy(t, "c > 0", carol),
+		})
+		require.NoError(t, err)
+
+		_, err = ms.NewPolicy(wctx, &types.MsgNewPolicy{
+			Creator:       "qredo1alice",
+			Name:          "unknown admin",
+			Policy:        boolparserPolicy(t, "a > 0", alice),
+			AdminPolicyId: 42,
+		})
+		require.Error(t, err)
+
+		res, err := ms.NewPolicy(wctx, &types.MsgNewPolicy{
+			Creator:       "qredo1alice",
+			Name:          "a or b",
+			Policy:        boolparserPolicy(t, "a + b > 0", alice, bob),
+			AdminPolicyId: admin.Id,
+		})
+		require.NoError(t, err)
+
+		newPolicy := boolparserPolicy(t, "a > 0", alice)
+
+		// alice could satisfy the policy, but not its admin policy
+		_, err = ms.UpdatePolicy(wctx, types.NewMsgUpdatePolicy("qredo1alice", res.Id, newPolicy, 0))
+		require.Error(t, err)
+
+		_, err = ms.UpdatePolicy(wctx, types.NewMsgUpdatePolicy("qredo1carol", res.Id, newPolicy, 0))
+		require.NoError(t, err)
+
+		p, found := pk.PolicyRepo().Get(keepers.Ctx, res.Id)
+		require.True(t, found)
+		require.Equal(t, "a > 0", unpackBoolparser(t, p).Definition)
+		require.Equal(t, admin.Id, p.AdminPolicyId)
+		// pending actions of the updated policy are invalidated
+		require.Equal(t, uint64(1), p.Nonce)
+	})
+
+	t.Run("lock out", func(t *testing.T) {
+		keepers := keepertest.NewTest(t)
+		pk := keepers.PolicyKeeper
+		ms := keeper.NewMsgServerImpl(*pk)
+		wctx := sdk.WrapSDKContext(keepers.Ctx)
+
+		res, err := ms.NewPolicy(wctx, &types.MsgNewPolicy{
+			Creator: "qredo1alice",
+			Name:    "a",
+			Policy:  boolparserPolicy(t, "a > 0", alice),
+		})
+		require.NoError(t, err)
+
+		// two approvals required, but there's a single participant
+		_, err = ms.UpdatePolicy(wctx, types.NewMsgUpdatePolicy("qredo1alice", res.Id, boolparserPolicy(t, "a + b > 1", alice), 0))
+		require.Error(t, err)
+
+		// no participants at all
+		_, err = ms.UpdatePolicy(wctx, types.NewMsgUpdatePolicy("qredo1alice", res.Id, boolparserPolicy(t, "a > 0"), 0))
+		require.Error(t, err)
+
+		p, found := pk.PolicyRepo().Get(keepers.Ctx, res.Id)
+		require.True(t, found)
+		require.Equal(t, "a > 0", unpackBoolparser(t, p).Definition)
+	})
+
+	t.Run("unknown policy", func(t *testing.T) {
+		ms, wctx := setupMsgServer(t)
+		_, err := ms.UpdatePolicy(wctx, types.NewMsgUpdatePolicy("qredo1alice", 42, boolparserPolicy(t, "a > 0", alice), 0))
+		require.Error(t, err)
+	})
+}
+
+func unpackBoolparser(t *testing.T, p *types.Policy) *types.BoolparserPolicy {
+	t.Helper()
+	var bp types.BoolparserPolicy
+	require.NoError(t, bp.Unmarshal(p.Policy.Value))
+	return &bp
+}
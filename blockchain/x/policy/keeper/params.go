@@ -8,8 +8,10 @@ import (
 )
 
 // GetParams get all parameters as types.Params
-func (Keeper) GetParams(sdk.Context) types.Params {
-	return types.NewParams()
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	params := types.DefaultParams()
+	k.paramstore.GetParamSetIfExists(ctx, &params)
+	return params
 }
 
 // SetParams set the params
@@ -0,0 +1,41 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/qredo/fusionchain/testutil/keeper"
+	"github.com/qredo/fusionchain/x/policy/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestDescribePolicyQuery(t *testing.T) {
+	keepers := keepertest.NewTest(t)
+	pk := keepers.PolicyKeeper
+	wctx := sdk.WrapSDKContext(keepers.Ctx)
+
+	id := appendBlackbirdPolicy(t, keepers, "treasury", []*types.PolicyParticipant{
+		{Abbreviation: "foo", Address: "qredo1foo"},
+		{Abbreviation: "bar", Address: "qredo1bar"},
+	})
+
+	res, err := pk.DescribePolicy(wctx, &types.QueryDescribePolicyRequest{Id: id})
+	require.NoError(t, err)
+	desc := res.Description
+	require.Equal(t, "treasury", desc.Name)
+	require.Equal(t, "any", desc.Root.Kind)
+	require.Equal(t, uint64(1), desc.Root.Threshold)
+	require.Len(t, desc.Root.Children, 2)
+	require.Equal(t, "qredo1foo", desc.Root.Children[0].Address)
+	require.Equal(t, "qredo1bar", desc.Root.Children[1].Address)
+
+	_, err = pk.DescribePolicy(wctx, &types.QueryDescribePolicyRequest{Id: id + 1})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = pk.DescribePolicy(wctx, nil)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -24,19 +24,24 @@ func (k Keeper) Policies(goCtx context.Context, req *types.QueryPoliciesRequest)
 	store := ctx.KVStore(k.storeKey)
 	policiesStore := prefix.NewStore(store, types.KeyPrefix(types.PolicyKey))
 
-	pageRes, err := query.Paginate(policiesStore, req.Pagination, func(key []byte, value []byte) error {
+	pageRes, err := query.FilteredPaginate(policiesStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
 		var policyPb types.Policy
 		if err := k.cdc.Unmarshal(value, &policyPb); err != nil {
-			return err
+			return false, err
 		}
 
-		res, err := types.NewPolicyResponse(k.cdc, &policyPb)
-		if err != nil {
-			return err
+		if req.PolicyTypeUrl != "" && policyPb.Policy.GetTypeUrl() != req.PolicyTypeUrl {
+			return false, nil
 		}
 
-		policies = append(policies, *res)
-		return nil
+		if accumulate {
+			res, err := types.NewPolicyResponse(k.cdc, &policyPb)
+			if err != nil {
+				return false, err
+			}
+			policies = append(policies, *res)
+		}
+		return true, nil
 	})
 
 	if err != nil {
@@ -0,0 +1,73 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/qredo/fusionchain/x/policy/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) PoliciesByParticipant(goCtx context.Context, req *types.QueryPoliciesByParticipantRequest) (*types.QueryPoliciesByParticipantResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	store := ctx.KVStore(k.storeKey)
+	policiesStore := prefix.NewStore(store, types.KeyPrefix(types.PolicyKey))
+
+	var (
+		policies []types.ParticipantPolicy
+		warnings []string
+	)
+
+	pageRes, err := query.FilteredPaginate(policiesStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var policyPb types.Policy
+		if err := k.cdc.Unmarshal(value, &policyPb); err != nil {
+			return false, err
+		}
+
+		pol, err := types.UnpackPolicy(k.cdc, &policyPb)
+		if err != nil {
+			// a single broken policy shouldn't prevent the participant from
+			// seeing the other ones
+			if accumulate {
+				warnings = append(warnings, fmt.Sprintf("skipped policy %d: %s", policyPb.Id, err))
+			}
+			return false, nil
+		}
+
+		abbr, err := pol.AddressToParticipant(req.Address)
+		if err != nil {
+			// address is not a participant in this policy
+			return false, nil
+		}
+
+		if accumulate {
+			policies = append(policies, types.ParticipantPolicy{
+				PolicyId:     policyPb.Id,
+				PolicyName:   policyPb.Name,
+				Abbreviation: abbr,
+			})
+		}
+		return true, nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPoliciesByParticipantResponse{
+		Pagination: pageRes,
+		Policies:   policies,
+		Warnings:   warnings,
+	}, nil
+}
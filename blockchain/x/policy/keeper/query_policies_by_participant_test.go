@@ -0,0 +1,73 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package keeper_test
+
+import (
+	"testing"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	keepertest "github.com/qredo/fusionchain/testutil/keeper"
+	"github.com/qredo/fusionchain/x/policy/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPoliciesByParticipantQuery(t *testing.T) {
+	keepers := keepertest.NewTest(t)
+	pk := keepers.PolicyKeeper
+	ctx := keepers.Ctx
+	wctx := sdk.WrapSDKContext(ctx)
+
+	fooBar := appendBlackbirdPolicy(t, keepers, "foo or bar", []*types.PolicyParticipant{
+		{Abbreviation: "foo", Address: "qredo1foo"},
+		{Abbreviation: "bar", Address: "qredo1bar"},
+	})
+	pk.PolicyRepo().Append(ctx, &types.Policy{
+		Name:   "broken",
+		Policy: &codectypes.Any{TypeUrl: "/fusionchain.policy.UnknownPolicy", Value: []byte{0x01}},
+	})
+	appendBlackbirdPolicy(t, keepers, "bar only", []*types.PolicyParticipant{
+		{Abbreviation: "foo", Address: "qredo1bar"},
+		{Abbreviation: "bar", Address: "qredo1baz"},
+	})
+
+	res, err := pk.PoliciesByParticipant(wctx, &types.QueryPoliciesByParticipantRequest{Address: "qredo1foo"})
+	require.NoError(t, err)
+	require.Equal(t, []types.ParticipantPolicy{
+		{PolicyId: fooBar, PolicyName: "foo or bar", Abbreviation: "foo"},
+	}, res.Policies)
+	require.Len(t, res.Warnings, 1)
+	require.Contains(t, res.Warnings[0], "skipped policy 2")
+
+	res, err = pk.PoliciesByParticipant(wctx, &types.QueryPoliciesByParticipantRequest{Address: "qredo1bar"})
+	require.NoError(t, err)
+	require.Equal(t, []types.ParticipantPolicy{
+		{PolicyId: 1, PolicyName: "foo or bar", Abbreviation: "bar"},
+		{PolicyId: 3, PolicyName: "bar only", Abbreviation: "foo"},
+	}, res.Policies)
+
+	res, err = pk.PoliciesByParticipant(wctx, &types.QueryPoliciesByParticipantRequest{Address: "qredo1nobody"})
+	require.NoError(t, err)
+	require.Empty(t, res.Policies)
+
+	_, err = pk.PoliciesByParticipant(wctx, nil)
+	require.Error(t, err)
+}
+
+// appendBlackbirdPolicy stores a "foo or bar" blackbird policy with the given
+// participants and returns its ID.
+func appendBlackbirdPolicy(t *testing.T, keepers *keepertest.KeeperTest, name string, participants []*types.PolicyParticipant) uint64 {
+	t.Helper()
+
+	wrapped, err := codectypes.NewAnyWithValue(&types.BlackbirdPolicy{
+		Data:         hexutil.MustDecode("0x080210011a0708032203666f6f1a0708032203626172"),
+		Participants: participants,
+	})
+	require.NoError(t, err)
+
+	return keepers.PolicyKeeper.PolicyRepo().Append(keepers.Ctx, &types.Policy{
+		Name:   name,
+		Policy: wrapped,
+	})
+}
@@ -0,0 +1,110 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package keeper_test
+
+import (
+	"testing"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	keepertest "github.com/qredo/fusionchain/testutil/keeper"
+	"github.com/qredo/fusionchain/x/policy/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPoliciesQuery(t *testing.T) {
+	keepers := keepertest.NewTest(t)
+	pk := keepers.PolicyKeeper
+	ctx := keepers.Ctx
+	wctx := sdk.WrapSDKContext(ctx)
+
+	participants := []*types.PolicyParticipant{
+		{Abbreviation: "foo", Address: "qredo1foo"},
+		{Abbreviation: "bar", Address: "qredo1bar"},
+	}
+	quorum, err := codectypes.NewAnyWithValue(&types.DestinationQuorumPolicy{
+		InternalThreshold: 1,
+		ExternalThreshold: 2,
+		Participants:      participants,
+	})
+	require.NoError(t, err)
+
+	// policies 1, 3, 5 and 7 are blackbird policies, 2, 4 and 6 are
+	// destination quorum policies
+	for i := 0; i < 7; i++ {
+		if i%2 == 0 {
+			appendBlackbirdPolicy(t, keepers, "blackbird", participants)
+		} else {
+			pk.PolicyRepo().Append(ctx, &types.Policy{Name: "quorum", Policy: quorum})
+		}
+	}
+
+	collect := func(req *types.QueryPoliciesRequest) []uint64 {
+		var ids []uint64
+		for {
+			res, err := pk.Policies(wctx, req)
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(res.Policies), int(req.Pagination.Limit))
+			for _, p := range res.Policies {
+				ids = append(ids, p.Policy.Id)
+			}
+			if len(res.Pagination.NextKey) == 0 {
+				return ids
+			}
+			req.Pagination = &query.PageRequest{Key: res.Pagination.NextKey, Limit: req.Pagination.Limit}
+		}
+	}
+
+	t.Run("all policies", func(t *testing.T) {
+		ids := collect(&types.QueryPoliciesRequest{Pagination: &query.PageRequest{Limit: 3}})
+		require.Equal(t, []uint64{1, 2, 3, 4, 5, 6, 7}, ids)
+	})
+
+	t.Run("first page", func(t *testing.T) {
+		res, err := pk.Policies(wctx, &types.QueryPoliciesRequest{Pagination: &query.PageRequest{Limit: 3, CountTotal: true}})
+		require.NoError(t, err)
+		require.Len(t, res.Policies, 3)
+		require.Equal(t, uint64(7), res.Pagination.Total)
+		// keys are the big endian encoding of the policy ID
+		require.Equal(t, sdk.Uint64ToBigEndian(4), res.Pagination.NextKey)
+		require.NotNil(t, res.Policies[0].Metadata)
+	})
+
+	t.Run("filtered by type", func(t *testing.T) {
+		ids := collect(&types.QueryPoliciesRequest{
+			Pagination:    &query.PageRequest{Limit: 2},
+			PolicyTypeUrl: "/fusionchain.policy.BlackbirdPolicy",
+		})
+		require.Equal(t, []uint64{1, 3, 5, 7}, ids)
+
+		ids = collect(&types.QueryPoliciesRequest{
+			Pagination:    &query.PageRequest{Limit: 2},
+			PolicyTypeUrl: "/fusionchain.policy.DestinationQuorumPolicy",
+		})
+		require.Equal(t, []uint64{2, 4, 6}, ids)
+	})
+
+	t.Run("filtered first page", func(t *testing.T) {
+		res, err := pk.Policies(wctx, &types.QueryPoliciesRequest{
+			Pagination:    &query.PageRequest{Limit: 2, CountTotal: true},
+			PolicyTypeUrl: "/fusionchain.policy.BlackbirdPolicy",
+		})
+		require.NoError(t, err)
+		require.Len(t, res.Policies, 2)
+		require.Equal(t, uint64(4), res.Pagination.Total)
+		require.Equal(t, sdk.Uint64ToBigEndian(5), res.Pagination.NextKey)
+	})
+
+	t.Run("unknown type", func(t *testing.T) {
+		res, err := pk.Policies(wctx, &types.QueryPoliciesRequest{
+			Pagination:    &query.PageRequest{Limit: 2},
+			PolicyTypeUrl: "/fusionchain.policy.UnknownPolicy",
+		})
+		require.NoError(t, err)
+		require.Empty(t, res.Policies)
+	})
+
+	_, err = pk.Policies(wctx, nil)
+	require.Error(t, err)
+}
@@ -80,6 +80,9 @@ type Action struct {
 	// approved or rejected.
 	Btl        uint64      `protobuf:"varint,7,opt,name=btl,proto3" json:"btl,omitempty"`
 	PolicyData []*KeyValue `protobuf:"bytes,8,rep,name=policy_data,json=policyData,proto3" json:"policy_data,omitempty"`
+	// Nonce of the policy when the action was created. The action can't be
+	// executed if the policy nonce changed since then.
+	PolicyNonce uint64 `protobuf:"varint,9,opt,name=policy_nonce,json=policyNonce,proto3" json:"policy_nonce,omitempty"`
 }
 
 func (m *Action) Reset()         { *m = Action{} }
@@ -171,6 +174,13 @@ func (m *Action) GetPolicyData() []*KeyValue {
 	return nil
 }
 
+func (m *Action) GetPolicyNonce() uint64 {
+	if m != nil {
+		return m.PolicyNonce
+	}
+	return 0
+}
+
 // KeyValue is a simple key/value pair.
 type KeyValue struct {
 	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
@@ -233,36 +243,36 @@ func init() {
 func init() { proto.RegisterFile("fusionchain/policy/action.proto", fileDescriptor_c3aebe4fda975471) }
 
 var fileDescriptor_c3aebe4fda975471 = []byte{
-	// 450 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xcf, 0x6e, 0xd3, 0x40,
-	0x10, 0xc6, 0xb3, 0x76, 0x9a, 0x26, 0x93, 0xaa, 0xb2, 0x56, 0x45, 0x6c, 0x68, 0x31, 0x56, 0x0f,
-	0xc8, 0x02, 0xc9, 0x96, 0xc2, 0x85, 0x0b, 0x87, 0x10, 0x2f, 0xc8, 0x2a, 0x4d, 0xa2, 0x8d, 0xd3,
-	0x03, 0x97, 0x68, 0x63, 0xbb, 0xa9, 0x45, 0xea, 0x35, 0xf6, 0xba, 0xc2, 0x6f, 0xc1, 0x9d, 0x0b,
-	0x8f, 0xc3, 0xb1, 0x47, 0x8e, 0x28, 0x79, 0x11, 0x64, 0xbb, 0x11, 0xa9, 0xc2, 0x6d, 0xe6, 0xfb,
-	0x7e, 0xf3, 0xc7, 0xde, 0x81, 0x17, 0xd7, 0x79, 0x16, 0x89, 0xd8, 0xbf, 0xe1, 0x51, 0x6c, 0x27,
-	0x62, 0x15, 0xf9, 0x85, 0xcd, 0x7d, 0x19, 0x89, 0xd8, 0x4a, 0x52, 0x21, 0x05, 0xc6, 0x3b, 0x80,
-	0x55, 0x03, 0xcf, 0x7a, 0x4b, 0x21, 0x96, 0xab, 0xd0, 0xae, 0x88, 0x45, 0x7e, 0x6d, 0xf3, 0xb8,
-	0xa8, 0xf1, 0xf3, 0x9f, 0x0a, 0xb4, 0x06, 0x55, 0x3d, 0x3e, 0x06, 0x25, 0x0a, 0x08, 0x32, 0x90,
-	0xd9, 0x64, 0x4a, 0x14, 0xe0, 0x33, 0xe8, 0xf0, 0x24, 0x49, 0xc5, 0x5d, 0x98, 0x66, 0x44, 0x31,
-	0x54, 0xb3, 0xc3, 0xfe, 0x09, 0xf8, 0x2d, 0xb4, 0x32, 0xc9, 0x65, 0x9e, 0x11, 0xd5, 0x40, 0xe6,
-	0x71, 0xdf, 0xb0, 0xf6, 0x07, 0x5b, 0x75, 0xe7, 0x69, 0xc5, 0xb1, 0x07, 0x1e, 0x9f, 0x42, 0xa7,
-	0xb6, 0xe7, 0x51, 0x40, 0x9a, 0xd5, 0xb8, 0x76, 0x2d, 0xb8, 0x01, 0x7e, 0x09, 0xea, 0x6d, 0xb6,
-	0x24, 0x07, 0x06, 0x32, 0xbb, 0xfd, 0x13, 0xab, 0x5e, 0xdc, 0xda, 0x2e, 0x6e, 0x0d, 0xe2, 0x82,
-	0x95, 0x00, 0x26, 0x70, 0xe8, 0xa7, 0x21, 0x97, 0x22, 0x25, 0x2d, 0x03, 0x99, 0x1d, 0xb6, 0x4d,
-	0xb1, 0x06, 0xea, 0x42, 0xae, 0xc8, 0x61, 0xd5, 0xb8, 0x0c, 0xf1, 0x3b, 0xe8, 0x3e, 0x0c, 0x0c,
-	0xb8, 0xe4, 0xa4, 0x6d, 0xa8, 0x66, 0xb7, 0x7f, 0xf6, 0xbf, 0x7d, 0x2f, 0xc2, 0xe2, 0x8a, 0xaf,
-	0xf2, 0x90, 0x41, 0x2d, 0x38, 0x5c, 0xf2, 0xf3, 0x3e, 0xb4, 0xb7, 0x7a, 0xd9, 0xfc, 0x4b, 0x58,
-	0x54, 0x3f, 0xa9, 0xc3, 0xca, 0x10, 0x9f, 0xc0, 0xc1, 0x5d, 0x69, 0x11, 0xc5, 0x40, 0xe6, 0x11,
-	0xab, 0x93, 0x57, 0x3f, 0x10, 0x1c, 0xed, 0x7e, 0x3c, 0x7e, 0x0e, 0xbd, 0xc1, 0xd0, 0x73, 0xc7,
-	0xa3, 0xf9, 0xd4, 0x1b, 0x78, 0xb3, 0xe9, 0x7c, 0x36, 0x9a, 0x4e, 0xe8, 0xd0, 0xfd, 0xe0, 0x52,
-	0x47, 0x6b, 0xe0, 0x1e, 0x3c, 0x79, 0x6c, 0x4f, 0xe8, 0xc8, 0x71, 0x47, 0x1f, 0x35, 0x84, 0x4f,
-	0xe1, 0xe9, 0x63, 0x6b, 0x38, 0xbe, 0x9c, 0x7c, 0xa2, 0x1e, 0x75, 0x34, 0x65, 0xbf, 0x8e, 0xd1,
-	0xab, 0xf1, 0x05, 0x75, 0x34, 0x75, 0xdf, 0xf2, 0xdc, 0x4b, 0x3a, 0x9e, 0x79, 0x5a, 0xf3, 0x3d,
-	0xfd, 0xb5, 0xd6, 0xd1, 0xfd, 0x5a, 0x47, 0x7f, 0xd6, 0x3a, 0xfa, 0xbe, 0xd1, 0x1b, 0xf7, 0x1b,
-	0xbd, 0xf1, 0x7b, 0xa3, 0x37, 0x3e, 0xbf, 0x5e, 0x46, 0xf2, 0x26, 0x5f, 0x58, 0xbe, 0xb8, 0xb5,
-	0xbf, 0xa6, 0x61, 0x20, 0xec, 0xdd, 0x7b, 0xfb, 0xb6, 0xbd, 0x38, 0x59, 0x24, 0x61, 0xb6, 0x68,
-	0x55, 0xcf, 0xf2, 0xe6, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x08, 0x20, 0xe1, 0x93, 0x94, 0x02,
-	0x00, 0x00,
+	// 460 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x52, 0xcf, 0x8e, 0x93, 0x40,
+	0x18, 0xef, 0x40, 0xb7, 0x5b, 0xbe, 0x36, 0x1b, 0x32, 0x59, 0xe3, 0xd4, 0x5d, 0x11, 0xf7, 0x60,
+	0x88, 0x26, 0x90, 0xd4, 0x8b, 0x17, 0x0f, 0xb5, 0xa0, 0x21, 0xeb, 0xd2, 0x86, 0xd2, 0x3d, 0x78,
+	0x69, 0xa6, 0xc0, 0x76, 0x89, 0x5d, 0x06, 0x61, 0xd8, 0xc8, 0x5b, 0x78, 0xf7, 0x71, 0xbc, 0x78,
+	0xdc, 0xa3, 0x47, 0xd3, 0xbe, 0x88, 0x01, 0xda, 0xd8, 0xa6, 0x7b, 0x9b, 0xf9, 0xfd, 0xfd, 0x32,
+	0xf3, 0xc1, 0x8b, 0x9b, 0x3c, 0x8b, 0x58, 0xec, 0xdf, 0xd2, 0x28, 0x36, 0x12, 0xb6, 0x8c, 0xfc,
+	0xc2, 0xa0, 0x3e, 0x8f, 0x58, 0xac, 0x27, 0x29, 0xe3, 0x0c, 0xe3, 0x1d, 0x81, 0x5e, 0x0b, 0x9e,
+	0xf5, 0x16, 0x8c, 0x2d, 0x96, 0xa1, 0x51, 0x29, 0xe6, 0xf9, 0x8d, 0x41, 0xe3, 0xa2, 0x96, 0x5f,
+	0xfc, 0x12, 0xa0, 0x35, 0xa8, 0xfc, 0xf8, 0x04, 0x84, 0x28, 0x20, 0x48, 0x45, 0x5a, 0xd3, 0x15,
+	0xa2, 0x00, 0x9f, 0x83, 0x44, 0x93, 0x24, 0x65, 0xf7, 0x61, 0x9a, 0x11, 0x41, 0x15, 0x35, 0xc9,
+	0xfd, 0x0f, 0xe0, 0x77, 0xd0, 0xca, 0x38, 0xe5, 0x79, 0x46, 0x44, 0x15, 0x69, 0x27, 0x7d, 0x55,
+	0x3f, 0x2c, 0xd6, 0xeb, 0xe4, 0x49, 0xa5, 0x73, 0x37, 0x7a, 0x7c, 0x06, 0x52, 0x4d, 0xcf, 0xa2,
+	0x80, 0x34, 0xab, 0xba, 0x76, 0x0d, 0xd8, 0x01, 0x7e, 0x05, 0xe2, 0x5d, 0xb6, 0x20, 0x47, 0x2a,
+	0xd2, 0x3a, 0xfd, 0x53, 0xbd, 0x1e, 0x5c, 0xdf, 0x0e, 0xae, 0x0f, 0xe2, 0xc2, 0x2d, 0x05, 0x98,
+	0xc0, 0xb1, 0x9f, 0x86, 0x94, 0xb3, 0x94, 0xb4, 0x54, 0xa4, 0x49, 0xee, 0xf6, 0x8a, 0x65, 0x10,
+	0xe7, 0x7c, 0x49, 0x8e, 0xab, 0xe0, 0xf2, 0x88, 0xdf, 0x43, 0x67, 0x53, 0x18, 0x50, 0x4e, 0x49,
+	0x5b, 0x15, 0xb5, 0x4e, 0xff, 0xfc, 0xb1, 0x79, 0x2f, 0xc3, 0xe2, 0x9a, 0x2e, 0xf3, 0xd0, 0x85,
+	0x1a, 0x30, 0x29, 0xa7, 0xf8, 0x25, 0x74, 0x37, 0xf6, 0x98, 0xc5, 0x7e, 0x48, 0xa4, 0x2a, 0x79,
+	0x13, 0xe9, 0x94, 0xd0, 0x45, 0x1f, 0xda, 0x5b, 0x6b, 0xd9, 0xff, 0x35, 0x2c, 0xaa, 0x77, 0x94,
+	0xdc, 0xf2, 0x88, 0x4f, 0xe1, 0xe8, 0xbe, 0xa4, 0x88, 0xa0, 0x22, 0xad, 0xeb, 0xd6, 0x97, 0xd7,
+	0x3f, 0x11, 0x74, 0x77, 0xdf, 0x07, 0x3f, 0x87, 0xde, 0x60, 0xe8, 0xd9, 0x23, 0x67, 0x36, 0xf1,
+	0x06, 0xde, 0x74, 0x32, 0x9b, 0x3a, 0x93, 0xb1, 0x35, 0xb4, 0x3f, 0xda, 0x96, 0x29, 0x37, 0x70,
+	0x0f, 0x9e, 0xec, 0xd3, 0x63, 0xcb, 0x31, 0x6d, 0xe7, 0x93, 0x8c, 0xf0, 0x19, 0x3c, 0xdd, 0xa7,
+	0x86, 0xa3, 0xab, 0xf1, 0x67, 0xcb, 0xb3, 0x4c, 0x59, 0x38, 0xf4, 0xb9, 0xd6, 0xf5, 0xe8, 0xd2,
+	0x32, 0x65, 0xf1, 0x90, 0xf2, 0xec, 0x2b, 0x6b, 0x34, 0xf5, 0xe4, 0xe6, 0x07, 0xeb, 0xf7, 0x4a,
+	0x41, 0x0f, 0x2b, 0x05, 0xfd, 0x5d, 0x29, 0xe8, 0xc7, 0x5a, 0x69, 0x3c, 0xac, 0x95, 0xc6, 0x9f,
+	0xb5, 0xd2, 0xf8, 0xf2, 0x66, 0x11, 0xf1, 0xdb, 0x7c, 0xae, 0xfb, 0xec, 0xce, 0xf8, 0x96, 0x86,
+	0x01, 0x33, 0x76, 0x57, 0xf2, 0xfb, 0x76, 0x29, 0x79, 0x91, 0x84, 0xd9, 0xbc, 0x55, 0xfd, 0xdc,
+	0xdb, 0x7f, 0x03, 0x00, 0xbf, 0xa7, 0x3c, 0x24, 0xb7, 0x02, 0x00, 0x00,
 }
 
 func (m *Action) Marshal() (dAtA []byte, err error) {
@@ -285,6 +295,11 @@ func (m *Action) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PolicyNonce != 0 {
+		i = encodeVarintAction(dAtA, i, uint64(m.PolicyNonce))
+		i--
+		dAtA[i] = 0x48
+	}
 	if len(m.PolicyData) > 0 {
 		for iNdEx := len(m.PolicyData) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -436,6 +451,9 @@ func (m *Action) Size() (n int) {
 			n += 1 + l + sovAction(uint64(l))
 		}
 	}
+	if m.PolicyNonce != 0 {
+		n += 1 + sovAction(uint64(m.PolicyNonce))
+	}
 	return n
 }
 
@@ -701,6 +719,25 @@ func (m *Action) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyNonce", wireType)
+			}
+			m.PolicyNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PolicyNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAction(dAtA[iNdEx:])
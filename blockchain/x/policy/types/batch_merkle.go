@@ -0,0 +1,102 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+)
+
+// Prefixes of the hashes of leaves and inner nodes of batch Merkle trees,
+// so that an inner node can't be passed off as a leaf.
+const (
+	batchLeafPrefix = 0x00
+	batchNodePrefix = 0x01
+)
+
+// BatchMerkleRoot returns the Merkle root of a batch of transfers, given
+// their signing hashes, for BatchApprovalPolicy. It returns nil for an
+// empty batch.
+//
+// Leaves are the SHA-256 hashes of the signing hashes, and each inner node
+// the SHA-256 hash of its children in ascending order, so that proofs don't
+// need to record the side of each sibling. A node without a sibling is
+// carried up to the next level unchanged.
+func BatchMerkleRoot(hashes [][]byte) []byte {
+	level := batchLeaves(hashes)
+	if len(level) == 0 {
+		return nil
+	}
+	for len(level) > 1 {
+		level = batchNextLevel(level)
+	}
+	return level[0]
+}
+
+// BatchMerkleProof returns the proof of inclusion of the i-th signing hash
+// in the Merkle root of the batch, see BatchMerkleRoot. It returns nil if i
+// is out of range.
+func BatchMerkleProof(hashes [][]byte, i int) [][]byte {
+	if i < 0 || i >= len(hashes) {
+		return nil
+	}
+	proof := [][]byte{}
+	level := batchLeaves(hashes)
+	for len(level) > 1 {
+		if sibling := i ^ 1; sibling < len(level) {
+			proof = append(proof, level[sibling])
+		}
+		level = batchNextLevel(level)
+		i /= 2
+	}
+	return proof
+}
+
+// VerifyBatchMerkleProof returns true if proof proves that the signing hash
+// is in the batch with the given Merkle root.
+func VerifyBatchMerkleProof(root, hash []byte, proof [][]byte) bool {
+	node := batchLeaf(hash)
+	for _, sibling := range proof {
+		if len(sibling) != sha256.Size {
+			return false
+		}
+		node = batchNode(node, sibling)
+	}
+	return bytes.Equal(node, root)
+}
+
+func batchLeaves(hashes [][]byte) [][]byte {
+	leaves := make([][]byte, len(hashes))
+	for i, hash := range hashes {
+		leaves[i] = batchLeaf(hash)
+	}
+	return leaves
+}
+
+func batchNextLevel(level [][]byte) [][]byte {
+	next := make([][]byte, 0, (len(level)+1)/2)
+	for i := 0; i < len(level); i += 2 {
+		if i+1 == len(level) {
+			next = append(next, level[i])
+		} else {
+			next = append(next, batchNode(level[i], level[i+1]))
+		}
+	}
+	return next
+}
+
+func batchLeaf(hash []byte) []byte {
+	sum := sha256.Sum256(append([]byte{batchLeafPrefix}, hash...))
+	return sum[:]
+}
+
+func batchNode(a, b []byte) []byte {
+	if bytes.Compare(a, b) > 0 {
+		a, b = b, a
+	}
+	h := sha256.New()
+	h.Write([]byte{batchNodePrefix})
+	h.Write(a)
+	h.Write(b)
+	return h.Sum(nil)
+}
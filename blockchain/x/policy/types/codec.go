@@ -16,13 +16,27 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgApproveAction{}, "policy/ApproveAction", nil)
 	cdc.RegisterConcrete(&MsgNewPolicy{}, "policy/MsgNewPolicy", nil)
 	cdc.RegisterConcrete(&MsgRevokeAction{}, "policy/MsgRevokeAction", nil)
+	cdc.RegisterConcrete(&MsgUpdatePolicy{}, "policy/MsgUpdatePolicy", nil)
 	// this line is used by starport scaffolding # 2
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*policy.Policy)(nil), &BlackbirdPolicy{})
 	registry.RegisterImplementations((*policy.Policy)(nil), &BoolparserPolicy{})
+	registry.RegisterImplementations((*policy.Policy)(nil), &OracleAttestationPolicy{})
+	registry.RegisterImplementations((*policy.Policy)(nil), &DestinationQuorumPolicy{})
+	registry.RegisterImplementations((*policy.Policy)(nil), &MaxFeePolicy{})
+	registry.RegisterImplementations((*policy.Policy)(nil), &RotatingKeyPolicy{})
+	registry.RegisterImplementations((*policy.Policy)(nil), &GroupedQuorumPolicy{})
+	registry.RegisterImplementations((*policy.Policy)(nil), &CooldownPolicy{})
+	registry.RegisterImplementations((*policy.Policy)(nil), &BatchApprovalPolicy{})
+	registry.RegisterImplementations((*policy.Policy)(nil), &TxCountLimitPolicy{})
+	registry.RegisterImplementations((*policy.Policy)(nil), &InternalOnlyPolicy{})
 	registry.RegisterImplementations((*policy.PolicyPayloadI)(nil), &BlackbirdPolicyPayload{})
+	registry.RegisterImplementations((*policy.PolicyPayloadI)(nil), &OracleAttestationPolicyPayload{})
+	registry.RegisterImplementations((*policy.PolicyPayloadI)(nil), &DestinationQuorumPolicyPayload{})
+	registry.RegisterImplementations((*policy.PolicyPayloadI)(nil), &RotatingKeyPolicyPayload{})
+	registry.RegisterImplementations((*policy.PolicyPayloadI)(nil), &BatchApprovalPolicyPayload{})
 	registry.RegisterImplementations((*any)(nil),
 		&BlackbirdPolicyMetadata{},
 	)
@@ -36,6 +50,9 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgRevokeAction{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgUpdatePolicy{},
+	)
 	// this line is used by starport scaffolding # 3
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
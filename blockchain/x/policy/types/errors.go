@@ -10,5 +10,7 @@ import (
 
 // x/policy module sentinel errors
 var (
-	ErrPolicyValidation = sdkerrors.Register(ModuleName, 1200, "policy validation required")
+	ErrPolicyValidation      = sdkerrors.Register(ModuleName, 1200, "policy validation required")
+	ErrInvalidPolicyEncoding = sdkerrors.Register(ModuleName, 1201, "invalid policy encoding")
+	ErrInvalidPolicy         = sdkerrors.Register(ModuleName, 1202, "invalid policy")
 )
@@ -0,0 +1,16 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package types
+
+// policy events
+const (
+	EventTypePolicyVerified = "policy_verified"
+	EventTypePolicyRejected = "policy_rejected"
+
+	AttributeKeyPolicyID   = "policy_id"
+	AttributeKeyActionID   = "action_id"
+	AttributeKeyActionType = "action_type"
+	AttributeKeyApprovers  = "approvers"
+	AttributeKeyMissing    = "missing"
+	AttributeKeyReason     = "reason"
+)
@@ -20,6 +20,9 @@ const (
 
 	PolicyCountKey = "policy/count"
 	PolicyKey      = "policy/value/"
+
+	LastTransferKey   = "policy/last_transfer/"
+	TransferWindowKey = "policy/transfer_window/"
 )
 
 func KeyPrefix(p string) []byte {
@@ -0,0 +1,58 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgUpdatePolicy = "update_policy"
+
+var _ sdk.Msg = &MsgUpdatePolicy{}
+
+func NewMsgUpdatePolicy(creator string, policyID uint64, policy *codectypes.Any, btl uint64) *MsgUpdatePolicy {
+	return &MsgUpdatePolicy{
+		Creator:  creator,
+		PolicyId: policyID,
+		Policy:   policy,
+		Btl:      btl,
+	}
+}
+
+func (msg *MsgUpdatePolicy) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUpdatePolicy) Type() string {
+	return TypeMsgUpdatePolicy
+}
+
+func (msg *MsgUpdatePolicy) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgUpdatePolicy) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUpdatePolicy) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.PolicyId == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "missing policy id")
+	}
+	if msg.Policy == nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "missing policy")
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+
+package types
+
+import (
+	"testing"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/qredo/fusionchain/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgUpdatePolicy_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgUpdatePolicy
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgUpdatePolicy{
+				Creator: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "missing policy id",
+			msg: MsgUpdatePolicy{
+				Creator: sample.AccAddress(),
+				Policy:  &codectypes.Any{},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "missing policy",
+			msg: MsgUpdatePolicy{
+				Creator:  sample.AccAddress(),
+				PolicyId: 1,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid",
+			msg: MsgUpdatePolicy{
+				Creator:  sample.AccAddress(),
+				PolicyId: 1,
+				Policy:   &codectypes.Any{},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
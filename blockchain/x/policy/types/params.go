@@ -3,35 +3,44 @@
 package types
 
 import (
+	"fmt"
+
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"gopkg.in/yaml.v2"
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
 
+// KeyMaxPolicyThreshold is the store key of the MaxPolicyThreshold param
+var KeyMaxPolicyThreshold = []byte("MaxPolicyThreshold")
+
 // ParamKeyTable the param key table for launch module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
 // NewParams creates a new Params instance
-func NewParams() Params {
-	return Params{}
+func NewParams(maxPolicyThreshold uint32) Params {
+	return Params{
+		MaxPolicyThreshold: maxPolicyThreshold,
+	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(0)
 }
 
 // ParamSetPairs get the params.ParamSet
-func (Params) ParamSetPairs() paramtypes.ParamSetPairs {
-	return paramtypes.ParamSetPairs{}
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyMaxPolicyThreshold, &p.MaxPolicyThreshold, validateMaxPolicyThreshold),
+	}
 }
 
 // Validate validates the set of params
-func (Params) Validate() error {
-	return nil
+func (p Params) Validate() error {
+	return validateMaxPolicyThreshold(p.MaxPolicyThreshold)
 }
 
 // String implements the Stringer interface.
@@ -39,3 +48,10 @@ func (p Params) String() string {
 	out, _ := yaml.Marshal(p)
 	return string(out)
 }
+
+func validateMaxPolicyThreshold(i interface{}) error {
+	if _, ok := i.(uint32); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
@@ -25,6 +25,9 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the module.
 type Params struct {
+	// max_policy_threshold is the maximum number of approvers a policy can
+	// require. Zero means no maximum.
+	MaxPolicyThreshold uint32 `protobuf:"varint,1,opt,name=max_policy_threshold,json=maxPolicyThreshold,proto3" json:"max_policy_threshold,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -59,6 +62,13 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetMaxPolicyThreshold() uint32 {
+	if m != nil {
+		return m.MaxPolicyThreshold
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "fusionchain.policy.Params")
 }
@@ -66,17 +76,19 @@ func init() {
 func init() { proto.RegisterFile("fusionchain/policy/params.proto", fileDescriptor_189e6e521085a643) }
 
 var fileDescriptor_189e6e521085a643 = []byte{
-	// 152 bytes of a gzipped FileDescriptorProto
+	// 186 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4f, 0x2b, 0x2d, 0xce,
 	0xcc, 0xcf, 0x4b, 0xce, 0x48, 0xcc, 0xcc, 0xd3, 0x2f, 0xc8, 0xcf, 0xc9, 0x4c, 0xae, 0xd4, 0x2f,
 	0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x42, 0x52, 0xa0,
 	0x07, 0x51, 0x20, 0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x96, 0xd6, 0x07, 0xb1, 0x20, 0x2a, 0x95,
-	0xf8, 0xb8, 0xd8, 0x02, 0xc0, 0x3a, 0xad, 0x58, 0x66, 0x2c, 0x90, 0x67, 0x70, 0x72, 0x3d, 0xf1,
-	0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8,
-	0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0xed, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24,
-	0xbd, 0xe4, 0xfc, 0x5c, 0xfd, 0xc2, 0xa2, 0xd4, 0x94, 0x7c, 0x7d, 0x64, 0x57, 0x54, 0xc0, 0xdc,
-	0x51, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0x36, 0xdd, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff,
-	0xf7, 0x05, 0x01, 0x10, 0xaa, 0x00, 0x00, 0x00,
+	0x1c, 0xb8, 0xd8, 0x02, 0xc0, 0x3a, 0x85, 0x0c, 0xb8, 0x44, 0x72, 0x13, 0x2b, 0xe2, 0x21, 0xaa,
+	0xe3, 0x4b, 0x32, 0x8a, 0x52, 0x8b, 0x33, 0xf2, 0x73, 0x52, 0x24, 0x18, 0x15, 0x18, 0x35, 0x78,
+	0x83, 0x84, 0x72, 0x13, 0x2b, 0x02, 0xc0, 0x52, 0x21, 0x30, 0x19, 0x2b, 0x96, 0x19, 0x0b, 0xe4,
+	0x19, 0x9c, 0x5c, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6,
+	0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a, 0x3b, 0x3d,
+	0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0xbf, 0xb0, 0x28, 0x35, 0x25, 0x5f, 0x1f,
+	0xd9, 0xdd, 0x15, 0x30, 0x97, 0x97, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0xdd, 0x63, 0x0c,
+	0x18, 0x00, 0x82, 0x89, 0x1f, 0x52, 0xdc, 0x00, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -99,6 +111,11 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxPolicyThreshold != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxPolicyThreshold))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -119,6 +136,9 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.MaxPolicyThreshold != 0 {
+		n += 1 + sovParams(uint64(m.MaxPolicyThreshold))
+	}
 	return n
 }
 
@@ -157,6 +177,25 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPolicyThreshold", wireType)
+			}
+			m.MaxPolicyThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPolicyThreshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
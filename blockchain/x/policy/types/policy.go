@@ -3,16 +3,25 @@
 package types
 
 import (
+	"context"
+	"crypto/sha256"
+	"errors"
 	"fmt"
+	"math/big"
+	"sort"
 	"strings"
+	"unicode"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	proto "github.com/cosmos/gogoproto/proto"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/qredo/fusionchain/boolparser"
 	"github.com/qredo/fusionchain/policy"
 	"github.com/qredo/fusionchain/repo"
 	"gitlab.qredo.com/edmund/blackbird/verifier/golang/impl"
+	"gitlab.qredo.com/edmund/blackbird/verifier/golang/protobuf"
 	"gitlab.qredo.com/edmund/blackbird/verifier/golang/simple"
+	protov2 "google.golang.org/protobuf/proto"
 )
 
 var _ repo.Object = (*Policy)(nil)
@@ -23,6 +32,134 @@ func (a *Policy) SetId(id uint64) {
 	a.Id = id
 }
 
+// EncodeDecision returns the deterministic encoding of a PolicyDecision for
+// the policy, approved by the given approvers with the given payload. The
+// order of the approvers doesn't affect the result.
+func (a *Policy) EncodeDecision(payload policy.PolicyPayload, approvers []string) ([]byte, error) {
+	decision := &PolicyDecision{
+		PolicyId:    a.Id,
+		Approvers:   policy.BuildApproverSet(approvers).Sorted(),
+		Payload:     payload.Any(),
+		PolicyNonce: a.Nonce,
+	}
+	return decision.Marshal()
+}
+
+// DecodePolicyDecision decodes a PolicyDecision encoded by
+// Policy.EncodeDecision.
+func DecodePolicyDecision(b []byte) (*PolicyDecision, error) {
+	var decision PolicyDecision
+	if err := decision.Unmarshal(b); err != nil {
+		return nil, fmt.Errorf("decoding policy decision: %w", err)
+	}
+	return &decision, nil
+}
+
+// GoverningPolicyID returns the ID of the policy that must approve updates
+// to the policy: its admin policy if set, or the policy itself.
+func (a *Policy) GoverningPolicyID() uint64 {
+	if a.AdminPolicyId != 0 {
+		return a.AdminPolicyId
+	}
+	return a.Id
+}
+
+// PolicyUpdateData returns the policy data of an action replacing a policy
+// with newPolicy. DataForSigning commits to the hash of the new policy, see
+// PolicyHash, so that approvals (e.g. oracle attestations) can't be reused
+// for a different update.
+func PolicyUpdateData(newPolicy policy.Policy) (map[string][]byte, error) {
+	hash, err := PolicyHash(newPolicy)
+	if err != nil {
+		return nil, err
+	}
+	return map[string][]byte{dataForSigningKey: hash}, nil
+}
+
+// PolicyParticipants returns the participants of p. It returns false if the
+// policy type doesn't have participants.
+func PolicyParticipants(p policy.Policy) ([]*PolicyParticipant, bool) {
+	withParticipants, ok := p.(interface {
+		GetParticipants() []*PolicyParticipant
+	})
+	if !ok {
+		return nil, false
+	}
+	return withParticipants.GetParticipants(), true
+}
+
+// ParticipantUtilization returns, for each participant abbreviation, the
+// number of policies it appears in, e.g. to spot participants that are a
+// single point of failure across a workspace. Policy types without
+// participants are skipped.
+func ParticipantUtilization(policies []*Policy, cdc codec.Codec) (map[string]int, error) {
+	counts := make(map[string]int)
+	for _, p := range policies {
+		unpacked, err := UnpackPolicy(cdc, p)
+		if err != nil {
+			return nil, fmt.Errorf("policy %d: %w", p.Id, err)
+		}
+		participants, ok := PolicyParticipants(unpacked)
+		if !ok {
+			continue
+		}
+		seen := make(map[string]bool, len(participants))
+		for _, participant := range participants {
+			if seen[participant.Abbreviation] {
+				continue
+			}
+			seen[participant.Abbreviation] = true
+			counts[participant.Abbreviation]++
+		}
+	}
+	return counts, nil
+}
+
+// CheckSatisfiable returns an error if the policy can't be satisfied even
+// when all of its participants approve, e.g. to prevent a policy update from
+// locking everyone out.
+func CheckSatisfiable(p policy.Policy) error {
+	participants, ok := PolicyParticipants(p)
+	if !ok {
+		return nil
+	}
+
+	abbreviations := make([]string, 0, len(participants))
+	for _, participant := range participants {
+		abbreviations = append(abbreviations, participant.Abbreviation)
+	}
+	approvers := policy.BuildApproverSet(abbreviations)
+
+	if bp, ok := p.(*BlackbirdPolicy); ok {
+		var bbPolicy protobuf.Policy
+		if err := protov2.Unmarshal(bp.Data, &bbPolicy); err != nil {
+			return fmt.Errorf("decoding blackbird policy: %w", err)
+		}
+		satisfied, err := evaluateContext(context.Background(), &bbPolicy, approvers)
+		switch {
+		case errors.Is(err, errUnsupportedTag):
+			// requires a witness, can't be checked in advance
+			return nil
+		case err != nil:
+			return err
+		case !satisfied:
+			return fmt.Errorf("policy can't be satisfied by its participants")
+		}
+		return nil
+	}
+
+	if _, ok := p.(*RotatingKeyPolicy); ok {
+		// requires signatures of the participants, can't be checked in
+		// advance
+		return nil
+	}
+
+	if err := p.Verify(context.Background(), approvers, policy.EmptyPolicyPayload(), nil); err != nil {
+		return fmt.Errorf("policy can't be satisfied by its participants: %w", err)
+	}
+	return nil
+}
+
 func UnpackPolicy(cdc codec.BinaryCodec, policyPb *Policy) (policy.Policy, error) {
 	var p policy.Policy
 	err := cdc.UnpackAny(policyPb.Policy, &p)
@@ -49,34 +186,275 @@ func (p *BoolparserPolicy) AddressToParticipant(addr string) (string, error) {
 	return "", fmt.Errorf("address not a participant of this policy")
 }
 
-func (p *BoolparserPolicy) Verify(approvers policy.ApproverSet, _ policy.PolicyPayload, policyData map[string][]byte) error {
-	expression := p.Definition
-	for abbr := range approvers {
-		expression = strings.ReplaceAll(expression, abbr, "1")
-	}
-
+func (p *BoolparserPolicy) Verify(_ context.Context, approvers policy.ApproverSet, _ policy.PolicyPayload, policyData map[string][]byte) error {
+	values := make(map[string]string, len(approvers)+len(policyData))
 	for valueName, value := range policyData {
-		expression = strings.ReplaceAll(expression, valueName, string(value))
+		values[valueName] = string(value)
+	}
+	for _, abbr := range approvers.Sorted() {
+		values[abbr] = "1"
 	}
 
-	if boolparser.BoolSolve(expression) {
+	if boolparser.BoolSolve(substituteIdentifiers(p.Definition, values)) {
 		return nil
 	}
 	return fmt.Errorf("expression not satisfied")
 }
 
+// substituteIdentifiers replaces the identifiers of expression, i.e. the
+// runs of letters, digits and underscores, that are keys of values. Only
+// whole identifiers are replaced, in a single pass: t1 doesn't match the
+// beginning of t10, and the replacements aren't substituted again.
+func substituteIdentifiers(expression string, values map[string]string) string {
+	var b strings.Builder
+	start := -1
+	flush := func(end int) {
+		if start < 0 {
+			return
+		}
+		ident := expression[start:end]
+		if value, ok := values[ident]; ok {
+			b.WriteString(value)
+		} else {
+			b.WriteString(ident)
+		}
+		start = -1
+	}
+	for i, r := range expression {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' {
+			if start < 0 {
+				start = i
+			}
+			continue
+		}
+		flush(i)
+		b.WriteRune(r)
+	}
+	flush(len(expression))
+	return b.String()
+}
+
 var _ (policy.Policy) = (*BlackbirdPolicy)(nil)
 
 func (p *BlackbirdPolicy) Validate() error {
+	var bbPolicy protobuf.Policy
+	if err := protov2.Unmarshal(p.Data, &bbPolicy); err != nil {
+		return fmt.Errorf("malformed blackbird policy data: %w", err)
+	}
+	if err := p.ThresholdFraction.Validate(); err != nil {
+		return err
+	}
+
 	participants := make(map[string]impl.Authority, len(p.Participants))
 	for _, participant := range p.Participants {
 		participants[participant.Abbreviation] = impl.ParticipantAsAuthority(participant.Address)
 	}
+	if err := checkParticipants(&bbPolicy, participants); err != nil {
+		return err
+	}
+
 	cleanData, err := simple.InstallCheck(p.Data, nil, participants)
 	p.Data = cleanData
 	return err
 }
 
+// checkParticipants returns an error if a signature in the blackbird policy
+// references an abbreviation that is not a participant.
+func checkParticipants(p *protobuf.Policy, participants map[string]impl.Authority) error {
+	if p.Tag == protobuf.PolicyTag_POLICY_SIGNATURE {
+		if addr, ok := p.Address.(*protobuf.Policy_CookedAddress); ok {
+			if _, found := participants[addr.CookedAddress]; !found {
+				return fmt.Errorf("policy references unknown participant %q", addr.CookedAddress)
+			}
+		}
+	}
+	for _, sub := range p.Subpolicies {
+		if err := checkParticipants(sub, participants); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MissingByBranch returns, for each branch of the policy that is not yet
+// satisfied by the current approvers, the approvers of the branch that
+// haven't approved yet.
+//
+// Branches are identified by their path in the policy tree: "root" for the
+// top level policy, "root/i" for its i-th subpolicy, and so on. Branches
+// that are satisfied, or that don't directly contain any signature, are
+// omitted.
+func (p *BlackbirdPolicy) MissingByBranch(current []string) (map[string][]string, error) {
+	var bbPolicy protobuf.Policy
+	if err := protov2.Unmarshal(p.Data, &bbPolicy); err != nil {
+		return nil, fmt.Errorf("decoding blackbird policy: %w", err)
+	}
+
+	approvers := policy.BuildApproverSet(current)
+	missing := make(map[string][]string)
+	if _, err := missingByBranch(&bbPolicy, "root", approvers, missing); err != nil {
+		return nil, err
+	}
+	return missing, nil
+}
+
+// missingByBranch fills missing for the subtree at path, returning whether
+// the subtree is satisfied by the approvers.
+func missingByBranch(p *protobuf.Policy, path string, approvers policy.ApproverSet, missing map[string][]string) (bool, error) {
+	switch p.Tag {
+	case protobuf.PolicyTag_POLICY_SIGNATURE:
+		return approvers[p.GetCookedAddress()], nil
+	case protobuf.PolicyTag_POLICY_ALL, protobuf.PolicyTag_POLICY_ANY:
+	default:
+		return false, fmt.Errorf("unsupported policy tag %s at %s", p.Tag, path)
+	}
+
+	satisfied := 0
+	var notApproved []string
+	for i, sub := range p.Subpolicies {
+		ok, err := missingByBranch(sub, fmt.Sprintf("%s/%d", path, i), approvers, missing)
+		if err != nil {
+			return false, err
+		}
+		if ok {
+			satisfied++
+		} else if sub.Tag == protobuf.PolicyTag_POLICY_SIGNATURE {
+			notApproved = append(notApproved, sub.GetCookedAddress())
+		}
+	}
+
+	threshold := len(p.Subpolicies)
+	if p.Tag == protobuf.PolicyTag_POLICY_ANY {
+		threshold = int(p.Threshold)
+	}
+	if satisfied >= threshold {
+		return true, nil
+	}
+	if len(notApproved) > 0 {
+		missing[path] = notApproved
+	}
+	return false, nil
+}
+
+// KeyResolver reports whether an address has a registered signing key.
+type KeyResolver interface {
+	HasKey(address string) bool
+}
+
+// ValidateKeysRegistered returns an error listing the participants whose
+// address doesn't resolve to a signing key.
+func (p *BlackbirdPolicy) ValidateKeysRegistered(resolver KeyResolver) error {
+	var missing []string
+	for _, participant := range p.Participants {
+		if !resolver.HasKey(participant.Address) {
+			missing = append(missing, fmt.Sprintf("%s (%s)", participant.Abbreviation, participant.Address))
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("participants without a registered key: %s", strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// EnforceMaxThreshold returns an error if the policy can't be satisfied by
+// less than max approvers.
+func (p *BlackbirdPolicy) EnforceMaxThreshold(max int) error {
+	var bbPolicy protobuf.Policy
+	if err := protov2.Unmarshal(p.Data, &bbPolicy); err != nil {
+		return fmt.Errorf("decoding blackbird policy: %w", err)
+	}
+
+	required := requiredApprovers(&bbPolicy)
+	if fractionRequired := p.ThresholdFraction.Required(len(p.Participants)); fractionRequired > required {
+		required = fractionRequired
+	}
+	if required > max {
+		return fmt.Errorf("policy requires %d approvers, the maximum allowed is %d", required, max)
+	}
+	return nil
+}
+
+// requiredApprovers returns the minimum number of signatures needed to
+// satisfy a blackbird policy.
+func requiredApprovers(p *protobuf.Policy) int {
+	required := make([]int, len(p.Subpolicies))
+	for i, sub := range p.Subpolicies {
+		required[i] = requiredApprovers(sub)
+	}
+
+	switch p.Tag {
+	case protobuf.PolicyTag_POLICY_SIGNATURE:
+		return 1
+	case protobuf.PolicyTag_POLICY_ANY:
+		// the cheapest way to satisfy the threshold is to pick the
+		// subpolicies requiring the least approvers
+		sort.Ints(required)
+		if p.Threshold < uint64(len(required)) {
+			required = required[:p.Threshold]
+		}
+	}
+
+	total := 0
+	for _, r := range required {
+		total += r
+	}
+	return total
+}
+
+// MaxRequiredSignatures returns the worst-case number of signatures needed
+// to satisfy the policy, i.e. the size of the largest minimal set of
+// approvers, to budget signing resources.
+func (p *BlackbirdPolicy) MaxRequiredSignatures() (int, error) {
+	var bbPolicy protobuf.Policy
+	if err := protov2.Unmarshal(p.Data, &bbPolicy); err != nil {
+		return 0, fmt.Errorf("decoding blackbird policy: %w", err)
+	}
+
+	required, err := maxRequiredSignatures(&bbPolicy)
+	if err != nil {
+		return 0, err
+	}
+	if fractionRequired := p.ThresholdFraction.Required(len(p.Participants)); fractionRequired > required {
+		required = fractionRequired
+	}
+	return required, nil
+}
+
+// maxRequiredSignatures returns the worst-case number of signatures needed to
+// satisfy a blackbird policy: all the subpolicies of an ALL node, and the
+// most expensive ones reaching the threshold of an ANY node.
+func maxRequiredSignatures(p *protobuf.Policy) (int, error) {
+	switch p.Tag {
+	case protobuf.PolicyTag_POLICY_SIGNATURE:
+		return 1, nil
+	case protobuf.PolicyTag_POLICY_ALL, protobuf.PolicyTag_POLICY_ANY:
+	default:
+		return 0, fmt.Errorf("unsupported policy tag %s", p.Tag)
+	}
+
+	required := make([]int, len(p.Subpolicies))
+	for i, sub := range p.Subpolicies {
+		r, err := maxRequiredSignatures(sub)
+		if err != nil {
+			return 0, err
+		}
+		required[i] = r
+	}
+
+	if p.Tag == protobuf.PolicyTag_POLICY_ANY {
+		sort.Sort(sort.Reverse(sort.IntSlice(required)))
+		if p.Threshold < uint64(len(required)) {
+			required = required[:p.Threshold]
+		}
+	}
+
+	total := 0
+	for _, r := range required {
+		total += r
+	}
+	return total, nil
+}
+
 func (p *BlackbirdPolicy) AddressToParticipant(addr string) (string, error) {
 	for _, participant := range p.Participants {
 		if participant.Address == addr {
@@ -86,7 +464,16 @@ func (p *BlackbirdPolicy) AddressToParticipant(addr string) (string, error) {
 	return "", fmt.Errorf("address not a participant of this policy")
 }
 
-func (p *BlackbirdPolicy) Verify(approvers policy.ApproverSet, policyPayload policy.PolicyPayload, _ map[string][]byte) error {
+// Verify checks the threshold fraction and the block height range of the
+// payload, then evaluates the policy with the blackbird verifier and the
+// witness of the payload. If ctx can be cancelled, the evaluation runs in its
+// own goroutine and Verify returns the error of ctx as soon as it's done,
+// without waiting for the verifier, which can't be interrupted.
+func (p *BlackbirdPolicy) Verify(ctx context.Context, approvers policy.ApproverSet, policyPayload policy.PolicyPayload, _ map[string][]byte) error {
+	if err := p.verifyThresholdFraction(approvers); err != nil {
+		return err
+	}
+
 	payload, err := policy.UnpackPayload[BlackbirdPolicyPayload](policyPayload)
 	if err != nil {
 		return err
@@ -94,10 +481,166 @@ func (p *BlackbirdPolicy) Verify(approvers policy.ApproverSet, policyPayload pol
 
 	var witness []byte
 	if payload != nil {
+		if err := payload.verifyBlockHeight(policyPayload.BlockHeight()); err != nil {
+			return err
+		}
 		witness = payload.Witness
 	}
 
-	return simple.Verify(p.Data, witness, nil, nil, approvers)
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if ctx.Done() == nil {
+		return blackbirdVerify(p.Data, witness, approvers)
+	}
+
+	result := make(chan error, 1)
+	go func() {
+		result <- blackbirdVerify(p.Data, witness, approvers)
+	}()
+	select {
+	case err := <-result:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// blackbirdVerify evaluates blackbird policies, replaced in tests.
+var blackbirdVerify = verifyBlackbird
+
+// verifyBlackbird is simple.Verify without a transaction. Without a witness,
+// the policy is taken from blackbirdCache instead of being decoded on every
+// call.
+func verifyBlackbird(data, witness []byte, approvers map[string]bool) error {
+	if len(witness) > 0 {
+		return simple.Verify(data, witness, nil, nil, approvers)
+	}
+	p, err := blackbirdCache.decode(data)
+	if err != nil {
+		return err
+	}
+	// the verifier takes the policy by value and doesn't modify it: pass a
+	// shallow copy of the cached policy, sharing its subpolicies
+	return impl.VerifyEngine(impl.TEST_FUEL, protobuf.Policy{
+		Tag:           p.Tag,
+		Threshold:     p.Threshold,
+		Subpolicies:   p.Subpolicies,
+		AddressPrefix: p.AddressPrefix,
+		Address:       p.Address,
+		Assets:        p.Assets,
+		AssetDefs:     p.AssetDefs,
+		Thunk:         p.Thunk,
+		RefId:         p.RefId,
+		Transaction:   p.Transaction,
+	}, protobuf.Witness{Tag: protobuf.WitnessTag_WITNESS_GUESS}, protobuf.Transaction{}, approvers)
+}
+
+// Validate returns an error if the block height range of the payload is
+// empty.
+func (p *BlackbirdPolicyPayload) Validate() error {
+	if p.MaxBlockHeight != 0 && p.MinBlockHeight > p.MaxBlockHeight {
+		return fmt.Errorf("invalid block height range: min %d is greater than max %d", p.MinBlockHeight, p.MaxBlockHeight)
+	}
+	return nil
+}
+
+// verifyBlockHeight returns an error if the approval isn't valid at height.
+func (p *BlackbirdPolicyPayload) verifyBlockHeight(height uint64) error {
+	if err := p.Validate(); err != nil {
+		return err
+	}
+	if height < p.MinBlockHeight {
+		return fmt.Errorf("approval not valid before block %d, current block is %d", p.MinBlockHeight, height)
+	}
+	if p.MaxBlockHeight != 0 && height > p.MaxBlockHeight {
+		return fmt.Errorf("approval expired at block %d, current block is %d", p.MaxBlockHeight, height)
+	}
+	return nil
+}
+
+// verifyThresholdFraction checks that enough participants approved to reach
+// the threshold fraction of the policy, if any.
+func (p *BlackbirdPolicy) verifyThresholdFraction(approvers policy.ApproverSet) error {
+	required := p.ThresholdFraction.Required(len(p.Participants))
+	if required == 0 {
+		return nil
+	}
+
+	approved := 0
+	seen := make(map[string]bool, len(p.Participants))
+	for _, participant := range p.Participants {
+		if approvers[participant.Abbreviation] && !seen[participant.Abbreviation] {
+			seen[participant.Abbreviation] = true
+			approved++
+		}
+	}
+	if approved < required {
+		return fmt.Errorf("%d of %d participants approved, %d required", approved, len(p.Participants), required)
+	}
+	return nil
+}
+
+// Validate returns an error if the fraction is not between 0 and 1. A nil
+// fraction is valid.
+func (f *ThresholdFraction) Validate() error {
+	if f == nil {
+		return nil
+	}
+	if f.Denominator == 0 {
+		return fmt.Errorf("invalid threshold fraction: zero denominator")
+	}
+	if f.Numerator > f.Denominator {
+		return fmt.Errorf("invalid threshold fraction: %d/%d is greater than one", f.Numerator, f.Denominator)
+	}
+	return nil
+}
+
+// Required returns the number of approvals required out of n participants,
+// rounding up. It returns 0 for a nil fraction.
+func (f *ThresholdFraction) Required(n int) int {
+	if f == nil || f.Denominator == 0 {
+		return 0
+	}
+	num := uint64(n) * uint64(f.Numerator)
+	den := uint64(f.Denominator)
+	return int((num + den - 1) / den)
+}
+
+// errUnsupportedTag is returned by evaluateContext for policies that can only
+// be evaluated by the blackbird verifier.
+var errUnsupportedTag = errors.New("unsupported policy tag")
+
+// evaluateContext returns whether the approvers satisfy the policy, or
+// ctx.Err() as soon as ctx is done.
+func evaluateContext(ctx context.Context, p *protobuf.Policy, approvers policy.ApproverSet) (bool, error) {
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+
+	var threshold int
+	switch p.Tag {
+	case protobuf.PolicyTag_POLICY_SIGNATURE:
+		return approvers[p.GetCookedAddress()], nil
+	case protobuf.PolicyTag_POLICY_ALL:
+		threshold = len(p.Subpolicies)
+	case protobuf.PolicyTag_POLICY_ANY:
+		threshold = int(p.Threshold)
+	default:
+		return false, fmt.Errorf("%w %s", errUnsupportedTag, p.Tag)
+	}
+
+	satisfied := 0
+	for _, sub := range p.Subpolicies {
+		ok, err := evaluateContext(ctx, sub, approvers)
+		if err != nil {
+			return false, err
+		}
+		if ok {
+			satisfied++
+		}
+	}
+	return satisfied >= threshold, nil
 }
 
 var _ (policy.PolicyMetadata) = (*BlackbirdPolicy)(nil)
@@ -113,3 +656,728 @@ func (p *BlackbirdPolicy) Metadata() (proto.Message, error) {
 		Pretty: pretty,
 	}, nil
 }
+
+// Keys of the policy data set by the treasury module for transactions.
+const (
+	txValueKey        = "TXVALUE"
+	txCoinKey         = "TXCOIN"
+	txMaxFeeKey       = "TXMAXFEE"
+	txToKey           = "TXTO"
+	dataForSigningKey = "DataForSigning"
+)
+
+var _ (policy.Policy) = (*OracleAttestationPolicy)(nil)
+
+func (p *OracleAttestationPolicy) Validate() error {
+	if len(p.OraclePubkey) == 0 {
+		return fmt.Errorf("missing oracle public key")
+	}
+	if _, err := crypto.DecompressPubkey(p.OraclePubkey); err != nil {
+		return fmt.Errorf("invalid oracle public key: %w", err)
+	}
+	if _, err := p.threshold(); err != nil {
+		return err
+	}
+	if p.Coin == "" {
+		return fmt.Errorf("missing threshold coin")
+	}
+	if len(p.Participants) == 0 {
+		return fmt.Errorf("missing participants")
+	}
+	return nil
+}
+
+func (p *OracleAttestationPolicy) AddressToParticipant(addr string) (string, error) {
+	for _, participant := range p.Participants {
+		if participant.Address == addr {
+			return participant.Abbreviation, nil
+		}
+	}
+	return "", fmt.Errorf("address not a participant of this policy")
+}
+
+// Verify passes if any of the participants approved and, for transfers of
+// the coin of the policy with an amount above the threshold, the payload
+// contains a valid attestation of the oracle over the hash of the transfer.
+// Transfers of other coins, whose amounts aren't comparable with the
+// threshold, always require an attestation, and so do transfers without an
+// amount.
+//
+// Actions that are not transfers (i.e. without a coin in policyData) don't
+// require an attestation.
+func (p *OracleAttestationPolicy) Verify(_ context.Context, approvers policy.ApproverSet, policyPayload policy.PolicyPayload, policyData map[string][]byte) error {
+	if len(approvers) == 0 {
+		return fmt.Errorf("no approvers")
+	}
+	if !IsTransferData(policyData) {
+		return nil
+	}
+
+	value, ok := policyData[txValueKey]
+	if !ok {
+		return fmt.Errorf("missing transfer amount")
+	}
+	amount, ok := new(big.Int).SetString(string(value), 10)
+	if !ok {
+		return fmt.Errorf("invalid transfer amount: %s", value)
+	}
+	threshold, err := p.threshold()
+	if err != nil {
+		return err
+	}
+	if strings.EqualFold(string(policyData[txCoinKey]), p.Coin) && amount.Cmp(threshold) <= 0 {
+		return nil
+	}
+
+	payload, err := policy.UnpackPayload[OracleAttestationPolicyPayload](policyPayload)
+	if err != nil {
+		return err
+	}
+	if payload == nil || len(payload.Attestation) == 0 {
+		return fmt.Errorf("missing oracle attestation")
+	}
+
+	hash := policyData[dataForSigningKey]
+	if len(hash) != 32 {
+		return fmt.Errorf("invalid transfer hash length: %d", len(hash))
+	}
+	if len(payload.Attestation) != 64 {
+		return fmt.Errorf("invalid oracle attestation length: %d", len(payload.Attestation))
+	}
+	if !policy.Secp256k1.Verify(p.OraclePubkey, hash, payload.Attestation) {
+		return fmt.Errorf("invalid oracle attestation")
+	}
+	return nil
+}
+
+func (p *OracleAttestationPolicy) threshold() (*big.Int, error) {
+	threshold, ok := new(big.Int).SetString(p.Threshold, 10)
+	if !ok || threshold.Sign() < 0 {
+		return nil, fmt.Errorf("invalid threshold: %q", p.Threshold)
+	}
+	return threshold, nil
+}
+
+var _ (policy.Policy) = (*DestinationQuorumPolicy)(nil)
+
+func (p *DestinationQuorumPolicy) Validate() error {
+	if p.InternalThreshold == 0 || p.ExternalThreshold == 0 {
+		return fmt.Errorf("thresholds must be greater than zero")
+	}
+	if int(p.InternalThreshold) > len(p.Participants) {
+		return fmt.Errorf("internal threshold %d can't be satisfied by %d participants", p.InternalThreshold, len(p.Participants))
+	}
+	if int(p.ExternalThreshold) > len(p.Participants) {
+		return fmt.Errorf("external threshold %d can't be satisfied by %d participants", p.ExternalThreshold, len(p.Participants))
+	}
+	seen := make(map[string]bool, len(p.Participants))
+	for _, participant := range p.Participants {
+		if seen[participant.Abbreviation] {
+			return fmt.Errorf("duplicate participant %q", participant.Abbreviation)
+		}
+		seen[participant.Abbreviation] = true
+	}
+	return nil
+}
+
+func (p *DestinationQuorumPolicy) AddressToParticipant(addr string) (string, error) {
+	for _, participant := range p.Participants {
+		if participant.Address == addr {
+			return participant.Abbreviation, nil
+		}
+	}
+	return "", fmt.Errorf("address not a participant of this policy")
+}
+
+// Verify passes if the number of approvers reaches the internal threshold,
+// when the payload flags the destination as internal, or the external
+// threshold otherwise.
+func (p *DestinationQuorumPolicy) Verify(_ context.Context, approvers policy.ApproverSet, policyPayload policy.PolicyPayload, _ map[string][]byte) error {
+	payload, err := policy.UnpackPayload[DestinationQuorumPolicyPayload](policyPayload)
+	if err != nil {
+		return err
+	}
+
+	threshold := p.ExternalThreshold
+	if payload != nil && payload.InternalDestination {
+		threshold = p.InternalThreshold
+	}
+
+	approvals := 0
+	for _, participant := range p.Participants {
+		if approvers[participant.Abbreviation] {
+			approvals++
+		}
+	}
+	if approvals < int(threshold) {
+		return fmt.Errorf("%d approvals out of %d required", approvals, threshold)
+	}
+	return nil
+}
+
+var _ (policy.Policy) = (*MaxFeePolicy)(nil)
+
+func (p *MaxFeePolicy) Validate() error {
+	if len(p.Ceilings) == 0 {
+		return fmt.Errorf("missing fee ceilings")
+	}
+	seen := make(map[string]bool, len(p.Ceilings))
+	for _, ceiling := range p.Ceilings {
+		if ceiling.Symbol == "" {
+			return fmt.Errorf("missing fee ceiling symbol")
+		}
+		if seen[ceiling.Symbol] {
+			return fmt.Errorf("duplicate fee ceiling for %s", ceiling.Symbol)
+		}
+		seen[ceiling.Symbol] = true
+		maxFee, err := ceiling.maxFee()
+		if err != nil {
+			return err
+		}
+		if maxFee.Sign() == 0 {
+			return fmt.Errorf("fee ceiling for %s must be greater than zero", ceiling.Symbol)
+		}
+	}
+	if len(p.Participants) == 0 {
+		return fmt.Errorf("missing participants")
+	}
+	return nil
+}
+
+func (p *MaxFeePolicy) AddressToParticipant(addr string) (string, error) {
+	for _, participant := range p.Participants {
+		if participant.Address == addr {
+			return participant.Abbreviation, nil
+		}
+	}
+	return "", fmt.Errorf("address not a participant of this policy")
+}
+
+// Verify passes if any of the participants approved and the max fee of the
+// transaction doesn't exceed the ceiling of its chain, identified by the
+// symbol of its coin identifier.
+//
+// Actions that are not transactions (i.e. without a coin in policyData)
+// only require an approval. Transactions on chains without a ceiling, or
+// whose max fee is unknown, are rejected.
+func (p *MaxFeePolicy) Verify(_ context.Context, approvers policy.ApproverSet, _ policy.PolicyPayload, policyData map[string][]byte) error {
+	if len(approvers) == 0 {
+		return fmt.Errorf("no approvers")
+	}
+
+	coin, ok := policyData[txCoinKey]
+	if !ok {
+		return nil
+	}
+	symbol, _, _ := strings.Cut(string(coin), "/")
+	ceiling := p.ceiling(symbol)
+	if ceiling == nil {
+		return fmt.Errorf("no fee ceiling for %s", symbol)
+	}
+	maxFee, err := ceiling.maxFee()
+	if err != nil {
+		return err
+	}
+
+	value, ok := policyData[txMaxFeeKey]
+	if !ok {
+		return fmt.Errorf("missing transaction fee")
+	}
+	fee, ok := new(big.Int).SetString(string(value), 10)
+	if !ok {
+		return fmt.Errorf("invalid transaction fee: %s", value)
+	}
+	if fee.Cmp(maxFee) > 0 {
+		return fmt.Errorf("transaction fee %s exceeds the ceiling of %s for %s", fee, maxFee, symbol)
+	}
+	return nil
+}
+
+func (p *MaxFeePolicy) ceiling(symbol string) *FeeCeiling {
+	for _, ceiling := range p.Ceilings {
+		if ceiling.Symbol == symbol {
+			return ceiling
+		}
+	}
+	return nil
+}
+
+func (c *FeeCeiling) maxFee() (*big.Int, error) {
+	maxFee, ok := new(big.Int).SetString(c.MaxFee, 10)
+	if !ok || maxFee.Sign() < 0 {
+		return nil, fmt.Errorf("invalid fee ceiling for %s: %q", c.Symbol, c.MaxFee)
+	}
+	return maxFee, nil
+}
+
+var _ (policy.Policy) = (*RotatingKeyPolicy)(nil)
+
+func (p *RotatingKeyPolicy) Validate() error {
+	if p.EpochLength == 0 {
+		return fmt.Errorf("epoch length must be greater than zero")
+	}
+	if p.Threshold == 0 {
+		return fmt.Errorf("threshold must be greater than zero")
+	}
+	if int(p.Threshold) > len(p.Participants) {
+		return fmt.Errorf("threshold %d can't be satisfied by %d participants", p.Threshold, len(p.Participants))
+	}
+	participants := make(map[string]bool, len(p.Participants))
+	for _, participant := range p.Participants {
+		if participants[participant.Abbreviation] {
+			return fmt.Errorf("duplicate participant %q", participant.Abbreviation)
+		}
+		participants[participant.Abbreviation] = true
+	}
+
+	scheduled := make(map[string]bool, len(p.Schedules))
+	owners := make(map[string]string)
+	for _, schedule := range p.Schedules {
+		if !participants[schedule.Abbreviation] {
+			return fmt.Errorf("key schedule for unknown participant %q", schedule.Abbreviation)
+		}
+		if scheduled[schedule.Abbreviation] {
+			return fmt.Errorf("duplicate key schedule for %q", schedule.Abbreviation)
+		}
+		scheduled[schedule.Abbreviation] = true
+		if len(schedule.Keys) == 0 {
+			return fmt.Errorf("empty key schedule for %q", schedule.Abbreviation)
+		}
+		for i, key := range schedule.Keys {
+			if i > 0 && key.StartEpoch <= schedule.Keys[i-1].StartEpoch {
+				return fmt.Errorf("key schedule for %q is not increasing: epoch %d after %d", schedule.Abbreviation, key.StartEpoch, schedule.Keys[i-1].StartEpoch)
+			}
+			scheme, err := key.Algorithm.Scheme()
+			if err != nil {
+				return fmt.Errorf("invalid key of %q for epoch %d: %w", schedule.Abbreviation, key.StartEpoch, err)
+			}
+			if err := scheme.ValidatePublicKey(key.Pubkey); err != nil {
+				return fmt.Errorf("invalid key of %q for epoch %d: %w", schedule.Abbreviation, key.StartEpoch, err)
+			}
+			if owner, ok := owners[string(key.Pubkey)]; ok && owner != schedule.Abbreviation {
+				return fmt.Errorf("participants %q and %q share a key", owner, schedule.Abbreviation)
+			}
+			owners[string(key.Pubkey)] = schedule.Abbreviation
+		}
+	}
+	for _, participant := range p.Participants {
+		if !scheduled[participant.Abbreviation] {
+			return fmt.Errorf("missing key schedule for %q", participant.Abbreviation)
+		}
+	}
+	return nil
+}
+
+func (p *RotatingKeyPolicy) AddressToParticipant(addr string) (string, error) {
+	for _, participant := range p.Participants {
+		if participant.Address == addr {
+			return participant.Abbreviation, nil
+		}
+	}
+	return "", fmt.Errorf("address not a participant of this policy")
+}
+
+// Verify checks the signatures in the payload of the participants who
+// approved the action with VerifyWithSignatures, at the epoch of the block
+// height of the payload. Signatures of participants who didn't approve the
+// action don't count.
+func (p *RotatingKeyPolicy) Verify(_ context.Context, approvers policy.ApproverSet, policyPayload policy.PolicyPayload, policyData map[string][]byte) error {
+	payload, err := policy.UnpackPayload[RotatingKeyPolicyPayload](policyPayload)
+	if err != nil {
+		return err
+	}
+	if payload == nil {
+		return fmt.Errorf("missing signatures")
+	}
+	signatures := make([]*ParticipantSignature, 0, len(payload.Signatures))
+	for _, sig := range payload.Signatures {
+		if approvers[sig.Abbreviation] {
+			signatures = append(signatures, sig)
+		}
+	}
+	return p.VerifyWithSignatures(p.Epoch(policyPayload.BlockHeight()), signatures, policyData[dataForSigningKey])
+}
+
+// VerifyWithSignatures passes if at least threshold distinct participants
+// signed hash, each with the key valid for epoch in their schedule and the
+// signature scheme of that key, so participants can mix key types.
+// Invalid signatures, including those made with keys of other epochs, and
+// signatures of non participants don't count.
+func (p *RotatingKeyPolicy) VerifyWithSignatures(epoch uint64, signatures []*ParticipantSignature, hash []byte) error {
+	if len(hash) != 32 {
+		return fmt.Errorf("invalid hash length: %d", len(hash))
+	}
+
+	signed := make(map[string]bool, len(signatures))
+	for _, sig := range signatures {
+		if signed[sig.Abbreviation] {
+			continue
+		}
+		key := p.keyAt(sig.Abbreviation, epoch)
+		if key == nil {
+			continue
+		}
+		scheme, err := key.Algorithm.Scheme()
+		if err != nil {
+			return err
+		}
+		if scheme.Verify(key.Pubkey, hash, sig.Signature) {
+			signed[sig.Abbreviation] = true
+		}
+	}
+	if len(signed) < int(p.Threshold) {
+		return fmt.Errorf("%d valid signatures for epoch %d out of %d required", len(signed), epoch, p.Threshold)
+	}
+	return nil
+}
+
+// Epoch returns the epoch of the block height.
+func (p *RotatingKeyPolicy) Epoch(height uint64) uint64 {
+	if p.EpochLength == 0 {
+		return 0
+	}
+	return height / p.EpochLength
+}
+
+// keyAt returns the key of the participant valid for epoch, i.e. the last
+// key of its schedule starting at or before epoch, or nil if there isn't
+// any.
+func (p *RotatingKeyPolicy) keyAt(abbreviation string, epoch uint64) *EpochKey {
+	for _, schedule := range p.Schedules {
+		if schedule.Abbreviation != abbreviation {
+			continue
+		}
+		var current *EpochKey
+		for _, key := range schedule.Keys {
+			if key.StartEpoch > epoch {
+				break
+			}
+			current = key
+		}
+		return current
+	}
+	return nil
+}
+
+// Scheme returns the scheme verifying the signatures of the algorithm.
+func (a SignatureAlgorithm) Scheme() (policy.SignatureScheme, error) {
+	switch a {
+	case SignatureAlgorithm_SIGNATURE_ALGORITHM_ECDSA_SECP256K1:
+		return policy.Secp256k1, nil
+	case SignatureAlgorithm_SIGNATURE_ALGORITHM_EDDSA_ED25519:
+		return policy.Ed25519, nil
+	}
+	return nil, fmt.Errorf("unsupported signature algorithm %s", a)
+}
+
+var _ (policy.Policy) = (*GroupedQuorumPolicy)(nil)
+
+func (p *GroupedQuorumPolicy) Validate() error {
+	if len(p.Groups) == 0 {
+		return fmt.Errorf("missing groups")
+	}
+	participants := make(map[string]bool, len(p.Participants))
+	for _, participant := range p.Participants {
+		if participants[participant.Abbreviation] {
+			return fmt.Errorf("duplicate participant %q", participant.Abbreviation)
+		}
+		participants[participant.Abbreviation] = true
+	}
+
+	labels := make(map[string]bool, len(p.Groups))
+	groupOf := make(map[string]string, len(p.Participants))
+	for _, group := range p.Groups {
+		if group.Label == "" {
+			return fmt.Errorf("missing group label")
+		}
+		if labels[group.Label] {
+			return fmt.Errorf("duplicate group %q", group.Label)
+		}
+		labels[group.Label] = true
+
+		if group.MinApprovals == 0 {
+			return fmt.Errorf("group %q: minimum approvals must be greater than zero", group.Label)
+		}
+		if int(group.MinApprovals) > len(group.Members) {
+			return fmt.Errorf("group %q: minimum of %d approvals can't be satisfied by %d members", group.Label, group.MinApprovals, len(group.Members))
+		}
+		for _, member := range group.Members {
+			if !participants[member] {
+				return fmt.Errorf("group %q: %q is not a participant", group.Label, member)
+			}
+			if other, ok := groupOf[member]; ok {
+				return fmt.Errorf("participant %q is in groups %q and %q", member, other, group.Label)
+			}
+			groupOf[member] = group.Label
+		}
+	}
+
+	for _, participant := range p.Participants {
+		if _, ok := groupOf[participant.Abbreviation]; !ok {
+			return fmt.Errorf("participant %q is not in any group", participant.Abbreviation)
+		}
+	}
+	return nil
+}
+
+func (p *GroupedQuorumPolicy) AddressToParticipant(addr string) (string, error) {
+	for _, participant := range p.Participants {
+		if participant.Address == addr {
+			return participant.Abbreviation, nil
+		}
+	}
+	return "", fmt.Errorf("address not a participant of this policy")
+}
+
+// Verify passes if every group has at least its minimum number of members
+// among the approvers. Approvals in excess in a group don't make up for
+// another group.
+func (p *GroupedQuorumPolicy) Verify(_ context.Context, approvers policy.ApproverSet, _ policy.PolicyPayload, _ map[string][]byte) error {
+	for _, group := range p.Groups {
+		approvals := 0
+		for _, member := range group.Members {
+			if approvers[member] {
+				approvals++
+			}
+		}
+		if approvals < int(group.MinApprovals) {
+			return fmt.Errorf("group %q: %d approvals out of %d required", group.Label, approvals, group.MinApprovals)
+		}
+	}
+	return nil
+}
+
+var _ (policy.Policy) = (*CooldownPolicy)(nil)
+
+func (p *CooldownPolicy) Validate() error {
+	if p.CooldownBlocks == 0 {
+		return fmt.Errorf("cooldown must be greater than zero")
+	}
+	if len(p.Participants) == 0 {
+		return fmt.Errorf("missing participants")
+	}
+	return nil
+}
+
+func (p *CooldownPolicy) AddressToParticipant(addr string) (string, error) {
+	for _, participant := range p.Participants {
+		if participant.Address == addr {
+			return participant.Abbreviation, nil
+		}
+	}
+	return "", fmt.Errorf("address not a participant of this policy")
+}
+
+// Verify passes if any of the participants approved and, for transactions,
+// at least CooldownBlocks blocks passed since the last transfer approved by
+// the policy. The height of the last transfer is taken from the payload, as
+// recorded by the keeper.
+//
+// Actions that are not transactions (i.e. without a coin in policyData)
+// only require an approval.
+func (p *CooldownPolicy) Verify(_ context.Context, approvers policy.ApproverSet, policyPayload policy.PolicyPayload, policyData map[string][]byte) error {
+	if len(approvers) == 0 {
+		return fmt.Errorf("no approvers")
+	}
+	if !IsTransferData(policyData) {
+		return nil
+	}
+
+	last := policyPayload.LastTransferHeight()
+	if last == 0 {
+		return nil
+	}
+	height := policyPayload.BlockHeight()
+	if height < last || height-last < p.CooldownBlocks {
+		return fmt.Errorf("cooldown: last transfer at block %d, next allowed at block %d", last, last+p.CooldownBlocks)
+	}
+	return nil
+}
+
+// IsTransferData reports whether the policy data of an action is the one of
+// a transaction set by the treasury module, i.e. if it carries a coin.
+func IsTransferData(policyData map[string][]byte) bool {
+	_, ok := policyData[txCoinKey]
+	return ok
+}
+
+var _ (policy.Policy) = (*TxCountLimitPolicy)(nil)
+
+func (p *TxCountLimitPolicy) Validate() error {
+	if p.MaxTransfers == 0 {
+		return fmt.Errorf("max transfers must be greater than zero")
+	}
+	if p.WindowBlocks == 0 {
+		return fmt.Errorf("window must be greater than zero")
+	}
+	if len(p.Participants) == 0 {
+		return fmt.Errorf("missing participants")
+	}
+	return nil
+}
+
+func (p *TxCountLimitPolicy) AddressToParticipant(addr string) (string, error) {
+	for _, participant := range p.Participants {
+		if participant.Address == addr {
+			return participant.Abbreviation, nil
+		}
+	}
+	return "", fmt.Errorf("address not a participant of this policy")
+}
+
+// Verify passes if any of the participants approved and, for transactions,
+// fewer than MaxTransfers transfers were approved by the policy in the
+// current window. The window is taken from the payload, as recorded by the
+// keeper.
+//
+// Actions that are not transactions (i.e. without a coin in policyData)
+// only require an approval.
+func (p *TxCountLimitPolicy) Verify(_ context.Context, approvers policy.ApproverSet, policyPayload policy.PolicyPayload, policyData map[string][]byte) error {
+	if len(approvers) == 0 {
+		return fmt.Errorf("no approvers")
+	}
+	if !IsTransferData(policyData) {
+		return nil
+	}
+
+	start, count := policyPayload.TransferWindow()
+	if p.windowEnded(start, count, policyPayload.BlockHeight()) {
+		return nil
+	}
+	if count >= p.MaxTransfers {
+		return fmt.Errorf("transfer count limit: %d transfers since block %d, next allowed at block %d", count, start, start+p.WindowBlocks)
+	}
+	return nil
+}
+
+// CountTransfer returns the window after a transfer approved at the given
+// height, given the start and transfer count of the current window. A new
+// window starts with the transfer if the current one ended.
+func (p *TxCountLimitPolicy) CountTransfer(start, count, height uint64) (uint64, uint64) {
+	if p.windowEnded(start, count, height) {
+		return height, 1
+	}
+	return start, count + 1
+}
+
+func (p *TxCountLimitPolicy) windowEnded(start, count, height uint64) bool {
+	return count == 0 || height < start || height-start >= p.WindowBlocks
+}
+
+var _ (policy.Policy) = (*InternalOnlyPolicy)(nil)
+
+func (p *InternalOnlyPolicy) Validate() error {
+	if len(p.Participants) == 0 {
+		return fmt.Errorf("missing participants")
+	}
+	return nil
+}
+
+func (p *InternalOnlyPolicy) AddressToParticipant(addr string) (string, error) {
+	for _, participant := range p.Participants {
+		if participant.Address == addr {
+			return participant.Abbreviation, nil
+		}
+	}
+	return "", fmt.Errorf("address not a participant of this policy")
+}
+
+// Verify passes if any of the participants approved and, for transactions,
+// the destination of the transfer is an internal address, i.e. the address
+// of a wallet of the treasury module. The internal addresses are resolved
+// with the resolver of the payload, set by the keeper.
+//
+// Actions that are not transactions (i.e. without a coin in policyData)
+// only require an approval.
+func (p *InternalOnlyPolicy) Verify(_ context.Context, approvers policy.ApproverSet, policyPayload policy.PolicyPayload, policyData map[string][]byte) error {
+	if len(approvers) == 0 {
+		return fmt.Errorf("no approvers")
+	}
+	if !IsTransferData(policyData) {
+		return nil
+	}
+
+	to := policyData[txToKey]
+	if len(to) == 0 {
+		return fmt.Errorf("missing destination of the transfer")
+	}
+	resolver := policyPayload.InternalAddresses()
+	if resolver == nil {
+		return fmt.Errorf("internal addresses unknown")
+	}
+	if !resolver.IsInternalAddress(to) {
+		return fmt.Errorf("destination 0x%x is not an internal address", to)
+	}
+	return nil
+}
+
+var _ (policy.Policy) = (*BatchApprovalPolicy)(nil)
+
+func (p *BatchApprovalPolicy) Validate() error {
+	if len(p.Root) != sha256.Size {
+		return fmt.Errorf("invalid root length: %d, expected %d", len(p.Root), sha256.Size)
+	}
+	if len(p.Participants) == 0 {
+		return fmt.Errorf("missing participants")
+	}
+	if int(p.Threshold) > len(p.Participants) {
+		return fmt.Errorf("threshold %d can't be satisfied by %d participants", p.Threshold, len(p.Participants))
+	}
+	return nil
+}
+
+func (p *BatchApprovalPolicy) AddressToParticipant(addr string) (string, error) {
+	for _, participant := range p.Participants {
+		if participant.Address == addr {
+			return participant.Abbreviation, nil
+		}
+	}
+	return "", fmt.Errorf("address not a participant of this policy")
+}
+
+// Verify passes for transactions whose signing hash is in the approved
+// batch, as proven by the payload. Transfers without a proof need the
+// approval of Threshold participants, and are rejected if it's zero. Other
+// actions pass if any of the participants approved.
+func (p *BatchApprovalPolicy) Verify(_ context.Context, approvers policy.ApproverSet, policyPayload policy.PolicyPayload, policyData map[string][]byte) error {
+	payload, err := policy.UnpackPayload[BatchApprovalPolicyPayload](policyPayload)
+	if err != nil {
+		return err
+	}
+	if IsTransferData(policyData) {
+		if payload == nil {
+			return p.verifyThreshold(approvers)
+		}
+		hash := policyData[dataForSigningKey]
+		if len(hash) != 32 {
+			return fmt.Errorf("invalid transfer hash length: %d", len(hash))
+		}
+		if !VerifyBatchMerkleProof(p.Root, hash, payload.Proof) {
+			return fmt.Errorf("invalid batch proof")
+		}
+		return nil
+	}
+
+	if len(approvers) == 0 {
+		return fmt.Errorf("no approvers")
+	}
+	return nil
+}
+
+// verifyThreshold checks that Threshold participants approved a transfer
+// without a batch proof.
+func (p *BatchApprovalPolicy) verifyThreshold(approvers policy.ApproverSet) error {
+	if p.Threshold == 0 {
+		return fmt.Errorf("missing batch proof")
+	}
+	approvals := 0
+	for _, participant := range p.Participants {
+		if approvers[participant.Abbreviation] {
+			approvals++
+		}
+	}
+	if approvals < int(p.Threshold) {
+		return fmt.Errorf("%d approvals out of %d required", approvals, p.Threshold)
+	}
+	return nil
+}
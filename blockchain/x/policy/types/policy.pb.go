@@ -23,12 +23,48 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type SignatureAlgorithm int32
+
+const (
+	SignatureAlgorithm_SIGNATURE_ALGORITHM_ECDSA_SECP256K1 SignatureAlgorithm = 0
+	SignatureAlgorithm_SIGNATURE_ALGORITHM_EDDSA_ED25519   SignatureAlgorithm = 1
+)
+
+var SignatureAlgorithm_name = map[int32]string{
+	0: "SIGNATURE_ALGORITHM_ECDSA_SECP256K1",
+	1: "SIGNATURE_ALGORITHM_EDDSA_ED25519",
+}
+
+var SignatureAlgorithm_value = map[string]int32{
+	"SIGNATURE_ALGORITHM_ECDSA_SECP256K1": 0,
+	"SIGNATURE_ALGORITHM_EDDSA_ED25519":   1,
+}
+
+func (x SignatureAlgorithm) String() string {
+	return proto.EnumName(SignatureAlgorithm_name, int32(x))
+}
+
+func (SignatureAlgorithm) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_50a6c43888f5f679, []int{0}
+}
+
 type Policy struct {
 	Id   uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	// The actual policy informations. It must be one the supported policy types:
 	// - BlackbirdPolicy
+	// - OracleAttestationPolicy
+	// - DestinationQuorumPolicy
+	// - MaxFeePolicy
+	// - RotatingKeyPolicy
+	// - GroupedQuorumPolicy
 	Policy *types.Any `protobuf:"bytes,3,opt,name=policy,proto3" json:"policy,omitempty"`
+	// Nonce is incremented every time the definition of the policy is updated,
+	// so that approvals collected for the old definition can't be used.
+	Nonce uint64 `protobuf:"varint,4,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// Optional ID of the policy that must approve updates to this policy. If
+	// not set, updates must be approved by the policy itself.
+	AdminPolicyId uint64 `protobuf:"varint,5,opt,name=admin_policy_id,json=adminPolicyId,proto3" json:"admin_policy_id,omitempty"`
 }
 
 func (m *Policy) Reset()         { *m = Policy{} }
@@ -85,6 +121,20 @@ func (m *Policy) GetPolicy() *types.Any {
 	return nil
 }
 
+func (m *Policy) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *Policy) GetAdminPolicyId() uint64 {
+	if m != nil {
+		return m.AdminPolicyId
+	}
+	return 0
+}
+
 type BoolparserPolicy struct {
 	// Definition of the policy, eg.
 	// "t1 + t2 + t3 > 1"
@@ -142,6 +192,10 @@ func (m *BoolparserPolicy) GetParticipants() []*PolicyParticipant {
 type BlackbirdPolicy struct {
 	Data         []byte               `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 	Participants []*PolicyParticipant `protobuf:"bytes,2,rep,name=participants,proto3" json:"participants,omitempty"`
+	// Optional minimum fraction of the participants that must approve, in
+	// addition to satisfying data. The number of required approvals is
+	// ceil(len(participants) * numerator / denominator).
+	ThresholdFraction *ThresholdFraction `protobuf:"bytes,3,opt,name=threshold_fraction,json=thresholdFraction,proto3" json:"threshold_fraction,omitempty"`
 }
 
 func (m *BlackbirdPolicy) Reset()         { *m = BlackbirdPolicy{} }
@@ -191,6 +245,66 @@ func (m *BlackbirdPolicy) GetParticipants() []*PolicyParticipant {
 	return nil
 }
 
+func (m *BlackbirdPolicy) GetThresholdFraction() *ThresholdFraction {
+	if m != nil {
+		return m.ThresholdFraction
+	}
+	return nil
+}
+
+// ThresholdFraction is a fraction of the participants of a policy, e.g. 2/3.
+type ThresholdFraction struct {
+	Numerator   uint32 `protobuf:"varint,1,opt,name=numerator,proto3" json:"numerator,omitempty"`
+	Denominator uint32 `protobuf:"varint,2,opt,name=denominator,proto3" json:"denominator,omitempty"`
+}
+
+func (m *ThresholdFraction) Reset()         { *m = ThresholdFraction{} }
+func (m *ThresholdFraction) String() string { return proto.CompactTextString(m) }
+func (*ThresholdFraction) ProtoMessage()    {}
+func (*ThresholdFraction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_50a6c43888f5f679, []int{3}
+}
+func (m *ThresholdFraction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ThresholdFraction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ThresholdFraction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ThresholdFraction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ThresholdFraction.Merge(m, src)
+}
+func (m *ThresholdFraction) XXX_Size() int {
+	return m.Size()
+}
+func (m *ThresholdFraction) XXX_DiscardUnknown() {
+	xxx_messageInfo_ThresholdFraction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ThresholdFraction proto.InternalMessageInfo
+
+func (m *ThresholdFraction) GetNumerator() uint32 {
+	if m != nil {
+		return m.Numerator
+	}
+	return 0
+}
+
+func (m *ThresholdFraction) GetDenominator() uint32 {
+	if m != nil {
+		return m.Denominator
+	}
+	return 0
+}
+
 type PolicyParticipant struct {
 	Abbreviation string `protobuf:"bytes,1,opt,name=abbreviation,proto3" json:"abbreviation,omitempty"`
 	Address      string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
@@ -200,7 +314,7 @@ func (m *PolicyParticipant) Reset()         { *m = PolicyParticipant{} }
 func (m *PolicyParticipant) String() string { return proto.CompactTextString(m) }
 func (*PolicyParticipant) ProtoMessage()    {}
 func (*PolicyParticipant) Descriptor() ([]byte, []int) {
-	return fileDescriptor_50a6c43888f5f679, []int{3}
+	return fileDescriptor_50a6c43888f5f679, []int{4}
 }
 func (m *PolicyParticipant) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -245,13 +359,17 @@ func (m *PolicyParticipant) GetAddress() string {
 
 type BlackbirdPolicyPayload struct {
 	Witness []byte `protobuf:"bytes,1,opt,name=witness,proto3" json:"witness,omitempty"`
+	// Range of block heights, inclusive, in which the approval is valid. Zero
+	// leaves the range unbounded on that side.
+	MinBlockHeight uint64 `protobuf:"varint,2,opt,name=min_block_height,json=minBlockHeight,proto3" json:"min_block_height,omitempty"`
+	MaxBlockHeight uint64 `protobuf:"varint,3,opt,name=max_block_height,json=maxBlockHeight,proto3" json:"max_block_height,omitempty"`
 }
 
 func (m *BlackbirdPolicyPayload) Reset()         { *m = BlackbirdPolicyPayload{} }
 func (m *BlackbirdPolicyPayload) String() string { return proto.CompactTextString(m) }
 func (*BlackbirdPolicyPayload) ProtoMessage()    {}
 func (*BlackbirdPolicyPayload) Descriptor() ([]byte, []int) {
-	return fileDescriptor_50a6c43888f5f679, []int{4}
+	return fileDescriptor_50a6c43888f5f679, []int{5}
 }
 func (m *BlackbirdPolicyPayload) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -287,23 +405,48 @@ func (m *BlackbirdPolicyPayload) GetWitness() []byte {
 	return nil
 }
 
-type BlackbirdPolicyMetadata struct {
-	// The "decompiled" version of the policy, in a readable format.
-	Pretty string `protobuf:"bytes,1,opt,name=pretty,proto3" json:"pretty,omitempty"`
+func (m *BlackbirdPolicyPayload) GetMinBlockHeight() uint64 {
+	if m != nil {
+		return m.MinBlockHeight
+	}
+	return 0
 }
 
-func (m *BlackbirdPolicyMetadata) Reset()         { *m = BlackbirdPolicyMetadata{} }
-func (m *BlackbirdPolicyMetadata) String() string { return proto.CompactTextString(m) }
-func (*BlackbirdPolicyMetadata) ProtoMessage()    {}
-func (*BlackbirdPolicyMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_50a6c43888f5f679, []int{5}
+func (m *BlackbirdPolicyPayload) GetMaxBlockHeight() uint64 {
+	if m != nil {
+		return m.MaxBlockHeight
+	}
+	return 0
 }
-func (m *BlackbirdPolicyMetadata) XXX_Unmarshal(b []byte) error {
+
+// OracleAttestationPolicy requires the approval of any of the participants
+// and, for transfers above a threshold, a signed attestation from an
+// off-chain oracle (e.g. for KYC or travel rule compliance).
+type OracleAttestationPolicy struct {
+	// Compressed secp256k1 public key of the oracle.
+	OraclePubkey []byte `protobuf:"bytes,1,opt,name=oracle_pubkey,json=oraclePubkey,proto3" json:"oracle_pubkey,omitempty"`
+	// Transfer amount, in the smallest unit of the coin, above which an
+	// attestation is required.
+	Threshold    string               `protobuf:"bytes,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	Participants []*PolicyParticipant `protobuf:"bytes,3,rep,name=participants,proto3" json:"participants,omitempty"`
+	// Serialized coin identifier of the coin of the threshold, e.g. "ETH" or
+	// "ETH/0xdac17f958d2ee523a2206206994597c13d831ec7". Transfers of other
+	// coins always require an attestation.
+	Coin string `protobuf:"bytes,4,opt,name=coin,proto3" json:"coin,omitempty"`
+}
+
+func (m *OracleAttestationPolicy) Reset()         { *m = OracleAttestationPolicy{} }
+func (m *OracleAttestationPolicy) String() string { return proto.CompactTextString(m) }
+func (*OracleAttestationPolicy) ProtoMessage()    {}
+func (*OracleAttestationPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_50a6c43888f5f679, []int{6}
+}
+func (m *OracleAttestationPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *BlackbirdPolicyMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *OracleAttestationPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_BlackbirdPolicyMetadata.Marshal(b, m, deterministic)
+		return xxx_messageInfo_OracleAttestationPolicy.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -313,415 +456,5057 @@ func (m *BlackbirdPolicyMetadata) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *BlackbirdPolicyMetadata) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BlackbirdPolicyMetadata.Merge(m, src)
+func (m *OracleAttestationPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OracleAttestationPolicy.Merge(m, src)
 }
-func (m *BlackbirdPolicyMetadata) XXX_Size() int {
+func (m *OracleAttestationPolicy) XXX_Size() int {
 	return m.Size()
 }
-func (m *BlackbirdPolicyMetadata) XXX_DiscardUnknown() {
-	xxx_messageInfo_BlackbirdPolicyMetadata.DiscardUnknown(m)
+func (m *OracleAttestationPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_OracleAttestationPolicy.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BlackbirdPolicyMetadata proto.InternalMessageInfo
+var xxx_messageInfo_OracleAttestationPolicy proto.InternalMessageInfo
 
-func (m *BlackbirdPolicyMetadata) GetPretty() string {
+func (m *OracleAttestationPolicy) GetOraclePubkey() []byte {
 	if m != nil {
-		return m.Pretty
+		return m.OraclePubkey
+	}
+	return nil
+}
+
+func (m *OracleAttestationPolicy) GetThreshold() string {
+	if m != nil {
+		return m.Threshold
 	}
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*Policy)(nil), "fusionchain.policy.Policy")
-	proto.RegisterType((*BoolparserPolicy)(nil), "fusionchain.policy.BoolparserPolicy")
-	proto.RegisterType((*BlackbirdPolicy)(nil), "fusionchain.policy.BlackbirdPolicy")
-	proto.RegisterType((*PolicyParticipant)(nil), "fusionchain.policy.PolicyParticipant")
-	proto.RegisterType((*BlackbirdPolicyPayload)(nil), "fusionchain.policy.BlackbirdPolicyPayload")
-	proto.RegisterType((*BlackbirdPolicyMetadata)(nil), "fusionchain.policy.BlackbirdPolicyMetadata")
+func (m *OracleAttestationPolicy) GetParticipants() []*PolicyParticipant {
+	if m != nil {
+		return m.Participants
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("fusionchain/policy/policy.proto", fileDescriptor_50a6c43888f5f679) }
+func (m *OracleAttestationPolicy) GetCoin() string {
+	if m != nil {
+		return m.Coin
+	}
+	return ""
+}
 
-var fileDescriptor_50a6c43888f5f679 = []byte{
-	// 377 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x52, 0xbd, 0xae, 0xd3, 0x30,
-	0x14, 0x6e, 0xd2, 0x2a, 0xa8, 0xa7, 0x15, 0x3f, 0x16, 0x2a, 0x81, 0x21, 0x44, 0x91, 0x90, 0x22,
-	0x81, 0x1c, 0x51, 0x9e, 0x80, 0x4a, 0x0c, 0x0c, 0x48, 0x25, 0x63, 0x37, 0x27, 0x76, 0x5b, 0x8b,
-	0xd4, 0x36, 0x8e, 0x0b, 0x64, 0xe0, 0x1d, 0x78, 0x2c, 0xc6, 0x8e, 0x8c, 0xa8, 0x7d, 0x91, 0xab,
-	0x3a, 0x8e, 0x6e, 0xda, 0xae, 0x77, 0xf2, 0x39, 0xc7, 0xdf, 0x8f, 0x3f, 0xeb, 0xc0, 0xeb, 0xf5,
-	0xbe, 0xe6, 0x52, 0x94, 0x5b, 0xc2, 0x45, 0xa6, 0x64, 0xc5, 0xcb, 0xc6, 0x1d, 0x58, 0x69, 0x69,
-	0x24, 0x42, 0x3d, 0x00, 0x6e, 0x6f, 0x5e, 0xbd, 0xdc, 0x48, 0xb9, 0xa9, 0x58, 0x66, 0x11, 0xc5,
-	0x7e, 0x9d, 0x11, 0xe1, 0xe0, 0xc9, 0x0a, 0x82, 0xa5, 0x05, 0xa1, 0xc7, 0xe0, 0x73, 0x1a, 0x7a,
-	0xb1, 0x97, 0x8e, 0x72, 0x9f, 0x53, 0x84, 0x60, 0x24, 0xc8, 0x8e, 0x85, 0x7e, 0xec, 0xa5, 0xe3,
-	0xdc, 0xd6, 0xe8, 0x1d, 0x04, 0xad, 0x64, 0x38, 0x8c, 0xbd, 0x74, 0x32, 0x7f, 0x8e, 0x5b, 0x65,
-	0xdc, 0x29, 0xe3, 0x8f, 0xa2, 0xc9, 0x1d, 0x26, 0xf9, 0x0d, 0x4f, 0x17, 0x52, 0x56, 0x8a, 0xe8,
-	0x9a, 0x69, 0xe7, 0x12, 0x01, 0x50, 0xb6, 0xe6, 0x82, 0x1b, 0x2e, 0x85, 0x75, 0x1b, 0xe7, 0xbd,
-	0x09, 0xfa, 0x0c, 0x53, 0x45, 0xb4, 0xe1, 0x25, 0x57, 0x44, 0x98, 0x3a, 0xf4, 0xe3, 0x61, 0x3a,
-	0x99, 0xbf, 0xc1, 0xb7, 0xa9, 0x70, 0xab, 0xb8, 0xbc, 0x47, 0xe7, 0x17, 0xd4, 0x44, 0xc1, 0x93,
-	0x45, 0x45, 0xca, 0x6f, 0x05, 0xd7, 0xd4, 0xb9, 0x23, 0x18, 0x51, 0x62, 0x88, 0xf5, 0x9d, 0xe6,
-	0xb6, 0x7e, 0x48, 0xc7, 0xaf, 0xf0, 0xec, 0x06, 0x82, 0x12, 0x98, 0x92, 0xa2, 0xd0, 0xec, 0x07,
-	0x27, 0xbd, 0xcc, 0x17, 0x33, 0x14, 0xc2, 0x23, 0x42, 0xa9, 0x66, 0x75, 0xed, 0xbe, 0xbb, 0x6b,
-	0x93, 0x39, 0xcc, 0xae, 0x42, 0x2c, 0x49, 0x53, 0x49, 0x42, 0xcf, 0x9c, 0x9f, 0xdc, 0x88, 0x33,
-	0xa7, 0x8d, 0xd3, 0xb5, 0xc9, 0x7b, 0x78, 0x71, 0xc5, 0xf9, 0xc2, 0x0c, 0xb1, 0x61, 0x67, 0x10,
-	0x28, 0xcd, 0x8c, 0x69, 0xdc, 0x33, 0x5c, 0xb7, 0xf8, 0xf4, 0xf7, 0x18, 0x79, 0x87, 0x63, 0xe4,
-	0xfd, 0x3f, 0x46, 0xde, 0x9f, 0x53, 0x34, 0x38, 0x9c, 0xa2, 0xc1, 0xbf, 0x53, 0x34, 0x58, 0xbd,
-	0xdd, 0x70, 0xb3, 0xdd, 0x17, 0xb8, 0x94, 0xbb, 0xec, 0xbb, 0x66, 0x54, 0x66, 0xfd, 0x0d, 0xfc,
-	0xd5, 0xed, 0xa0, 0x69, 0x14, 0xab, 0x8b, 0xc0, 0xee, 0xc1, 0x87, 0xbb, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x40, 0x18, 0xf2, 0xb3, 0xa6, 0x02, 0x00, 0x00,
+type OracleAttestationPolicyPayload struct {
+	// Signature of the oracle over the hash of the transfer, in the 64 bytes
+	// [R || S] format.
+	Attestation []byte `protobuf:"bytes,1,opt,name=attestation,proto3" json:"attestation,omitempty"`
 }
 
-func (m *Policy) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *OracleAttestationPolicyPayload) Reset()         { *m = OracleAttestationPolicyPayload{} }
+func (m *OracleAttestationPolicyPayload) String() string { return proto.CompactTextString(m) }
+func (*OracleAttestationPolicyPayload) ProtoMessage()    {}
+func (*OracleAttestationPolicyPayload) Descriptor() ([]byte, []int) {
+	return fileDescriptor_50a6c43888f5f679, []int{7}
+}
+func (m *OracleAttestationPolicyPayload) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OracleAttestationPolicyPayload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OracleAttestationPolicyPayload.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *OracleAttestationPolicyPayload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OracleAttestationPolicyPayload.Merge(m, src)
+}
+func (m *OracleAttestationPolicyPayload) XXX_Size() int {
+	return m.Size()
+}
+func (m *OracleAttestationPolicyPayload) XXX_DiscardUnknown() {
+	xxx_messageInfo_OracleAttestationPolicyPayload.DiscardUnknown(m)
 }
 
-func (m *Policy) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_OracleAttestationPolicyPayload proto.InternalMessageInfo
+
+func (m *OracleAttestationPolicyPayload) GetAttestation() []byte {
+	if m != nil {
+		return m.Attestation
+	}
+	return nil
 }
 
-func (m *Policy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Policy != nil {
-		{
-			size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPolicy(dAtA, i, uint64(size))
+// DestinationQuorumPolicy requires a different number of approvals depending
+// on whether the destination of the transfer is one of our own wallets
+// (internal) or not (external).
+type DestinationQuorumPolicy struct {
+	// Number of approvals required for transfers to internal wallets.
+	InternalThreshold uint32 `protobuf:"varint,1,opt,name=internal_threshold,json=internalThreshold,proto3" json:"internal_threshold,omitempty"`
+	// Number of approvals required for transfers to external wallets.
+	ExternalThreshold uint32               `protobuf:"varint,2,opt,name=external_threshold,json=externalThreshold,proto3" json:"external_threshold,omitempty"`
+	Participants      []*PolicyParticipant `protobuf:"bytes,3,rep,name=participants,proto3" json:"participants,omitempty"`
+}
+
+func (m *DestinationQuorumPolicy) Reset()         { *m = DestinationQuorumPolicy{} }
+func (m *DestinationQuorumPolicy) String() string { return proto.CompactTextString(m) }
+func (*DestinationQuorumPolicy) ProtoMessage()    {}
+func (*DestinationQuorumPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_50a6c43888f5f679, []int{8}
+}
+func (m *DestinationQuorumPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DestinationQuorumPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DestinationQuorumPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1a
+		return b[:n], nil
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintPolicy(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *DestinationQuorumPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DestinationQuorumPolicy.Merge(m, src)
+}
+func (m *DestinationQuorumPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *DestinationQuorumPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_DestinationQuorumPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DestinationQuorumPolicy proto.InternalMessageInfo
+
+func (m *DestinationQuorumPolicy) GetInternalThreshold() uint32 {
+	if m != nil {
+		return m.InternalThreshold
 	}
-	if m.Id != 0 {
-		i = encodeVarintPolicy(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *DestinationQuorumPolicy) GetExternalThreshold() uint32 {
+	if m != nil {
+		return m.ExternalThreshold
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *BoolparserPolicy) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *DestinationQuorumPolicy) GetParticipants() []*PolicyParticipant {
+	if m != nil {
+		return m.Participants
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *BoolparserPolicy) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type DestinationQuorumPolicyPayload struct {
+	// True if the destination of the transfer is an internal wallet.
+	InternalDestination bool `protobuf:"varint,1,opt,name=internal_destination,json=internalDestination,proto3" json:"internal_destination,omitempty"`
 }
 
-func (m *BoolparserPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Participants) > 0 {
-		for iNdEx := len(m.Participants) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Participants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPolicy(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+func (m *DestinationQuorumPolicyPayload) Reset()         { *m = DestinationQuorumPolicyPayload{} }
+func (m *DestinationQuorumPolicyPayload) String() string { return proto.CompactTextString(m) }
+func (*DestinationQuorumPolicyPayload) ProtoMessage()    {}
+func (*DestinationQuorumPolicyPayload) Descriptor() ([]byte, []int) {
+	return fileDescriptor_50a6c43888f5f679, []int{9}
+}
+func (m *DestinationQuorumPolicyPayload) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DestinationQuorumPolicyPayload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DestinationQuorumPolicyPayload.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Definition) > 0 {
-		i -= len(m.Definition)
-		copy(dAtA[i:], m.Definition)
-		i = encodeVarintPolicy(dAtA, i, uint64(len(m.Definition)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *DestinationQuorumPolicyPayload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DestinationQuorumPolicyPayload.Merge(m, src)
+}
+func (m *DestinationQuorumPolicyPayload) XXX_Size() int {
+	return m.Size()
+}
+func (m *DestinationQuorumPolicyPayload) XXX_DiscardUnknown() {
+	xxx_messageInfo_DestinationQuorumPolicyPayload.DiscardUnknown(m)
 }
 
-func (m *BlackbirdPolicy) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_DestinationQuorumPolicyPayload proto.InternalMessageInfo
+
+func (m *DestinationQuorumPolicyPayload) GetInternalDestination() bool {
+	if m != nil {
+		return m.InternalDestination
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *BlackbirdPolicy) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MaxFeePolicy rejects transactions whose maximum fee is above the ceiling
+// configured for their chain, and otherwise passes if any of the
+// participants approved.
+type MaxFeePolicy struct {
+	Ceilings     []*FeeCeiling        `protobuf:"bytes,1,rep,name=ceilings,proto3" json:"ceilings,omitempty"`
+	Participants []*PolicyParticipant `protobuf:"bytes,2,rep,name=participants,proto3" json:"participants,omitempty"`
 }
 
-func (m *BlackbirdPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Participants) > 0 {
-		for iNdEx := len(m.Participants) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Participants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPolicy(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+func (m *MaxFeePolicy) Reset()         { *m = MaxFeePolicy{} }
+func (m *MaxFeePolicy) String() string { return proto.CompactTextString(m) }
+func (*MaxFeePolicy) ProtoMessage()    {}
+func (*MaxFeePolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_50a6c43888f5f679, []int{10}
+}
+func (m *MaxFeePolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MaxFeePolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MaxFeePolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintPolicy(dAtA, i, uint64(len(m.Data)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MaxFeePolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MaxFeePolicy.Merge(m, src)
+}
+func (m *MaxFeePolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *MaxFeePolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MaxFeePolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MaxFeePolicy proto.InternalMessageInfo
+
+func (m *MaxFeePolicy) GetCeilings() []*FeeCeiling {
+	if m != nil {
+		return m.Ceilings
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *PolicyParticipant) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MaxFeePolicy) GetParticipants() []*PolicyParticipant {
+	if m != nil {
+		return m.Participants
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *PolicyParticipant) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// FeeCeiling is the maximum fee allowed for transactions on a chain.
+type FeeCeiling struct {
+	// Symbol of the native currency of the chain, as in the coin identifier
+	// of its transfers (e.g. "ETH").
+	Symbol string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// Maximum fee in the smallest unit of the native currency (e.g. wei), as
+	// a decimal string.
+	MaxFee string `protobuf:"bytes,2,opt,name=max_fee,json=maxFee,proto3" json:"max_fee,omitempty"`
 }
 
-func (m *PolicyParticipant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintPolicy(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x12
+func (m *FeeCeiling) Reset()         { *m = FeeCeiling{} }
+func (m *FeeCeiling) String() string { return proto.CompactTextString(m) }
+func (*FeeCeiling) ProtoMessage()    {}
+func (*FeeCeiling) Descriptor() ([]byte, []int) {
+	return fileDescriptor_50a6c43888f5f679, []int{11}
+}
+func (m *FeeCeiling) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeCeiling) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeCeiling.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Abbreviation) > 0 {
-		i -= len(m.Abbreviation)
-		copy(dAtA[i:], m.Abbreviation)
-		i = encodeVarintPolicy(dAtA, i, uint64(len(m.Abbreviation)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *FeeCeiling) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeCeiling.Merge(m, src)
+}
+func (m *FeeCeiling) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeCeiling) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeCeiling.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeCeiling proto.InternalMessageInfo
+
+func (m *FeeCeiling) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *BlackbirdPolicyPayload) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *FeeCeiling) GetMaxFee() string {
+	if m != nil {
+		return m.MaxFee
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *BlackbirdPolicyPayload) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// RotatingKeyPolicy requires a number of participants to sign the action,
+// each with the key valid for the current epoch according to its rotation
+// schedule. Epochs are ranges of epoch_length blocks, starting from block 0.
+type RotatingKeyPolicy struct {
+	// Number of distinct participants that must sign.
+	Threshold uint32 `protobuf:"varint,1,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// Number of blocks in an epoch.
+	EpochLength  uint64               `protobuf:"varint,2,opt,name=epoch_length,json=epochLength,proto3" json:"epoch_length,omitempty"`
+	Participants []*PolicyParticipant `protobuf:"bytes,3,rep,name=participants,proto3" json:"participants,omitempty"`
+	// Rotation schedule of the keys of each participant.
+	Schedules []*KeySchedule `protobuf:"bytes,4,rep,name=schedules,proto3" json:"schedules,omitempty"`
 }
 
-func (m *BlackbirdPolicyPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Witness) > 0 {
-		i -= len(m.Witness)
-		copy(dAtA[i:], m.Witness)
-		i = encodeVarintPolicy(dAtA, i, uint64(len(m.Witness)))
-		i--
-		dAtA[i] = 0xa
+func (m *RotatingKeyPolicy) Reset()         { *m = RotatingKeyPolicy{} }
+func (m *RotatingKeyPolicy) String() string { return proto.CompactTextString(m) }
+func (*RotatingKeyPolicy) ProtoMessage()    {}
+func (*RotatingKeyPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_50a6c43888f5f679, []int{12}
+}
+func (m *RotatingKeyPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RotatingKeyPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RotatingKeyPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *RotatingKeyPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RotatingKeyPolicy.Merge(m, src)
+}
+func (m *RotatingKeyPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *RotatingKeyPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_RotatingKeyPolicy.DiscardUnknown(m)
 }
 
-func (m *BlackbirdPolicyMetadata) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_RotatingKeyPolicy proto.InternalMessageInfo
+
+func (m *RotatingKeyPolicy) GetThreshold() uint32 {
+	if m != nil {
+		return m.Threshold
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *BlackbirdPolicyMetadata) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *RotatingKeyPolicy) GetEpochLength() uint64 {
+	if m != nil {
+		return m.EpochLength
+	}
+	return 0
 }
 
-func (m *BlackbirdPolicyMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Pretty) > 0 {
-		i -= len(m.Pretty)
-		copy(dAtA[i:], m.Pretty)
-		i = encodeVarintPolicy(dAtA, i, uint64(len(m.Pretty)))
-		i--
-		dAtA[i] = 0xa
+func (m *RotatingKeyPolicy) GetParticipants() []*PolicyParticipant {
+	if m != nil {
+		return m.Participants
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func encodeVarintPolicy(dAtA []byte, offset int, v uint64) int {
-	offset -= sovPolicy(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *RotatingKeyPolicy) GetSchedules() []*KeySchedule {
+	if m != nil {
+		return m.Schedules
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *Policy) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovPolicy(uint64(m.Id))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovPolicy(uint64(l))
-	}
-	if m.Policy != nil {
-		l = m.Policy.Size()
-		n += 1 + l + sovPolicy(uint64(l))
-	}
-	return n
+
+// KeySchedule lists the keys of a participant, sorted by the epoch from
+// which they are valid. Each key is valid until the next one starts.
+type KeySchedule struct {
+	Abbreviation string      `protobuf:"bytes,1,opt,name=abbreviation,proto3" json:"abbreviation,omitempty"`
+	Keys         []*EpochKey `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"`
 }
 
-func (m *BoolparserPolicy) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Definition)
-	if l > 0 {
-		n += 1 + l + sovPolicy(uint64(l))
-	}
-	if len(m.Participants) > 0 {
-		for _, e := range m.Participants {
-			l = e.Size()
-			n += 1 + l + sovPolicy(uint64(l))
+func (m *KeySchedule) Reset()         { *m = KeySchedule{} }
+func (m *KeySchedule) String() string { return proto.CompactTextString(m) }
+func (*KeySchedule) ProtoMessage()    {}
+func (*KeySchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_50a6c43888f5f679, []int{13}
+}
+func (m *KeySchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KeySchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_KeySchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *KeySchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KeySchedule.Merge(m, src)
+}
+func (m *KeySchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *KeySchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_KeySchedule.DiscardUnknown(m)
 }
 
-func (m *BlackbirdPolicy) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovPolicy(uint64(l))
-	}
-	if len(m.Participants) > 0 {
-		for _, e := range m.Participants {
-			l = e.Size()
-			n += 1 + l + sovPolicy(uint64(l))
-		}
+var xxx_messageInfo_KeySchedule proto.InternalMessageInfo
+
+func (m *KeySchedule) GetAbbreviation() string {
+	if m != nil {
+		return m.Abbreviation
 	}
-	return n
+	return ""
 }
 
-func (m *PolicyParticipant) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Abbreviation)
-	if l > 0 {
-		n += 1 + l + sovPolicy(uint64(l))
+func (m *KeySchedule) GetKeys() []*EpochKey {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+type EpochKey struct {
+	// First epoch in which the key is valid.
+	StartEpoch uint64 `protobuf:"varint,1,opt,name=start_epoch,json=startEpoch,proto3" json:"start_epoch,omitempty"`
+	// Public key, compressed for secp256k1 keys.
+	Pubkey []byte `protobuf:"bytes,2,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	// Algorithm of the key, and of the signatures made with it.
+	Algorithm SignatureAlgorithm `protobuf:"varint,3,opt,name=algorithm,proto3,enum=fusionchain.policy.SignatureAlgorithm" json:"algorithm,omitempty"`
+}
+
+func (m *EpochKey) Reset()         { *m = EpochKey{} }
+func (m *EpochKey) String() string { return proto.CompactTextString(m) }
+func (*EpochKey) ProtoMessage()    {}
+func (*EpochKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_50a6c43888f5f679, []int{14}
+}
+func (m *EpochKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochKey.Merge(m, src)
+}
+func (m *EpochKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochKey proto.InternalMessageInfo
+
+func (m *EpochKey) GetStartEpoch() uint64 {
+	if m != nil {
+		return m.StartEpoch
+	}
+	return 0
+}
+
+func (m *EpochKey) GetPubkey() []byte {
+	if m != nil {
+		return m.Pubkey
+	}
+	return nil
+}
+
+func (m *EpochKey) GetAlgorithm() SignatureAlgorithm {
+	if m != nil {
+		return m.Algorithm
+	}
+	return SignatureAlgorithm_SIGNATURE_ALGORITHM_ECDSA_SECP256K1
+}
+
+type RotatingKeyPolicyPayload struct {
+	Signatures []*ParticipantSignature `protobuf:"bytes,1,rep,name=signatures,proto3" json:"signatures,omitempty"`
+}
+
+func (m *RotatingKeyPolicyPayload) Reset()         { *m = RotatingKeyPolicyPayload{} }
+func (m *RotatingKeyPolicyPayload) String() string { return proto.CompactTextString(m) }
+func (*RotatingKeyPolicyPayload) ProtoMessage()    {}
+func (*RotatingKeyPolicyPayload) Descriptor() ([]byte, []int) {
+	return fileDescriptor_50a6c43888f5f679, []int{15}
+}
+func (m *RotatingKeyPolicyPayload) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RotatingKeyPolicyPayload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RotatingKeyPolicyPayload.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RotatingKeyPolicyPayload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RotatingKeyPolicyPayload.Merge(m, src)
+}
+func (m *RotatingKeyPolicyPayload) XXX_Size() int {
+	return m.Size()
+}
+func (m *RotatingKeyPolicyPayload) XXX_DiscardUnknown() {
+	xxx_messageInfo_RotatingKeyPolicyPayload.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RotatingKeyPolicyPayload proto.InternalMessageInfo
+
+func (m *RotatingKeyPolicyPayload) GetSignatures() []*ParticipantSignature {
+	if m != nil {
+		return m.Signatures
+	}
+	return nil
+}
+
+type ParticipantSignature struct {
+	Abbreviation string `protobuf:"bytes,1,opt,name=abbreviation,proto3" json:"abbreviation,omitempty"`
+	// Signature over the hash of the action data, in the 64 bytes [R || S]
+	// format for secp256k1 keys.
+	Signature []byte `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *ParticipantSignature) Reset()         { *m = ParticipantSignature{} }
+func (m *ParticipantSignature) String() string { return proto.CompactTextString(m) }
+func (*ParticipantSignature) ProtoMessage()    {}
+func (*ParticipantSignature) Descriptor() ([]byte, []int) {
+	return fileDescriptor_50a6c43888f5f679, []int{16}
+}
+func (m *ParticipantSignature) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParticipantSignature) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParticipantSignature.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ParticipantSignature) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParticipantSignature.Merge(m, src)
+}
+func (m *ParticipantSignature) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParticipantSignature) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParticipantSignature.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParticipantSignature proto.InternalMessageInfo
+
+func (m *ParticipantSignature) GetAbbreviation() string {
+	if m != nil {
+		return m.Abbreviation
+	}
+	return ""
+}
+
+func (m *ParticipantSignature) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+// GroupedQuorumPolicy requires a minimum number of approvals from each group
+// of participants (e.g. at least one from ops and one from finance).
+type GroupedQuorumPolicy struct {
+	Participants []*PolicyParticipant `protobuf:"bytes,1,rep,name=participants,proto3" json:"participants,omitempty"`
+	// Groups the participants are tagged with. Every participant belongs to
+	// exactly one group.
+	Groups []*ParticipantGroup `protobuf:"bytes,2,rep,name=groups,proto3" json:"groups,omitempty"`
+}
+
+func (m *GroupedQuorumPolicy) Reset()         { *m = GroupedQuorumPolicy{} }
+func (m *GroupedQuorumPolicy) String() string { return proto.CompactTextString(m) }
+func (*GroupedQuorumPolicy) ProtoMessage()    {}
+func (*GroupedQuorumPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_50a6c43888f5f679, []int{17}
+}
+func (m *GroupedQuorumPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GroupedQuorumPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GroupedQuorumPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GroupedQuorumPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GroupedQuorumPolicy.Merge(m, src)
+}
+func (m *GroupedQuorumPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *GroupedQuorumPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_GroupedQuorumPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GroupedQuorumPolicy proto.InternalMessageInfo
+
+func (m *GroupedQuorumPolicy) GetParticipants() []*PolicyParticipant {
+	if m != nil {
+		return m.Participants
+	}
+	return nil
+}
+
+func (m *GroupedQuorumPolicy) GetGroups() []*ParticipantGroup {
+	if m != nil {
+		return m.Groups
+	}
+	return nil
+}
+
+type ParticipantGroup struct {
+	// Label of the group, e.g. "ops".
+	Label string `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	// Number of members of the group that must approve.
+	MinApprovals uint32 `protobuf:"varint,2,opt,name=min_approvals,json=minApprovals,proto3" json:"min_approvals,omitempty"`
+	// Abbreviations of the participants in the group.
+	Members []string `protobuf:"bytes,3,rep,name=members,proto3" json:"members,omitempty"`
+}
+
+func (m *ParticipantGroup) Reset()         { *m = ParticipantGroup{} }
+func (m *ParticipantGroup) String() string { return proto.CompactTextString(m) }
+func (*ParticipantGroup) ProtoMessage()    {}
+func (*ParticipantGroup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_50a6c43888f5f679, []int{18}
+}
+func (m *ParticipantGroup) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParticipantGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParticipantGroup.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ParticipantGroup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParticipantGroup.Merge(m, src)
+}
+func (m *ParticipantGroup) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParticipantGroup) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParticipantGroup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParticipantGroup proto.InternalMessageInfo
+
+func (m *ParticipantGroup) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+func (m *ParticipantGroup) GetMinApprovals() uint32 {
+	if m != nil {
+		return m.MinApprovals
+	}
+	return 0
+}
+
+func (m *ParticipantGroup) GetMembers() []string {
+	if m != nil {
+		return m.Members
+	}
+	return nil
+}
+
+// CooldownPolicy requires at least cooldown_blocks blocks to pass between
+// consecutive transfers approved by the policy, and otherwise passes if any
+// of the participants approved.
+type CooldownPolicy struct {
+	// Minimum number of blocks between two transfers.
+	CooldownBlocks uint64               `protobuf:"varint,1,opt,name=cooldown_blocks,json=cooldownBlocks,proto3" json:"cooldown_blocks,omitempty"`
+	Participants   []*PolicyParticipant `protobuf:"bytes,2,rep,name=participants,proto3" json:"participants,omitempty"`
+}
+
+func (m *CooldownPolicy) Reset()         { *m = CooldownPolicy{} }
+func (m *CooldownPolicy) String() string { return proto.CompactTextString(m) }
+func (*CooldownPolicy) ProtoMessage()    {}
+func (*CooldownPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_50a6c43888f5f679, []int{19}
+}
+func (m *CooldownPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CooldownPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CooldownPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CooldownPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CooldownPolicy.Merge(m, src)
+}
+func (m *CooldownPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *CooldownPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_CooldownPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CooldownPolicy proto.InternalMessageInfo
+
+func (m *CooldownPolicy) GetCooldownBlocks() uint64 {
+	if m != nil {
+		return m.CooldownBlocks
+	}
+	return 0
+}
+
+func (m *CooldownPolicy) GetParticipants() []*PolicyParticipant {
+	if m != nil {
+		return m.Participants
+	}
+	return nil
+}
+
+// BatchApprovalPolicy approves a batch of transfers at once: the
+// participants approve the Merkle root of the signing hashes of the
+// transfers when the policy is set, and each transfer of the batch then
+// passes with a proof of its inclusion, without further approvals.
+// Transfers without a proof need threshold approvals, other actions pass
+// if any of the participants approved.
+type BatchApprovalPolicy struct {
+	// Merkle root of the batch, 32 bytes, see BatchMerkleRoot.
+	Root         []byte               `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`
+	Participants []*PolicyParticipant `protobuf:"bytes,2,rep,name=participants,proto3" json:"participants,omitempty"`
+	// Number of participants that must approve transfers without a proof of
+	// their inclusion in the batch. If zero, such transfers are rejected.
+	Threshold uint32 `protobuf:"varint,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
+}
+
+func (m *BatchApprovalPolicy) Reset()         { *m = BatchApprovalPolicy{} }
+func (m *BatchApprovalPolicy) String() string { return proto.CompactTextString(m) }
+func (*BatchApprovalPolicy) ProtoMessage()    {}
+func (*BatchApprovalPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_50a6c43888f5f679, []int{20}
+}
+func (m *BatchApprovalPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchApprovalPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchApprovalPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BatchApprovalPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchApprovalPolicy.Merge(m, src)
+}
+func (m *BatchApprovalPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchApprovalPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchApprovalPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchApprovalPolicy proto.InternalMessageInfo
+
+func (m *BatchApprovalPolicy) GetRoot() []byte {
+	if m != nil {
+		return m.Root
+	}
+	return nil
+}
+
+func (m *BatchApprovalPolicy) GetParticipants() []*PolicyParticipant {
+	if m != nil {
+		return m.Participants
+	}
+	return nil
+}
+
+func (m *BatchApprovalPolicy) GetThreshold() uint32 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+type BatchApprovalPolicyPayload struct {
+	// Hashes of the siblings on the path from the leaf of the transfer to the
+	// root, see BatchMerkleProof.
+	Proof [][]byte `protobuf:"bytes,1,rep,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (m *BatchApprovalPolicyPayload) Reset()         { *m = BatchApprovalPolicyPayload{} }
+func (m *BatchApprovalPolicyPayload) String() string { return proto.CompactTextString(m) }
+func (*BatchApprovalPolicyPayload) ProtoMessage()    {}
+func (*BatchApprovalPolicyPayload) Descriptor() ([]byte, []int) {
+	return fileDescriptor_50a6c43888f5f679, []int{21}
+}
+func (m *BatchApprovalPolicyPayload) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchApprovalPolicyPayload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchApprovalPolicyPayload.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BatchApprovalPolicyPayload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchApprovalPolicyPayload.Merge(m, src)
+}
+func (m *BatchApprovalPolicyPayload) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchApprovalPolicyPayload) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchApprovalPolicyPayload.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchApprovalPolicyPayload proto.InternalMessageInfo
+
+func (m *BatchApprovalPolicyPayload) GetProof() [][]byte {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+// TxCountLimitPolicy limits the number of transfers approved by the policy
+// to max_transfers per window of window_blocks blocks, and otherwise passes
+// if any of the participants approved.
+type TxCountLimitPolicy struct {
+	// Maximum number of transfers in a window.
+	MaxTransfers uint64 `protobuf:"varint,1,opt,name=max_transfers,json=maxTransfers,proto3" json:"max_transfers,omitempty"`
+	// Length of a window in blocks. A window starts with the first transfer
+	// after the previous one ended.
+	WindowBlocks uint64               `protobuf:"varint,2,opt,name=window_blocks,json=windowBlocks,proto3" json:"window_blocks,omitempty"`
+	Participants []*PolicyParticipant `protobuf:"bytes,3,rep,name=participants,proto3" json:"participants,omitempty"`
+}
+
+func (m *TxCountLimitPolicy) Reset()         { *m = TxCountLimitPolicy{} }
+func (m *TxCountLimitPolicy) String() string { return proto.CompactTextString(m) }
+func (*TxCountLimitPolicy) ProtoMessage()    {}
+func (*TxCountLimitPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_50a6c43888f5f679, []int{22}
+}
+func (m *TxCountLimitPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxCountLimitPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxCountLimitPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TxCountLimitPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxCountLimitPolicy.Merge(m, src)
+}
+func (m *TxCountLimitPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxCountLimitPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxCountLimitPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxCountLimitPolicy proto.InternalMessageInfo
+
+func (m *TxCountLimitPolicy) GetMaxTransfers() uint64 {
+	if m != nil {
+		return m.MaxTransfers
+	}
+	return 0
+}
+
+func (m *TxCountLimitPolicy) GetWindowBlocks() uint64 {
+	if m != nil {
+		return m.WindowBlocks
+	}
+	return 0
+}
+
+func (m *TxCountLimitPolicy) GetParticipants() []*PolicyParticipant {
+	if m != nil {
+		return m.Participants
+	}
+	return nil
+}
+
+// InternalOnlyPolicy only allows transfers to addresses of the wallets of
+// the treasury module, preventing accidental external transfers, and
+// otherwise passes if any of the participants approved.
+type InternalOnlyPolicy struct {
+	Participants []*PolicyParticipant `protobuf:"bytes,1,rep,name=participants,proto3" json:"participants,omitempty"`
+}
+
+func (m *InternalOnlyPolicy) Reset()         { *m = InternalOnlyPolicy{} }
+func (m *InternalOnlyPolicy) String() string { return proto.CompactTextString(m) }
+func (*InternalOnlyPolicy) ProtoMessage()    {}
+func (*InternalOnlyPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_50a6c43888f5f679, []int{23}
+}
+func (m *InternalOnlyPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InternalOnlyPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InternalOnlyPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InternalOnlyPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InternalOnlyPolicy.Merge(m, src)
+}
+func (m *InternalOnlyPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *InternalOnlyPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_InternalOnlyPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InternalOnlyPolicy proto.InternalMessageInfo
+
+func (m *InternalOnlyPolicy) GetParticipants() []*PolicyParticipant {
+	if m != nil {
+		return m.Participants
+	}
+	return nil
+}
+
+// PolicyDecision is the record of the approvals that satisfied a policy,
+// suitable for being stored on chain.
+type PolicyDecision struct {
+	PolicyId uint64 `protobuf:"varint,1,opt,name=policy_id,json=policyId,proto3" json:"policy_id,omitempty"`
+	// Abbreviations of the approvers, sorted and without duplicates.
+	Approvers []string `protobuf:"bytes,2,rep,name=approvers,proto3" json:"approvers,omitempty"`
+	// Payload passed to the policy verification, if any.
+	Payload *types.Any `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	// Nonce of the policy at the time of the decision.
+	PolicyNonce uint64 `protobuf:"varint,4,opt,name=policy_nonce,json=policyNonce,proto3" json:"policy_nonce,omitempty"`
+}
+
+func (m *PolicyDecision) Reset()         { *m = PolicyDecision{} }
+func (m *PolicyDecision) String() string { return proto.CompactTextString(m) }
+func (*PolicyDecision) ProtoMessage()    {}
+func (*PolicyDecision) Descriptor() ([]byte, []int) {
+	return fileDescriptor_50a6c43888f5f679, []int{24}
+}
+func (m *PolicyDecision) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PolicyDecision) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PolicyDecision.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PolicyDecision) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PolicyDecision.Merge(m, src)
+}
+func (m *PolicyDecision) XXX_Size() int {
+	return m.Size()
+}
+func (m *PolicyDecision) XXX_DiscardUnknown() {
+	xxx_messageInfo_PolicyDecision.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PolicyDecision proto.InternalMessageInfo
+
+func (m *PolicyDecision) GetPolicyId() uint64 {
+	if m != nil {
+		return m.PolicyId
+	}
+	return 0
+}
+
+func (m *PolicyDecision) GetApprovers() []string {
+	if m != nil {
+		return m.Approvers
+	}
+	return nil
+}
+
+func (m *PolicyDecision) GetPayload() *types.Any {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (m *PolicyDecision) GetPolicyNonce() uint64 {
+	if m != nil {
+		return m.PolicyNonce
+	}
+	return 0
+}
+
+type BlackbirdPolicyMetadata struct {
+	// The "decompiled" version of the policy, in a readable format.
+	Pretty string `protobuf:"bytes,1,opt,name=pretty,proto3" json:"pretty,omitempty"`
+}
+
+func (m *BlackbirdPolicyMetadata) Reset()         { *m = BlackbirdPolicyMetadata{} }
+func (m *BlackbirdPolicyMetadata) String() string { return proto.CompactTextString(m) }
+func (*BlackbirdPolicyMetadata) ProtoMessage()    {}
+func (*BlackbirdPolicyMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_50a6c43888f5f679, []int{25}
+}
+func (m *BlackbirdPolicyMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlackbirdPolicyMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlackbirdPolicyMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlackbirdPolicyMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlackbirdPolicyMetadata.Merge(m, src)
+}
+func (m *BlackbirdPolicyMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlackbirdPolicyMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlackbirdPolicyMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlackbirdPolicyMetadata proto.InternalMessageInfo
+
+func (m *BlackbirdPolicyMetadata) GetPretty() string {
+	if m != nil {
+		return m.Pretty
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("fusionchain.policy.SignatureAlgorithm", SignatureAlgorithm_name, SignatureAlgorithm_value)
+	proto.RegisterType((*Policy)(nil), "fusionchain.policy.Policy")
+	proto.RegisterType((*BoolparserPolicy)(nil), "fusionchain.policy.BoolparserPolicy")
+	proto.RegisterType((*BlackbirdPolicy)(nil), "fusionchain.policy.BlackbirdPolicy")
+	proto.RegisterType((*ThresholdFraction)(nil), "fusionchain.policy.ThresholdFraction")
+	proto.RegisterType((*PolicyParticipant)(nil), "fusionchain.policy.PolicyParticipant")
+	proto.RegisterType((*BlackbirdPolicyPayload)(nil), "fusionchain.policy.BlackbirdPolicyPayload")
+	proto.RegisterType((*OracleAttestationPolicy)(nil), "fusionchain.policy.OracleAttestationPolicy")
+	proto.RegisterType((*OracleAttestationPolicyPayload)(nil), "fusionchain.policy.OracleAttestationPolicyPayload")
+	proto.RegisterType((*DestinationQuorumPolicy)(nil), "fusionchain.policy.DestinationQuorumPolicy")
+	proto.RegisterType((*DestinationQuorumPolicyPayload)(nil), "fusionchain.policy.DestinationQuorumPolicyPayload")
+	proto.RegisterType((*MaxFeePolicy)(nil), "fusionchain.policy.MaxFeePolicy")
+	proto.RegisterType((*FeeCeiling)(nil), "fusionchain.policy.FeeCeiling")
+	proto.RegisterType((*RotatingKeyPolicy)(nil), "fusionchain.policy.RotatingKeyPolicy")
+	proto.RegisterType((*KeySchedule)(nil), "fusionchain.policy.KeySchedule")
+	proto.RegisterType((*EpochKey)(nil), "fusionchain.policy.EpochKey")
+	proto.RegisterType((*RotatingKeyPolicyPayload)(nil), "fusionchain.policy.RotatingKeyPolicyPayload")
+	proto.RegisterType((*ParticipantSignature)(nil), "fusionchain.policy.ParticipantSignature")
+	proto.RegisterType((*GroupedQuorumPolicy)(nil), "fusionchain.policy.GroupedQuorumPolicy")
+	proto.RegisterType((*ParticipantGroup)(nil), "fusionchain.policy.ParticipantGroup")
+	proto.RegisterType((*CooldownPolicy)(nil), "fusionchain.policy.CooldownPolicy")
+	proto.RegisterType((*BatchApprovalPolicy)(nil), "fusionchain.policy.BatchApprovalPolicy")
+	proto.RegisterType((*BatchApprovalPolicyPayload)(nil), "fusionchain.policy.BatchApprovalPolicyPayload")
+	proto.RegisterType((*TxCountLimitPolicy)(nil), "fusionchain.policy.TxCountLimitPolicy")
+	proto.RegisterType((*InternalOnlyPolicy)(nil), "fusionchain.policy.InternalOnlyPolicy")
+	proto.RegisterType((*PolicyDecision)(nil), "fusionchain.policy.PolicyDecision")
+	proto.RegisterType((*BlackbirdPolicyMetadata)(nil), "fusionchain.policy.BlackbirdPolicyMetadata")
+}
+
+func init() { proto.RegisterFile("fusionchain/policy/policy.proto", fileDescriptor_50a6c43888f5f679) }
+
+var fileDescriptor_50a6c43888f5f679 = []byte{
+	// 1294 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0x26, 0x6e, 0x1a, 0x3f, 0xbb, 0x69, 0x32, 0x8d, 0x1a, 0x53, 0x2a, 0x37, 0xdd, 0xd2,
+	0x36, 0xe2, 0xc3, 0x21, 0x41, 0x45, 0x02, 0xd1, 0x83, 0xf3, 0xd1, 0x36, 0x4a, 0x3f, 0xd2, 0x75,
+	0x90, 0x10, 0x17, 0x6b, 0xbc, 0x3b, 0xb6, 0x47, 0xd9, 0x9d, 0x59, 0x66, 0xc7, 0x8d, 0x7d, 0xe0,
+	0x86, 0x90, 0xb8, 0x21, 0x24, 0x24, 0x4e, 0xdc, 0xf9, 0x1b, 0xb8, 0x71, 0x40, 0x1c, 0x7b, 0xe4,
+	0x84, 0x50, 0xfb, 0x8f, 0xa0, 0xf9, 0xca, 0x3a, 0xb6, 0x0b, 0x55, 0xf1, 0xc9, 0x9e, 0xdf, 0xfb,
+	0xcd, 0x9b, 0xf7, 0x35, 0xef, 0xcd, 0xc2, 0xb5, 0x76, 0x2f, 0xa3, 0x9c, 0x85, 0x5d, 0x4c, 0xd9,
+	0x46, 0xca, 0x63, 0x1a, 0x0e, 0xec, 0x4f, 0x2d, 0x15, 0x5c, 0x72, 0x84, 0x86, 0x08, 0x35, 0x23,
+	0xb9, 0xf2, 0x56, 0x87, 0xf3, 0x4e, 0x4c, 0x36, 0x34, 0xa3, 0xd5, 0x6b, 0x6f, 0x60, 0x66, 0xe9,
+	0xfe, 0x4f, 0x1e, 0xcc, 0x1f, 0x6a, 0x16, 0x5a, 0x84, 0x59, 0x1a, 0x55, 0xbc, 0x35, 0x6f, 0xbd,
+	0x10, 0xcc, 0xd2, 0x08, 0x21, 0x28, 0x30, 0x9c, 0x90, 0xca, 0xec, 0x9a, 0xb7, 0x5e, 0x0c, 0xf4,
+	0x7f, 0xf4, 0x3e, 0xcc, 0x1b, 0x9d, 0x95, 0xb9, 0x35, 0x6f, 0xbd, 0xb4, 0xb5, 0x52, 0x33, 0xaa,
+	0x6b, 0x4e, 0x75, 0xad, 0xce, 0x06, 0x81, 0xe5, 0xa0, 0x15, 0x38, 0xc7, 0x38, 0x0b, 0x49, 0xa5,
+	0xa0, 0x95, 0x9a, 0x05, 0xba, 0x05, 0x17, 0x71, 0x94, 0x50, 0xd6, 0x34, 0xac, 0x26, 0x8d, 0x2a,
+	0xe7, 0xb4, 0xfc, 0x82, 0x86, 0x8d, 0x35, 0xfb, 0x91, 0xff, 0x35, 0x2c, 0x6d, 0x73, 0x1e, 0xa7,
+	0x58, 0x64, 0x44, 0x58, 0x1b, 0xab, 0x00, 0x11, 0x69, 0x53, 0x46, 0x25, 0xe5, 0x4c, 0xdb, 0x5a,
+	0x0c, 0x86, 0x10, 0xb4, 0x0f, 0xe5, 0x14, 0x0b, 0x49, 0x43, 0x9a, 0x62, 0x26, 0xb3, 0xca, 0xec,
+	0xda, 0xdc, 0x7a, 0x69, 0xeb, 0x66, 0x6d, 0x3c, 0x28, 0x35, 0xa3, 0xf1, 0x30, 0x67, 0x07, 0x67,
+	0xb6, 0xfa, 0xbf, 0x7b, 0x70, 0x71, 0x3b, 0xc6, 0xe1, 0x71, 0x8b, 0x8a, 0xc8, 0x1e, 0x8f, 0xa0,
+	0x10, 0x61, 0x89, 0xf5, 0xc1, 0xe5, 0x40, 0xff, 0x9f, 0xe2, 0x91, 0xe8, 0x08, 0x90, 0xec, 0x0a,
+	0x92, 0x75, 0x79, 0x1c, 0x35, 0xdb, 0x02, 0x87, 0xda, 0x4b, 0x13, 0xe9, 0x89, 0x0a, 0x8f, 0x1c,
+	0xfb, 0x9e, 0x25, 0x07, 0xcb, 0x72, 0x14, 0xf2, 0x1b, 0xb0, 0x3c, 0xc6, 0x43, 0x57, 0xa1, 0xc8,
+	0x7a, 0x09, 0x11, 0x58, 0x72, 0xa1, 0xdd, 0xb9, 0x10, 0xe4, 0x00, 0x5a, 0x83, 0x52, 0x44, 0x18,
+	0x4f, 0x28, 0xd3, 0xf2, 0x59, 0x2d, 0x1f, 0x86, 0xfc, 0xa7, 0xb0, 0x3c, 0xe6, 0x0d, 0xf2, 0xa1,
+	0x8c, 0x5b, 0x2d, 0x41, 0x9e, 0x51, 0x3c, 0x94, 0x9f, 0x33, 0x18, 0xaa, 0xc0, 0x79, 0x1c, 0x45,
+	0x82, 0x64, 0x99, 0x2d, 0x2c, 0xb7, 0xf4, 0xbf, 0xf5, 0xe0, 0xf2, 0x48, 0xc0, 0x0f, 0xf1, 0x20,
+	0xe6, 0x38, 0x52, 0x9b, 0x4e, 0xa8, 0x64, 0x6a, 0x93, 0x09, 0xbd, 0x5b, 0xa2, 0x75, 0x58, 0x52,
+	0xa5, 0xd4, 0x8a, 0x79, 0x78, 0xdc, 0xec, 0x12, 0xda, 0xe9, 0x4a, 0xad, 0xb7, 0x10, 0x2c, 0x26,
+	0x94, 0x6d, 0x2b, 0xf8, 0x81, 0x46, 0x35, 0x13, 0xf7, 0xcf, 0x32, 0xe7, 0x2c, 0x13, 0xf7, 0x87,
+	0x98, 0xfe, 0xaf, 0x1e, 0xac, 0x3e, 0x11, 0x38, 0x8c, 0x49, 0x5d, 0x4a, 0x92, 0x49, 0x6d, 0xb8,
+	0xad, 0x80, 0x1b, 0x70, 0x81, 0x6b, 0x51, 0x33, 0xed, 0xb5, 0x8e, 0xc9, 0xc0, 0xda, 0x53, 0x36,
+	0xe0, 0xa1, 0xc6, 0x54, 0x70, 0x4f, 0xd3, 0x60, 0xbd, 0xcc, 0x81, 0xb1, 0x82, 0x99, 0x7b, 0xf3,
+	0x82, 0x41, 0x50, 0x08, 0x39, 0x65, 0xfa, 0x7e, 0x15, 0x03, 0xfd, 0xdf, 0xdf, 0x86, 0xea, 0x2b,
+	0x8c, 0x77, 0xd1, 0x5c, 0x83, 0x12, 0xce, 0x65, 0xd6, 0x83, 0x61, 0xc8, 0xff, 0xcd, 0x83, 0xd5,
+	0x5d, 0x92, 0x49, 0x95, 0x6c, 0xca, 0xd9, 0xd3, 0x1e, 0x17, 0xbd, 0xc4, 0x46, 0xe0, 0x03, 0x40,
+	0x94, 0x49, 0x22, 0x18, 0x8e, 0x9b, 0xb9, 0x97, 0xa6, 0x84, 0x96, 0x9d, 0xe4, 0xb4, 0xe0, 0x14,
+	0x9d, 0xf4, 0xc7, 0xe8, 0xa6, 0xa2, 0x96, 0x49, 0x7f, 0x94, 0x3e, 0xbd, 0xe0, 0xf8, 0x0d, 0xa8,
+	0xbe, 0xc2, 0x07, 0x17, 0x88, 0x4d, 0x58, 0x39, 0x75, 0x25, 0xca, 0xa9, 0xda, 0x99, 0x85, 0xe0,
+	0x92, 0x93, 0x0d, 0x69, 0xf1, 0x7f, 0xf4, 0xa0, 0xfc, 0x08, 0xf7, 0xef, 0x11, 0x62, 0xc3, 0xf1,
+	0x29, 0x2c, 0x84, 0x84, 0xc6, 0x94, 0x75, 0x54, 0x6d, 0x2a, 0x63, 0xab, 0x93, 0x8c, 0xbd, 0x47,
+	0xc8, 0x8e, 0xa1, 0x05, 0xa7, 0xfc, 0x69, 0x76, 0xab, 0xbb, 0x00, 0xf9, 0x11, 0xe8, 0x32, 0xcc,
+	0x67, 0x83, 0xa4, 0xc5, 0x63, 0x7b, 0x05, 0xed, 0x0a, 0xad, 0xc2, 0x79, 0x75, 0x07, 0xda, 0xc4,
+	0x75, 0xf5, 0xf9, 0x44, 0xfb, 0xe2, 0xff, 0xe5, 0xc1, 0x72, 0xc0, 0x55, 0xf6, 0x59, 0xe7, 0x80,
+	0x0c, 0xac, 0x6f, 0x67, 0xea, 0xd8, 0x36, 0x89, 0xbc, 0x8e, 0xaf, 0x43, 0x99, 0xa4, 0x3c, 0xec,
+	0x36, 0x63, 0xc2, 0x3a, 0xb2, 0x6b, 0xaf, 0x5d, 0x49, 0x63, 0x0f, 0x35, 0x34, 0xcd, 0x52, 0xbf,
+	0x0b, 0xc5, 0x2c, 0xec, 0x92, 0xa8, 0x17, 0x93, 0xac, 0x52, 0xd0, 0x7a, 0xae, 0x4d, 0xd2, 0x73,
+	0x40, 0x06, 0x0d, 0xcb, 0x0b, 0xf2, 0x1d, 0x7e, 0x08, 0xa5, 0x21, 0xc9, 0x6b, 0x75, 0xaa, 0x0f,
+	0xa1, 0x70, 0x4c, 0x06, 0x2e, 0x2b, 0x57, 0x27, 0x1d, 0xb6, 0xa7, 0x7c, 0x3d, 0x20, 0x83, 0x40,
+	0x33, 0xfd, 0xef, 0x3c, 0x58, 0x70, 0x10, 0xba, 0x06, 0xa5, 0x4c, 0x62, 0x21, 0x9b, 0x3a, 0x20,
+	0x76, 0xae, 0x82, 0x86, 0x34, 0x47, 0x25, 0xc9, 0xf6, 0x90, 0x59, 0x7d, 0x03, 0xed, 0x0a, 0xed,
+	0x42, 0x11, 0xc7, 0x1d, 0x2e, 0xa8, 0xec, 0x26, 0xba, 0x43, 0x2d, 0x6e, 0xdd, 0x9a, 0x74, 0x78,
+	0x83, 0x76, 0x18, 0x96, 0x3d, 0x41, 0xea, 0x8e, 0x1d, 0xe4, 0x1b, 0xfd, 0x08, 0x2a, 0x63, 0x09,
+	0x75, 0x75, 0xff, 0x00, 0x20, 0x73, 0x9b, 0x5d, 0xd5, 0xae, 0x4f, 0x4c, 0x4a, 0x9e, 0x81, 0xd3,
+	0xd3, 0x82, 0xa1, 0xbd, 0xfe, 0x17, 0xb0, 0x32, 0x89, 0xf3, 0x5a, 0xf1, 0xbd, 0x0a, 0xc5, 0x53,
+	0x4d, 0x36, 0x04, 0x39, 0xe0, 0xff, 0xec, 0xc1, 0xa5, 0xfb, 0x82, 0xf7, 0x52, 0x12, 0x9d, 0x69,
+	0x3f, 0xa3, 0x25, 0xe5, 0xbd, 0x79, 0x49, 0x7d, 0x06, 0xf3, 0x1d, 0x75, 0x82, 0x4b, 0xf1, 0x3b,
+	0xff, 0x11, 0x02, 0x6d, 0x4e, 0x60, 0xf7, 0xf8, 0x1d, 0x58, 0x1a, 0x95, 0xa9, 0x07, 0x4f, 0x8c,
+	0x5b, 0xc4, 0x5d, 0x3b, 0xb3, 0x50, 0x33, 0x43, 0xcd, 0x28, 0x9c, 0xa6, 0x82, 0x3f, 0xc3, 0x71,
+	0x66, 0xbb, 0x5f, 0x39, 0xa1, 0xac, 0xee, 0x30, 0x35, 0xe2, 0x12, 0x92, 0xb4, 0x88, 0x30, 0xb7,
+	0xa4, 0x18, 0xb8, 0xa5, 0xff, 0x8d, 0x07, 0x8b, 0x3b, 0x9c, 0xc7, 0x11, 0x3f, 0x71, 0x53, 0xe8,
+	0x36, 0x5c, 0x0c, 0x2d, 0x62, 0x06, 0x5a, 0x66, 0xeb, 0x6b, 0xd1, 0xc1, 0x7a, 0x9e, 0x4d, 0xb5,
+	0xc3, 0xfc, 0xe0, 0xc1, 0xa5, 0x6d, 0x2c, 0xc3, 0xae, 0xb3, 0x39, 0x7f, 0x13, 0x09, 0xce, 0xa5,
+	0x7b, 0x13, 0xa9, 0xff, 0xd3, 0x7c, 0x13, 0x9d, 0xe9, 0x41, 0x73, 0x23, 0x3d, 0xc8, 0xdf, 0x82,
+	0x2b, 0x13, 0x6c, 0x72, 0x75, 0xbe, 0x02, 0xe7, 0x52, 0xc1, 0x79, 0x5b, 0x17, 0x49, 0x39, 0x30,
+	0x0b, 0xff, 0x17, 0x0f, 0xd0, 0x51, 0x7f, 0x87, 0xf7, 0x98, 0x7c, 0x48, 0x13, 0x2a, 0xf3, 0xc9,
+	0xae, 0x7a, 0xa3, 0x14, 0x98, 0x65, 0x6d, 0x95, 0x06, 0x13, 0xd1, 0x72, 0x82, 0xfb, 0x47, 0x0e,
+	0x53, 0xa4, 0x13, 0xca, 0x22, 0x7e, 0xe2, 0xc2, 0x6e, 0x9a, 0x5e, 0xd9, 0x80, 0xaf, 0x08, 0xfa,
+	0xff, 0x98, 0x61, 0x4d, 0x40, 0xfb, 0x76, 0x0a, 0x3d, 0x61, 0xf1, 0x60, 0xea, 0x77, 0x40, 0x5d,
+	0xb3, 0x45, 0xc3, 0xd9, 0x25, 0x21, 0x55, 0xdb, 0xd1, 0xdb, 0x50, 0xcc, 0x5f, 0xe6, 0x26, 0x08,
+	0x0b, 0xa9, 0x7d, 0x94, 0xab, 0x74, 0x98, 0x3a, 0x26, 0xc2, 0xa4, 0xb5, 0x18, 0xe4, 0x00, 0xaa,
+	0xc1, 0xf9, 0xd4, 0xc4, 0xfe, 0x5f, 0xbf, 0x0f, 0x1c, 0x49, 0x8d, 0x10, 0x7b, 0xd4, 0xf0, 0x77,
+	0x42, 0xc9, 0x60, 0x8f, 0x15, 0xe4, 0x6f, 0xc2, 0xea, 0xc8, 0xa3, 0xf0, 0x11, 0x91, 0x58, 0xbf,
+	0xbc, 0x55, 0x03, 0x15, 0x44, 0xca, 0x81, 0x9b, 0x72, 0x66, 0xf5, 0x6e, 0x04, 0x68, 0xbc, 0x37,
+	0xa2, 0xdb, 0x70, 0xa3, 0xb1, 0x7f, 0xff, 0x71, 0xfd, 0xe8, 0xf3, 0x60, 0xaf, 0x59, 0x7f, 0x78,
+	0xff, 0x49, 0xb0, 0x7f, 0xf4, 0xe0, 0x51, 0x73, 0x6f, 0x67, 0xb7, 0x51, 0x6f, 0x36, 0xf6, 0x76,
+	0x0e, 0xb7, 0xee, 0x7c, 0x7c, 0xb0, 0xb9, 0x34, 0x83, 0x6e, 0xc2, 0xf5, 0x89, 0xc4, 0x5d, 0x45,
+	0xdc, 0xdb, 0xdd, 0xba, 0x73, 0x67, 0xf3, 0x93, 0x25, 0x6f, 0x7b, 0xef, 0x8f, 0x17, 0x55, 0xef,
+	0xf9, 0x8b, 0xaa, 0xf7, 0xf7, 0x8b, 0xaa, 0xf7, 0xfd, 0xcb, 0xea, 0xcc, 0xf3, 0x97, 0xd5, 0x99,
+	0x3f, 0x5f, 0x56, 0x67, 0xbe, 0x7c, 0xaf, 0x43, 0x65, 0xb7, 0xd7, 0xaa, 0x85, 0x3c, 0xd9, 0xf8,
+	0x4a, 0x90, 0x88, 0x6f, 0x0c, 0x7f, 0xb4, 0xf5, 0xdd, 0x67, 0x9b, 0x1c, 0xa4, 0x24, 0x6b, 0xcd,
+	0xeb, 0xc8, 0x7c, 0xf4, 0xcf, 0x00, 0x91, 0xd5, 0x87, 0x2f, 0xd9, 0x0d, 0x00, 0x00,
+}
+
+func (m *Policy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Policy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Policy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AdminPolicyId != 0 {
+		i = encodeVarintPolicy(dAtA, i, uint64(m.AdminPolicyId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintPolicy(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Policy != nil {
+		{
+			size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPolicy(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintPolicy(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintPolicy(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BoolparserPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BoolparserPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BoolparserPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Participants) > 0 {
+		for iNdEx := len(m.Participants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Participants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPolicy(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Definition) > 0 {
+		i -= len(m.Definition)
+		copy(dAtA[i:], m.Definition)
+		i = encodeVarintPolicy(dAtA, i, uint64(len(m.Definition)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlackbirdPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlackbirdPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlackbirdPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ThresholdFraction != nil {
+		{
+			size, err := m.ThresholdFraction.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPolicy(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Participants) > 0 {
+		for iNdEx := len(m.Participants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Participants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPolicy(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintPolicy(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ThresholdFraction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ThresholdFraction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ThresholdFraction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Denominator != 0 {
+		i = encodeVarintPolicy(dAtA, i, uint64(m.Denominator))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Numerator != 0 {
+		i = encodeVarintPolicy(dAtA, i, uint64(m.Numerator))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PolicyParticipant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PolicyParticipant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PolicyParticipant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintPolicy(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Abbreviation) > 0 {
+		i -= len(m.Abbreviation)
+		copy(dAtA[i:], m.Abbreviation)
+		i = encodeVarintPolicy(dAtA, i, uint64(len(m.Abbreviation)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlackbirdPolicyPayload) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlackbirdPolicyPayload) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlackbirdPolicyPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxBlockHeight != 0 {
+		i = encodeVarintPolicy(dAtA, i, uint64(m.MaxBlockHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MinBlockHeight != 0 {
+		i = encodeVarintPolicy(dAtA, i, uint64(m.MinBlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Witness) > 0 {
+		i -= len(m.Witness)
+		copy(dAtA[i:], m.Witness)
+		i = encodeVarintPolicy(dAtA, i, uint64(len(m.Witness)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OracleAttestationPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OracleAttestationPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OracleAttestationPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Coin) > 0 {
+		i -= len(m.Coin)
+		copy(dAtA[i:], m.Coin)
+		i = encodeVarintPolicy(dAtA, i, uint64(len(m.Coin)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Participants) > 0 {
+		for iNdEx := len(m.Participants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Participants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPolicy(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Threshold) > 0 {
+		i -= len(m.Threshold)
+		copy(dAtA[i:], m.Threshold)
+		i = encodeVarintPolicy(dAtA, i, uint64(len(m.Threshold)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OraclePubkey) > 0 {
+		i -= len(m.OraclePubkey)
+		copy(dAtA[i:], m.OraclePubkey)
+		i = encodeVarintPolicy(dAtA, i, uint64(len(m.OraclePubkey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OracleAttestationPolicyPayload) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OracleAttestationPolicyPayload) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OracleAttestationPolicyPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Attestation) > 0 {
+		i -= len(m.Attestation)
+		copy(dAtA[i:], m.Attestation)
+		i = encodeVarintPolicy(dAtA, i, uint64(len(m.Attestation)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DestinationQuorumPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DestinationQuorumPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DestinationQuorumPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Participants) > 0 {
+		for iNdEx := len(m.Participants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Participants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPolicy(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.ExternalThreshold != 0 {
+		i = encodeVarintPolicy(dAtA, i, uint64(m.ExternalThreshold))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.InternalThreshold != 0 {
+		i = encodeVarintPolicy(dAtA, i, uint64(m.InternalThreshold))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DestinationQuorumPolicyPayload) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DestinationQuorumPolicyPayload) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DestinationQuorumPolicyPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.InternalDestination {
+		i--
+		if m.InternalDestination {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MaxFeePolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MaxFeePolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MaxFeePolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Participants) > 0 {
+		for iNdEx := len(m.Participants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Participants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPolicy(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Ceilings) > 0 {
+		for iNdEx := len(m.Ceilings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Ceilings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPolicy(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeCeiling) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeCeiling) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeCeiling) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MaxFee) > 0 {
+		i -= len(m.MaxFee)
+		copy(dAtA[i:], m.MaxFee)
+		i = encodeVarintPolicy(dAtA, i, uint64(len(m.MaxFee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintPolicy(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RotatingKeyPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RotatingKeyPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RotatingKeyPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Schedules) > 0 {
+		for iNdEx := len(m.Schedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Schedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPolicy(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Participants) > 0 {
+		for iNdEx := len(m.Participants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Participants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPolicy(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.EpochLength != 0 {
+		i = encodeVarintPolicy(dAtA, i, uint64(m.EpochLength))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Threshold != 0 {
+		i = encodeVarintPolicy(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *KeySchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KeySchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KeySchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Keys) > 0 {
+		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Keys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPolicy(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Abbreviation) > 0 {
+		i -= len(m.Abbreviation)
+		copy(dAtA[i:], m.Abbreviation)
+		i = encodeVarintPolicy(dAtA, i, uint64(len(m.Abbreviation)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Algorithm != 0 {
+		i = encodeVarintPolicy(dAtA, i, uint64(m.Algorithm))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Pubkey) > 0 {
+		i -= len(m.Pubkey)
+		copy(dAtA[i:], m.Pubkey)
+		i = encodeVarintPolicy(dAtA, i, uint64(len(m.Pubkey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.StartEpoch != 0 {
+		i = encodeVarintPolicy(dAtA, i, uint64(m.StartEpoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RotatingKeyPolicyPayload) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RotatingKeyPolicyPayload) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RotatingKeyPolicyPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signatures) > 0 {
+		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Signatures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPolicy(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ParticipantSignature) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParticipantSignature) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParticipantSignature) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintPolicy(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Abbreviation) > 0 {
+		i -= len(m.Abbreviation)
+		copy(dAtA[i:], m.Abbreviation)
+		i = encodeVarintPolicy(dAtA, i, uint64(len(m.Abbreviation)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GroupedQuorumPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GroupedQuorumPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GroupedQuorumPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Groups) > 0 {
+		for iNdEx := len(m.Groups) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Groups[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPolicy(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Participants) > 0 {
+		for iNdEx := len(m.Participants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Participants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPolicy(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ParticipantGroup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParticipantGroup) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParticipantGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Members[iNdEx])
+			copy(dAtA[i:], m.Members[iNdEx])
+			i = encodeVarintPolicy(dAtA, i, uint64(len(m.Members[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.MinApprovals != 0 {
+		i = encodeVarintPolicy(dAtA, i, uint64(m.MinApprovals))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Label) > 0 {
+		i -= len(m.Label)
+		copy(dAtA[i:], m.Label)
+		i = encodeVarintPolicy(dAtA, i, uint64(len(m.Label)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CooldownPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CooldownPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CooldownPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Participants) > 0 {
+		for iNdEx := len(m.Participants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Participants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPolicy(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.CooldownBlocks != 0 {
+		i = encodeVarintPolicy(dAtA, i, uint64(m.CooldownBlocks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchApprovalPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchApprovalPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchApprovalPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Threshold != 0 {
+		i = encodeVarintPolicy(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Participants) > 0 {
+		for iNdEx := len(m.Participants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Participants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPolicy(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Root) > 0 {
+		i -= len(m.Root)
+		copy(dAtA[i:], m.Root)
+		i = encodeVarintPolicy(dAtA, i, uint64(len(m.Root)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchApprovalPolicyPayload) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchApprovalPolicyPayload) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchApprovalPolicyPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Proof) > 0 {
+		for iNdEx := len(m.Proof) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Proof[iNdEx])
+			copy(dAtA[i:], m.Proof[iNdEx])
+			i = encodeVarintPolicy(dAtA, i, uint64(len(m.Proof[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TxCountLimitPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxCountLimitPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxCountLimitPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Participants) > 0 {
+		for iNdEx := len(m.Participants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Participants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPolicy(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.WindowBlocks != 0 {
+		i = encodeVarintPolicy(dAtA, i, uint64(m.WindowBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxTransfers != 0 {
+		i = encodeVarintPolicy(dAtA, i, uint64(m.MaxTransfers))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InternalOnlyPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InternalOnlyPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InternalOnlyPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Participants) > 0 {
+		for iNdEx := len(m.Participants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Participants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPolicy(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PolicyDecision) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PolicyDecision) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PolicyDecision) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PolicyNonce != 0 {
+		i = encodeVarintPolicy(dAtA, i, uint64(m.PolicyNonce))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Payload != nil {
+		{
+			size, err := m.Payload.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPolicy(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Approvers) > 0 {
+		for iNdEx := len(m.Approvers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Approvers[iNdEx])
+			copy(dAtA[i:], m.Approvers[iNdEx])
+			i = encodeVarintPolicy(dAtA, i, uint64(len(m.Approvers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.PolicyId != 0 {
+		i = encodeVarintPolicy(dAtA, i, uint64(m.PolicyId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlackbirdPolicyMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlackbirdPolicyMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlackbirdPolicyMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Pretty) > 0 {
+		i -= len(m.Pretty)
+		copy(dAtA[i:], m.Pretty)
+		i = encodeVarintPolicy(dAtA, i, uint64(len(m.Pretty)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPolicy(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPolicy(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Policy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovPolicy(uint64(m.Id))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPolicy(uint64(l))
+	}
+	if m.Policy != nil {
+		l = m.Policy.Size()
+		n += 1 + l + sovPolicy(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovPolicy(uint64(m.Nonce))
+	}
+	if m.AdminPolicyId != 0 {
+		n += 1 + sovPolicy(uint64(m.AdminPolicyId))
+	}
+	return n
+}
+
+func (m *BoolparserPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Definition)
+	if l > 0 {
+		n += 1 + l + sovPolicy(uint64(l))
+	}
+	if len(m.Participants) > 0 {
+		for _, e := range m.Participants {
+			l = e.Size()
+			n += 1 + l + sovPolicy(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BlackbirdPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovPolicy(uint64(l))
+	}
+	if len(m.Participants) > 0 {
+		for _, e := range m.Participants {
+			l = e.Size()
+			n += 1 + l + sovPolicy(uint64(l))
+		}
+	}
+	if m.ThresholdFraction != nil {
+		l = m.ThresholdFraction.Size()
+		n += 1 + l + sovPolicy(uint64(l))
+	}
+	return n
+}
+
+func (m *ThresholdFraction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Numerator != 0 {
+		n += 1 + sovPolicy(uint64(m.Numerator))
+	}
+	if m.Denominator != 0 {
+		n += 1 + sovPolicy(uint64(m.Denominator))
+	}
+	return n
+}
+
+func (m *PolicyParticipant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Abbreviation)
+	if l > 0 {
+		n += 1 + l + sovPolicy(uint64(l))
 	}
 	l = len(m.Address)
 	if l > 0 {
 		n += 1 + l + sovPolicy(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func (m *BlackbirdPolicyPayload) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Witness)
+	if l > 0 {
+		n += 1 + l + sovPolicy(uint64(l))
+	}
+	if m.MinBlockHeight != 0 {
+		n += 1 + sovPolicy(uint64(m.MinBlockHeight))
+	}
+	if m.MaxBlockHeight != 0 {
+		n += 1 + sovPolicy(uint64(m.MaxBlockHeight))
+	}
+	return n
+}
+
+func (m *OracleAttestationPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OraclePubkey)
+	if l > 0 {
+		n += 1 + l + sovPolicy(uint64(l))
+	}
+	l = len(m.Threshold)
+	if l > 0 {
+		n += 1 + l + sovPolicy(uint64(l))
+	}
+	if len(m.Participants) > 0 {
+		for _, e := range m.Participants {
+			l = e.Size()
+			n += 1 + l + sovPolicy(uint64(l))
+		}
+	}
+	l = len(m.Coin)
+	if l > 0 {
+		n += 1 + l + sovPolicy(uint64(l))
+	}
+	return n
+}
+
+func (m *OracleAttestationPolicyPayload) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Attestation)
+	if l > 0 {
+		n += 1 + l + sovPolicy(uint64(l))
+	}
+	return n
+}
+
+func (m *DestinationQuorumPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.InternalThreshold != 0 {
+		n += 1 + sovPolicy(uint64(m.InternalThreshold))
+	}
+	if m.ExternalThreshold != 0 {
+		n += 1 + sovPolicy(uint64(m.ExternalThreshold))
+	}
+	if len(m.Participants) > 0 {
+		for _, e := range m.Participants {
+			l = e.Size()
+			n += 1 + l + sovPolicy(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DestinationQuorumPolicyPayload) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.InternalDestination {
+		n += 2
+	}
+	return n
+}
+
+func (m *MaxFeePolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Ceilings) > 0 {
+		for _, e := range m.Ceilings {
+			l = e.Size()
+			n += 1 + l + sovPolicy(uint64(l))
+		}
+	}
+	if len(m.Participants) > 0 {
+		for _, e := range m.Participants {
+			l = e.Size()
+			n += 1 + l + sovPolicy(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FeeCeiling) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovPolicy(uint64(l))
+	}
+	l = len(m.MaxFee)
+	if l > 0 {
+		n += 1 + l + sovPolicy(uint64(l))
+	}
+	return n
+}
+
+func (m *RotatingKeyPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Threshold != 0 {
+		n += 1 + sovPolicy(uint64(m.Threshold))
+	}
+	if m.EpochLength != 0 {
+		n += 1 + sovPolicy(uint64(m.EpochLength))
+	}
+	if len(m.Participants) > 0 {
+		for _, e := range m.Participants {
+			l = e.Size()
+			n += 1 + l + sovPolicy(uint64(l))
+		}
+	}
+	if len(m.Schedules) > 0 {
+		for _, e := range m.Schedules {
+			l = e.Size()
+			n += 1 + l + sovPolicy(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *KeySchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Abbreviation)
+	if l > 0 {
+		n += 1 + l + sovPolicy(uint64(l))
+	}
+	if len(m.Keys) > 0 {
+		for _, e := range m.Keys {
+			l = e.Size()
+			n += 1 + l + sovPolicy(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EpochKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartEpoch != 0 {
+		n += 1 + sovPolicy(uint64(m.StartEpoch))
+	}
+	l = len(m.Pubkey)
+	if l > 0 {
+		n += 1 + l + sovPolicy(uint64(l))
+	}
+	if m.Algorithm != 0 {
+		n += 1 + sovPolicy(uint64(m.Algorithm))
+	}
+	return n
+}
+
+func (m *RotatingKeyPolicyPayload) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Signatures) > 0 {
+		for _, e := range m.Signatures {
+			l = e.Size()
+			n += 1 + l + sovPolicy(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ParticipantSignature) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Abbreviation)
+	if l > 0 {
+		n += 1 + l + sovPolicy(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovPolicy(uint64(l))
+	}
+	return n
+}
+
+func (m *GroupedQuorumPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Participants) > 0 {
+		for _, e := range m.Participants {
+			l = e.Size()
+			n += 1 + l + sovPolicy(uint64(l))
+		}
+	}
+	if len(m.Groups) > 0 {
+		for _, e := range m.Groups {
+			l = e.Size()
+			n += 1 + l + sovPolicy(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ParticipantGroup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Label)
+	if l > 0 {
+		n += 1 + l + sovPolicy(uint64(l))
+	}
+	if m.MinApprovals != 0 {
+		n += 1 + sovPolicy(uint64(m.MinApprovals))
+	}
+	if len(m.Members) > 0 {
+		for _, s := range m.Members {
+			l = len(s)
+			n += 1 + l + sovPolicy(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CooldownPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CooldownBlocks != 0 {
+		n += 1 + sovPolicy(uint64(m.CooldownBlocks))
+	}
+	if len(m.Participants) > 0 {
+		for _, e := range m.Participants {
+			l = e.Size()
+			n += 1 + l + sovPolicy(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BatchApprovalPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Root)
+	if l > 0 {
+		n += 1 + l + sovPolicy(uint64(l))
+	}
+	if len(m.Participants) > 0 {
+		for _, e := range m.Participants {
+			l = e.Size()
+			n += 1 + l + sovPolicy(uint64(l))
+		}
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovPolicy(uint64(m.Threshold))
+	}
+	return n
+}
+
+func (m *BatchApprovalPolicyPayload) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Proof) > 0 {
+		for _, b := range m.Proof {
+			l = len(b)
+			n += 1 + l + sovPolicy(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TxCountLimitPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxTransfers != 0 {
+		n += 1 + sovPolicy(uint64(m.MaxTransfers))
+	}
+	if m.WindowBlocks != 0 {
+		n += 1 + sovPolicy(uint64(m.WindowBlocks))
+	}
+	if len(m.Participants) > 0 {
+		for _, e := range m.Participants {
+			l = e.Size()
+			n += 1 + l + sovPolicy(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *InternalOnlyPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Participants) > 0 {
+		for _, e := range m.Participants {
+			l = e.Size()
+			n += 1 + l + sovPolicy(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PolicyDecision) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PolicyId != 0 {
+		n += 1 + sovPolicy(uint64(m.PolicyId))
+	}
+	if len(m.Approvers) > 0 {
+		for _, s := range m.Approvers {
+			l = len(s)
+			n += 1 + l + sovPolicy(uint64(l))
+		}
+	}
+	if m.Payload != nil {
+		l = m.Payload.Size()
+		n += 1 + l + sovPolicy(uint64(l))
+	}
+	if m.PolicyNonce != 0 {
+		n += 1 + sovPolicy(uint64(m.PolicyNonce))
+	}
+	return n
+}
+
+func (m *BlackbirdPolicyMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pretty)
+	if l > 0 {
+		n += 1 + l + sovPolicy(uint64(l))
+	}
+	return n
+}
+
+func sovPolicy(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPolicy(x uint64) (n int) {
+	return sovPolicy(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Policy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPolicy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Policy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Policy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Policy == nil {
+				m.Policy = &types.Any{}
+			}
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminPolicyId", wireType)
+			}
+			m.AdminPolicyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AdminPolicyId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPolicy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BoolparserPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPolicy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BoolparserPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BoolparserPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Definition", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Definition = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Participants = append(m.Participants, &PolicyParticipant{})
+			if err := m.Participants[len(m.Participants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPolicy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlackbirdPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPolicy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlackbirdPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlackbirdPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Participants = append(m.Participants, &PolicyParticipant{})
+			if err := m.Participants[len(m.Participants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThresholdFraction", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ThresholdFraction == nil {
+				m.ThresholdFraction = &ThresholdFraction{}
+			}
+			if err := m.ThresholdFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPolicy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ThresholdFraction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPolicy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ThresholdFraction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ThresholdFraction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Numerator", wireType)
+			}
+			m.Numerator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Numerator |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denominator", wireType)
+			}
+			m.Denominator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Denominator |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPolicy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PolicyParticipant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPolicy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PolicyParticipant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PolicyParticipant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Abbreviation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Abbreviation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPolicy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlackbirdPolicyPayload) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPolicy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlackbirdPolicyPayload: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlackbirdPolicyPayload: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Witness", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Witness = append(m.Witness[:0], dAtA[iNdEx:postIndex]...)
+			if m.Witness == nil {
+				m.Witness = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBlockHeight", wireType)
+			}
+			m.MinBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBlockHeight", wireType)
+			}
+			m.MaxBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPolicy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OracleAttestationPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPolicy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OracleAttestationPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OracleAttestationPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OraclePubkey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OraclePubkey = append(m.OraclePubkey[:0], dAtA[iNdEx:postIndex]...)
+			if m.OraclePubkey == nil {
+				m.OraclePubkey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Threshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Participants = append(m.Participants, &PolicyParticipant{})
+			if err := m.Participants[len(m.Participants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPolicy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OracleAttestationPolicyPayload) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPolicy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OracleAttestationPolicyPayload: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OracleAttestationPolicyPayload: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestation", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attestation = append(m.Attestation[:0], dAtA[iNdEx:postIndex]...)
+			if m.Attestation == nil {
+				m.Attestation = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPolicy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DestinationQuorumPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPolicy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DestinationQuorumPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DestinationQuorumPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InternalThreshold", wireType)
+			}
+			m.InternalThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InternalThreshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalThreshold", wireType)
+			}
+			m.ExternalThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExternalThreshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Participants = append(m.Participants, &PolicyParticipant{})
+			if err := m.Participants[len(m.Participants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPolicy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DestinationQuorumPolicyPayload) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPolicy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DestinationQuorumPolicyPayload: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DestinationQuorumPolicyPayload: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InternalDestination", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InternalDestination = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPolicy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MaxFeePolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPolicy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MaxFeePolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MaxFeePolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ceilings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ceilings = append(m.Ceilings, &FeeCeiling{})
+			if err := m.Ceilings[len(m.Ceilings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Participants = append(m.Participants, &PolicyParticipant{})
+			if err := m.Participants[len(m.Participants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPolicy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeCeiling) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPolicy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeCeiling: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeCeiling: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxFee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPolicy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RotatingKeyPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPolicy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RotatingKeyPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RotatingKeyPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochLength", wireType)
+			}
+			m.EpochLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Participants = append(m.Participants, &PolicyParticipant{})
+			if err := m.Participants[len(m.Participants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schedules = append(m.Schedules, &KeySchedule{})
+			if err := m.Schedules[len(m.Schedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPolicy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KeySchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPolicy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KeySchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KeySchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Abbreviation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Abbreviation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Keys = append(m.Keys, &EpochKey{})
+			if err := m.Keys[len(m.Keys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPolicy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EpochKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPolicy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartEpoch", wireType)
+			}
+			m.StartEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pubkey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pubkey = append(m.Pubkey[:0], dAtA[iNdEx:postIndex]...)
+			if m.Pubkey == nil {
+				m.Pubkey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Algorithm", wireType)
+			}
+			m.Algorithm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Algorithm |= SignatureAlgorithm(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPolicy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RotatingKeyPolicyPayload) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPolicy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RotatingKeyPolicyPayload: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RotatingKeyPolicyPayload: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signatures = append(m.Signatures, &ParticipantSignature{})
+			if err := m.Signatures[len(m.Signatures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPolicy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParticipantSignature) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPolicy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParticipantSignature: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParticipantSignature: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Abbreviation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Abbreviation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPolicy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *BlackbirdPolicyPayload) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Witness)
-	if l > 0 {
-		n += 1 + l + sovPolicy(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *BlackbirdPolicyMetadata) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Pretty)
-	if l > 0 {
-		n += 1 + l + sovPolicy(uint64(l))
+func (m *GroupedQuorumPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPolicy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GroupedQuorumPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GroupedQuorumPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Participants = append(m.Participants, &PolicyParticipant{})
+			if err := m.Participants[len(m.Participants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Groups = append(m.Groups, &ParticipantGroup{})
+			if err := m.Groups[len(m.Groups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPolicy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovPolicy(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozPolicy(x uint64) (n int) {
-	return sovPolicy(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Policy) Unmarshal(dAtA []byte) error {
+func (m *ParticipantGroup) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -744,17 +5529,17 @@ func (m *Policy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Policy: wiretype end group for non-group")
+			return fmt.Errorf("proto: ParticipantGroup: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Policy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ParticipantGroup: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
 			}
-			m.Id = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPolicy
@@ -764,14 +5549,46 @@ func (m *Policy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Label = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinApprovals", wireType)
+			}
+			m.MinApprovals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinApprovals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -799,11 +5616,80 @@ func (m *Policy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Members = append(m.Members, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPolicy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CooldownPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPolicy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CooldownPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CooldownPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CooldownBlocks", wireType)
+			}
+			m.CooldownBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CooldownBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Participants", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -830,10 +5716,8 @@ func (m *Policy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Policy == nil {
-				m.Policy = &types.Any{}
-			}
-			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Participants = append(m.Participants, &PolicyParticipant{})
+			if err := m.Participants[len(m.Participants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -858,7 +5742,7 @@ func (m *Policy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BoolparserPolicy) Unmarshal(dAtA []byte) error {
+func (m *BatchApprovalPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -881,17 +5765,17 @@ func (m *BoolparserPolicy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BoolparserPolicy: wiretype end group for non-group")
+			return fmt.Errorf("proto: BatchApprovalPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BoolparserPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BatchApprovalPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Definition", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPolicy
@@ -901,23 +5785,25 @@ func (m *BoolparserPolicy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthPolicy
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPolicy
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Definition = string(dAtA[iNdEx:postIndex])
+			m.Root = append(m.Root[:0], dAtA[iNdEx:postIndex]...)
+			if m.Root == nil {
+				m.Root = []byte{}
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -953,6 +5839,25 @@ func (m *BoolparserPolicy) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPolicy(dAtA[iNdEx:])
@@ -974,7 +5879,7 @@ func (m *BoolparserPolicy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BlackbirdPolicy) Unmarshal(dAtA []byte) error {
+func (m *BatchApprovalPolicyPayload) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -997,15 +5902,15 @@ func (m *BlackbirdPolicy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BlackbirdPolicy: wiretype end group for non-group")
+			return fmt.Errorf("proto: BatchApprovalPolicyPayload: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BlackbirdPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BatchApprovalPolicyPayload: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1032,12 +5937,98 @@ func (m *BlackbirdPolicy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
-			}
+			m.Proof = append(m.Proof, make([]byte, postIndex-iNdEx))
+			copy(m.Proof[len(m.Proof)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPolicy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxCountLimitPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPolicy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxCountLimitPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxCountLimitPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTransfers", wireType)
+			}
+			m.MaxTransfers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTransfers |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowBlocks", wireType)
+			}
+			m.WindowBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Participants", wireType)
 			}
@@ -1092,7 +6083,7 @@ func (m *BlackbirdPolicy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PolicyParticipant) Unmarshal(dAtA []byte) error {
+func (m *InternalOnlyPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1115,17 +6106,17 @@ func (m *PolicyParticipant) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PolicyParticipant: wiretype end group for non-group")
+			return fmt.Errorf("proto: InternalOnlyPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PolicyParticipant: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InternalOnlyPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Abbreviation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Participants", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPolicy
@@ -1135,55 +6126,25 @@ func (m *PolicyParticipant) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPolicy
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPolicy
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Abbreviation = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPolicy
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPolicy
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPolicy
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Participants = append(m.Participants, &PolicyParticipant{})
+			if err := m.Participants[len(m.Participants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1206,7 +6167,7 @@ func (m *PolicyParticipant) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BlackbirdPolicyPayload) Unmarshal(dAtA []byte) error {
+func (m *PolicyDecision) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1229,17 +6190,36 @@ func (m *BlackbirdPolicyPayload) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BlackbirdPolicyPayload: wiretype end group for non-group")
+			return fmt.Errorf("proto: PolicyDecision: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BlackbirdPolicyPayload: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PolicyDecision: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyId", wireType)
+			}
+			m.PolicyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PolicyId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Witness", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Approvers", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPolicy
@@ -1249,26 +6229,79 @@ func (m *BlackbirdPolicyPayload) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPolicy
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPolicy
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Witness = append(m.Witness[:0], dAtA[iNdEx:postIndex]...)
-			if m.Witness == nil {
-				m.Witness = []byte{}
+			m.Approvers = append(m.Approvers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Payload == nil {
+				m.Payload = &types.Any{}
+			}
+			if err := m.Payload.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyNonce", wireType)
+			}
+			m.PolicyNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PolicyNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPolicy(dAtA[iNdEx:])
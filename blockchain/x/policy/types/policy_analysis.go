@@ -0,0 +1,240 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package types
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/qredo/fusionchain/policy"
+	"gitlab.qredo.com/edmund/blackbird/verifier/golang/protobuf"
+	protov2 "google.golang.org/protobuf/proto"
+)
+
+// PolicyWarningKind classifies the issues reported by Analyze.
+type PolicyWarningKind string
+
+const (
+	// PolicyWarningUnsatisfiable is a branch that can't be satisfied even
+	// when all the participants approve.
+	PolicyWarningUnsatisfiable PolicyWarningKind = "unsatisfiable"
+
+	// PolicyWarningRedundant is a branch of an OR that never makes a
+	// difference, because another branch is satisfied whenever it is.
+	PolicyWarningRedundant PolicyWarningKind = "redundant"
+)
+
+// PolicyWarning is an issue found by the static analysis of a policy.
+type PolicyWarning struct {
+	Kind PolicyWarningKind
+
+	// Path identifies the branch in the policy tree, as in MissingByBranch.
+	Path string
+
+	Message string
+}
+
+func (w PolicyWarning) String() string {
+	return fmt.Sprintf("%s: %s: %s", w.Path, w.Kind, w.Message)
+}
+
+// maxAnalyzedSets caps the number of approver sets enumerated to look for
+// redundant branches, ORs with larger subpolicies are not checked.
+const maxAnalyzedSets = 256
+
+var errTooManySets = errors.New("too many approver sets")
+
+// Analyze reports the branches of the policy that are unsatisfiable, e.g.
+// an ANY whose threshold exceeds its subpolicies or an ALL containing such
+// a branch, and the branches of an OR (ANY with threshold 1) that are
+// redundant because another branch requires a subset of their approvals.
+//
+// Only signatures, ALL and ANY nodes can be analyzed.
+func (p *BlackbirdPolicy) Analyze() ([]PolicyWarning, error) {
+	var bbPolicy protobuf.Policy
+	if err := protov2.Unmarshal(p.Data, &bbPolicy); err != nil {
+		return nil, fmt.Errorf("decoding blackbird policy: %w", err)
+	}
+
+	a := policyAnalyzer{participants: make(policy.ApproverSet, len(p.Participants))}
+	for _, participant := range p.Participants {
+		a.participants[participant.Abbreviation] = true
+	}
+	if _, err := a.analyze(&bbPolicy, "root"); err != nil {
+		return nil, err
+	}
+	return a.warnings, nil
+}
+
+type policyAnalyzer struct {
+	participants policy.ApproverSet
+	warnings     []PolicyWarning
+}
+
+func (a *policyAnalyzer) warn(kind PolicyWarningKind, path string, format string, args ...any) {
+	a.warnings = append(a.warnings, PolicyWarning{Kind: kind, Path: path, Message: fmt.Sprintf(format, args...)})
+}
+
+// analyze records the warnings for the subtree at path, returning whether
+// the subtree is satisfiable.
+func (a *policyAnalyzer) analyze(p *protobuf.Policy, path string) (bool, error) {
+	switch p.Tag {
+	case protobuf.PolicyTag_POLICY_SIGNATURE:
+		if !a.participants[p.GetCookedAddress()] {
+			a.warn(PolicyWarningUnsatisfiable, path, "signature of unknown participant %q", p.GetCookedAddress())
+			return false, nil
+		}
+		return true, nil
+	case protobuf.PolicyTag_POLICY_ALL, protobuf.PolicyTag_POLICY_ANY:
+	default:
+		return false, fmt.Errorf("unsupported policy tag %s at %s", p.Tag, path)
+	}
+
+	satisfiable := make([]bool, len(p.Subpolicies))
+	var unsatisfiablePaths []string
+	for i, sub := range p.Subpolicies {
+		subPath := fmt.Sprintf("%s/%d", path, i)
+		ok, err := a.analyze(sub, subPath)
+		if err != nil {
+			return false, err
+		}
+		satisfiable[i] = ok
+		if !ok {
+			unsatisfiablePaths = append(unsatisfiablePaths, subPath)
+		}
+	}
+	count := len(p.Subpolicies) - len(unsatisfiablePaths)
+
+	if p.Tag == protobuf.PolicyTag_POLICY_ALL {
+		if len(unsatisfiablePaths) > 0 {
+			a.warn(PolicyWarningUnsatisfiable, path, "requires all subpolicies, but %s can't be satisfied", strings.Join(unsatisfiablePaths, ", "))
+			return false, nil
+		}
+		return true, nil
+	}
+
+	if count < int(p.Threshold) {
+		a.warn(PolicyWarningUnsatisfiable, path, "threshold %d exceeds the %d satisfiable subpolicies", p.Threshold, count)
+		return false, nil
+	}
+	if p.Threshold == 1 {
+		a.findRedundant(p, path, satisfiable)
+	}
+	return true, nil
+}
+
+// findRedundant records the satisfiable subpolicies of an OR that imply
+// another one. Of two equivalent subpolicies, the latter is reported.
+func (a *policyAnalyzer) findRedundant(p *protobuf.Policy, path string, satisfiable []bool) {
+	sets := make([][]policy.ApproverSet, len(p.Subpolicies))
+	for i, sub := range p.Subpolicies {
+		if !satisfiable[i] {
+			continue
+		}
+		s, err := approverSets(sub)
+		if err != nil {
+			// too large to enumerate, skip the check
+			return
+		}
+		sets[i] = s
+	}
+
+	for i := range p.Subpolicies {
+		if !satisfiable[i] {
+			continue
+		}
+		for j, other := range p.Subpolicies {
+			if i == j || !satisfiable[j] || !implies(sets[i], other) {
+				continue
+			}
+			if j > i && implies(sets[j], p.Subpolicies[i]) {
+				// equivalent, report j instead
+				continue
+			}
+			a.warn(PolicyWarningRedundant, fmt.Sprintf("%s/%d", path, i), "%s/%d is satisfied whenever this branch is", path, j)
+			break
+		}
+	}
+}
+
+// implies returns whether all the approver sets satisfy p.
+func implies(sets []policy.ApproverSet, p *protobuf.Policy) bool {
+	for _, s := range sets {
+		ok, err := evaluateContext(context.Background(), p, s)
+		if err != nil || !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// approverSets returns the approver sets satisfying p, built by picking
+// the subpolicies of each node needed to reach its threshold. Every minimal
+// set of approvers satisfying p is included.
+func approverSets(p *protobuf.Policy) ([]policy.ApproverSet, error) {
+	switch p.Tag {
+	case protobuf.PolicyTag_POLICY_SIGNATURE:
+		return []policy.ApproverSet{{p.GetCookedAddress(): true}}, nil
+	case protobuf.PolicyTag_POLICY_ALL:
+		return thresholdSets(p.Subpolicies, len(p.Subpolicies))
+	case protobuf.PolicyTag_POLICY_ANY:
+		return thresholdSets(p.Subpolicies, int(p.Threshold))
+	default:
+		return nil, fmt.Errorf("%w %s", errUnsupportedTag, p.Tag)
+	}
+}
+
+// thresholdSets returns the approver sets satisfying threshold of the
+// subpolicies.
+func thresholdSets(subpolicies []*protobuf.Policy, threshold int) ([]policy.ApproverSet, error) {
+	if threshold <= 0 {
+		return []policy.ApproverSet{{}}, nil
+	}
+	if len(subpolicies) < threshold {
+		return nil, nil
+	}
+
+	first, err := approverSets(subpolicies[0])
+	if err != nil {
+		return nil, err
+	}
+	rest, err := thresholdSets(subpolicies[1:], threshold-1)
+	if err != nil {
+		return nil, err
+	}
+	with, err := unionProduct(first, rest)
+	if err != nil {
+		return nil, err
+	}
+	without, err := thresholdSets(subpolicies[1:], threshold)
+	if err != nil {
+		return nil, err
+	}
+	if len(with)+len(without) > maxAnalyzedSets {
+		return nil, errTooManySets
+	}
+	return append(with, without...), nil
+}
+
+// unionProduct returns the union of every pair of sets from a and b.
+func unionProduct(a, b []policy.ApproverSet) ([]policy.ApproverSet, error) {
+	if len(a)*len(b) > maxAnalyzedSets {
+		return nil, errTooManySets
+	}
+	product := make([]policy.ApproverSet, 0, len(a)*len(b))
+	for _, x := range a {
+		for _, y := range b {
+			union := make(policy.ApproverSet, len(x)+len(y))
+			for k := range x {
+				union[k] = true
+			}
+			for k := range y {
+				union[k] = true
+			}
+			product = append(product, union)
+		}
+	}
+	return product, nil
+}
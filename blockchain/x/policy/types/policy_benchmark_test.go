@@ -0,0 +1,149 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package types
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/qredo/fusionchain/policy"
+	"github.com/stretchr/testify/require"
+	"gitlab.qredo.com/edmund/blackbird/verifier/golang/protobuf"
+	"gitlab.qredo.com/edmund/blackbird/verifier/golang/simple"
+	protov2 "google.golang.org/protobuf/proto"
+)
+
+// thresholdBlackbirdPolicy returns a policy requiring the approval of a
+// majority of n participants, and the abbreviations of the participants.
+func thresholdBlackbirdPolicy(t testing.TB, n int) (*Policy, []string) {
+	t.Helper()
+	abbreviations := make([]string, n)
+	participants := make([]*PolicyParticipant, n)
+	subpolicies := make([]*protobuf.Policy, n)
+	for i := range participants {
+		abbreviations[i] = fmt.Sprintf("p%d", i)
+		participants[i] = &PolicyParticipant{Abbreviation: abbreviations[i], Address: fmt.Sprintf("qredo1p%d", i)}
+		subpolicies[i] = signaturePolicy(abbreviations[i])
+	}
+	data := marshalBlackbird(t, anyOfPolicy(uint64(n/2+1), subpolicies...))
+	return buildPolicy(t, &BlackbirdPolicy{Data: data, Participants: participants}), abbreviations
+}
+
+// BenchmarkVerifyBlackbirdPolicy measures the verification of a policy as
+// read from the store: decoding, unpacking and verifying it.
+func BenchmarkVerifyBlackbirdPolicy(b *testing.B) {
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	RegisterInterfaces(interfaceRegistry)
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+	ctx := context.Background()
+
+	for _, n := range []int{5, 25, 100} {
+		p, abbreviations := thresholdBlackbirdPolicy(b, n)
+		stored := cdc.MustMarshal(p)
+		approvers := policy.BuildApproverSet(abbreviations[:n/2+1])
+
+		for _, cached := range []bool{false, true} {
+			b.Run(fmt.Sprintf("participants=%d/cached=%t", n, cached), func(b *testing.B) {
+				b.ReportAllocs()
+				for i := 0; i < b.N; i++ {
+					if !cached {
+						blackbirdCache.reset()
+					}
+					var policyPb Policy
+					cdc.MustUnmarshal(stored, &policyPb)
+					unpacked, err := UnpackPolicy(cdc, &policyPb)
+					if err != nil {
+						b.Fatal(err)
+					}
+					if err := unpacked.Verify(ctx, approvers, policy.EmptyPolicyPayload(), nil); err != nil {
+						b.Fatal(err)
+					}
+				}
+			})
+		}
+	}
+}
+
+// BenchmarkVerifyRotatingKeyPolicy measures the verification of the
+// signatures of all the participants of a rotating key policy, which are
+// checked one at a time.
+func BenchmarkVerifyRotatingKeyPolicy(b *testing.B) {
+	hash := crypto.Keccak256([]byte("action"))
+
+	for _, n := range []int{10, 50, 100} {
+		p := &RotatingKeyPolicy{Threshold: uint32(n), EpochLength: 100}
+		signatures := make([]*ParticipantSignature, n)
+		for i := range signatures {
+			key, err := crypto.GenerateKey()
+			require.NoError(b, err)
+			sig, err := crypto.Sign(hash, key)
+			require.NoError(b, err)
+
+			abbreviation := fmt.Sprintf("p%d", i)
+			p.Participants = append(p.Participants, &PolicyParticipant{Abbreviation: abbreviation, Address: fmt.Sprintf("qredo1p%d", i)})
+			p.Schedules = append(p.Schedules, &KeySchedule{Abbreviation: abbreviation, Keys: []*EpochKey{{Pubkey: crypto.CompressPubkey(&key.PublicKey)}}})
+			signatures[i] = &ParticipantSignature{Abbreviation: abbreviation, Signature: sig[:64]}
+		}
+		require.NoError(b, p.Validate())
+
+		b.Run(fmt.Sprintf("signatures=%d", n), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if err := p.VerifyWithSignatures(0, signatures, hash); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func TestBlackbirdPolicyCache(t *testing.T) {
+	ctx := context.Background()
+	p, abbreviations := thresholdBlackbirdPolicy(t, 7)
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+	unpacked, err := UnpackPolicy(cdc, p)
+	require.NoError(t, err)
+	data := unpacked.(*BlackbirdPolicy).Data
+
+	// every subset of the participants is verified the same with an empty
+	// cache, with the decoded policy cached and by simple.Verify
+	for subset := 0; subset < 1<<len(abbreviations); subset++ {
+		var approved []string
+		for i, abbreviation := range abbreviations {
+			if subset&(1<<i) != 0 {
+				approved = append(approved, abbreviation)
+			}
+		}
+		approvers := policy.BuildApproverSet(approved)
+
+		blackbirdCache.reset()
+		uncached := unpacked.Verify(ctx, approvers, policy.EmptyPolicyPayload(), nil)
+		require.Len(t, blackbirdCache.policies, 1)
+		cached := unpacked.Verify(ctx, approvers, policy.EmptyPolicyPayload(), nil)
+		require.Equal(t, uncached, cached, "approvers %v", approved)
+		require.Equal(t, simple.Verify(data, nil, nil, nil, approvers), cached, "approvers %v", approved)
+		require.Equal(t, len(approved) >= 4, cached == nil, "approvers %v", approved)
+	}
+
+	// the cache is bounded
+	blackbirdCache.reset()
+	for i := 0; i < maxCachedBlackbirdPolicies+10; i++ {
+		data, err := protov2.Marshal(&protobuf.Policy{Tag: protobuf.PolicyTag_POLICY_ANY, Threshold: uint64(i)})
+		require.NoError(t, err)
+		_, err = blackbirdCache.decode(data)
+		require.NoError(t, err)
+	}
+	require.Len(t, blackbirdCache.policies, maxCachedBlackbirdPolicies)
+
+	// invalid data isn't cached, and fails as with simple.Verify
+	blackbirdCache.reset()
+	err = verifyBlackbird([]byte{0xff}, nil, nil)
+	require.Error(t, err)
+	require.Equal(t, simple.Verify([]byte{0xff}, nil, nil, nil, nil).Error(), err.Error())
+	require.Empty(t, blackbirdCache.policies)
+}
@@ -0,0 +1,64 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package types
+
+import (
+	"sync"
+
+	"gitlab.qredo.com/edmund/blackbird/verifier/golang/impl"
+	"gitlab.qredo.com/edmund/blackbird/verifier/golang/protobuf"
+	protov2 "google.golang.org/protobuf/proto"
+)
+
+// maxCachedBlackbirdPolicies is the maximum number of decoded blackbird
+// policies kept by blackbirdCache.
+const maxCachedBlackbirdPolicies = 1024
+
+// blackbirdCache caches the decoded blackbird policies by their serialized
+// data, so that verifying a policy doesn't decode its data again, see
+// verifyBlackbird.
+var blackbirdCache = &decodedPolicyCache{policies: make(map[string]*protobuf.Policy)}
+
+// decodedPolicyCache is a bounded cache of decoded blackbird policies, safe
+// for concurrent use. The cached policies are shared and must not be
+// modified.
+type decodedPolicyCache struct {
+	mu       sync.Mutex
+	policies map[string]*protobuf.Policy
+}
+
+// decode returns the decoded blackbird policy data, from the cache if it
+// was already decoded. Decoding errors are the ones of simple.Verify.
+func (c *decodedPolicyCache) decode(data []byte) (*protobuf.Policy, error) {
+	c.mu.Lock()
+	p, ok := c.policies[string(data)]
+	c.mu.Unlock()
+	if ok {
+		return p, nil
+	}
+
+	p = &protobuf.Policy{}
+	if err := protov2.Unmarshal(data, p); err != nil {
+		return nil, impl.ErrCouldNotDecodePolicy{PBError: err}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.policies) >= maxCachedBlackbirdPolicies {
+		// evict an arbitrary policy, verification doesn't depend on the
+		// content of the cache
+		for key := range c.policies {
+			delete(c.policies, key)
+			break
+		}
+	}
+	c.policies[string(data)] = p
+	return p, nil
+}
+
+// reset empties the cache.
+func (c *decodedPolicyCache) reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.policies = make(map[string]*protobuf.Policy)
+}
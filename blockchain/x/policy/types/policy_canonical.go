@@ -0,0 +1,40 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package types
+
+import (
+	"crypto/sha256"
+	"fmt"
+
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/qredo/fusionchain/policy"
+)
+
+// CanonicalBytes returns the canonical encoding of p, suitable for hashing:
+// the policy is normalized as in IngestPolicy, wrapped in an Any with its
+// type URL and encoded with the generated marshalers, which write the fields
+// in field number order and omit the default values. Equivalent policies
+// have the same canonical bytes regardless of how they were built or
+// decoded.
+func CanonicalBytes(p policy.Policy) ([]byte, error) {
+	normalized, err := normalizePolicy(p)
+	if err != nil {
+		return nil, err
+	}
+	wrapped, err := cdctypes.NewAnyWithValue(normalized)
+	if err != nil {
+		return nil, fmt.Errorf("wrapping policy: %w", err)
+	}
+	return wrapped.Marshal()
+}
+
+// PolicyHash returns the SHA-256 hash of the canonical bytes of p, see
+// CanonicalBytes.
+func PolicyHash(p policy.Policy) ([]byte, error) {
+	b, err := CanonicalBytes(p)
+	if err != nil {
+		return nil, err
+	}
+	hash := sha256.Sum256(b)
+	return hash[:], nil
+}
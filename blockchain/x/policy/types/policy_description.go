@@ -0,0 +1,181 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package types
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"gitlab.qredo.com/edmund/blackbird/verifier/golang/protobuf"
+	protov2 "google.golang.org/protobuf/proto"
+)
+
+// parametrized is implemented by the policy types with parameters other than
+// their participants.
+type parametrized interface {
+	parameters() []*PolicyParameter
+}
+
+// DescribePolicy decodes a stored policy into its human-readable
+// description. Blackbird policies are decoded into the tree of their
+// conditions.
+func DescribePolicy(cdc codec.BinaryCodec, policyPb *Policy) (*PolicyDescription, error) {
+	p, err := UnpackPolicy(cdc, policyPb)
+	if err != nil {
+		return nil, err
+	}
+	summary, err := policySummary(p)
+	if err != nil {
+		return nil, err
+	}
+
+	desc := &PolicyDescription{
+		Id:            policyPb.Id,
+		Name:          policyPb.Name,
+		TypeUrl:       policyPb.Policy.GetTypeUrl(),
+		Summary:       summary,
+		AdminPolicyId: policyPb.AdminPolicyId,
+	}
+
+	if participants, ok := PolicyParticipants(p); ok {
+		desc.Participants = append(desc.Participants, participants...)
+		sort.SliceStable(desc.Participants, func(i, j int) bool {
+			return desc.Participants[i].Abbreviation < desc.Participants[j].Abbreviation
+		})
+	}
+
+	if withParameters, ok := p.(parametrized); ok {
+		desc.Parameters = withParameters.parameters()
+		sort.SliceStable(desc.Parameters, func(i, j int) bool {
+			return desc.Parameters[i].Name < desc.Parameters[j].Name
+		})
+	}
+
+	if bp, ok := p.(*BlackbirdPolicy); ok {
+		var bbPolicy protobuf.Policy
+		if err := protov2.Unmarshal(bp.Data, &bbPolicy); err != nil {
+			return nil, fmt.Errorf("decoding blackbird policy: %w", err)
+		}
+		desc.Root = describeNode(&bbPolicy, desc.Participants)
+	}
+
+	return desc, nil
+}
+
+// describeNode returns the description of a node of a blackbird policy and
+// of its children, resolving the signers to the addresses of participants.
+func describeNode(p *protobuf.Policy, participants []*PolicyParticipant) *PolicyNode {
+	node := &PolicyNode{
+		Kind: strings.ToLower(strings.TrimPrefix(p.Tag.String(), "POLICY_")),
+	}
+	switch p.Tag {
+	case protobuf.PolicyTag_POLICY_ALL:
+		node.Threshold = uint64(len(p.Subpolicies))
+	case protobuf.PolicyTag_POLICY_ANY:
+		node.Threshold = p.Threshold
+	case protobuf.PolicyTag_POLICY_SIGNATURE:
+		node.Abbreviation = p.GetCookedAddress()
+		for _, participant := range participants {
+			if participant.Abbreviation == node.Abbreviation {
+				node.Address = participant.Address
+				break
+			}
+		}
+	}
+	for _, sub := range p.Subpolicies {
+		node.Children = append(node.Children, describeNode(sub, participants))
+	}
+	return node
+}
+
+func (p *BlackbirdPolicy) parameters() []*PolicyParameter {
+	if p.ThresholdFraction == nil {
+		return nil
+	}
+	return []*PolicyParameter{
+		{Name: "threshold_fraction", Value: fmt.Sprintf("%d/%d", p.ThresholdFraction.Numerator, p.ThresholdFraction.Denominator)},
+	}
+}
+
+func (p *BoolparserPolicy) parameters() []*PolicyParameter {
+	return []*PolicyParameter{
+		{Name: "definition", Value: p.Definition},
+	}
+}
+
+func (p *OracleAttestationPolicy) parameters() []*PolicyParameter {
+	return []*PolicyParameter{
+		{Name: "oracle_pubkey", Value: hexutil.Encode(p.OraclePubkey)},
+		{Name: "threshold", Value: p.Threshold},
+		{Name: "coin", Value: p.Coin},
+	}
+}
+
+func (p *DestinationQuorumPolicy) parameters() []*PolicyParameter {
+	return []*PolicyParameter{
+		{Name: "internal_threshold", Value: strconv.FormatUint(uint64(p.InternalThreshold), 10)},
+		{Name: "external_threshold", Value: strconv.FormatUint(uint64(p.ExternalThreshold), 10)},
+	}
+}
+
+func (p *MaxFeePolicy) parameters() []*PolicyParameter {
+	parameters := make([]*PolicyParameter, 0, len(p.Ceilings))
+	for _, ceiling := range p.Ceilings {
+		parameters = append(parameters, &PolicyParameter{Name: "max_fee." + ceiling.Symbol, Value: ceiling.MaxFee})
+	}
+	return parameters
+}
+
+func (p *RotatingKeyPolicy) parameters() []*PolicyParameter {
+	parameters := []*PolicyParameter{
+		{Name: "threshold", Value: strconv.FormatUint(uint64(p.Threshold), 10)},
+		{Name: "epoch_length", Value: strconv.FormatUint(p.EpochLength, 10)},
+	}
+	for _, schedule := range p.Schedules {
+		keys := make([]string, 0, len(schedule.Keys))
+		for _, key := range schedule.Keys {
+			formatted := fmt.Sprintf("epoch %d: %s", key.StartEpoch, hexutil.Encode(key.Pubkey))
+			if key.Algorithm != SignatureAlgorithm_SIGNATURE_ALGORITHM_ECDSA_SECP256K1 {
+				formatted += fmt.Sprintf(" (%s)", key.Algorithm)
+			}
+			keys = append(keys, formatted)
+		}
+		parameters = append(parameters, &PolicyParameter{Name: "keys." + schedule.Abbreviation, Value: strings.Join(keys, ", ")})
+	}
+	return parameters
+}
+
+func (p *GroupedQuorumPolicy) parameters() []*PolicyParameter {
+	parameters := make([]*PolicyParameter, 0, len(p.Groups))
+	for _, group := range p.Groups {
+		parameters = append(parameters, &PolicyParameter{
+			Name:  "group." + group.Label,
+			Value: fmt.Sprintf("%d of %s", group.MinApprovals, strings.Join(group.Members, ", ")),
+		})
+	}
+	return parameters
+}
+
+func (p *CooldownPolicy) parameters() []*PolicyParameter {
+	return []*PolicyParameter{
+		{Name: "cooldown_blocks", Value: strconv.FormatUint(p.CooldownBlocks, 10)},
+	}
+}
+
+func (p *TxCountLimitPolicy) parameters() []*PolicyParameter {
+	return []*PolicyParameter{
+		{Name: "max_transfers", Value: strconv.FormatUint(p.MaxTransfers, 10)},
+		{Name: "window_blocks", Value: strconv.FormatUint(p.WindowBlocks, 10)},
+	}
+}
+
+func (p *BatchApprovalPolicy) parameters() []*PolicyParameter {
+	return []*PolicyParameter{
+		{Name: "root", Value: hexutil.Encode(p.Root)},
+		{Name: "threshold", Value: strconv.FormatUint(uint64(p.Threshold), 10)},
+	}
+}
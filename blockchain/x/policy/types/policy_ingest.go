@@ -0,0 +1,94 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package types
+
+import (
+	"fmt"
+	"sort"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/codec"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	proto "github.com/cosmos/gogoproto/proto"
+	"github.com/qredo/fusionchain/policy"
+)
+
+// normalizer is implemented by the policy types with lists, other than the
+// participants, whose order doesn't affect the rules of the policy.
+type normalizer interface {
+	normalize()
+}
+
+// IngestPolicy is the entry point for the policies submitted by users. It
+// unpacks and validates the policy in p, then returns a copy of p ready to
+// be stored, with the policy normalized: the participants and the other
+// unordered lists are sorted, so that equivalent policies are encoded in
+// the same way.
+//
+// A policy that can't be unpacked fails with ErrInvalidPolicyEncoding, one
+// that doesn't validate fails with ErrInvalidPolicy.
+func IngestPolicy(cdc codec.BinaryCodec, p *Policy) (*Policy, error) {
+	if p == nil || p.Policy == nil {
+		return nil, errorsmod.Wrap(ErrInvalidPolicyEncoding, "missing policy")
+	}
+	unpacked, err := UnpackPolicy(cdc, p)
+	if err != nil {
+		return nil, errorsmod.Wrap(ErrInvalidPolicyEncoding, err.Error())
+	}
+	if err := unpacked.Validate(); err != nil {
+		return nil, errorsmod.Wrap(ErrInvalidPolicy, err.Error())
+	}
+
+	normalized, err := normalizePolicy(unpacked)
+	if err != nil {
+		return nil, errorsmod.Wrap(ErrInvalidPolicyEncoding, err.Error())
+	}
+
+	wrapped, err := cdctypes.NewAnyWithValue(normalized)
+	if err != nil {
+		return nil, errorsmod.Wrap(ErrInvalidPolicyEncoding, err.Error())
+	}
+	ingested := *p
+	ingested.Policy = wrapped
+	return &ingested, nil
+}
+
+// normalizePolicy returns a normalized copy of p, leaving p untouched.
+func normalizePolicy(p policy.Policy) (proto.Message, error) {
+	msg, ok := p.(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("%T is not a protobuf message", p)
+	}
+	cloned := proto.Clone(msg)
+	normalized := cloned.(policy.Policy)
+	if participants, ok := PolicyParticipants(normalized); ok {
+		sort.SliceStable(participants, func(i, j int) bool {
+			return participants[i].Abbreviation < participants[j].Abbreviation
+		})
+	}
+	if n, ok := normalized.(normalizer); ok {
+		n.normalize()
+	}
+	return cloned, nil
+}
+
+func (p *MaxFeePolicy) normalize() {
+	sort.SliceStable(p.Ceilings, func(i, j int) bool {
+		return p.Ceilings[i].Symbol < p.Ceilings[j].Symbol
+	})
+}
+
+func (p *RotatingKeyPolicy) normalize() {
+	sort.SliceStable(p.Schedules, func(i, j int) bool {
+		return p.Schedules[i].Abbreviation < p.Schedules[j].Abbreviation
+	})
+}
+
+func (p *GroupedQuorumPolicy) normalize() {
+	sort.SliceStable(p.Groups, func(i, j int) bool {
+		return p.Groups[i].Label < p.Groups[j].Label
+	})
+	for _, group := range p.Groups {
+		sort.Strings(group.Members)
+	}
+}
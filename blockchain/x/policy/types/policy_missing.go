@@ -0,0 +1,218 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package types
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/qredo/fusionchain/policy"
+	"gitlab.qredo.com/edmund/blackbird/verifier/golang/protobuf"
+	protov2 "google.golang.org/protobuf/proto"
+)
+
+// MissingApprovers returns the approvers still needed to satisfy p, sorted.
+// Policies that can compute a smallest set of additional approvers, e.g.
+// BlackbirdPolicy, return it; for the others, all the participants that
+// didn't approve are returned.
+func MissingApprovers(p policy.Policy, approvers policy.ApproverSet) []string {
+	if withMissing, ok := p.(interface {
+		MissingApprovers(policy.ApproverSet) []string
+	}); ok {
+		return withMissing.MissingApprovers(approvers)
+	}
+
+	participants, _ := PolicyParticipants(p)
+	var missing []string
+	for _, participant := range participants {
+		if !approvers[participant.Abbreviation] {
+			missing = append(missing, participant.Abbreviation)
+		}
+	}
+	return sortedUnique(missing)
+}
+
+// MissingApprovers returns a smallest set of additional approvers that,
+// together with the current ones, satisfies the policy, e.g. to tell a user
+// who still has to approve. Among sets of the same size, the first in
+// lexicographic order is returned.
+//
+// The set is computed branch by branch: for ANY nodes the cheapest
+// subpolicies are picked, for ALL nodes the approvers of all of them. The
+// threshold fraction, if any, is then completed with the first participants
+// that didn't approve.
+//
+// It returns nil if the policy is already satisfied, or if it can't be
+// satisfied by approvals alone (e.g. it has conditions on hashes).
+func (p *BlackbirdPolicy) MissingApprovers(approvers policy.ApproverSet) []string {
+	var bbPolicy protobuf.Policy
+	if err := protov2.Unmarshal(p.Data, &bbPolicy); err != nil {
+		return nil
+	}
+	missing, ok := minimalMissing(&bbPolicy, approvers)
+	if !ok {
+		return nil
+	}
+
+	required := p.ThresholdFraction.Required(len(p.Participants))
+	approved := make(map[string]bool, len(p.Participants))
+	for _, abbreviation := range missing {
+		approved[abbreviation] = true
+	}
+	candidates := make([]string, 0, len(p.Participants))
+	for _, participant := range p.Participants {
+		if approvers[participant.Abbreviation] {
+			approved[participant.Abbreviation] = true
+		} else {
+			candidates = append(candidates, participant.Abbreviation)
+		}
+	}
+	sort.Strings(candidates)
+	for _, candidate := range candidates {
+		if len(approved) >= required {
+			break
+		}
+		if !approved[candidate] {
+			approved[candidate] = true
+			missing = append(missing, candidate)
+		}
+	}
+	return sortedUnique(missing)
+}
+
+// minimalMissing returns the smallest set of additional approvers needed to
+// satisfy the subtree, or false if approvals can't satisfy it.
+func minimalMissing(p *protobuf.Policy, approvers policy.ApproverSet) ([]string, bool) {
+	switch p.Tag {
+	case protobuf.PolicyTag_POLICY_SIGNATURE:
+		if approvers[p.GetCookedAddress()] {
+			return nil, true
+		}
+		return []string{p.GetCookedAddress()}, true
+	case protobuf.PolicyTag_POLICY_ALL, protobuf.PolicyTag_POLICY_ANY:
+	default:
+		return nil, false
+	}
+
+	var candidates [][]string
+	for _, sub := range p.Subpolicies {
+		missing, ok := minimalMissing(sub, approvers)
+		if ok {
+			candidates = append(candidates, missing)
+		} else if p.Tag == protobuf.PolicyTag_POLICY_ALL {
+			return nil, false
+		}
+	}
+
+	if p.Tag == protobuf.PolicyTag_POLICY_ANY {
+		if uint64(len(candidates)) < p.Threshold {
+			return nil, false
+		}
+		sort.SliceStable(candidates, func(i, j int) bool {
+			if len(candidates[i]) != len(candidates[j]) {
+				return len(candidates[i]) < len(candidates[j])
+			}
+			return strings.Join(candidates[i], ",") < strings.Join(candidates[j], ",")
+		})
+		candidates = candidates[:p.Threshold]
+	}
+
+	var missing []string
+	for _, c := range candidates {
+		missing = append(missing, c...)
+	}
+	return sortedUnique(missing), true
+}
+
+// MissingApprovers returns the smallest set of additional approvers that
+// satisfies the policy: the first members of each group, in lexicographic
+// order, that didn't approve yet. It returns nil if the policy is already
+// satisfied.
+func (p *GroupedQuorumPolicy) MissingApprovers(approvers policy.ApproverSet) []string {
+	var missing []string
+	for _, group := range p.Groups {
+		members := append([]string(nil), group.Members...)
+		sort.Strings(members)
+		needed := int(group.MinApprovals)
+		var notApproved []string
+		for _, member := range members {
+			if approvers[member] {
+				needed--
+			} else {
+				notApproved = append(notApproved, member)
+			}
+		}
+		if needed > 0 {
+			missing = append(missing, notApproved[:min(needed, len(notApproved))]...)
+		}
+	}
+	return sortedUnique(missing)
+}
+
+// MissingApprovers returns the first participant, in lexicographic order,
+// if none of the participants approved, nil otherwise. The fee ceiling
+// doesn't depend on the approvers.
+func (p *MaxFeePolicy) MissingApprovers(approvers policy.ApproverSet) []string {
+	return missingAnyParticipant(p.Participants, approvers)
+}
+
+// MissingApprovers returns the first participant, in lexicographic order,
+// if none of the participants approved, nil otherwise. The cooldown
+// doesn't depend on the approvers.
+func (p *CooldownPolicy) MissingApprovers(approvers policy.ApproverSet) []string {
+	return missingAnyParticipant(p.Participants, approvers)
+}
+
+// MissingApprovers returns the first participant, in lexicographic order,
+// if none of the participants approved, nil otherwise. The transfer count
+// limit doesn't depend on the approvers.
+func (p *TxCountLimitPolicy) MissingApprovers(approvers policy.ApproverSet) []string {
+	return missingAnyParticipant(p.Participants, approvers)
+}
+
+// MissingApprovers returns the first participant, in lexicographic order,
+// if none of the participants approved, nil otherwise. The destination of
+// the transfer doesn't depend on the approvers.
+func (p *InternalOnlyPolicy) MissingApprovers(approvers policy.ApproverSet) []string {
+	return missingAnyParticipant(p.Participants, approvers)
+}
+
+// MissingApprovers returns the first participant, in lexicographic order,
+// if none of the participants approved, nil otherwise. Transfers in the
+// batch don't need approvals, but the proof isn't known here.
+func (p *BatchApprovalPolicy) MissingApprovers(approvers policy.ApproverSet) []string {
+	return missingAnyParticipant(p.Participants, approvers)
+}
+
+// missingAnyParticipant returns the missing approvers of policies requiring
+// the approval of any of the participants.
+func missingAnyParticipant(participants []*PolicyParticipant, approvers policy.ApproverSet) []string {
+	if len(participants) == 0 {
+		return nil
+	}
+	first := participants[0].Abbreviation
+	for _, participant := range participants {
+		if approvers[participant.Abbreviation] {
+			return nil
+		}
+		if participant.Abbreviation < first {
+			first = participant.Abbreviation
+		}
+	}
+	return []string{first}
+}
+
+// sortedUnique sorts s and removes its duplicates, in place.
+func sortedUnique(s []string) []string {
+	if len(s) == 0 {
+		return nil
+	}
+	sort.Strings(s)
+	unique := s[:1]
+	for _, v := range s[1:] {
+		if v != unique[len(unique)-1] {
+			unique = append(unique, v)
+		}
+	}
+	return unique
+}
@@ -0,0 +1,131 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package types
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/qredo/fusionchain/policy"
+	"gitlab.qredo.com/edmund/blackbird/verifier/golang/simple"
+)
+
+// summarizer is implemented by the policy types that can be described by
+// HumanSummary.
+type summarizer interface {
+	summary() (string, error)
+}
+
+// HumanSummary returns a human-readable description of the policy, e.g.
+//
+//	Require 2 of: finance(qredo1…), security(qredo1…)
+//
+// to be shown to approvers. The text is canonical: it only depends on the
+// policy rules, and not on the order of its participants, so that it can
+// be hashed and compared with the description an approver was shown.
+func (p *Policy) HumanSummary(cdc codec.Codec) (string, error) {
+	unpacked, err := UnpackPolicy(cdc, p)
+	if err != nil {
+		return "", err
+	}
+	return policySummary(unpacked)
+}
+
+func policySummary(p policy.Policy) (string, error) {
+	s, ok := p.(summarizer)
+	if !ok {
+		return "", fmt.Errorf("no summary for policy type %T", p)
+	}
+	return s.summary()
+}
+
+// summarizeParticipants lists the participants as abbreviation(address),
+// sorted.
+func summarizeParticipants(participants []*PolicyParticipant) string {
+	formatted := make([]string, 0, len(participants))
+	for _, participant := range participants {
+		formatted = append(formatted, fmt.Sprintf("%s(%s)", participant.Abbreviation, participant.Address))
+	}
+	sort.Strings(formatted)
+	return strings.Join(formatted, ", ")
+}
+
+func (p *BoolparserPolicy) summary() (string, error) {
+	return fmt.Sprintf("Require %q of: %s", p.Definition, summarizeParticipants(p.Participants)), nil
+}
+
+func (p *BlackbirdPolicy) summary() (string, error) {
+	pretty, err := simple.Unparse(p.Data)
+	if err != nil {
+		return "", err
+	}
+	s := fmt.Sprintf("Require %q of: %s", pretty, summarizeParticipants(p.Participants))
+	if f := p.ThresholdFraction; f != nil {
+		s += fmt.Sprintf("; at least %d/%d of the participants", f.Numerator, f.Denominator)
+	}
+	return s, nil
+}
+
+func (p *OracleAttestationPolicy) summary() (string, error) {
+	return fmt.Sprintf("Require 1 of: %s; attestation of oracle %s above %s %s",
+		summarizeParticipants(p.Participants), hexutil.Encode(p.OraclePubkey), p.Threshold, p.Coin), nil
+}
+
+func (p *DestinationQuorumPolicy) summary() (string, error) {
+	return fmt.Sprintf("Require %d of (internal destination) or %d of (external destination): %s",
+		p.InternalThreshold, p.ExternalThreshold, summarizeParticipants(p.Participants)), nil
+}
+
+func (p *MaxFeePolicy) summary() (string, error) {
+	ceilings := make([]string, 0, len(p.Ceilings))
+	for _, ceiling := range p.Ceilings {
+		ceilings = append(ceilings, fmt.Sprintf("%s %s", ceiling.Symbol, ceiling.MaxFee))
+	}
+	sort.Strings(ceilings)
+	return fmt.Sprintf("Require 1 of: %s; max fee: %s", summarizeParticipants(p.Participants), strings.Join(ceilings, ", ")), nil
+}
+
+func (p *RotatingKeyPolicy) summary() (string, error) {
+	return fmt.Sprintf("Require %d signatures of: %s; keys rotated every %d blocks",
+		p.Threshold, summarizeParticipants(p.Participants), p.EpochLength), nil
+}
+
+func (p *GroupedQuorumPolicy) summary() (string, error) {
+	groups := make([]string, 0, len(p.Groups))
+	for _, group := range p.Groups {
+		members := append([]string(nil), group.Members...)
+		sort.Strings(members)
+		groups = append(groups, fmt.Sprintf("%d of %s (%s)", group.MinApprovals, group.Label, strings.Join(members, ", ")))
+	}
+	sort.Strings(groups)
+	return fmt.Sprintf("Require %s: %s", strings.Join(groups, " and "), summarizeParticipants(p.Participants)), nil
+}
+
+func (p *CooldownPolicy) summary() (string, error) {
+	return fmt.Sprintf("Require 1 of: %s; at least %d blocks between transfers",
+		summarizeParticipants(p.Participants), p.CooldownBlocks), nil
+}
+
+func (p *TxCountLimitPolicy) summary() (string, error) {
+	return fmt.Sprintf("Require 1 of: %s; at most %d transfers every %d blocks",
+		summarizeParticipants(p.Participants), p.MaxTransfers, p.WindowBlocks), nil
+}
+
+func (p *InternalOnlyPolicy) summary() (string, error) {
+	return fmt.Sprintf("Require 1 of: %s; transfers to internal wallets only",
+		summarizeParticipants(p.Participants)), nil
+}
+
+func (p *BatchApprovalPolicy) summary() (string, error) {
+	s := fmt.Sprintf("Require 1 of: %s; transfers in batch %s approved",
+		summarizeParticipants(p.Participants), hexutil.Encode(p.Root))
+	if p.Threshold > 0 {
+		s += fmt.Sprintf("; %d of the participants for other transfers", p.Threshold)
+	} else {
+		s += "; other transfers rejected"
+	}
+	return s, nil
+}
@@ -3,14 +3,23 @@
 package types
 
 import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rand"
+	"math/big"
 	"testing"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	proto "github.com/cosmos/gogoproto/proto"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/qredo/fusionchain/policy"
 	"github.com/stretchr/testify/require"
+	"gitlab.qredo.com/edmund/blackbird/verifier/golang/protobuf"
+	protov2 "google.golang.org/protobuf/proto"
 )
 
 func TestPolicy(t *testing.T) {
@@ -27,9 +36,9 @@ func TestPolicy(t *testing.T) {
 	require.NoError(t, err)
 
 	// verify the unpacked Policy
-	require.NoError(t, unpackedPolicy.Verify(policy.BuildApproverSet([]string{"foo"}), policy.EmptyPolicyPayload(), nil))
-	require.NoError(t, unpackedPolicy.Verify(policy.BuildApproverSet([]string{"bar"}), policy.EmptyPolicyPayload(), nil))
-	require.Error(t, unpackedPolicy.Verify(policy.BuildApproverSet([]string{"baz"}), policy.EmptyPolicyPayload(), nil))
+	require.NoError(t, unpackedPolicy.Verify(context.Background(), policy.BuildApproverSet([]string{"foo"}), policy.EmptyPolicyPayload(), nil))
+	require.NoError(t, unpackedPolicy.Verify(context.Background(), policy.BuildApproverSet([]string{"bar"}), policy.EmptyPolicyPayload(), nil))
+	require.Error(t, unpackedPolicy.Verify(context.Background(), policy.BuildApproverSet([]string{"baz"}), policy.EmptyPolicyPayload(), nil))
 }
 
 func TestValidateBlackbirdPolicy(t *testing.T) {
@@ -80,6 +89,18 @@ func TestValidateBlackbirdPolicy(t *testing.T) {
 				},
 			},
 
+			wantErr: true,
+		},
+		{
+			name: "corrupted data",
+			policy: &BlackbirdPolicy{
+				Data: hexutil.MustDecode("0x080210011a0708032203666f6f1a07080322"),
+				Participants: []*PolicyParticipant{
+					{Abbreviation: "foo", Address: "qredoXXXXXXX"},
+					{Abbreviation: "bar", Address: "qredoYYYYYYY"},
+				},
+			},
+
 			wantErr: true,
 		},
 	}
@@ -100,6 +121,508 @@ func TestValidateBlackbirdPolicy(t *testing.T) {
 	}
 }
 
+// keySet is a KeyResolver backed by a set of addresses.
+type keySet map[string]bool
+
+func (s keySet) HasKey(address string) bool { return s[address] }
+
+func TestBlackbirdPolicyValidateKeysRegistered(t *testing.T) {
+	p := &BlackbirdPolicy{
+		Participants: []*PolicyParticipant{
+			{Abbreviation: "foo", Address: "qredo1foo"},
+			{Abbreviation: "bar", Address: "qredo1bar"},
+		},
+	}
+
+	require.NoError(t, p.ValidateKeysRegistered(keySet{"qredo1foo": true, "qredo1bar": true}))
+
+	err := p.ValidateKeysRegistered(keySet{"qredo1foo": true})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "bar (qredo1bar)")
+	require.NotContains(t, err.Error(), "qredo1foo")
+
+	require.NoError(t, (&BlackbirdPolicy{}).ValidateKeysRegistered(keySet{}))
+}
+
+func TestBlackbirdPolicyEnforceMaxThreshold(t *testing.T) {
+	// any of foo, bar
+	anyOf := hexutil.MustDecode("0x080210011a0708032203666f6f1a0708032203626172")
+	// 2 of foo, bar, baz
+	twoOf := marshalBlackbird(t, anyOfPolicy(2, signaturePolicy("foo"), signaturePolicy("bar"), signaturePolicy("baz")))
+	// foo and (bar or baz)
+	nested := marshalBlackbird(t, allOfPolicy(signaturePolicy("foo"), anyOfPolicy(1, signaturePolicy("bar"), signaturePolicy("baz"))))
+
+	tests := []struct {
+		name    string
+		data    []byte
+		max     int
+		wantErr bool
+	}{
+		{name: "any of two, at cap", data: anyOf, max: 1},
+		{name: "any of two, over cap", data: anyOf, max: 0, wantErr: true},
+		{name: "two of three, under cap", data: twoOf, max: 20},
+		{name: "two of three, at cap", data: twoOf, max: 2},
+		{name: "two of three, over cap", data: twoOf, max: 1, wantErr: true},
+		{name: "nested, at cap", data: nested, max: 2},
+		{name: "nested, over cap", data: nested, max: 1, wantErr: true},
+		{name: "malformed data", data: []byte{0xff}, max: 20, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &BlackbirdPolicy{Data: tt.data}
+			if tt.wantErr {
+				require.Error(t, p.EnforceMaxThreshold(tt.max))
+			} else {
+				require.NoError(t, p.EnforceMaxThreshold(tt.max))
+			}
+		})
+	}
+}
+
+func TestBlackbirdPolicyMaxRequiredSignatures(t *testing.T) {
+	tests := []struct {
+		name     string
+		policy   *protobuf.Policy
+		fraction *ThresholdFraction
+		want     int
+	}{
+		{name: "single signature", policy: signaturePolicy("a"), want: 1},
+		{name: "2 of 3", policy: anyOfPolicy(2, signaturePolicy("a"), signaturePolicy("b"), signaturePolicy("c")), want: 2},
+		{name: "all of 3", policy: allOfPolicy(signaturePolicy("a"), signaturePolicy("b"), signaturePolicy("c")), want: 3},
+		{
+			// a or (b and c and d)
+			name:   "or takes the most expensive branch",
+			policy: anyOfPolicy(1, signaturePolicy("a"), allOfPolicy(signaturePolicy("b"), signaturePolicy("c"), signaturePolicy("d"))),
+			want:   3,
+		},
+		{
+			// (1 of a, b) and (2 of c, (d and e), f)
+			name: "and sums the branches",
+			policy: allOfPolicy(
+				anyOfPolicy(1, signaturePolicy("a"), signaturePolicy("b")),
+				anyOfPolicy(2, signaturePolicy("c"), allOfPolicy(signaturePolicy("d"), signaturePolicy("e")), signaturePolicy("f")),
+			),
+			want: 4,
+		},
+		{
+			name:     "threshold fraction",
+			policy:   anyOfPolicy(1, signaturePolicy("a"), signaturePolicy("b"), signaturePolicy("c"), signaturePolicy("d")),
+			fraction: &ThresholdFraction{Numerator: 2, Denominator: 3},
+			want:     3,
+		},
+	}
+
+	participants := []*PolicyParticipant{
+		{Abbreviation: "a", Address: "qredo1a"},
+		{Abbreviation: "b", Address: "qredo1b"},
+		{Abbreviation: "c", Address: "qredo1c"},
+		{Abbreviation: "d", Address: "qredo1d"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &BlackbirdPolicy{Data: marshalBlackbird(t, tt.policy), Participants: participants, ThresholdFraction: tt.fraction}
+			got, err := p.MaxRequiredSignatures()
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+
+	t.Run("unsupported tag", func(t *testing.T) {
+		data := marshalBlackbird(t, allOfPolicy(signaturePolicy("a"), &protobuf.Policy{Tag: protobuf.PolicyTag_POLICY_REF_LOCAL}))
+		_, err := (&BlackbirdPolicy{Data: data}).MaxRequiredSignatures()
+		require.Error(t, err)
+	})
+
+	_, err := (&BlackbirdPolicy{Data: []byte{0xff}}).MaxRequiredSignatures()
+	require.Error(t, err)
+}
+
+func TestBlackbirdPolicyAnalyze(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy *protobuf.Policy
+		want   []PolicyWarning
+	}{
+		{
+			name:   "no warnings",
+			policy: anyOfPolicy(1, allOfPolicy(signaturePolicy("a"), signaturePolicy("b")), allOfPolicy(signaturePolicy("c"), signaturePolicy("d"))),
+		},
+		{
+			// a and (3 of b, c)
+			name:   "and with an unsatisfiable child",
+			policy: allOfPolicy(signaturePolicy("a"), anyOfPolicy(3, signaturePolicy("b"), signaturePolicy("c"))),
+			want: []PolicyWarning{
+				{Kind: PolicyWarningUnsatisfiable, Path: "root/1", Message: "threshold 3 exceeds the 2 satisfiable subpolicies"},
+				{Kind: PolicyWarningUnsatisfiable, Path: "root", Message: "requires all subpolicies, but root/1 can't be satisfied"},
+			},
+		},
+		{
+			name:   "unknown participant",
+			policy: anyOfPolicy(1, signaturePolicy("a"), signaturePolicy("z")),
+			want: []PolicyWarning{
+				{Kind: PolicyWarningUnsatisfiable, Path: "root/1", Message: `signature of unknown participant "z"`},
+			},
+		},
+		{
+			// a or (a and b)
+			name:   "or with a redundant child",
+			policy: anyOfPolicy(1, signaturePolicy("a"), allOfPolicy(signaturePolicy("a"), signaturePolicy("b"))),
+			want: []PolicyWarning{
+				{Kind: PolicyWarningRedundant, Path: "root/1", Message: "root/0 is satisfied whenever this branch is"},
+			},
+		},
+		{
+			// (2 of a, b, c) or (a and b)
+			name:   "or with a redundant threshold child",
+			policy: anyOfPolicy(1, anyOfPolicy(2, signaturePolicy("a"), signaturePolicy("b"), signaturePolicy("c")), allOfPolicy(signaturePolicy("a"), signaturePolicy("b"))),
+			want: []PolicyWarning{
+				{Kind: PolicyWarningRedundant, Path: "root/1", Message: "root/0 is satisfied whenever this branch is"},
+			},
+		},
+		{
+			name:   "or with equivalent children",
+			policy: anyOfPolicy(1, allOfPolicy(signaturePolicy("a"), signaturePolicy("b")), allOfPolicy(signaturePolicy("b"), signaturePolicy("a"))),
+			want: []PolicyWarning{
+				{Kind: PolicyWarningRedundant, Path: "root/1", Message: "root/0 is satisfied whenever this branch is"},
+			},
+		},
+		{
+			// 2 of (a, a and b, c): a and b isn't redundant with a threshold
+			name:   "threshold above one isn't checked for redundancy",
+			policy: anyOfPolicy(2, signaturePolicy("a"), allOfPolicy(signaturePolicy("a"), signaturePolicy("b")), signaturePolicy("c")),
+		},
+	}
+
+	participants := []*PolicyParticipant{
+		{Abbreviation: "a", Address: "qredo1a"},
+		{Abbreviation: "b", Address: "qredo1b"},
+		{Abbreviation: "c", Address: "qredo1c"},
+		{Abbreviation: "d", Address: "qredo1d"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := (&BlackbirdPolicy{Data: marshalBlackbird(t, tt.policy), Participants: participants}).Analyze()
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+
+	t.Run("unsupported tag", func(t *testing.T) {
+		data := marshalBlackbird(t, allOfPolicy(signaturePolicy("a"), &protobuf.Policy{Tag: protobuf.PolicyTag_POLICY_REF_LOCAL}))
+		_, err := (&BlackbirdPolicy{Data: data, Participants: participants}).Analyze()
+		require.Error(t, err)
+	})
+
+	_, err := (&BlackbirdPolicy{Data: []byte{0xff}}).Analyze()
+	require.Error(t, err)
+}
+
+func TestBlackbirdPolicyMissingByBranch(t *testing.T) {
+	// (2 of a, b, c) and (1 of d, e) and f
+	p := &BlackbirdPolicy{Data: marshalBlackbird(t, allOfPolicy(
+		anyOfPolicy(2, signaturePolicy("a"), signaturePolicy("b"), signaturePolicy("c")),
+		anyOfPolicy(1, signaturePolicy("d"), signaturePolicy("e")),
+		signaturePolicy("f"),
+	))}
+
+	tests := []struct {
+		name    string
+		current []string
+		want    map[string][]string
+	}{
+		{
+			name:    "no approvals",
+			current: []string{},
+			want: map[string][]string{
+				"root":   {"f"},
+				"root/0": {"a", "b", "c"},
+				"root/1": {"d", "e"},
+			},
+		},
+		{
+			name:    "partial approvals",
+			current: []string{"a", "d"},
+			want: map[string][]string{
+				"root":   {"f"},
+				"root/0": {"b", "c"},
+			},
+		},
+		{
+			name:    "only signature missing",
+			current: []string{"a", "c", "e"},
+			want: map[string][]string{
+				"root": {"f"},
+			},
+		},
+		{
+			name:    "satisfied",
+			current: []string{"a", "b", "e", "f"},
+			want:    map[string][]string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			missing, err := p.MissingByBranch(tt.current)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, missing)
+		})
+	}
+
+	_, err := (&BlackbirdPolicy{Data: []byte{0xff}}).MissingByBranch(nil)
+	require.Error(t, err)
+}
+
+func TestBlackbirdPolicyVerifyContext(t *testing.T) {
+	// a and (1 of b, (a and (1 of b, (...))))
+	nested := signaturePolicy("b")
+	for i := 0; i < 1000; i++ {
+		nested = allOfPolicy(signaturePolicy("a"), anyOfPolicy(1, signaturePolicy("b"), nested))
+	}
+	p := &BlackbirdPolicy{Data: marshalBlackbird(t, nested)}
+
+	t.Run("satisfied", func(t *testing.T) {
+		approvers := policy.BuildApproverSet([]string{"a", "b"})
+		require.NoError(t, p.Verify(context.Background(), approvers, policy.EmptyPolicyPayload(), nil))
+	})
+
+	t.Run("not satisfied", func(t *testing.T) {
+		approvers := policy.BuildApproverSet([]string{"a"})
+		require.Error(t, p.Verify(context.Background(), approvers, policy.EmptyPolicyPayload(), nil))
+	})
+
+	t.Run("deadline exceeded before evaluation", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Nanosecond)
+		defer cancel()
+		<-ctx.Done()
+
+		approvers := policy.BuildApproverSet([]string{"a", "b"})
+		err := p.Verify(ctx, approvers, policy.EmptyPolicyPayload(), nil)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	t.Run("deadline exceeded during evaluation", func(t *testing.T) {
+		// the evaluation of the nested policy outlasts the deadline
+		started, release := make(chan struct{}), make(chan struct{})
+		defer func(verify func([]byte, []byte, map[string]bool) error) {
+			close(release)
+			blackbirdVerify = verify
+		}(blackbirdVerify)
+		blackbirdVerify = func(data, witness []byte, approvers map[string]bool) error {
+			close(started)
+			<-release
+			return verifyBlackbird(data, witness, approvers)
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		approvers := policy.BuildApproverSet([]string{"a", "b"})
+		err := p.Verify(ctx, approvers, policy.EmptyPolicyPayload(), nil)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		<-started
+	})
+
+	t.Run("cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		approvers := policy.BuildApproverSet([]string{"a", "b"})
+		err := p.Verify(ctx, approvers, policy.EmptyPolicyPayload(), nil)
+		require.ErrorIs(t, err, context.Canceled)
+	})
+
+	t.Run("policy ignoring context", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		boolPolicy := &BoolparserPolicy{Definition: "a", Participants: []*PolicyParticipant{{Abbreviation: "a", Address: "qredo1a"}}}
+		err := boolPolicy.Verify(ctx, policy.BuildApproverSet([]string{"a"}), policy.EmptyPolicyPayload(), nil)
+		require.NoError(t, err)
+	})
+
+	t.Run("corrupted data", func(t *testing.T) {
+		err := (&BlackbirdPolicy{Data: []byte{0xff}}).Verify(context.Background(), nil, policy.EmptyPolicyPayload(), nil)
+		require.Error(t, err)
+	})
+}
+
+func TestThresholdFractionRequired(t *testing.T) {
+	tests := []struct {
+		fraction *ThresholdFraction
+		n        int
+		want     int
+	}{
+		{fraction: &ThresholdFraction{Numerator: 2, Denominator: 3}, n: 4, want: 3},
+		{fraction: &ThresholdFraction{Numerator: 2, Denominator: 3}, n: 3, want: 2},
+		{fraction: &ThresholdFraction{Numerator: 2, Denominator: 3}, n: 6, want: 4},
+		{fraction: &ThresholdFraction{Numerator: 2, Denominator: 3}, n: 7, want: 5},
+		{fraction: &ThresholdFraction{Numerator: 1, Denominator: 2}, n: 5, want: 3},
+		{fraction: &ThresholdFraction{Numerator: 1, Denominator: 1}, n: 4, want: 4},
+		{fraction: &ThresholdFraction{Numerator: 0, Denominator: 1}, n: 4, want: 0},
+		{fraction: &ThresholdFraction{Numerator: 1, Denominator: 3}, n: 0, want: 0},
+		{fraction: nil, n: 4, want: 0},
+	}
+
+	for _, tt := range tests {
+		require.Equal(t, tt.want, tt.fraction.Required(tt.n), "%v of %d", tt.fraction, tt.n)
+	}
+}
+
+func TestBlackbirdPolicyThresholdFraction(t *testing.T) {
+	// any of a, b, c, d
+	data := marshalBlackbird(t, anyOfPolicy(1, signaturePolicy("a"), signaturePolicy("b"), signaturePolicy("c"), signaturePolicy("d")))
+	participants := []*PolicyParticipant{
+		{Abbreviation: "a", Address: "qredo1a"},
+		{Abbreviation: "b", Address: "qredo1b"},
+		{Abbreviation: "c", Address: "qredo1c"},
+		{Abbreviation: "d", Address: "qredo1d"},
+	}
+
+	t.Run("validate", func(t *testing.T) {
+		for _, fraction := range []*ThresholdFraction{{Numerator: 1, Denominator: 0}, {Numerator: 4, Denominator: 3}} {
+			p := &BlackbirdPolicy{Data: data, Participants: participants, ThresholdFraction: fraction}
+			require.Error(t, p.Validate(), "%v", fraction)
+		}
+		for _, fraction := range []*ThresholdFraction{nil, {Numerator: 2, Denominator: 3}, {Numerator: 3, Denominator: 3}} {
+			p := &BlackbirdPolicy{Data: data, Participants: participants, ThresholdFraction: fraction}
+			require.NoError(t, p.Validate(), "%v", fraction)
+		}
+	})
+
+	// two-thirds of 4 participants is 3
+	p := &BlackbirdPolicy{Data: data, Participants: participants, ThresholdFraction: &ThresholdFraction{Numerator: 2, Denominator: 3}}
+
+	tests := []struct {
+		name      string
+		approvers []string
+		wantErr   bool
+	}{
+		{name: "one", approvers: []string{"a"}, wantErr: true},
+		{name: "two", approvers: []string{"a", "b"}, wantErr: true},
+		{name: "two and a stranger", approvers: []string{"a", "b", "z"}, wantErr: true},
+		{name: "three", approvers: []string{"a", "b", "d"}},
+		{name: "all", approvers: []string{"a", "b", "c", "d"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			approvers := policy.BuildApproverSet(tt.approvers)
+			err := p.Verify(context.Background(), approvers, policy.EmptyPolicyPayload(), nil)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+
+	t.Run("scales with participants", func(t *testing.T) {
+		fewer := &BlackbirdPolicy{Data: data, Participants: participants[:3], ThresholdFraction: p.ThresholdFraction}
+		require.NoError(t, fewer.Verify(context.Background(), policy.BuildApproverSet([]string{"a", "b"}), policy.EmptyPolicyPayload(), nil))
+	})
+
+	t.Run("max threshold", func(t *testing.T) {
+		require.NoError(t, p.EnforceMaxThreshold(3))
+		require.Error(t, p.EnforceMaxThreshold(2))
+	})
+}
+
+func TestBlackbirdPolicyBlockHeightRange(t *testing.T) {
+	p := &BlackbirdPolicy{Data: marshalBlackbird(t, signaturePolicy("a")), Participants: []*PolicyParticipant{{Abbreviation: "a", Address: "qredo1a"}}}
+
+	t.Run("validate", func(t *testing.T) {
+		require.NoError(t, (&BlackbirdPolicyPayload{}).Validate())
+		require.NoError(t, (&BlackbirdPolicyPayload{MinBlockHeight: 10}).Validate())
+		require.NoError(t, (&BlackbirdPolicyPayload{MinBlockHeight: 10, MaxBlockHeight: 10}).Validate())
+		require.Error(t, (&BlackbirdPolicyPayload{MinBlockHeight: 11, MaxBlockHeight: 10}).Validate())
+	})
+
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	RegisterInterfaces(interfaceRegistry)
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+	payload := func(min, max, height uint64) policy.PolicyPayload {
+		wrapped, err := codectypes.NewAnyWithValue(&BlackbirdPolicyPayload{MinBlockHeight: min, MaxBlockHeight: max})
+		require.NoError(t, err)
+		return policy.NewPolicyPayload(cdc, wrapped).WithBlockHeight(height)
+	}
+
+	tests := []struct {
+		name    string
+		payload policy.PolicyPayload
+		wantErr bool
+	}{
+		{name: "no payload", payload: policy.EmptyPolicyPayload().WithBlockHeight(100)},
+		{name: "unbounded", payload: payload(0, 0, 100)},
+		{name: "in range", payload: payload(90, 110, 100)},
+		{name: "at min", payload: payload(100, 110, 100)},
+		{name: "at max", payload: payload(90, 100, 100)},
+		{name: "only min", payload: payload(90, 0, 100)},
+		{name: "only max", payload: payload(0, 110, 100)},
+		{name: "before min", payload: payload(101, 110, 100), wantErr: true},
+		{name: "after max", payload: payload(90, 99, 100), wantErr: true},
+		{name: "empty range", payload: payload(110, 90, 100), wantErr: true},
+	}
+
+	approvers := policy.BuildApproverSet([]string{"a"})
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := p.Verify(context.Background(), approvers, tt.payload, nil)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestCheckSatisfiable(t *testing.T) {
+	data := marshalBlackbird(t, anyOfPolicy(2, signaturePolicy("a"), signaturePolicy("b"), signaturePolicy("c")))
+
+	tests := []struct {
+		name    string
+		p       policy.Policy
+		wantErr bool
+	}{
+		{
+			name: "blackbird",
+			p: &BlackbirdPolicy{Data: data, Participants: []*PolicyParticipant{
+				{Abbreviation: "a", Address: "qredo1a"},
+				{Abbreviation: "c", Address: "qredo1c"},
+			}},
+		},
+		{
+			name:    "blackbird missing participants",
+			p:       &BlackbirdPolicy{Data: data, Participants: []*PolicyParticipant{{Abbreviation: "a", Address: "qredo1a"}}},
+			wantErr: true,
+		},
+		{
+			name: "boolparser",
+			p:    &BoolparserPolicy{Definition: "a > 0", Participants: []*PolicyParticipant{{Abbreviation: "a", Address: "qredo1a"}}},
+		},
+		{
+			name:    "boolparser threshold too high",
+			p:       &BoolparserPolicy{Definition: "a + b > 1", Participants: []*PolicyParticipant{{Abbreviation: "a", Address: "qredo1a"}}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckSatisfiable(tt.p)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
 func TestVerifyBoolparserPolicy(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -200,7 +723,7 @@ func TestVerifyBoolparserPolicy(t *testing.T) {
 			policyData := make(map[string][]byte)
 			policyData["TXVALUE"] = []byte("200")
 
-			err = unpackedPolicy.Verify(policy.BuildApproverSet(tt.approvers), policy.EmptyPolicyPayload(), policyData)
+			err = unpackedPolicy.Verify(context.Background(), policy.BuildApproverSet(tt.approvers), policy.EmptyPolicyPayload(), policyData)
 			if tt.wantErr {
 				require.Error(t, err)
 			} else {
@@ -210,6 +733,29 @@ func TestVerifyBoolparserPolicy(t *testing.T) {
 	}
 }
 
+func TestVerifyBoolparserPolicyApproverOrder(t *testing.T) {
+	// t1 is a prefix of t10, and must only match the t1 identifier
+	p := &BoolparserPolicy{
+		Definition: "t1 + t10 > 1",
+		Participants: []*PolicyParticipant{
+			{Abbreviation: "t1", Address: "qredoXXXXXXX"},
+			{Abbreviation: "t10", Address: "qredoYYYYYYY"},
+		},
+	}
+
+	require.NoError(t, p.Verify(context.Background(), policy.BuildApproverSet([]string{"t1", "t10"}), policy.EmptyPolicyPayload(), nil))
+	require.NoError(t, p.Verify(context.Background(), policy.BuildApproverSet([]string{"t10", "t1"}), policy.EmptyPolicyPayload(), nil))
+	require.Error(t, p.Verify(context.Background(), policy.BuildApproverSet([]string{"t1"}), policy.EmptyPolicyPayload(), nil))
+	require.Error(t, p.Verify(context.Background(), policy.BuildApproverSet([]string{"t10"}), policy.EmptyPolicyPayload(), nil))
+}
+
+func TestSubstituteIdentifiers(t *testing.T) {
+	values := map[string]string{"t1": "1", "TXVALUE": "100", "a": "TXVALUE"}
+	require.Equal(t, "1 + t10 > 1", substituteIdentifiers("t1 + t10 > 1", values))
+	require.Equal(t, "(100 < 1000) & (1 > 0)", substituteIdentifiers("(TXVALUE < 1000) & (t1 > 0)", values))
+	require.Equal(t, "TXVALUE + TXVALUES", substituteIdentifiers("a + TXVALUES", values))
+}
+
 func TestWrongPolicy(t *testing.T) {
 	// craft a Policy with a type that does not implement policy.Policy
 	p := buildPolicy(t, &GenesisState{}) // here GenesisState is just a random proto.Message
@@ -221,17 +767,1645 @@ func TestWrongPolicy(t *testing.T) {
 	require.Error(t, err)
 }
 
-func buildPolicy(t *testing.T, v proto.Message) *Policy {
-	t.Helper()
-
-	wrappedMsg, err := codectypes.NewAnyWithValue(v)
+func TestValidateOracleAttestationPolicy(t *testing.T) {
+	oracleKey, err := crypto.GenerateKey()
 	require.NoError(t, err)
-
-	policy := &Policy{
-		Id:     1,
-		Name:   "test policy",
-		Policy: wrappedMsg,
+	participants := []*PolicyParticipant{
+		{Abbreviation: "foo", Address: "qredoXXXXXXX"},
 	}
 
-	return policy
+	tests := []struct {
+		name    string
+		policy  *OracleAttestationPolicy
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			policy: &OracleAttestationPolicy{
+				OraclePubkey: crypto.CompressPubkey(&oracleKey.PublicKey),
+				Threshold:    "1000",
+				Coin:         "ETH",
+				Participants: participants,
+			},
+		},
+		{
+			name: "empty pubkey",
+			policy: &OracleAttestationPolicy{
+				Threshold:    "1000",
+				Coin:         "ETH",
+				Participants: participants,
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid pubkey",
+			policy: &OracleAttestationPolicy{
+				OraclePubkey: []byte{0x02, 0x01, 0x02, 0x03},
+				Threshold:    "1000",
+				Coin:         "ETH",
+				Participants: participants,
+			},
+			wantErr: true,
+		},
+		{
+			name: "missing coin",
+			policy: &OracleAttestationPolicy{
+				OraclePubkey: crypto.CompressPubkey(&oracleKey.PublicKey),
+				Threshold:    "1000",
+				Participants: participants,
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid threshold",
+			policy: &OracleAttestationPolicy{
+				OraclePubkey: crypto.CompressPubkey(&oracleKey.PublicKey),
+				Threshold:    "-1",
+				Coin:         "ETH",
+				Participants: participants,
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.wantErr {
+				require.Error(t, tt.policy.Validate())
+			} else {
+				require.NoError(t, tt.policy.Validate())
+			}
+		})
+	}
+}
+
+func TestVerifyOracleAttestationPolicy(t *testing.T) {
+	oracleKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	otherKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	p := &OracleAttestationPolicy{
+		OraclePubkey: crypto.CompressPubkey(&oracleKey.PublicKey),
+		Threshold:    "1000",
+		Coin:         "ETH",
+		Participants: []*PolicyParticipant{
+			{Abbreviation: "foo", Address: "qredoXXXXXXX"},
+		},
+	}
+
+	hash := crypto.Keccak256([]byte("transfer"))
+	attest := func(key *ecdsa.PrivateKey) []byte {
+		sig, err := crypto.Sign(hash, key)
+		require.NoError(t, err)
+		return sig[:64]
+	}
+	// malleate returns the high-S complement of sig, (R, N - S)
+	malleate := func(sig []byte) []byte {
+		s := new(big.Int).Sub(crypto.S256().Params().N, new(big.Int).SetBytes(sig[32:]))
+		return append(append([]byte{}, sig[:32]...), s.FillBytes(make([]byte, 32))...)
+	}
+
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	RegisterInterfaces(interfaceRegistry)
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+	payload := func(attestation []byte) policy.PolicyPayload {
+		wrapped, err := codectypes.NewAnyWithValue(&OracleAttestationPolicyPayload{Attestation: attestation})
+		require.NoError(t, err)
+		return policy.NewPolicyPayload(cdc, wrapped)
+	}
+
+	tests := []struct {
+		name      string
+		approvers []string
+		payload   policy.PolicyPayload
+		data      map[string][]byte
+		amount    string
+		wantErr   bool
+	}{
+		{
+			name:      "valid attestation",
+			approvers: []string{"foo"},
+			payload:   payload(attest(oracleKey)),
+			amount:    "5000",
+		},
+		{
+			name:      "missing attestation",
+			approvers: []string{"foo"},
+			payload:   policy.EmptyPolicyPayload(),
+			amount:    "5000",
+			wantErr:   true,
+		},
+		{
+			name:      "attestation signed with the wrong key",
+			approvers: []string{"foo"},
+			payload:   payload(attest(otherKey)),
+			amount:    "5000",
+			wantErr:   true,
+		},
+		{
+			name:      "high-S attestation",
+			approvers: []string{"foo"},
+			payload:   payload(malleate(attest(oracleKey))),
+			amount:    "5000",
+			wantErr:   true,
+		},
+		{
+			name:      "below threshold",
+			approvers: []string{"foo"},
+			payload:   policy.EmptyPolicyPayload(),
+			amount:    "1000",
+		},
+		{
+			name:      "below threshold, other coin",
+			approvers: []string{"foo"},
+			payload:   policy.EmptyPolicyPayload(),
+			data:      map[string][]byte{"TXCOIN": []byte("ETH/0xdac17f958d2ee523a2206206994597c13d831ec7")},
+			amount:    "1000",
+			wantErr:   true,
+		},
+		{
+			name:      "valid attestation, other coin",
+			approvers: []string{"foo"},
+			payload:   payload(attest(oracleKey)),
+			data:      map[string][]byte{"TXCOIN": []byte("ETH/0xdac17f958d2ee523a2206206994597c13d831ec7")},
+			amount:    "1000",
+		},
+		{
+			name:      "missing amount",
+			approvers: []string{"foo"},
+			payload:   policy.EmptyPolicyPayload(),
+			data:      map[string][]byte{"TXVALUE": nil},
+			wantErr:   true,
+		},
+		{
+			name:      "not a transfer",
+			approvers: []string{"foo"},
+			payload:   policy.EmptyPolicyPayload(),
+			data:      map[string][]byte{"TXCOIN": nil, "TXVALUE": nil},
+		},
+		{
+			name:      "valid attestation, no approvers",
+			approvers: []string{},
+			payload:   payload(attest(oracleKey)),
+			amount:    "5000",
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policyData := map[string][]byte{
+				"TXVALUE":        []byte(tt.amount),
+				"TXCOIN":         []byte("ETH"),
+				"DataForSigning": hash,
+			}
+			// nil values of tt.data remove the key
+			for k, v := range tt.data {
+				if v == nil {
+					delete(policyData, k)
+				} else {
+					policyData[k] = v
+				}
+			}
+			err := p.Verify(context.Background(), policy.BuildApproverSet(tt.approvers), tt.payload, policyData)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateDestinationQuorumPolicy(t *testing.T) {
+	participants := []*PolicyParticipant{
+		{Abbreviation: "t1", Address: "qredoXXXXXXX"},
+		{Abbreviation: "t2", Address: "qredoYYYYYYY"},
+		{Abbreviation: "t3", Address: "qredoZZZZZZZ"},
+	}
+
+	tests := []struct {
+		name    string
+		policy  *DestinationQuorumPolicy
+		wantErr bool
+	}{
+		{
+			name:   "valid",
+			policy: &DestinationQuorumPolicy{InternalThreshold: 1, ExternalThreshold: 3, Participants: participants},
+		},
+		{
+			name:    "zero internal threshold",
+			policy:  &DestinationQuorumPolicy{InternalThreshold: 0, ExternalThreshold: 3, Participants: participants},
+			wantErr: true,
+		},
+		{
+			name:    "unsatisfiable external threshold",
+			policy:  &DestinationQuorumPolicy{InternalThreshold: 1, ExternalThreshold: 4, Participants: participants},
+			wantErr: true,
+		},
+		{
+			name: "duplicate participant",
+			policy: &DestinationQuorumPolicy{InternalThreshold: 1, ExternalThreshold: 2, Participants: []*PolicyParticipant{
+				{Abbreviation: "t1", Address: "qredoXXXXXXX"},
+				{Abbreviation: "t1", Address: "qredoYYYYYYY"},
+			}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.wantErr {
+				require.Error(t, tt.policy.Validate())
+			} else {
+				require.NoError(t, tt.policy.Validate())
+			}
+		})
+	}
+}
+
+func TestVerifyDestinationQuorumPolicy(t *testing.T) {
+	p := &DestinationQuorumPolicy{
+		InternalThreshold: 1,
+		ExternalThreshold: 2,
+		Participants: []*PolicyParticipant{
+			{Abbreviation: "t1", Address: "qredoXXXXXXX"},
+			{Abbreviation: "t2", Address: "qredoYYYYYYY"},
+			{Abbreviation: "t3", Address: "qredoZZZZZZZ"},
+		},
+	}
+
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	RegisterInterfaces(interfaceRegistry)
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+	payload := func(internal bool) policy.PolicyPayload {
+		wrapped, err := codectypes.NewAnyWithValue(&DestinationQuorumPolicyPayload{InternalDestination: internal})
+		require.NoError(t, err)
+		return policy.NewPolicyPayload(cdc, wrapped)
+	}
+
+	tests := []struct {
+		name      string
+		approvers []string
+		payload   policy.PolicyPayload
+		wantErr   bool
+	}{
+		{name: "internal, one approval", approvers: []string{"t1"}, payload: payload(true)},
+		{name: "internal, no approvals", approvers: []string{}, payload: payload(true), wantErr: true},
+		{name: "external, two approvals", approvers: []string{"t1", "t2"}, payload: payload(false)},
+		{name: "external, one approval", approvers: []string{"t1"}, payload: payload(false), wantErr: true},
+		{name: "no payload defaults to external", approvers: []string{"t1"}, payload: policy.EmptyPolicyPayload(), wantErr: true},
+		{name: "non participants don't count", approvers: []string{"t1", "other"}, payload: payload(false), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := p.Verify(context.Background(), policy.BuildApproverSet(tt.approvers), tt.payload, nil)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateMaxFeePolicy(t *testing.T) {
+	participants := []*PolicyParticipant{
+		{Abbreviation: "foo", Address: "qredoXXXXXXX"},
+	}
+
+	tests := []struct {
+		name    string
+		policy  *MaxFeePolicy
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			policy: &MaxFeePolicy{
+				Ceilings:     []*FeeCeiling{{Symbol: "ETH", MaxFee: "1000000000000000"}, {Symbol: "TIA", MaxFee: "20000"}},
+				Participants: participants,
+			},
+		},
+		{
+			name:    "no ceilings",
+			policy:  &MaxFeePolicy{Participants: participants},
+			wantErr: true,
+		},
+		{
+			name: "zero ceiling",
+			policy: &MaxFeePolicy{
+				Ceilings:     []*FeeCeiling{{Symbol: "ETH", MaxFee: "0"}},
+				Participants: participants,
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid ceiling",
+			policy: &MaxFeePolicy{
+				Ceilings:     []*FeeCeiling{{Symbol: "ETH", MaxFee: "0.1"}},
+				Participants: participants,
+			},
+			wantErr: true,
+		},
+		{
+			name: "duplicate symbol",
+			policy: &MaxFeePolicy{
+				Ceilings:     []*FeeCeiling{{Symbol: "ETH", MaxFee: "1000"}, {Symbol: "ETH", MaxFee: "2000"}},
+				Participants: participants,
+			},
+			wantErr: true,
+		},
+		{
+			name: "no participants",
+			policy: &MaxFeePolicy{
+				Ceilings: []*FeeCeiling{{Symbol: "ETH", MaxFee: "1000"}},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.wantErr {
+				require.Error(t, tt.policy.Validate())
+			} else {
+				require.NoError(t, tt.policy.Validate())
+			}
+		})
+	}
+}
+
+func TestVerifyMaxFeePolicy(t *testing.T) {
+	p := &MaxFeePolicy{
+		// 21000 gas at 50 gwei
+		Ceilings: []*FeeCeiling{{Symbol: "ETH", MaxFee: "1050000000000000"}},
+		Participants: []*PolicyParticipant{
+			{Abbreviation: "foo", Address: "qredoXXXXXXX"},
+		},
+	}
+
+	fee := func(gas, price int64) []byte {
+		return []byte(new(big.Int).Mul(big.NewInt(gas), big.NewInt(price)).String())
+	}
+
+	tests := []struct {
+		name       string
+		approvers  []string
+		policyData map[string][]byte
+		wantErr    bool
+	}{
+		{
+			name:       "under the ceiling",
+			approvers:  []string{"foo"},
+			policyData: map[string][]byte{"TXCOIN": []byte("ETH"), "TXMAXFEE": fee(21000, 30e9)},
+		},
+		{
+			name:       "at the ceiling",
+			approvers:  []string{"foo"},
+			policyData: map[string][]byte{"TXCOIN": []byte("ETH"), "TXMAXFEE": fee(21000, 50e9)},
+		},
+		{
+			name:       "above the ceiling",
+			approvers:  []string{"foo"},
+			policyData: map[string][]byte{"TXCOIN": []byte("ETH"), "TXMAXFEE": fee(21000, 51e9)},
+			wantErr:    true,
+		},
+		{
+			name:       "token transfer above the ceiling",
+			approvers:  []string{"foo"},
+			policyData: map[string][]byte{"TXCOIN": []byte("ETH/0xdac17f958d2ee523a2206206994597c13d831ec7"), "TXMAXFEE": fee(65000, 50e9)},
+			wantErr:    true,
+		},
+		{
+			name:       "chain without ceiling",
+			approvers:  []string{"foo"},
+			policyData: map[string][]byte{"TXCOIN": []byte("TIA"), "TXMAXFEE": fee(1, 1)},
+			wantErr:    true,
+		},
+		{
+			name:       "missing fee",
+			approvers:  []string{"foo"},
+			policyData: map[string][]byte{"TXCOIN": []byte("ETH")},
+			wantErr:    true,
+		},
+		{
+			name:      "not a transaction",
+			approvers: []string{"foo"},
+		},
+		{
+			name:       "under the ceiling, no approvers",
+			approvers:  []string{},
+			policyData: map[string][]byte{"TXCOIN": []byte("ETH"), "TXMAXFEE": fee(21000, 30e9)},
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := p.Verify(context.Background(), policy.BuildApproverSet(tt.approvers), policy.EmptyPolicyPayload(), tt.policyData)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateRotatingKeyPolicy(t *testing.T) {
+	pubkey := func() []byte {
+		key, err := crypto.GenerateKey()
+		require.NoError(t, err)
+		return crypto.CompressPubkey(&key.PublicKey)
+	}
+	k1, k2, k3 := pubkey(), pubkey(), pubkey()
+	participants := []*PolicyParticipant{
+		{Abbreviation: "t1", Address: "qredoXXXXXXX"},
+		{Abbreviation: "t2", Address: "qredoYYYYYYY"},
+	}
+
+	tests := []struct {
+		name    string
+		policy  *RotatingKeyPolicy
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			policy: &RotatingKeyPolicy{Threshold: 2, EpochLength: 100, Participants: participants, Schedules: []*KeySchedule{
+				{Abbreviation: "t1", Keys: []*EpochKey{{StartEpoch: 0, Pubkey: k1}, {StartEpoch: 5, Pubkey: k3}}},
+				{Abbreviation: "t2", Keys: []*EpochKey{{StartEpoch: 0, Pubkey: k2}}},
+			}},
+		},
+		{
+			name: "ed25519 key",
+			policy: &RotatingKeyPolicy{Threshold: 2, EpochLength: 100, Participants: participants, Schedules: []*KeySchedule{
+				{Abbreviation: "t1", Keys: []*EpochKey{{StartEpoch: 0, Pubkey: k1}, {StartEpoch: 5, Pubkey: make([]byte, ed25519.PublicKeySize), Algorithm: SignatureAlgorithm_SIGNATURE_ALGORITHM_EDDSA_ED25519}}},
+				{Abbreviation: "t2", Keys: []*EpochKey{{StartEpoch: 0, Pubkey: k2}}},
+			}},
+		},
+		{
+			name: "secp256k1 key as ed25519",
+			policy: &RotatingKeyPolicy{Threshold: 2, EpochLength: 100, Participants: participants, Schedules: []*KeySchedule{
+				{Abbreviation: "t1", Keys: []*EpochKey{{StartEpoch: 0, Pubkey: k1, Algorithm: SignatureAlgorithm_SIGNATURE_ALGORITHM_EDDSA_ED25519}}},
+				{Abbreviation: "t2", Keys: []*EpochKey{{StartEpoch: 0, Pubkey: k2}}},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "unknown algorithm",
+			policy: &RotatingKeyPolicy{Threshold: 2, EpochLength: 100, Participants: participants, Schedules: []*KeySchedule{
+				{Abbreviation: "t1", Keys: []*EpochKey{{StartEpoch: 0, Pubkey: k1, Algorithm: 7}}},
+				{Abbreviation: "t2", Keys: []*EpochKey{{StartEpoch: 0, Pubkey: k2}}},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "zero epoch length",
+			policy: &RotatingKeyPolicy{Threshold: 1, Participants: participants, Schedules: []*KeySchedule{
+				{Abbreviation: "t1", Keys: []*EpochKey{{Pubkey: k1}}},
+				{Abbreviation: "t2", Keys: []*EpochKey{{Pubkey: k2}}},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "unsatisfiable threshold",
+			policy: &RotatingKeyPolicy{Threshold: 3, EpochLength: 100, Participants: participants, Schedules: []*KeySchedule{
+				{Abbreviation: "t1", Keys: []*EpochKey{{Pubkey: k1}}},
+				{Abbreviation: "t2", Keys: []*EpochKey{{Pubkey: k2}}},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "schedule not increasing",
+			policy: &RotatingKeyPolicy{Threshold: 1, EpochLength: 100, Participants: participants, Schedules: []*KeySchedule{
+				{Abbreviation: "t1", Keys: []*EpochKey{{StartEpoch: 5, Pubkey: k1}, {StartEpoch: 5, Pubkey: k3}}},
+				{Abbreviation: "t2", Keys: []*EpochKey{{Pubkey: k2}}},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "missing schedule",
+			policy: &RotatingKeyPolicy{Threshold: 1, EpochLength: 100, Participants: participants, Schedules: []*KeySchedule{
+				{Abbreviation: "t1", Keys: []*EpochKey{{Pubkey: k1}}},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "shared key",
+			policy: &RotatingKeyPolicy{Threshold: 1, EpochLength: 100, Participants: participants, Schedules: []*KeySchedule{
+				{Abbreviation: "t1", Keys: []*EpochKey{{Pubkey: k1}}},
+				{Abbreviation: "t2", Keys: []*EpochKey{{Pubkey: k2}, {StartEpoch: 1, Pubkey: k1}}},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "invalid key",
+			policy: &RotatingKeyPolicy{Threshold: 1, EpochLength: 100, Participants: participants, Schedules: []*KeySchedule{
+				{Abbreviation: "t1", Keys: []*EpochKey{{Pubkey: []byte{0x02, 0x01, 0x02, 0x03}}}},
+				{Abbreviation: "t2", Keys: []*EpochKey{{Pubkey: k2}}},
+			}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.wantErr {
+				require.Error(t, tt.policy.Validate())
+			} else {
+				require.NoError(t, tt.policy.Validate())
+			}
+		})
+	}
+}
+
+func TestVerifyRotatingKeyPolicy(t *testing.T) {
+	generateKey := func() *ecdsa.PrivateKey {
+		key, err := crypto.GenerateKey()
+		require.NoError(t, err)
+		return key
+	}
+	t1Old, t1New, t2 := generateKey(), generateKey(), generateKey()
+
+	// t1 rotates its key at epoch 3, i.e. block 300
+	p := &RotatingKeyPolicy{
+		Threshold:   2,
+		EpochLength: 100,
+		Participants: []*PolicyParticipant{
+			{Abbreviation: "t1", Address: "qredoXXXXXXX"},
+			{Abbreviation: "t2", Address: "qredoYYYYYYY"},
+		},
+		Schedules: []*KeySchedule{
+			{Abbreviation: "t1", Keys: []*EpochKey{
+				{StartEpoch: 0, Pubkey: crypto.CompressPubkey(&t1Old.PublicKey)},
+				{StartEpoch: 3, Pubkey: crypto.CompressPubkey(&t1New.PublicKey)},
+			}},
+			{Abbreviation: "t2", Keys: []*EpochKey{
+				{StartEpoch: 0, Pubkey: crypto.CompressPubkey(&t2.PublicKey)},
+			}},
+		},
+	}
+	require.NoError(t, p.Validate())
+
+	hash := crypto.Keccak256([]byte("action"))
+	sign := func(abbreviation string, key *ecdsa.PrivateKey) *ParticipantSignature {
+		sig, err := crypto.Sign(hash, key)
+		require.NoError(t, err)
+		return &ParticipantSignature{Abbreviation: abbreviation, Signature: sig[:64]}
+	}
+
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	RegisterInterfaces(interfaceRegistry)
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+	payload := func(height uint64, signatures ...*ParticipantSignature) policy.PolicyPayload {
+		wrapped, err := codectypes.NewAnyWithValue(&RotatingKeyPolicyPayload{Signatures: signatures})
+		require.NoError(t, err)
+		return policy.NewPolicyPayload(cdc, wrapped).WithBlockHeight(height)
+	}
+
+	tests := []struct {
+		name      string
+		approvers []string
+		payload   policy.PolicyPayload
+		wantErr   bool
+	}{
+		{name: "old key before rotation", payload: payload(299, sign("t1", t1Old), sign("t2", t2))},
+		{name: "new key after rotation", payload: payload(300, sign("t1", t1New), sign("t2", t2))},
+		{name: "old key after rotation", payload: payload(300, sign("t1", t1Old), sign("t2", t2)), wantErr: true},
+		{name: "new key before rotation", payload: payload(299, sign("t1", t1New), sign("t2", t2)), wantErr: true},
+		{name: "same participant twice", payload: payload(300, sign("t1", t1New), sign("t1", t1New)), wantErr: true},
+		{name: "signature of another participant's key", payload: payload(300, sign("t1", t2), sign("t2", t2)), wantErr: true},
+		{name: "non participant", payload: payload(300, sign("t1", t1New), sign("t3", t2)), wantErr: true},
+		{name: "invalid signature before valid one", payload: payload(300, sign("t1", t1Old), sign("t1", t1New), sign("t2", t2))},
+		{name: "signature of a participant who didn't approve", approvers: []string{"t1"}, payload: payload(300, sign("t1", t1New), sign("t2", t2)), wantErr: true},
+		{name: "no signatures", payload: policy.EmptyPolicyPayload().WithBlockHeight(300), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			approvers := tt.approvers
+			if approvers == nil {
+				approvers = []string{"t1", "t2"}
+			}
+			err := p.Verify(context.Background(), policy.BuildApproverSet(approvers), tt.payload, map[string][]byte{"DataForSigning": hash})
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestVerifyRotatingKeyPolicyMixedSchemes(t *testing.T) {
+	secpKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	edPubkey, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	p := &RotatingKeyPolicy{
+		Threshold:   2,
+		EpochLength: 100,
+		Participants: []*PolicyParticipant{
+			{Abbreviation: "secp", Address: "qredoXXXXXXX"},
+			{Abbreviation: "ed", Address: "qredoYYYYYYY"},
+		},
+		Schedules: []*KeySchedule{
+			{Abbreviation: "secp", Keys: []*EpochKey{{Pubkey: crypto.CompressPubkey(&secpKey.PublicKey)}}},
+			{Abbreviation: "ed", Keys: []*EpochKey{{Pubkey: edPubkey, Algorithm: SignatureAlgorithm_SIGNATURE_ALGORITHM_EDDSA_ED25519}}},
+		},
+	}
+	require.NoError(t, p.Validate())
+
+	hash := crypto.Keccak256([]byte("action"))
+	secpSig, err := crypto.Sign(hash, secpKey)
+	require.NoError(t, err)
+	secpSignature := &ParticipantSignature{Abbreviation: "secp", Signature: secpSig[:64]}
+	edSignature := &ParticipantSignature{Abbreviation: "ed", Signature: ed25519.Sign(edKey, hash)}
+
+	tests := []struct {
+		name       string
+		signatures []*ParticipantSignature
+		wantErr    bool
+	}{
+		{name: "both", signatures: []*ParticipantSignature{secpSignature, edSignature}},
+		{name: "ed25519 only", signatures: []*ParticipantSignature{edSignature}, wantErr: true},
+		{name: "secp256k1 only", signatures: []*ParticipantSignature{secpSignature}, wantErr: true},
+		{
+			name: "one valid and one invalid signature",
+			signatures: []*ParticipantSignature{
+				{Abbreviation: "ed", Signature: secpSig[:64]},
+				secpSignature,
+				edSignature,
+			},
+		},
+		{
+			name: "secp256k1 signature for ed25519 key",
+			signatures: []*ParticipantSignature{
+				secpSignature,
+				{Abbreviation: "ed", Signature: secpSig[:64]},
+			},
+			wantErr: true,
+		},
+		{
+			name: "ed25519 signature for secp256k1 key",
+			signatures: []*ParticipantSignature{
+				{Abbreviation: "secp", Signature: edSignature.Signature},
+				edSignature,
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := p.VerifyWithSignatures(0, tt.signatures, hash)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPolicyEncodeDecision(t *testing.T) {
+	p := buildPolicy(t, &BlackbirdPolicy{
+		Data: hexutil.MustDecode("0x080210011a0708032203666f6f1a0708032203626172"),
+	})
+
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	RegisterInterfaces(interfaceRegistry)
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+	wrapped, err := codectypes.NewAnyWithValue(&BlackbirdPolicyPayload{Witness: []byte{0x01, 0x02}})
+	require.NoError(t, err)
+	payload := policy.NewPolicyPayload(cdc, wrapped)
+
+	t.Run("round trip", func(t *testing.T) {
+		b, err := p.EncodeDecision(payload, []string{"foo", "bar"})
+		require.NoError(t, err)
+
+		decision, err := DecodePolicyDecision(b)
+		require.NoError(t, err)
+		require.Equal(t, p.Id, decision.PolicyId)
+		require.Equal(t, []string{"bar", "foo"}, decision.Approvers)
+		require.Equal(t, wrapped.TypeUrl, decision.Payload.TypeUrl)
+		require.Equal(t, wrapped.Value, decision.Payload.Value)
+	})
+
+	t.Run("round trip without payload", func(t *testing.T) {
+		b, err := p.EncodeDecision(policy.EmptyPolicyPayload(), []string{"foo"})
+		require.NoError(t, err)
+
+		decision, err := DecodePolicyDecision(b)
+		require.NoError(t, err)
+		require.Equal(t, []string{"foo"}, decision.Approvers)
+		require.Nil(t, decision.Payload)
+	})
+
+	t.Run("deterministic", func(t *testing.T) {
+		b1, err := p.EncodeDecision(payload, []string{"foo", "bar"})
+		require.NoError(t, err)
+		b2, err := p.EncodeDecision(payload, []string{"bar", "foo", "bar"})
+		require.NoError(t, err)
+		b3, err := p.EncodeDecision(payload, []string{"foo", "bar"})
+		require.NoError(t, err)
+		require.Equal(t, b1, b2)
+		require.Equal(t, b1, b3)
+
+		b4, err := p.EncodeDecision(payload, []string{"foo"})
+		require.NoError(t, err)
+		require.NotEqual(t, b1, b4)
+	})
+
+	t.Run("nonce", func(t *testing.T) {
+		b1, err := p.EncodeDecision(payload, []string{"foo"})
+		require.NoError(t, err)
+
+		advanced := *p
+		advanced.Nonce++
+		b2, err := advanced.EncodeDecision(payload, []string{"foo"})
+		require.NoError(t, err)
+		require.NotEqual(t, b1, b2)
+
+		decision, err := DecodePolicyDecision(b2)
+		require.NoError(t, err)
+		require.Equal(t, advanced.Nonce, decision.PolicyNonce)
+	})
+
+	t.Run("invalid encoding", func(t *testing.T) {
+		_, err := DecodePolicyDecision([]byte{0xff})
+		require.Error(t, err)
+	})
+}
+
+func buildPolicy(t testing.TB, v proto.Message) *Policy {
+	t.Helper()
+
+	wrappedMsg, err := codectypes.NewAnyWithValue(v)
+	require.NoError(t, err)
+
+	policy := &Policy{
+		Id:     1,
+		Name:   "test policy",
+		Policy: wrappedMsg,
+	}
+
+	return policy
+}
+
+// signaturePolicy returns a blackbird policy satisfied by the approval of the
+// participant with the given abbreviation.
+func signaturePolicy(abbreviation string) *protobuf.Policy {
+	return &protobuf.Policy{
+		Tag:     protobuf.PolicyTag_POLICY_SIGNATURE,
+		Address: &protobuf.Policy_CookedAddress{CookedAddress: abbreviation},
+	}
+}
+
+// allOfPolicy returns a blackbird policy satisfied when all the subpolicies
+// are.
+func allOfPolicy(subpolicies ...*protobuf.Policy) *protobuf.Policy {
+	return &protobuf.Policy{Tag: protobuf.PolicyTag_POLICY_ALL, Subpolicies: subpolicies}
+}
+
+// anyOfPolicy returns a blackbird policy satisfied when threshold of the
+// subpolicies are.
+func anyOfPolicy(threshold uint64, subpolicies ...*protobuf.Policy) *protobuf.Policy {
+	return &protobuf.Policy{Tag: protobuf.PolicyTag_POLICY_ANY, Threshold: threshold, Subpolicies: subpolicies}
+}
+
+// marshalBlackbird returns the serialized blackbird policy, as stored in
+// BlackbirdPolicy.Data.
+func marshalBlackbird(t testing.TB, p *protobuf.Policy) []byte {
+	t.Helper()
+	data, err := protov2.Marshal(p)
+	require.NoError(t, err)
+	return data
+}
+
+func TestPolicyHumanSummary(t *testing.T) {
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	RegisterInterfaces(interfaceRegistry)
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+
+	finance := &PolicyParticipant{Abbreviation: "finance", Address: "qredo1finance"}
+	security := &PolicyParticipant{Abbreviation: "security", Address: "qredo1security"}
+
+	tests := []struct {
+		name      string
+		policy    proto.Message
+		reordered proto.Message
+		want      string
+	}{
+		{
+			name:      "blackbird",
+			policy:    &BlackbirdPolicy{Data: hexutil.MustDecode("0x080210011a0708032203666f6f1a0708032203626172"), Participants: []*PolicyParticipant{finance, security}},
+			reordered: &BlackbirdPolicy{Data: hexutil.MustDecode("0x080210011a0708032203666f6f1a0708032203626172"), Participants: []*PolicyParticipant{security, finance}},
+			want:      `Require "@foo or @bar" of: finance(qredo1finance), security(qredo1security)`,
+		},
+		{
+			name:      "blackbird with threshold fraction",
+			policy:    &BlackbirdPolicy{Data: hexutil.MustDecode("0x080210011a0708032203666f6f1a0708032203626172"), Participants: []*PolicyParticipant{finance, security}, ThresholdFraction: &ThresholdFraction{Numerator: 2, Denominator: 3}},
+			reordered: &BlackbirdPolicy{Data: hexutil.MustDecode("0x080210011a0708032203666f6f1a0708032203626172"), Participants: []*PolicyParticipant{security, finance}, ThresholdFraction: &ThresholdFraction{Numerator: 2, Denominator: 3}},
+			want:      `Require "@foo or @bar" of: finance(qredo1finance), security(qredo1security); at least 2/3 of the participants`,
+		},
+		{
+			name:      "destination quorum",
+			policy:    &DestinationQuorumPolicy{InternalThreshold: 1, ExternalThreshold: 2, Participants: []*PolicyParticipant{finance, security}},
+			reordered: &DestinationQuorumPolicy{InternalThreshold: 1, ExternalThreshold: 2, Participants: []*PolicyParticipant{security, finance}},
+			want:      "Require 1 of (internal destination) or 2 of (external destination): finance(qredo1finance), security(qredo1security)",
+		},
+		{
+			name:      "max fee",
+			policy:    &MaxFeePolicy{Ceilings: []*FeeCeiling{{Symbol: "TIA", MaxFee: "20000"}, {Symbol: "ETH", MaxFee: "1000"}}, Participants: []*PolicyParticipant{finance, security}},
+			reordered: &MaxFeePolicy{Ceilings: []*FeeCeiling{{Symbol: "ETH", MaxFee: "1000"}, {Symbol: "TIA", MaxFee: "20000"}}, Participants: []*PolicyParticipant{security, finance}},
+			want:      "Require 1 of: finance(qredo1finance), security(qredo1security); max fee: ETH 1000, TIA 20000",
+		},
+		{
+			name: "grouped quorum",
+			policy: &GroupedQuorumPolicy{Participants: []*PolicyParticipant{finance, security}, Groups: []*ParticipantGroup{
+				{Label: "ops", MinApprovals: 1, Members: []string{"security"}},
+				{Label: "finance", MinApprovals: 1, Members: []string{"finance"}},
+			}},
+			reordered: &GroupedQuorumPolicy{Participants: []*PolicyParticipant{security, finance}, Groups: []*ParticipantGroup{
+				{Label: "finance", MinApprovals: 1, Members: []string{"finance"}},
+				{Label: "ops", MinApprovals: 1, Members: []string{"security"}},
+			}},
+			want: "Require 1 of finance (finance) and 1 of ops (security): finance(qredo1finance), security(qredo1security)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := buildPolicy(t, tt.policy)
+			summary, err := p.HumanSummary(cdc)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, summary)
+
+			again, err := p.HumanSummary(cdc)
+			require.NoError(t, err)
+			require.Equal(t, summary, again)
+
+			reordered, err := buildPolicy(t, tt.reordered).HumanSummary(cdc)
+			require.NoError(t, err)
+			require.Equal(t, summary, reordered)
+		})
+	}
+
+	t.Run("unknown policy type", func(t *testing.T) {
+		_, err := buildPolicy(t, &PolicyParticipant{}).HumanSummary(cdc)
+		require.Error(t, err)
+	})
+}
+
+func TestDescribePolicy(t *testing.T) {
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	RegisterInterfaces(interfaceRegistry)
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+
+	t.Run("blackbird", func(t *testing.T) {
+		// same policy as TestPolicy, with participants
+		p := buildPolicy(t, &BlackbirdPolicy{
+			Data: hexutil.MustDecode("0x080210011a0708032203666f6f1a0708032203626172"),
+			Participants: []*PolicyParticipant{
+				{Abbreviation: "foo", Address: "qredo1foo"},
+				{Abbreviation: "bar", Address: "qredo1bar"},
+			},
+		})
+
+		desc, err := DescribePolicy(cdc, p)
+		require.NoError(t, err)
+		require.Equal(t, p.Id, desc.Id)
+		require.Equal(t, "test policy", desc.Name)
+		require.Equal(t, "/fusionchain.policy.BlackbirdPolicy", desc.TypeUrl)
+		require.Equal(t, `Require "@foo or @bar" of: bar(qredo1bar), foo(qredo1foo)`, desc.Summary)
+		require.Equal(t, []*PolicyParticipant{
+			{Abbreviation: "bar", Address: "qredo1bar"},
+			{Abbreviation: "foo", Address: "qredo1foo"},
+		}, desc.Participants)
+		require.Empty(t, desc.Parameters)
+		require.Equal(t, &PolicyNode{
+			Kind:      "any",
+			Threshold: 1,
+			Children: []*PolicyNode{
+				{Kind: "signature", Abbreviation: "foo", Address: "qredo1foo"},
+				{Kind: "signature", Abbreviation: "bar", Address: "qredo1bar"},
+			},
+		}, desc.Root)
+	})
+
+	t.Run("parameters", func(t *testing.T) {
+		p := buildPolicy(t, &DestinationQuorumPolicy{
+			InternalThreshold: 1,
+			ExternalThreshold: 2,
+			Participants:      []*PolicyParticipant{{Abbreviation: "foo", Address: "qredo1foo"}},
+		})
+
+		desc, err := DescribePolicy(cdc, p)
+		require.NoError(t, err)
+		require.Nil(t, desc.Root)
+		require.Equal(t, []*PolicyParameter{
+			{Name: "external_threshold", Value: "2"},
+			{Name: "internal_threshold", Value: "1"},
+		}, desc.Parameters)
+	})
+
+	t.Run("corrupted blackbird data", func(t *testing.T) {
+		_, err := DescribePolicy(cdc, buildPolicy(t, &BlackbirdPolicy{Data: []byte{0xff}}))
+		require.Error(t, err)
+	})
+}
+
+func TestValidateGroupedQuorumPolicy(t *testing.T) {
+	participants := []*PolicyParticipant{
+		{Abbreviation: "ops1", Address: "qredoXXXXXXX"},
+		{Abbreviation: "ops2", Address: "qredoYYYYYYY"},
+		{Abbreviation: "fin1", Address: "qredoZZZZZZZ"},
+	}
+
+	tests := []struct {
+		name    string
+		policy  *GroupedQuorumPolicy
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			policy: &GroupedQuorumPolicy{Participants: participants, Groups: []*ParticipantGroup{
+				{Label: "ops", MinApprovals: 2, Members: []string{"ops1", "ops2"}},
+				{Label: "finance", MinApprovals: 1, Members: []string{"fin1"}},
+			}},
+		},
+		{
+			name:    "no groups",
+			policy:  &GroupedQuorumPolicy{Participants: participants},
+			wantErr: true,
+		},
+		{
+			name: "minimum exceeding membership",
+			policy: &GroupedQuorumPolicy{Participants: participants, Groups: []*ParticipantGroup{
+				{Label: "ops", MinApprovals: 1, Members: []string{"ops1", "ops2"}},
+				{Label: "finance", MinApprovals: 2, Members: []string{"fin1"}},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "zero minimum",
+			policy: &GroupedQuorumPolicy{Participants: participants, Groups: []*ParticipantGroup{
+				{Label: "ops", MinApprovals: 0, Members: []string{"ops1", "ops2"}},
+				{Label: "finance", MinApprovals: 1, Members: []string{"fin1"}},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "duplicate group",
+			policy: &GroupedQuorumPolicy{Participants: participants, Groups: []*ParticipantGroup{
+				{Label: "ops", MinApprovals: 1, Members: []string{"ops1", "ops2"}},
+				{Label: "ops", MinApprovals: 1, Members: []string{"fin1"}},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "missing label",
+			policy: &GroupedQuorumPolicy{Participants: participants, Groups: []*ParticipantGroup{
+				{MinApprovals: 1, Members: []string{"ops1", "ops2", "fin1"}},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "member not a participant",
+			policy: &GroupedQuorumPolicy{Participants: participants, Groups: []*ParticipantGroup{
+				{Label: "ops", MinApprovals: 1, Members: []string{"ops1", "ops2", "ops3"}},
+				{Label: "finance", MinApprovals: 1, Members: []string{"fin1"}},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "participant in two groups",
+			policy: &GroupedQuorumPolicy{Participants: participants, Groups: []*ParticipantGroup{
+				{Label: "ops", MinApprovals: 1, Members: []string{"ops1", "ops2"}},
+				{Label: "finance", MinApprovals: 1, Members: []string{"fin1", "ops2"}},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "participant without group",
+			policy: &GroupedQuorumPolicy{Participants: participants, Groups: []*ParticipantGroup{
+				{Label: "ops", MinApprovals: 1, Members: []string{"ops1", "ops2"}},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "duplicate participant",
+			policy: &GroupedQuorumPolicy{Participants: append([]*PolicyParticipant{{Abbreviation: "ops1", Address: "qredoWWWWWWW"}}, participants...), Groups: []*ParticipantGroup{
+				{Label: "ops", MinApprovals: 1, Members: []string{"ops1", "ops2"}},
+				{Label: "finance", MinApprovals: 1, Members: []string{"fin1"}},
+			}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.wantErr {
+				require.Error(t, tt.policy.Validate())
+			} else {
+				require.NoError(t, tt.policy.Validate())
+			}
+		})
+	}
+}
+
+func TestVerifyGroupedQuorumPolicy(t *testing.T) {
+	p := &GroupedQuorumPolicy{
+		Participants: []*PolicyParticipant{
+			{Abbreviation: "ops1", Address: "qredoXXXXXXX"},
+			{Abbreviation: "ops2", Address: "qredoYYYYYYY"},
+			{Abbreviation: "ops3", Address: "qredoWWWWWWW"},
+			{Abbreviation: "fin1", Address: "qredoZZZZZZZ"},
+			{Abbreviation: "fin2", Address: "qredoVVVVVVV"},
+		},
+		Groups: []*ParticipantGroup{
+			{Label: "ops", MinApprovals: 1, Members: []string{"ops1", "ops2", "ops3"}},
+			{Label: "finance", MinApprovals: 1, Members: []string{"fin1", "fin2"}},
+		},
+	}
+	require.NoError(t, p.Validate())
+
+	tests := []struct {
+		name      string
+		approvers []string
+		wantErr   bool
+	}{
+		{name: "one from each group", approvers: []string{"ops2", "fin1"}},
+		{name: "all participants", approvers: []string{"ops1", "ops2", "ops3", "fin1", "fin2"}},
+		{name: "enough approvals but missing finance", approvers: []string{"ops1", "ops2", "ops3"}, wantErr: true},
+		{name: "enough approvals but missing ops", approvers: []string{"fin1", "fin2"}, wantErr: true},
+		{name: "non participants don't count", approvers: []string{"ops1", "other"}, wantErr: true},
+		{name: "no approvers", approvers: []string{}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := p.Verify(context.Background(), policy.BuildApproverSet(tt.approvers), policy.EmptyPolicyPayload(), nil)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestParticipantUtilization(t *testing.T) {
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	RegisterInterfaces(interfaceRegistry)
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+
+	alice := &PolicyParticipant{Abbreviation: "alice", Address: "qredo1alice"}
+	bob := &PolicyParticipant{Abbreviation: "bob", Address: "qredo1bob"}
+	carol := &PolicyParticipant{Abbreviation: "carol", Address: "qredo1carol"}
+
+	policies := []*Policy{
+		buildPolicy(t, &BlackbirdPolicy{Data: hexutil.MustDecode("0x080210011a0708032203666f6f1a0708032203626172"), Participants: []*PolicyParticipant{alice, bob}}),
+		buildPolicy(t, &DestinationQuorumPolicy{InternalThreshold: 1, ExternalThreshold: 2, Participants: []*PolicyParticipant{alice, carol}}),
+		buildPolicy(t, &MaxFeePolicy{Ceilings: []*FeeCeiling{{Symbol: "ETH", MaxFee: "1000"}}, Participants: []*PolicyParticipant{alice}}),
+		buildPolicy(t, &BoolparserPolicy{Definition: "alice", Participants: []*PolicyParticipant{alice, alice}}),
+	}
+
+	counts, err := ParticipantUtilization(policies, cdc)
+	require.NoError(t, err)
+	require.Equal(t, map[string]int{"alice": 4, "bob": 1, "carol": 1}, counts)
+
+	counts, err = ParticipantUtilization(nil, cdc)
+	require.NoError(t, err)
+	require.Empty(t, counts)
+
+	_, err = ParticipantUtilization([]*Policy{buildPolicy(t, &PolicyParticipant{})}, cdc)
+	require.Error(t, err)
+}
+
+func TestIngestPolicy(t *testing.T) {
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	RegisterInterfaces(interfaceRegistry)
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+
+	finance := &PolicyParticipant{Abbreviation: "finance", Address: "qredo1finance"}
+	security := &PolicyParticipant{Abbreviation: "security", Address: "qredo1security"}
+
+	t.Run("normalized", func(t *testing.T) {
+		p := buildPolicy(t, &MaxFeePolicy{
+			Ceilings:     []*FeeCeiling{{Symbol: "TIA", MaxFee: "20000"}, {Symbol: "ETH", MaxFee: "1000"}},
+			Participants: []*PolicyParticipant{security, finance},
+		})
+		p.AdminPolicyId = 3
+
+		ingested, err := IngestPolicy(cdc, p)
+		require.NoError(t, err)
+		require.Equal(t, p.Name, ingested.Name)
+		require.Equal(t, p.AdminPolicyId, ingested.AdminPolicyId)
+
+		unpacked, err := UnpackPolicy(cdc, ingested)
+		require.NoError(t, err)
+		require.Equal(t, &MaxFeePolicy{
+			Ceilings:     []*FeeCeiling{{Symbol: "ETH", MaxFee: "1000"}, {Symbol: "TIA", MaxFee: "20000"}},
+			Participants: []*PolicyParticipant{finance, security},
+		}, unpacked)
+
+		// the submitted policy isn't modified
+		original, err := UnpackPolicy(cdc, p)
+		require.NoError(t, err)
+		require.Equal(t, "TIA", original.(*MaxFeePolicy).Ceilings[0].Symbol)
+	})
+
+	t.Run("equivalent policies are encoded in the same way", func(t *testing.T) {
+		a, err := IngestPolicy(cdc, buildPolicy(t, &GroupedQuorumPolicy{Participants: []*PolicyParticipant{finance, security}, Groups: []*ParticipantGroup{
+			{Label: "ops", MinApprovals: 1, Members: []string{"security"}},
+			{Label: "finance", MinApprovals: 1, Members: []string{"finance"}},
+		}}))
+		require.NoError(t, err)
+		b, err := IngestPolicy(cdc, buildPolicy(t, &GroupedQuorumPolicy{Participants: []*PolicyParticipant{security, finance}, Groups: []*ParticipantGroup{
+			{Label: "finance", MinApprovals: 1, Members: []string{"finance"}},
+			{Label: "ops", MinApprovals: 1, Members: []string{"security"}},
+		}}))
+		require.NoError(t, err)
+		require.Equal(t, a.Policy.Value, b.Policy.Value)
+	})
+
+	tests := []struct {
+		name    string
+		policy  *Policy
+		wantErr error
+	}{
+		{
+			name:    "nil policy",
+			policy:  nil,
+			wantErr: ErrInvalidPolicyEncoding,
+		},
+		{
+			name:    "missing policy",
+			policy:  &Policy{Name: "empty"},
+			wantErr: ErrInvalidPolicyEncoding,
+		},
+		{
+			name:    "unknown policy type",
+			policy:  &Policy{Policy: &codectypes.Any{TypeUrl: "/fusionchain.policy.UnknownPolicy"}},
+			wantErr: ErrInvalidPolicyEncoding,
+		},
+		{
+			name:    "not a policy",
+			policy:  buildPolicy(t, &PolicyParticipant{}),
+			wantErr: ErrInvalidPolicyEncoding,
+		},
+		{
+			name:    "invalid policy",
+			policy:  buildPolicy(t, &MaxFeePolicy{Participants: []*PolicyParticipant{finance}}),
+			wantErr: ErrInvalidPolicy,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := IngestPolicy(cdc, tt.policy)
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestValidateCooldownPolicy(t *testing.T) {
+	participants := []*PolicyParticipant{{Abbreviation: "foo", Address: "qredoXXXXXXX"}}
+
+	require.NoError(t, (&CooldownPolicy{CooldownBlocks: 10, Participants: participants}).Validate())
+	require.Error(t, (&CooldownPolicy{CooldownBlocks: 0, Participants: participants}).Validate())
+	require.Error(t, (&CooldownPolicy{CooldownBlocks: 10}).Validate())
+}
+
+func TestVerifyCooldownPolicy(t *testing.T) {
+	p := &CooldownPolicy{
+		CooldownBlocks: 10,
+		Participants:   []*PolicyParticipant{{Abbreviation: "foo", Address: "qredoXXXXXXX"}},
+	}
+	transfer := map[string][]byte{txCoinKey: []byte("ETH"), txValueKey: []byte("1")}
+
+	tests := []struct {
+		name         string
+		approvers    []string
+		policyData   map[string][]byte
+		height       uint64
+		lastTransfer uint64
+		wantErr      bool
+	}{
+		{name: "first transfer", approvers: []string{"foo"}, policyData: transfer, height: 5},
+		{name: "inside the cooldown", approvers: []string{"foo"}, policyData: transfer, height: 109, lastTransfer: 100, wantErr: true},
+		{name: "same block", approvers: []string{"foo"}, policyData: transfer, height: 100, lastTransfer: 100, wantErr: true},
+		{name: "after the cooldown", approvers: []string{"foo"}, policyData: transfer, height: 110, lastTransfer: 100},
+		{name: "not a transfer", approvers: []string{"foo"}, height: 101, lastTransfer: 100},
+		{name: "no approvers", approvers: []string{}, policyData: transfer, height: 5, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			payload := policy.EmptyPolicyPayload().WithBlockHeight(tt.height).WithLastTransferHeight(tt.lastTransfer)
+			err := p.Verify(context.Background(), policy.BuildApproverSet(tt.approvers), payload, tt.policyData)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestBlackbirdPolicyMissingApprovers(t *testing.T) {
+	participants := func(abbreviations ...string) []*PolicyParticipant {
+		var participants []*PolicyParticipant
+		for _, a := range abbreviations {
+			participants = append(participants, &PolicyParticipant{Abbreviation: a, Address: "qredo1" + a})
+		}
+		return participants
+	}
+
+	// the policy of TestPolicy: any of foo and bar
+	fooOrBar := hexutil.MustDecode("0x080210011a0708032203666f6f1a0708032203626172")
+	twoOfThree := marshalBlackbird(t, anyOfPolicy(2, signaturePolicy("foo"), signaturePolicy("bar"), signaturePolicy("baz")))
+	// a and ((b and c) or d)
+	nested := marshalBlackbird(t, allOfPolicy(
+		signaturePolicy("a"),
+		anyOfPolicy(1, allOfPolicy(signaturePolicy("b"), signaturePolicy("c")), signaturePolicy("d")),
+	))
+
+	tests := []struct {
+		name      string
+		policy    *BlackbirdPolicy
+		approvers []string
+		want      []string
+	}{
+		{name: "foo or bar, no approvals", policy: &BlackbirdPolicy{Data: fooOrBar}, approvers: []string{}, want: []string{"bar"}},
+		{name: "foo or bar, foo approved", policy: &BlackbirdPolicy{Data: fooOrBar}, approvers: []string{"foo"}},
+		{name: "foo or bar, other approver", policy: &BlackbirdPolicy{Data: fooOrBar}, approvers: []string{"baz"}, want: []string{"bar"}},
+		{name: "2 of 3, foo approved", policy: &BlackbirdPolicy{Data: twoOfThree}, approvers: []string{"foo"}, want: []string{"bar"}},
+		{name: "2 of 3, no approvals", policy: &BlackbirdPolicy{Data: twoOfThree}, approvers: []string{}, want: []string{"bar", "baz"}},
+		{name: "nested, cheapest branch", policy: &BlackbirdPolicy{Data: nested}, approvers: []string{}, want: []string{"a", "d"}},
+		{name: "nested, branch partially approved", policy: &BlackbirdPolicy{Data: nested}, approvers: []string{"a", "b"}, want: []string{"c"}},
+		{
+			name:      "threshold fraction",
+			policy:    &BlackbirdPolicy{Data: fooOrBar, Participants: participants("foo", "bar", "baz"), ThresholdFraction: &ThresholdFraction{Numerator: 2, Denominator: 3}},
+			approvers: []string{},
+			want:      []string{"bar", "baz"},
+		},
+		{name: "invalid data", policy: &BlackbirdPolicy{Data: []byte{0xff}}, approvers: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			approvers := policy.BuildApproverSet(tt.approvers)
+			missing := tt.policy.MissingApprovers(approvers)
+			require.Equal(t, tt.want, missing)
+
+			if len(missing) > 0 {
+				// the missing approvers complete the policy
+				completed := policy.BuildApproverSet(append(tt.approvers, missing...))
+				require.NoError(t, tt.policy.Verify(context.Background(), completed, policy.EmptyPolicyPayload(), nil))
+			}
+		})
+	}
+}
+
+func TestMissingApproversAnyParticipant(t *testing.T) {
+	participants := []*PolicyParticipant{
+		{Abbreviation: "foo", Address: "qredoXXXXXXX"},
+		{Abbreviation: "bar", Address: "qredoYYYYYYY"},
+	}
+
+	p := &CooldownPolicy{CooldownBlocks: 10, Participants: participants}
+	require.Equal(t, []string{"bar"}, p.MissingApprovers(policy.BuildApproverSet(nil)))
+	require.Nil(t, p.MissingApprovers(policy.BuildApproverSet([]string{"foo"})))
+
+	m := &MaxFeePolicy{Ceilings: []*FeeCeiling{{Symbol: "ETH", MaxFee: "1"}}, Participants: participants}
+	require.Equal(t, []string{"bar"}, m.MissingApprovers(policy.BuildApproverSet([]string{"other"})))
+	require.Nil(t, m.MissingApprovers(policy.BuildApproverSet([]string{"bar"})))
+}
+
+func TestGroupedQuorumPolicyMissingApprovers(t *testing.T) {
+	p := &GroupedQuorumPolicy{
+		Groups: []*ParticipantGroup{
+			{Label: "ops", MinApprovals: 2, Members: []string{"ops3", "ops1", "ops2"}},
+			{Label: "finance", MinApprovals: 1, Members: []string{"fin1", "fin2"}},
+		},
+	}
+
+	require.Equal(t, []string{"fin1", "ops1", "ops2"}, p.MissingApprovers(policy.BuildApproverSet(nil)))
+	require.Equal(t, []string{"ops1"}, p.MissingApprovers(policy.BuildApproverSet([]string{"ops3", "fin2"})))
+	require.Nil(t, p.MissingApprovers(policy.BuildApproverSet([]string{"ops2", "ops3", "fin2"})))
+}
+
+func TestMissingApprovers(t *testing.T) {
+	participants := []*PolicyParticipant{
+		{Abbreviation: "foo", Address: "qredoXXXXXXX"},
+		{Abbreviation: "bar", Address: "qredoYYYYYYY"},
+		{Abbreviation: "baz", Address: "qredoZZZZZZZ"},
+	}
+
+	// policies computing their missing approvers
+	bp := &BlackbirdPolicy{Data: marshalBlackbird(t, anyOfPolicy(2, signaturePolicy("foo"), signaturePolicy("bar"), signaturePolicy("baz"))), Participants: participants}
+	require.Equal(t, []string{"bar"}, MissingApprovers(bp, policy.BuildApproverSet([]string{"foo"})))
+	require.Nil(t, MissingApprovers(bp, policy.BuildApproverSet([]string{"foo", "baz"})))
+
+	// other policies list the participants that didn't approve
+	boolparser := &BoolparserPolicy{Definition: "foo + bar + baz > 1", Participants: participants}
+	require.Equal(t, []string{"bar", "baz"}, MissingApprovers(boolparser, policy.BuildApproverSet([]string{"foo"})))
+	require.Nil(t, MissingApprovers(boolparser, policy.BuildApproverSet([]string{"foo", "bar", "baz"})))
+}
+
+func TestValidateBatchApprovalPolicy(t *testing.T) {
+	participants := []*PolicyParticipant{{Abbreviation: "foo", Address: "qredoXXXXXXX"}}
+	root := make([]byte, 32)
+
+	require.NoError(t, (&BatchApprovalPolicy{Root: root, Participants: participants}).Validate())
+	require.Error(t, (&BatchApprovalPolicy{Root: root[:31], Participants: participants}).Validate())
+	require.Error(t, (&BatchApprovalPolicy{Participants: participants}).Validate())
+	require.Error(t, (&BatchApprovalPolicy{Root: root}).Validate())
+	require.NoError(t, (&BatchApprovalPolicy{Root: root, Participants: participants, Threshold: 1}).Validate())
+	require.Error(t, (&BatchApprovalPolicy{Root: root, Participants: participants, Threshold: 2}).Validate())
+}
+
+func TestBatchMerkleProof(t *testing.T) {
+	for n := 1; n <= 9; n++ {
+		var hashes [][]byte
+		for i := 0; i < n; i++ {
+			hashes = append(hashes, crypto.Keccak256([]byte{byte(i)}))
+		}
+		root := BatchMerkleRoot(hashes)
+		require.Len(t, root, 32)
+		for i, hash := range hashes {
+			require.True(t, VerifyBatchMerkleProof(root, hash, BatchMerkleProof(hashes, i)), "leaf %d of %d", i, n)
+		}
+		require.False(t, VerifyBatchMerkleProof(root, crypto.Keccak256([]byte("excluded")), BatchMerkleProof(hashes, 0)))
+		require.Nil(t, BatchMerkleProof(hashes, n))
+	}
+	require.Nil(t, BatchMerkleRoot(nil))
+
+	// an inner node isn't a valid leaf
+	hashes := [][]byte{crypto.Keccak256([]byte("a")), crypto.Keccak256([]byte("b")), crypto.Keccak256([]byte("c"))}
+	inner := batchNode(batchLeaf(hashes[0]), batchLeaf(hashes[1]))
+	require.False(t, VerifyBatchMerkleProof(BatchMerkleRoot(hashes), inner, BatchMerkleProof(hashes, 2)))
+}
+
+func TestVerifyBatchApprovalPolicy(t *testing.T) {
+	var batch [][]byte
+	for i := 0; i < 5; i++ {
+		batch = append(batch, crypto.Keccak256([]byte{byte(i)}))
+	}
+	p := &BatchApprovalPolicy{
+		Root: BatchMerkleRoot(batch),
+		Participants: []*PolicyParticipant{
+			{Abbreviation: "foo", Address: "qredoXXXXXXX"},
+			{Abbreviation: "bar", Address: "qredoYYYYYYY"},
+		},
+	}
+	require.NoError(t, p.Validate())
+
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	RegisterInterfaces(interfaceRegistry)
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+	payload := func(proof [][]byte) policy.PolicyPayload {
+		wrapped, err := codectypes.NewAnyWithValue(&BatchApprovalPolicyPayload{Proof: proof})
+		require.NoError(t, err)
+		return policy.NewPolicyPayload(cdc, wrapped)
+	}
+	transfer := func(hash []byte) map[string][]byte {
+		return map[string][]byte{txCoinKey: []byte("ETH"), txValueKey: []byte("1"), dataForSigningKey: hash}
+	}
+
+	tampered := BatchMerkleProof(batch, 2)
+	tampered[0] = append([]byte(nil), tampered[0]...)
+	tampered[0][0] ^= 1
+	excluded := crypto.Keccak256([]byte("excluded"))
+
+	tests := []struct {
+		name       string
+		threshold  uint32
+		approvers  []string
+		payload    policy.PolicyPayload
+		policyData map[string][]byte
+		wantErr    bool
+	}{
+		{name: "transfer in the batch", payload: payload(BatchMerkleProof(batch, 2)), policyData: transfer(batch[2])},
+		{name: "last transfer in the batch", payload: payload(BatchMerkleProof(batch, 4)), policyData: transfer(batch[4])},
+		{name: "transfer not in the batch", payload: payload(BatchMerkleProof(batch, 2)), policyData: transfer(excluded), wantErr: true},
+		{name: "tampered proof", payload: payload(tampered), policyData: transfer(batch[2]), wantErr: true},
+		{name: "proof of another transfer", payload: payload(BatchMerkleProof(batch, 1)), policyData: transfer(batch[2]), wantErr: true},
+		{name: "invalid proof, approved", approvers: []string{"foo"}, payload: payload(tampered), policyData: transfer(batch[2]), wantErr: true},
+		{name: "no proof, approved", approvers: []string{"foo"}, payload: policy.EmptyPolicyPayload(), policyData: transfer(excluded), wantErr: true},
+		{name: "no proof, not approved", payload: policy.EmptyPolicyPayload(), policyData: transfer(batch[2]), wantErr: true},
+		{name: "no proof, threshold reached", threshold: 2, approvers: []string{"foo", "bar"}, payload: policy.EmptyPolicyPayload(), policyData: transfer(excluded)},
+		{name: "no proof, threshold not reached", threshold: 2, approvers: []string{"foo"}, payload: policy.EmptyPolicyPayload(), policyData: transfer(excluded), wantErr: true},
+		{name: "no proof, non-participant approvals", threshold: 2, approvers: []string{"foo", "baz"}, payload: policy.EmptyPolicyPayload(), policyData: transfer(excluded), wantErr: true},
+		{name: "not a transfer", approvers: []string{"foo"}, payload: policy.EmptyPolicyPayload()},
+		{name: "not a transfer, not approved", payload: policy.EmptyPolicyPayload(), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := *p
+			p.Threshold = tt.threshold
+			err := p.Verify(context.Background(), policy.BuildApproverSet(tt.approvers), tt.payload, tt.policyData)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateTxCountLimitPolicy(t *testing.T) {
+	participants := []*PolicyParticipant{{Abbreviation: "foo", Address: "qredoXXXXXXX"}}
+
+	require.NoError(t, (&TxCountLimitPolicy{MaxTransfers: 5, WindowBlocks: 600, Participants: participants}).Validate())
+	require.Error(t, (&TxCountLimitPolicy{MaxTransfers: 0, WindowBlocks: 600, Participants: participants}).Validate())
+	require.Error(t, (&TxCountLimitPolicy{MaxTransfers: 5, WindowBlocks: 0, Participants: participants}).Validate())
+	require.Error(t, (&TxCountLimitPolicy{MaxTransfers: 5, WindowBlocks: 600}).Validate())
+}
+
+func TestVerifyTxCountLimitPolicy(t *testing.T) {
+	p := &TxCountLimitPolicy{
+		MaxTransfers: 2,
+		WindowBlocks: 10,
+		Participants: []*PolicyParticipant{{Abbreviation: "foo", Address: "qredoXXXXXXX"}},
+	}
+	transfer := map[string][]byte{txCoinKey: []byte("ETH"), txValueKey: []byte("1")}
+
+	tests := []struct {
+		name        string
+		approvers   []string
+		policyData  map[string][]byte
+		height      uint64
+		windowStart uint64
+		count       uint64
+		wantErr     bool
+	}{
+		{name: "first transfer", approvers: []string{"foo"}, policyData: transfer, height: 5},
+		{name: "below the limit", approvers: []string{"foo"}, policyData: transfer, height: 105, windowStart: 100, count: 1},
+		{name: "limit reached", approvers: []string{"foo"}, policyData: transfer, height: 109, windowStart: 100, count: 2, wantErr: true},
+		{name: "window ended", approvers: []string{"foo"}, policyData: transfer, height: 110, windowStart: 100, count: 2},
+		{name: "not a transfer", approvers: []string{"foo"}, height: 101, windowStart: 100, count: 2},
+		{name: "no approvers", approvers: []string{}, policyData: transfer, height: 5, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			payload := policy.EmptyPolicyPayload().WithBlockHeight(tt.height).WithTransferWindow(tt.windowStart, tt.count)
+			err := p.Verify(context.Background(), policy.BuildApproverSet(tt.approvers), payload, tt.policyData)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+// internalAddressSet resolves the addresses in the set as internal.
+type internalAddressSet map[string]bool
+
+func (s internalAddressSet) IsInternalAddress(addr []byte) bool {
+	return s[string(addr)]
+}
+
+func TestVerifyInternalOnlyPolicy(t *testing.T) {
+	p := &InternalOnlyPolicy{
+		Participants: []*PolicyParticipant{{Abbreviation: "foo", Address: "qredoXXXXXXX"}},
+	}
+	require.NoError(t, p.Validate())
+	require.Error(t, (&InternalOnlyPolicy{}).Validate())
+
+	internal := hexutil.MustDecode("0xea223ca8968ca59e0bc79ba331c2f6f636a3fb82")
+	external := hexutil.MustDecode("0x48c04ed5691981c42154c6167398f95e8f38a7ff")
+	resolver := internalAddressSet{string(internal): true}
+	transferTo := func(to []byte) map[string][]byte {
+		return map[string][]byte{txCoinKey: []byte("ETH"), txValueKey: []byte("1"), txToKey: to}
+	}
+
+	tests := []struct {
+		name       string
+		approvers  []string
+		policyData map[string][]byte
+		resolver   policy.InternalAddressResolver
+		wantErr    bool
+	}{
+		{name: "internal destination", approvers: []string{"foo"}, policyData: transferTo(internal), resolver: resolver},
+		{name: "external destination", approvers: []string{"foo"}, policyData: transferTo(external), resolver: resolver, wantErr: true},
+		{name: "missing destination", approvers: []string{"foo"}, policyData: transferTo(nil), resolver: resolver, wantErr: true},
+		{name: "unknown internal addresses", approvers: []string{"foo"}, policyData: transferTo(internal), wantErr: true},
+		{name: "not a transfer", approvers: []string{"foo"}, resolver: resolver},
+		{name: "no approvers", approvers: []string{}, policyData: transferTo(internal), resolver: resolver, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			payload := policy.EmptyPolicyPayload().WithInternalAddresses(tt.resolver)
+			err := p.Verify(context.Background(), policy.BuildApproverSet(tt.approvers), payload, tt.policyData)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestTxCountLimitPolicy_CountTransfer(t *testing.T) {
+	p := &TxCountLimitPolicy{MaxTransfers: 2, WindowBlocks: 10}
+
+	start, count := p.CountTransfer(0, 0, 100)
+	require.Equal(t, uint64(100), start)
+	require.Equal(t, uint64(1), count)
+
+	start, count = p.CountTransfer(start, count, 109)
+	require.Equal(t, uint64(100), start)
+	require.Equal(t, uint64(2), count)
+
+	start, count = p.CountTransfer(start, count, 110)
+	require.Equal(t, uint64(110), start)
+	require.Equal(t, uint64(1), count)
+}
+
+func TestCanonicalBytes(t *testing.T) {
+	finance := &PolicyParticipant{Abbreviation: "finance", Address: "qredo1finance"}
+	ops := &PolicyParticipant{Abbreviation: "ops", Address: "qredo1ops"}
+
+	t.Run("participant and list order", func(t *testing.T) {
+		a := &MaxFeePolicy{
+			Participants: []*PolicyParticipant{finance, ops},
+			Ceilings:     []*FeeCeiling{{Symbol: "BTC", MaxFee: "1000"}, {Symbol: "ETH", MaxFee: "5000"}},
+		}
+		b := &MaxFeePolicy{
+			Ceilings:     []*FeeCeiling{{Symbol: "ETH", MaxFee: "5000"}, {Symbol: "BTC", MaxFee: "1000"}},
+			Participants: []*PolicyParticipant{ops, finance},
+		}
+		requireSameCanonicalForm(t, a, b)
+
+		// b is left untouched
+		require.Equal(t, "ops", b.Participants[0].Abbreviation)
+		require.Equal(t, "ETH", b.Ceilings[0].Symbol)
+	})
+
+	t.Run("empty and nil fields", func(t *testing.T) {
+		a := &BlackbirdPolicy{Data: []byte{0x08, 0x01}}
+		b := &BlackbirdPolicy{Data: []byte{0x08, 0x01}, Participants: []*PolicyParticipant{}}
+		requireSameCanonicalForm(t, a, b)
+	})
+
+	t.Run("non-canonical wire encoding", func(t *testing.T) {
+		a := &CooldownPolicy{CooldownBlocks: 10, Participants: []*PolicyParticipant{finance}}
+
+		// participants (field 2) before cooldown_blocks (field 1), with
+		// cooldown_blocks repeated: the last value wins
+		participant, err := finance.Marshal()
+		require.NoError(t, err)
+		wire := append([]byte{0x12, byte(len(participant))}, participant...)
+		wire = append(wire, 0x08, 0x05, 0x08, 0x0a)
+		var b CooldownPolicy
+		require.NoError(t, b.Unmarshal(wire))
+		requireSameCanonicalForm(t, a, &b)
+	})
+
+	t.Run("different policies", func(t *testing.T) {
+		a, err := PolicyHash(&CooldownPolicy{CooldownBlocks: 10, Participants: []*PolicyParticipant{finance}})
+		require.NoError(t, err)
+		b, err := PolicyHash(&CooldownPolicy{CooldownBlocks: 11, Participants: []*PolicyParticipant{finance}})
+		require.NoError(t, err)
+		require.NotEqual(t, a, b)
+
+		// same fields, different type
+		c, err := PolicyHash(&TxCountLimitPolicy{MaxTransfers: 10, Participants: []*PolicyParticipant{finance}})
+		require.NoError(t, err)
+		d, err := PolicyHash(&CooldownPolicy{CooldownBlocks: 10, Participants: []*PolicyParticipant{finance}})
+		require.NoError(t, err)
+		require.NotEqual(t, c, d)
+	})
+
+	t.Run("not a protobuf message", func(t *testing.T) {
+		_, err := CanonicalBytes(policy.NewAnyInGroupPolicy([]string{"finance"}))
+		require.Error(t, err)
+	})
+}
+
+func requireSameCanonicalForm(t *testing.T, a, b policy.Policy) {
+	t.Helper()
+	aBytes, err := CanonicalBytes(a)
+	require.NoError(t, err)
+	bBytes, err := CanonicalBytes(b)
+	require.NoError(t, err)
+	require.Equal(t, aBytes, bBytes)
+
+	aHash, err := PolicyHash(a)
+	require.NoError(t, err)
+	bHash, err := PolicyHash(b)
+	require.NoError(t, err)
+	require.Equal(t, aHash, bHash)
+	require.Len(t, aHash, 32)
 }
@@ -146,6 +146,8 @@ type MsgNewPolicy struct {
 	Creator string     `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
 	Name    string     `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	Policy  *types.Any `protobuf:"bytes,3,opt,name=policy,proto3" json:"policy,omitempty"`
+	// Optional ID of the policy that must approve updates to the new policy.
+	AdminPolicyId uint64 `protobuf:"varint,4,opt,name=admin_policy_id,json=adminPolicyId,proto3" json:"admin_policy_id,omitempty"`
 }
 
 func (m *MsgNewPolicy) Reset()         { *m = MsgNewPolicy{} }
@@ -202,6 +204,13 @@ func (m *MsgNewPolicy) GetPolicy() *types.Any {
 	return nil
 }
 
+func (m *MsgNewPolicy) GetAdminPolicyId() uint64 {
+	if m != nil {
+		return m.AdminPolicyId
+	}
+	return 0
+}
+
 type MsgNewPolicyResponse struct {
 	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
@@ -342,6 +351,111 @@ func (m *MsgRevokeActionResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRevokeActionResponse proto.InternalMessageInfo
 
+type MsgUpdatePolicy struct {
+	Creator  string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	PolicyId uint64 `protobuf:"varint,2,opt,name=policy_id,json=policyId,proto3" json:"policy_id,omitempty"`
+	// The new definition of the policy.
+	Policy *types.Any `protobuf:"bytes,3,opt,name=policy,proto3" json:"policy,omitempty"`
+	Btl    uint64     `protobuf:"varint,4,opt,name=btl,proto3" json:"btl,omitempty"`
+}
+
+func (m *MsgUpdatePolicy) Reset()         { *m = MsgUpdatePolicy{} }
+func (m *MsgUpdatePolicy) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdatePolicy) ProtoMessage()    {}
+func (*MsgUpdatePolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e86d56aba2b053b1, []int{6}
+}
+func (m *MsgUpdatePolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdatePolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdatePolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdatePolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdatePolicy.Merge(m, src)
+}
+func (m *MsgUpdatePolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdatePolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdatePolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdatePolicy proto.InternalMessageInfo
+
+func (m *MsgUpdatePolicy) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgUpdatePolicy) GetPolicyId() uint64 {
+	if m != nil {
+		return m.PolicyId
+	}
+	return 0
+}
+
+func (m *MsgUpdatePolicy) GetPolicy() *types.Any {
+	if m != nil {
+		return m.Policy
+	}
+	return nil
+}
+
+func (m *MsgUpdatePolicy) GetBtl() uint64 {
+	if m != nil {
+		return m.Btl
+	}
+	return 0
+}
+
+type MsgUpdatePolicyResponse struct {
+}
+
+func (m *MsgUpdatePolicyResponse) Reset()         { *m = MsgUpdatePolicyResponse{} }
+func (m *MsgUpdatePolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdatePolicyResponse) ProtoMessage()    {}
+func (*MsgUpdatePolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e86d56aba2b053b1, []int{7}
+}
+func (m *MsgUpdatePolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdatePolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdatePolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdatePolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdatePolicyResponse.Merge(m, src)
+}
+func (m *MsgUpdatePolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdatePolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdatePolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdatePolicyResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgApproveAction)(nil), "fusionchain.policy.MsgApproveAction")
 	proto.RegisterType((*MsgApproveActionResponse)(nil), "fusionchain.policy.MsgApproveActionResponse")
@@ -349,39 +463,46 @@ func init() {
 	proto.RegisterType((*MsgNewPolicyResponse)(nil), "fusionchain.policy.MsgNewPolicyResponse")
 	proto.RegisterType((*MsgRevokeAction)(nil), "fusionchain.policy.MsgRevokeAction")
 	proto.RegisterType((*MsgRevokeActionResponse)(nil), "fusionchain.policy.MsgRevokeActionResponse")
+	proto.RegisterType((*MsgUpdatePolicy)(nil), "fusionchain.policy.MsgUpdatePolicy")
+	proto.RegisterType((*MsgUpdatePolicyResponse)(nil), "fusionchain.policy.MsgUpdatePolicyResponse")
 }
 
 func init() { proto.RegisterFile("fusionchain/policy/tx.proto", fileDescriptor_e86d56aba2b053b1) }
 
 var fileDescriptor_e86d56aba2b053b1 = []byte{
-	// 431 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x93, 0x4f, 0x6f, 0xd3, 0x30,
-	0x14, 0xc0, 0xeb, 0xae, 0x2a, 0xf4, 0xed, 0x0f, 0xc8, 0x9a, 0x20, 0xcb, 0xa4, 0x10, 0x05, 0x84,
-	0x22, 0x6d, 0x72, 0xa4, 0x72, 0xe4, 0x54, 0x24, 0x0e, 0x1c, 0x82, 0xa6, 0x08, 0x09, 0x89, 0xcb,
-	0x70, 0x13, 0x2f, 0x33, 0x74, 0xb1, 0x89, 0x9d, 0xb1, 0x7c, 0x0b, 0xbe, 0x04, 0xe2, 0xab, 0x70,
-	0xdc, 0x91, 0x23, 0x6a, 0xbf, 0x08, 0x92, 0x9d, 0x84, 0xac, 0xa8, 0xb0, 0x0b, 0x37, 0xdb, 0xef,
-	0x67, 0xbf, 0xdf, 0x7b, 0xb6, 0xe1, 0xf0, 0xac, 0x52, 0x5c, 0x14, 0xe9, 0x39, 0xe5, 0x45, 0x24,
-	0xc5, 0x82, 0xa7, 0x75, 0xa4, 0xaf, 0x88, 0x2c, 0x85, 0x16, 0x18, 0xf7, 0x82, 0xc4, 0x06, 0xdd,
-	0x83, 0x5c, 0x88, 0x7c, 0xc1, 0x22, 0x43, 0xcc, 0xab, 0xb3, 0x88, 0x16, 0xb5, 0xc5, 0x83, 0x6f,
-	0x08, 0xee, 0xc7, 0x2a, 0x9f, 0x49, 0x59, 0x8a, 0x4b, 0x36, 0x4b, 0x35, 0x17, 0x05, 0x76, 0xe0,
-	0x4e, 0x5a, 0x32, 0xaa, 0x45, 0xe9, 0x20, 0x1f, 0x85, 0x93, 0xa4, 0x9d, 0xe2, 0x47, 0xb0, 0x4d,
-	0x0d, 0x73, 0xaa, 0x6b, 0xc9, 0x9c, 0xa1, 0x89, 0x82, 0x5d, 0x7a, 0x53, 0x4b, 0x86, 0x0f, 0x61,
-	0xd2, 0x00, 0x3c, 0x73, 0xb6, 0x7c, 0x14, 0x8e, 0x92, 0xbb, 0x76, 0xe1, 0x55, 0x86, 0x9f, 0xc3,
-	0x9e, 0x35, 0x3a, 0x95, 0xb4, 0x5e, 0x08, 0x9a, 0x39, 0x23, 0x1f, 0x85, 0xdb, 0xd3, 0x7d, 0x62,
-	0x05, 0x49, 0x2b, 0x48, 0x66, 0x45, 0x9d, 0xec, 0x5a, 0xf6, 0xc4, 0xa2, 0xc1, 0x14, 0x9c, 0x75,
-	0xd1, 0x84, 0x29, 0x29, 0x0a, 0xc5, 0xf0, 0x03, 0x18, 0x2b, 0x4d, 0x75, 0xa5, 0x1a, 0xdf, 0x66,
-	0x16, 0x7c, 0x80, 0x9d, 0x58, 0xe5, 0xaf, 0xd9, 0xe7, 0x13, 0x73, 0xd4, 0x5f, 0x0a, 0xc3, 0x30,
-	0x2a, 0xe8, 0x45, 0x5b, 0x91, 0x19, 0xe3, 0x63, 0x18, 0x5b, 0x05, 0x53, 0xc8, 0x26, 0xcd, 0x86,
-	0x09, 0x9e, 0xc2, 0x7e, 0x3f, 0x57, 0xe7, 0xb6, 0x07, 0x43, 0x9e, 0x99, 0x74, 0xa3, 0x64, 0xc8,
-	0xb3, 0x80, 0xc3, 0xbd, 0x58, 0xe5, 0x09, 0xbb, 0x14, 0x1f, 0xff, 0x73, 0xbf, 0x83, 0x03, 0x78,
-	0xb8, 0x96, 0xaa, 0xb5, 0x9a, 0x7e, 0x1d, 0xc2, 0x56, 0xac, 0x72, 0x9c, 0xc2, 0xee, 0xcd, 0xbb,
-	0x7f, 0x42, 0xfe, 0x7c, 0x40, 0x64, 0xbd, 0xf1, 0xee, 0xf1, 0x6d, 0xa8, 0xae, 0x05, 0x6f, 0x61,
-	0xf2, 0xfb, 0x0e, 0xfc, 0x0d, 0x5b, 0x3b, 0xc2, 0x0d, 0xff, 0x45, 0x74, 0x07, 0xbf, 0x87, 0x9d,
-	0x1b, 0x8d, 0x7c, 0xbc, 0x61, 0x67, 0x1f, 0x72, 0x8f, 0x6e, 0x01, 0xb5, 0x19, 0x5e, 0xbc, 0xfc,
-	0xbe, 0xf4, 0xd0, 0xf5, 0xd2, 0x43, 0x3f, 0x97, 0x1e, 0xfa, 0xb2, 0xf2, 0x06, 0xd7, 0x2b, 0x6f,
-	0xf0, 0x63, 0xe5, 0x0d, 0xde, 0x1d, 0xe5, 0x5c, 0x9f, 0x57, 0x73, 0x92, 0x8a, 0x8b, 0xe8, 0x53,
-	0xc9, 0x32, 0x11, 0xf5, 0xbf, 0xe5, 0x55, 0xf7, 0x31, 0x6b, 0xc9, 0xd4, 0x7c, 0x6c, 0x9e, 0xcc,
-	0xb3, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x45, 0xba, 0x27, 0x82, 0xbb, 0x03, 0x00, 0x00,
+	// 502 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0x41, 0x6f, 0xd3, 0x30,
+	0x14, 0x80, 0xeb, 0x36, 0x2a, 0xf4, 0x6d, 0xdd, 0x26, 0x6b, 0x82, 0x2c, 0x95, 0x42, 0x15, 0xd0,
+	0x54, 0xa9, 0x53, 0x22, 0x95, 0x23, 0xa7, 0x22, 0x71, 0xd8, 0xa1, 0x68, 0x8a, 0x40, 0x48, 0x5c,
+	0x8a, 0x9b, 0x78, 0x99, 0x45, 0x1b, 0x9b, 0xd8, 0x1d, 0xcb, 0x2f, 0x80, 0x23, 0xe2, 0x4f, 0xf0,
+	0x57, 0x38, 0xee, 0xc8, 0x11, 0xb5, 0x7f, 0x04, 0x11, 0x27, 0x59, 0x5a, 0xd4, 0xad, 0x1c, 0x76,
+	0x8b, 0xed, 0xcf, 0x7e, 0xdf, 0xf3, 0x7b, 0x31, 0x74, 0xce, 0xe7, 0x92, 0xf1, 0x38, 0xb8, 0x20,
+	0x2c, 0xf6, 0x04, 0x9f, 0xb2, 0x20, 0xf5, 0xd4, 0x95, 0x2b, 0x12, 0xae, 0x38, 0xc6, 0x95, 0x45,
+	0x57, 0x2f, 0x5a, 0x47, 0x11, 0xe7, 0xd1, 0x94, 0x7a, 0x19, 0x31, 0x99, 0x9f, 0x7b, 0x24, 0x4e,
+	0x35, 0xee, 0xfc, 0x40, 0x70, 0x30, 0x92, 0xd1, 0x50, 0x88, 0x84, 0x5f, 0xd2, 0x61, 0xa0, 0x18,
+	0x8f, 0xb1, 0x09, 0x0f, 0x82, 0x84, 0x12, 0xc5, 0x13, 0x13, 0x75, 0x51, 0xaf, 0xe5, 0x17, 0x43,
+	0xfc, 0x04, 0x76, 0x48, 0xc6, 0x8c, 0x55, 0x2a, 0xa8, 0x59, 0xcf, 0x56, 0x41, 0x4f, 0xbd, 0x49,
+	0x05, 0xc5, 0x1d, 0x68, 0xe5, 0x00, 0x0b, 0xcd, 0x46, 0x17, 0xf5, 0x0c, 0xff, 0xa1, 0x9e, 0x38,
+	0x0d, 0xf1, 0x0b, 0xd8, 0xd3, 0x46, 0x63, 0x41, 0xd2, 0x29, 0x27, 0xa1, 0x69, 0x74, 0x51, 0x6f,
+	0x67, 0x70, 0xe8, 0x6a, 0x41, 0xb7, 0x10, 0x74, 0x87, 0x71, 0xea, 0xb7, 0x35, 0x7b, 0xa6, 0x51,
+	0x67, 0x00, 0xe6, 0xba, 0xa8, 0x4f, 0xa5, 0xe0, 0xb1, 0xa4, 0xf8, 0x11, 0x34, 0xa5, 0x22, 0x6a,
+	0x2e, 0x73, 0xdf, 0x7c, 0xe4, 0x7c, 0x47, 0xb0, 0x3b, 0x92, 0xd1, 0x6b, 0xfa, 0xf9, 0x2c, 0x3b,
+	0xeb, 0x96, 0xcc, 0x30, 0x18, 0x31, 0x99, 0x15, 0x29, 0x65, 0xdf, 0xf8, 0x04, 0x9a, 0xda, 0xc1,
+	0x6c, 0xdc, 0xe2, 0x99, 0x33, 0xf8, 0x18, 0xf6, 0x49, 0x38, 0x63, 0xf1, 0x38, 0xcf, 0x91, 0xe9,
+	0xf4, 0x0c, 0xbf, 0x9d, 0x4d, 0x6b, 0x83, 0xd3, 0xd0, 0x39, 0x86, 0xc3, 0xaa, 0x53, 0x99, 0xc4,
+	0x1e, 0xd4, 0x59, 0x98, 0x69, 0x19, 0x7e, 0x9d, 0x85, 0x0e, 0x83, 0xfd, 0x91, 0x8c, 0x7c, 0x7a,
+	0xc9, 0x3f, 0xde, 0x73, 0x61, 0x9c, 0x23, 0x78, 0xbc, 0x16, 0xaa, 0xb0, 0x72, 0xbe, 0xa2, 0x4c,
+	0xe3, 0xad, 0x08, 0x89, 0xa2, 0x77, 0xde, 0x62, 0x07, 0x5a, 0x37, 0xd9, 0xd7, 0x75, 0x14, 0x91,
+	0x27, 0xfe, 0x9f, 0xd7, 0x79, 0x00, 0x8d, 0x89, 0x9a, 0xe6, 0x57, 0xf8, 0xf7, 0x33, 0xb7, 0xac,
+	0x9a, 0x14, 0x96, 0x83, 0x2f, 0x0d, 0x68, 0x8c, 0x64, 0x84, 0x03, 0x68, 0xaf, 0xb6, 0xf2, 0x33,
+	0xf7, 0xdf, 0xff, 0xc1, 0x5d, 0xef, 0x23, 0xeb, 0x64, 0x1b, 0xaa, 0x2c, 0xd4, 0x3b, 0x68, 0xdd,
+	0x74, 0x54, 0x77, 0xc3, 0xd6, 0x92, 0xb0, 0x7a, 0x77, 0x11, 0xe5, 0xc1, 0x1f, 0x60, 0x77, 0xa5,
+	0xdc, 0x4f, 0x37, 0xec, 0xac, 0x42, 0x56, 0x7f, 0x0b, 0xa8, 0x1a, 0x61, 0xa5, 0x92, 0x9b, 0x22,
+	0x54, 0x21, 0xab, 0xbf, 0x05, 0x54, 0x44, 0x78, 0xf9, 0xea, 0xe7, 0xc2, 0x46, 0xd7, 0x0b, 0x1b,
+	0xfd, 0x5e, 0xd8, 0xe8, 0xdb, 0xd2, 0xae, 0x5d, 0x2f, 0xed, 0xda, 0xaf, 0xa5, 0x5d, 0x7b, 0xdf,
+	0x8f, 0x98, 0xba, 0x98, 0x4f, 0xdc, 0x80, 0xcf, 0xbc, 0x4f, 0x09, 0x0d, 0xb9, 0x57, 0x7d, 0xc7,
+	0xae, 0xca, 0x97, 0x2c, 0x15, 0x54, 0x4e, 0x9a, 0x59, 0x4f, 0x3c, 0xff, 0x33, 0x00, 0xa1, 0x43,
+	0xe4, 0xc8, 0xec, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -402,6 +523,9 @@ type MsgClient interface {
 	NewPolicy(ctx context.Context, in *MsgNewPolicy, opts ...grpc.CallOption) (*MsgNewPolicyResponse, error)
 	// Revoke an existing Action while in pending state.
 	RevokeAction(ctx context.Context, in *MsgRevokeAction, opts ...grpc.CallOption) (*MsgRevokeActionResponse, error)
+	// Replace the definition of an existing policy. The update must be
+	// approved by the admin policy of the policy, or by the policy itself.
+	UpdatePolicy(ctx context.Context, in *MsgUpdatePolicy, opts ...grpc.CallOption) (*MsgUpdatePolicyResponse, error)
 }
 
 type msgClient struct {
@@ -439,6 +563,15 @@ func (c *msgClient) RevokeAction(ctx context.Context, in *MsgRevokeAction, opts
 	return out, nil
 }
 
+func (c *msgClient) UpdatePolicy(ctx context.Context, in *MsgUpdatePolicy, opts ...grpc.CallOption) (*MsgUpdatePolicyResponse, error) {
+	out := new(MsgUpdatePolicyResponse)
+	err := c.cc.Invoke(ctx, "/fusionchain.policy.Msg/UpdatePolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// Add an approval to an existing Action.
@@ -447,6 +580,9 @@ type MsgServer interface {
 	NewPolicy(context.Context, *MsgNewPolicy) (*MsgNewPolicyResponse, error)
 	// Revoke an existing Action while in pending state.
 	RevokeAction(context.Context, *MsgRevokeAction) (*MsgRevokeActionResponse, error)
+	// Replace the definition of an existing policy. The update must be
+	// approved by the admin policy of the policy, or by the policy itself.
+	UpdatePolicy(context.Context, *MsgUpdatePolicy) (*MsgUpdatePolicyResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -462,6 +598,9 @@ func (*UnimplementedMsgServer) NewPolicy(ctx context.Context, req *MsgNewPolicy)
 func (*UnimplementedMsgServer) RevokeAction(ctx context.Context, req *MsgRevokeAction) (*MsgRevokeActionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RevokeAction not implemented")
 }
+func (*UnimplementedMsgServer) UpdatePolicy(ctx context.Context, req *MsgUpdatePolicy) (*MsgUpdatePolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePolicy not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -521,6 +660,24 @@ func _Msg_RevokeAction_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdatePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdatePolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdatePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fusionchain.policy.Msg/UpdatePolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdatePolicy(ctx, req.(*MsgUpdatePolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "fusionchain.policy.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -537,6 +694,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RevokeAction",
 			Handler:    _Msg_RevokeAction_Handler,
 		},
+		{
+			MethodName: "UpdatePolicy",
+			Handler:    _Msg_UpdatePolicy_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "fusionchain/policy/tx.proto",
@@ -646,6 +807,11 @@ func (m *MsgNewPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AdminPolicyId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AdminPolicyId))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.Policy != nil {
 		{
 			size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
@@ -768,6 +934,81 @@ func (m *MsgRevokeActionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUpdatePolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdatePolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdatePolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Btl != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Btl))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Policy != nil {
+		{
+			size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PolicyId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PolicyId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdatePolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdatePolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdatePolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -834,6 +1075,9 @@ func (m *MsgNewPolicy) Size() (n int) {
 		l = m.Policy.Size()
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.AdminPolicyId != 0 {
+		n += 1 + sovTx(uint64(m.AdminPolicyId))
+	}
 	return n
 }
 
@@ -878,6 +1122,38 @@ func (m *MsgRevokeActionResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdatePolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PolicyId != 0 {
+		n += 1 + sovTx(uint64(m.PolicyId))
+	}
+	if m.Policy != nil {
+		l = m.Policy.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Btl != 0 {
+		n += 1 + sovTx(uint64(m.Btl))
+	}
+	return n
+}
+
+func (m *MsgUpdatePolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1264,6 +1540,25 @@ func (m *MsgNewPolicy) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminPolicyId", wireType)
+			}
+			m.AdminPolicyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AdminPolicyId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1537,6 +1832,212 @@ func (m *MsgRevokeActionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdatePolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdatePolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdatePolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyId", wireType)
+			}
+			m.PolicyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PolicyId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Policy == nil {
+				m.Policy = &types.Any{}
+			}
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Btl", wireType)
+			}
+			m.Btl = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Btl |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdatePolicyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdatePolicyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdatePolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
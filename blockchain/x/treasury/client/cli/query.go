@@ -29,11 +29,14 @@ func GetQueryCmd(_ string) *cobra.Command {
 	cmd.AddCommand(CmdQueryParams())
 	cmd.AddCommand(CmdKeyRequests())
 	cmd.AddCommand(CmdKeys())
+	cmd.AddCommand(CmdKeyAddresses())
 	cmd.AddCommand(CmdSignatureRequests())
 	cmd.AddCommand(CmdKeyRequestById())
 	cmd.AddCommand(CmdSignatureRequestById())
 	cmd.AddCommand(CmdSignTransactionRequests())
 	cmd.AddCommand(CmdSignTransactionRequestById())
+	cmd.AddCommand(CmdEvaluateWalletPolicies())
+	cmd.AddCommand(CmdSigningRequest())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
@@ -0,0 +1,88 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package cli
+
+import (
+	"encoding/hex"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/qredo/fusionchain/x/treasury/types"
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagApprovers = "approvers"
+	flagPolicyIDs = "policy-ids"
+)
+
+func CmdEvaluateWalletPolicies() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "evaluate-wallet-policies [key-id] [unsigned-tx] [ethereum-chain-id]",
+		Short: "Query whether an Ethereum transaction would pass the policies of a wallet",
+		Long: `Evaluates the sign policy of the workspace of the key, and the policies
+passed with --policy-ids, against the hex-encoded unsigned transaction
+approved by the addresses passed with --approvers. The result of each
+policy is reported, even after a failure.`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			keyID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			unsignedTx, err := hex.DecodeString(args[1])
+			if err != nil {
+				return err
+			}
+			chainID, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+			metadata, err := cdctypes.NewAnyWithValue(&types.MetadataEthereum{ChainId: chainID})
+			if err != nil {
+				return err
+			}
+			approvers, err := cmd.Flags().GetStringSlice(flagApprovers)
+			if err != nil {
+				return err
+			}
+			policyIDs, err := cmd.Flags().GetUintSlice(flagPolicyIDs)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryEvaluateWalletPoliciesRequest{
+				KeyId:               keyID,
+				WalletType:          types.WalletType_WALLET_TYPE_ETH,
+				UnsignedTransaction: unsignedTx,
+				Metadata:            metadata,
+				Approvers:           approvers,
+			}
+			for _, id := range policyIDs {
+				params.PolicyIds = append(params.PolicyIds, uint64(id))
+			}
+
+			res, err := queryClient.EvaluateWalletPolicies(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().StringSlice(flagApprovers, nil, "Comma-separated addresses of the approvers")
+	cmd.Flags().UintSlice(flagPolicyIDs, nil, "Comma-separated IDs of additional policies to evaluate")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,68 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package cli
+
+import (
+	"encoding/hex"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/qredo/fusionchain/x/treasury/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdSigningRequest() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "signing-request [key-id] [unsigned-tx] [ethereum-chain-id]",
+		Short: "Query the data to sign for an Ethereum transaction of a wallet",
+		Long: `Parses the hex-encoded unsigned transaction with the wallet of the key and
+returns the data to sign, the key and its type, and the address of the
+wallet to verify the signature against.`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			keyID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			unsignedTx, err := hex.DecodeString(args[1])
+			if err != nil {
+				return err
+			}
+			chainID, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+			metadata, err := cdctypes.NewAnyWithValue(&types.MetadataEthereum{ChainId: chainID})
+			if err != nil {
+				return err
+			}
+
+			params := &types.QuerySigningRequestRequest{
+				KeyId:               keyID,
+				WalletType:          types.WalletType_WALLET_TYPE_ETH,
+				UnsignedTransaction: unsignedTx,
+				Metadata:            metadata,
+			}
+
+			res, err := queryClient.SigningRequest(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
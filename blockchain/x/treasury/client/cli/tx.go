@@ -31,6 +31,9 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdNewSignatureRequest())
 	cmd.AddCommand(CmdFulfilSignatureRequest())
 	cmd.AddCommand(CmdNewSignTransactionRequest())
+	cmd.AddCommand(CmdRotateWalletKey())
+	cmd.AddCommand(CmdResetWalletNonce())
+	cmd.AddCommand(CmdImportWalletPolicies())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
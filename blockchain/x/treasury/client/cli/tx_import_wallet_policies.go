@@ -0,0 +1,64 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package cli
+
+import (
+	"encoding/hex"
+	"os"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/qredo/fusionchain/x/treasury/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdImportWalletPolicies() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import-wallet-policies [workspace-addr] [bundle-file] [signer-public-key] [btl]",
+		Short: "Broadcast message ImportWalletPolicies",
+		Long: `Imports the policy bundle file, signed by the hex-encoded compressed
+secp256k1 public key, as the admin and sign policies of the workspace. This
+replaces the policies of all the wallets of the keys of the workspace, once
+approved by its admin policy.`,
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bundle, err := os.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+
+			signer, err := hex.DecodeString(args[2])
+			if err != nil {
+				return err
+			}
+
+			btl, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgImportWalletPolicies(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				bundle,
+				signer,
+				btl,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
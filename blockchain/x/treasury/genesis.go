@@ -27,12 +27,22 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		k.SetSignRequest(ctx, &genState.SignRequests[i])
 	}
 	k.SetSignRequestCount(ctx, uint64(len(genState.SignRequests)))
+
+	for i := range genState.SupportedChains {
+		k.SetSupportedChain(ctx, &genState.SupportedChains[i])
+	}
+
+	for i := range genState.AddressLabels {
+		k.SetAddressLabel(ctx, &genState.AddressLabels[i])
+	}
 }
 
 // ExportGenesis returns the module's exported genesis
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis := types.DefaultGenesis()
 	genesis.Params = k.GetParams(ctx)
+	genesis.SupportedChains = k.GetAllSupportedChains(ctx)
+	genesis.AddressLabels = k.GetAllAddressLabels(ctx)
 
 	// this line is used by starport scaffolding # genesis/module/export
 
@@ -14,7 +14,11 @@ import (
 
 func TestGenesis(t *testing.T) {
 	genesisState := types.GenesisState{
-		Params: types.DefaultParams(),
+		Params:          types.DefaultParams(),
+		SupportedChains: types.DefaultSupportedChains(),
+		AddressLabels: []types.AddressLabel{
+			{Address: []byte{0x71, 0x66, 0x0c, 0x40}, Label: "Coinbase hot wallet"},
+		},
 
 		// this line is used by starport scaffolding # genesis/test/state
 	}
@@ -29,5 +33,8 @@ func TestGenesis(t *testing.T) {
 	nullify.Fill(&genesisState)
 	nullify.Fill(got)
 
+	require.ElementsMatch(t, genesisState.SupportedChains, got.SupportedChains)
+	require.ElementsMatch(t, genesisState.AddressLabels, got.AddressLabels)
+
 	// this line is used by starport scaffolding # genesis/test/assert
 }
@@ -0,0 +1,64 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/qredo/fusionchain/x/treasury/types"
+)
+
+func (k Keeper) SetAddressLabel(ctx sdk.Context, label *types.AddressLabel) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AddressLabelKey))
+	store.Set(label.Address, k.cdc.MustMarshal(label))
+}
+
+func (k Keeper) GetAddressLabel(ctx sdk.Context, addr []byte) (*types.AddressLabel, bool) {
+	if len(addr) == 0 {
+		return nil, false
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AddressLabelKey))
+	b := store.Get(addr)
+	if b == nil {
+		return nil, false
+	}
+
+	var label types.AddressLabel
+	k.cdc.MustUnmarshal(b, &label)
+
+	return &label, true
+}
+
+// GetAllAddressLabels returns the address labels ordered by address.
+func (k Keeper) GetAllAddressLabels(ctx sdk.Context) []types.AddressLabel {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AddressLabelKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	var labels []types.AddressLabel
+	for ; iterator.Valid(); iterator.Next() {
+		var label types.AddressLabel
+		k.cdc.MustUnmarshal(iterator.Value(), &label)
+		labels = append(labels, label)
+	}
+	return labels
+}
+
+// AddressLabels returns the resolver of the address labels stored in ctx.
+func (k Keeper) AddressLabels(ctx sdk.Context) types.AddressLabelResolver {
+	return addressLabelStore{k: k, ctx: ctx}
+}
+
+type addressLabelStore struct {
+	k   Keeper
+	ctx sdk.Context
+}
+
+func (s addressLabelStore) AddressLabel(addr []byte) (string, bool) {
+	label, found := s.k.GetAddressLabel(s.ctx, addr)
+	if !found {
+		return "", false
+	}
+	return label.Label, true
+}
@@ -0,0 +1,40 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/qredo/fusionchain/testutil/keeper"
+	"github.com/qredo/fusionchain/x/treasury/types"
+)
+
+func TestAddressLabels(t *testing.T) {
+	keepers := keepertest.NewTest(t)
+	tk := keepers.TreasuryKeeper
+	ctx := keepers.Ctx
+
+	exchange := common.HexToAddress("0x71660c4005BA85c37ccec55d0C4493E66Fe775d3")
+	treasury := common.HexToAddress("0x48c04ed5691981C42154C6167398f95e8f38a7fF")
+	unknown := common.HexToAddress("0xea223ca8968ca59e0bc79ba331c2f6f636a3fb82")
+	tk.SetAddressLabel(ctx, &types.AddressLabel{Address: treasury.Bytes(), Label: "internal treasury"})
+	tk.SetAddressLabel(ctx, &types.AddressLabel{Address: exchange.Bytes(), Label: "Coinbase hot wallet"})
+
+	labels := tk.GetAllAddressLabels(ctx)
+	require.Len(t, labels, 2)
+
+	got, found := tk.GetAddressLabel(ctx, exchange.Bytes())
+	require.True(t, found)
+	require.Equal(t, "Coinbase hot wallet", got.Label)
+
+	resolver := tk.AddressLabels(ctx)
+	labelled := types.Transfer{To: treasury.Bytes(), Amount: big.NewInt(1)}.WithAddressLabels(resolver)
+	require.Equal(t, "internal treasury", labelled.Metadata[types.MetadataToLabel])
+
+	unlabelled := types.Transfer{To: unknown.Bytes(), Amount: big.NewInt(1)}.WithAddressLabels(resolver)
+	require.Nil(t, unlabelled.Metadata)
+}
@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/qredo/fusionchain/x/treasury/types"
+)
+
+func (k Keeper) SetSupportedChain(ctx sdk.Context, chain *types.SupportedChain) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SupportedChainKey))
+	newValue := k.cdc.MustMarshal(chain)
+	store.Set(sdk.Uint64ToBigEndian(chain.ChainId), newValue)
+}
+
+func (k Keeper) GetSupportedChain(ctx sdk.Context, chainID uint64) (*types.SupportedChain, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SupportedChainKey))
+	b := store.Get(sdk.Uint64ToBigEndian(chainID))
+	if b == nil {
+		return nil, false
+	}
+
+	var chain types.SupportedChain
+	k.cdc.MustUnmarshal(b, &chain)
+
+	return &chain, true
+}
+
+// GetAllSupportedChains returns the supported chains ordered by chain ID.
+func (k Keeper) GetAllSupportedChains(ctx sdk.Context) []types.SupportedChain {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SupportedChainKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	var chains []types.SupportedChain
+	for ; iterator.Valid(); iterator.Next() {
+		var chain types.SupportedChain
+		k.cdc.MustUnmarshal(iterator.Value(), &chain)
+		chains = append(chains, chain)
+	}
+	return chains
+}
+
+// EthereumWallet returns the Ethereum wallet of key bound to the supported
+// chain with the given ID. Coin identifiers of the transactions it parses
+// use the native symbol of the chain.
+func (k Keeper) EthereumWallet(ctx sdk.Context, key *types.Key, chainID uint64) (*types.EthereumWallet, error) {
+	chain, found := k.GetSupportedChain(ctx, chainID)
+	if !found {
+		return nil, fmt.Errorf("%w: %d", types.ErrUnsupportedChain, chainID)
+	}
+	return types.NewEthereumWalletForChain(key, chain.EVMChain())
+}
+
+// newWallet returns the wallet of the given type for key. Ethereum wallets
+// are bound to the chain of the metadata, which must be supported.
+func (k Keeper) newWallet(ctx sdk.Context, key *types.Key, walletType types.WalletType, meta types.Metadata) (types.Wallet, error) {
+	if walletType != types.WalletType_WALLET_TYPE_ETH {
+		return types.NewWallet(key, walletType)
+	}
+	ethMeta, ok := meta.(*types.MetadataEthereum)
+	if !ok || ethMeta == nil {
+		return nil, fmt.Errorf("invalid metadata field, expected *MetadataEthereum, got %T", meta)
+	}
+	return k.EthereumWallet(ctx, key, ethMeta.ChainId)
+}
@@ -0,0 +1,58 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/qredo/fusionchain/testutil/keeper"
+	"github.com/qredo/fusionchain/x/treasury/types"
+)
+
+func TestSupportedChains(t *testing.T) {
+	keepers := keepertest.NewTest(t)
+	tk := keepers.TreasuryKeeper
+	ctx := keepers.Ctx
+
+	for _, chain := range types.DefaultSupportedChains() {
+		chain := chain
+		tk.SetSupportedChain(ctx, &chain)
+	}
+
+	chains := tk.GetAllSupportedChains(ctx)
+	require.Len(t, chains, len(types.EVMChains))
+	for i := 1; i < len(chains); i++ {
+		require.Less(t, chains[i-1].ChainId, chains[i].ChainId)
+	}
+
+	got, found := tk.GetSupportedChain(ctx, types.EVMChainPolygon.ID)
+	require.True(t, found)
+	require.Equal(t, "MATIC", got.NativeSymbol)
+
+	_, found = tk.GetSupportedChain(ctx, 11155111)
+	require.False(t, found)
+}
+
+func TestEthereumWallet(t *testing.T) {
+	keepers := keepertest.NewTest(t)
+	tk := keepers.TreasuryKeeper
+	ctx := keepers.Ctx
+
+	polygon := types.EVMChainPolygon.SupportedChain()
+	tk.SetSupportedChain(ctx, &polygon)
+
+	privKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	key := &types.Key{
+		Type:      types.KeyType_KEY_TYPE_ECDSA_SECP256K1,
+		PublicKey: crypto.CompressPubkey(&privKey.PublicKey),
+	}
+
+	wallet, err := tk.EthereumWallet(ctx, key, types.EVMChainPolygon.ID)
+	require.NoError(t, err)
+	require.Equal(t, crypto.PubkeyToAddress(privKey.PublicKey).Hex(), wallet.Address())
+
+	_, err = tk.EthereumWallet(ctx, key, types.EVMChainEthereum.ID)
+	require.ErrorIs(t, err, types.ErrUnsupportedChain)
+}
@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/qredo/fusionchain/x/treasury/types"
+)
+
+// AddPreSignHooks registers hooks to be run, in registration order, before
+// the signature of a transaction is requested.
+//
+// Hooks must be added before NewMsgServerImpl is called, as the message
+// server holds a copy of the keeper.
+func (k *Keeper) AddPreSignHooks(hooks ...types.PreSignHook) {
+	k.preSignHooks = append(k.preSignHooks, hooks...)
+}
+
+// runPreSignHooks runs the registered hooks in order, stopping at the first
+// error.
+func (k Keeper) runPreSignHooks(ctx sdk.Context, w types.Wallet, transfer types.Transfer) error {
+	for i, hook := range k.preSignHooks {
+		if err := hook(ctx, w, transfer); err != nil {
+			return fmt.Errorf("pre-sign hook %d: %w", i, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+package keeper_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+	keepertest "github.com/qredo/fusionchain/testutil/keeper"
+	"github.com/qredo/fusionchain/x/identity"
+	idTypes "github.com/qredo/fusionchain/x/identity/types"
+	"github.com/qredo/fusionchain/x/treasury"
+	"github.com/qredo/fusionchain/x/treasury/keeper"
+	"github.com/qredo/fusionchain/x/treasury/types"
+	"github.com/stretchr/testify/require"
+)
+
+func unsignedEthTransfer(t *testing.T, value *big.Int) []byte {
+	t.Helper()
+	to := common.HexToAddress("0x48c04ed5691981C42154C6167398f95e8f38a7fF")
+	b, err := rlp.EncodeToBytes(&types.DynamicFeeTxWithoutSignature{
+		ChainID:   big.NewInt(1),
+		GasTipCap: big.NewInt(1_000_000_000),
+		GasFeeCap: big.NewInt(30_000_000_000),
+		Gas:       21_000,
+		To:        &to,
+		Value:     value,
+	})
+	require.NoError(t, err)
+	return append([]byte{ethtypes.DynamicFeeTxType}, b...)
+}
+
+func Test_Keeper_PreSignHooks(t *testing.T) {
+	oneEther := big.NewInt(1_000_000_000_000_000_000)
+	metadata, err := cdctypes.NewAnyWithValue(&types.MetadataEthereum{ChainId: 1})
+	require.NoError(t, err)
+
+	key := defaultECDSAKey
+	key.WorkspaceAddr = defaultWs.Address
+	key.KeyringAddr = defaultKr.Address
+
+	tests := []struct {
+		name      string
+		value     *big.Int
+		wantCalls []string
+		wantErr   bool
+	}{
+		{
+			name:      "PASS: all hooks run in order",
+			value:     big.NewInt(1000),
+			wantCalls: []string{"first", "threshold", "last"},
+		},
+		{
+			name:      "FAIL: rejected by a hook, later hooks not run",
+			value:     new(big.Int).Add(oneEther, big.NewInt(1)),
+			wantCalls: []string{"first", "threshold"},
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			keepers := keepertest.NewTest(t)
+			ik := keepers.IdentityKeeper
+			tk := keepers.TreasuryKeeper
+			ctx := keepers.Ctx
+			goCtx := sdk.WrapSDKContext(ctx)
+
+			identity.InitGenesis(ctx, *ik, idTypes.GenesisState{
+				Keyrings:   []idTypes.Keyring{defaultKr},
+				Workspaces: []idTypes.Workspace{defaultWs},
+			})
+			treasury.InitGenesis(ctx, *tk, types.GenesisState{
+				Keys:            []types.Key{key},
+				SupportedChains: types.DefaultSupportedChains(),
+			})
+
+			var calls []string
+			tk.AddPreSignHooks(
+				func(sdk.Context, types.Wallet, types.Transfer) error {
+					calls = append(calls, "first")
+					return nil
+				},
+				func(_ sdk.Context, _ types.Wallet, transfer types.Transfer) error {
+					calls = append(calls, "threshold")
+					if transfer.Amount.Cmp(oneEther) > 0 {
+						return errors.New("transfer over threshold")
+					}
+					return nil
+				},
+				func(sdk.Context, types.Wallet, types.Transfer) error {
+					calls = append(calls, "last")
+					return nil
+				},
+			)
+
+			msgSer := keeper.NewMsgServerImpl(*tk)
+			msg := types.NewMsgNewSignTransactionRequest("testOwner", key.Id, types.WalletType_WALLET_TYPE_ETH, unsignedEthTransfer(t, tt.value), 100, metadata)
+			got, err := msgSer.NewSignTransactionRequest(goCtx, msg)
+			require.Equal(t, tt.wantCalls, calls)
+			if tt.wantErr {
+				require.ErrorContains(t, err, "transfer over threshold")
+				require.Zero(t, tk.SignatureRequestsRepo().GetCount(ctx))
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, uint64(1), got.SignatureRequestId)
+		})
+	}
+}
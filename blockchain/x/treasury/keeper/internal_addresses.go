@@ -0,0 +1,79 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/qredo/fusionchain/policy"
+	"github.com/qredo/fusionchain/x/treasury/types"
+)
+
+// workspaceDataKey is the key of the policy data holding the address of the
+// workspace of the wallet of a transfer, see transferPolicyData.
+const workspaceDataKey = "TXWORKSPACE"
+
+// InternalAddresses returns the resolver of the addresses of the wallets of
+// the keys stored in ctx, for every wallet type, see
+// types.WalletTransferAddresses. Only the wallets of the workspace of the
+// action with the given policy data are internal, and none if it isn't a
+// transfer of one of our wallets.
+func (k Keeper) InternalAddresses(ctx sdk.Context, policyData map[string][]byte) policy.InternalAddressResolver {
+	return internalAddressStore{k: k, ctx: ctx, workspaceAddr: string(policyData[workspaceDataKey])}
+}
+
+type internalAddressStore struct {
+	k             Keeper
+	ctx           sdk.Context
+	workspaceAddr string
+}
+
+func (s internalAddressStore) IsInternalAddress(addr []byte) bool {
+	if s.workspaceAddr == "" {
+		return false
+	}
+	store := prefix.NewStore(s.k.internalAddressStore(s.ctx), internalAddressPrefix(s.workspaceAddr, addr))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+	return iterator.Valid()
+}
+
+// indexKeyAddresses adds the addresses of the wallets of key to the index of
+// the internal addresses of its workspace.
+func (k Keeper) indexKeyAddresses(ctx sdk.Context, key *types.Key) {
+	store := k.internalAddressStore(ctx)
+	for _, addr := range types.WalletTransferAddresses(key) {
+		store.Set(internalAddressKey(key, addr), sdk.Uint64ToBigEndian(key.Id))
+	}
+}
+
+// unindexKeyAddresses removes the addresses of the wallets of key from the
+// index of the internal addresses of its workspace. The addresses shared
+// with other keys (e.g. after a rotation) are still indexed for them.
+func (k Keeper) unindexKeyAddresses(ctx sdk.Context, key *types.Key) {
+	store := k.internalAddressStore(ctx)
+	for _, addr := range types.WalletTransferAddresses(key) {
+		store.Delete(internalAddressKey(key, addr))
+	}
+}
+
+func (k Keeper) internalAddressStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.InternalAddressKey))
+}
+
+// internalAddressPrefix returns the prefix of the index entries of addr in
+// the workspace: the workspace address, a separator, and the length
+// prefixed address.
+func internalAddressPrefix(workspaceAddr string, addr []byte) []byte {
+	b := make([]byte, 0, len(workspaceAddr)+2+len(addr))
+	b = append(b, workspaceAddr...)
+	b = append(b, '/', byte(len(addr)))
+	return append(b, addr...)
+}
+
+// internalAddressKey returns the key of the index entry of addr for key,
+// the prefix of addr in the workspace of key followed by the ID of key.
+func internalAddressKey(key *types.Key, addr []byte) []byte {
+	return append(internalAddressPrefix(key.WorkspaceAddr, addr), sdk.Uint64ToBigEndian(key.Id)...)
+}
@@ -0,0 +1,156 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/qredo/fusionchain/testutil/keeper"
+	"github.com/qredo/fusionchain/x/identity"
+	idTypes "github.com/qredo/fusionchain/x/identity/types"
+	policytypes "github.com/qredo/fusionchain/x/policy/types"
+	"github.com/qredo/fusionchain/x/treasury"
+	"github.com/qredo/fusionchain/x/treasury/keeper"
+	"github.com/qredo/fusionchain/x/treasury/types"
+)
+
+func Test_Keeper_InternalOnlyPolicy(t *testing.T) {
+	metadata, err := cdctypes.NewAnyWithValue(&types.MetadataEthereum{ChainId: 1})
+	require.NoError(t, err)
+
+	keepers := keepertest.NewTest(t)
+	ik := keepers.IdentityKeeper
+	pk := keepers.PolicyKeeper
+	tk := keepers.TreasuryKeeper
+	ctx := keepers.Ctx
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	// registers the internal addresses of the treasury
+	keeper.NewMsgServerImpl(*tk)
+
+	wrapped, err := cdctypes.NewAnyWithValue(&policytypes.InternalOnlyPolicy{
+		Participants: []*policytypes.PolicyParticipant{{Abbreviation: "a", Address: "qredo1a"}},
+	})
+	require.NoError(t, err)
+	internalOnly := pk.PolicyRepo().Append(ctx, &policytypes.Policy{Name: "internal only", Policy: wrapped})
+
+	ws := defaultWs
+	ws.SignPolicyId = internalOnly
+	key := defaultECDSAKey
+	key.WorkspaceAddr = ws.Address
+	key.KeyringAddr = defaultKr.Address
+	otherKey := key
+	otherKey.Id = 2
+	otherKey.PublicKey = hexutil.MustDecode("0x025cd45a6614df5348692ea4d0f7c16255b75a6b6f67bea5013621fe84af8031f0")
+	otherWorkspaceKey := key
+	otherWorkspaceKey.Id = 3
+	otherWorkspaceKey.WorkspaceAddr = "otherWorkspace"
+	otherWorkspaceKey.PublicKey = newPublicKey(t)
+
+	identity.InitGenesis(ctx, *ik, idTypes.GenesisState{
+		Keyrings:   []idTypes.Keyring{defaultKr},
+		Workspaces: []idTypes.Workspace{ws},
+	})
+	treasury.InitGenesis(ctx, *tk, types.GenesisState{
+		Keys:            []types.Key{key, otherKey, otherWorkspaceKey},
+		SupportedChains: types.DefaultSupportedChains(),
+	})
+
+	internal, err := types.EthereumAddress(&otherKey)
+	require.NoError(t, err)
+	otherWorkspace, err := types.EthereumAddress(&otherWorkspaceKey)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		to   common.Address
+		want bool
+	}{
+		{name: "internal wallet", to: common.HexToAddress(internal), want: true},
+		{name: "wallet of another workspace", to: common.HexToAddress(otherWorkspace), want: false},
+		{name: "external address", to: common.HexToAddress("0x48c04ed5691981C42154C6167398f95e8f38a7fF"), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			to := tt.to
+			b, err := rlp.EncodeToBytes(&types.DynamicFeeTxWithoutSignature{
+				ChainID:   big.NewInt(1),
+				GasTipCap: big.NewInt(1_000_000_000),
+				GasFeeCap: big.NewInt(30_000_000_000),
+				Gas:       21_000,
+				To:        &to,
+				Value:     big.NewInt(1_000),
+			})
+			require.NoError(t, err)
+
+			res, err := tk.EvaluateWalletPolicies(goCtx, &types.QueryEvaluateWalletPoliciesRequest{
+				KeyId:               key.Id,
+				WalletType:          types.WalletType_WALLET_TYPE_ETH,
+				UnsignedTransaction: append([]byte{ethtypes.DynamicFeeTxType}, b...),
+				Metadata:            metadata,
+				Approvers:           []string{"qredo1a"},
+			})
+			require.NoError(t, err)
+			require.Len(t, res.Evaluations, 1)
+			require.Equal(t, tt.want, res.Evaluations[0].Passed, res.Evaluations[0].Reason)
+		})
+	}
+}
+
+// newPublicKey returns a new compressed secp256k1 public key.
+func newPublicKey(t *testing.T) []byte {
+	t.Helper()
+	priv, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	return crypto.CompressPubkey(&priv.PublicKey)
+}
+
+func Test_Keeper_InternalAddresses_Rotation(t *testing.T) {
+	keepers := keepertest.NewTest(t)
+	tk := keepers.TreasuryKeeper
+	ctx := keepers.Ctx
+
+	key := defaultECDSAKey
+	key.WorkspaceAddr = defaultWs.Address
+	key.KeyringAddr = defaultKr.Address
+	newKey := key
+	newKey.Id = 2
+	newKey.PublicKey = newPublicKey(t)
+
+	identity.InitGenesis(ctx, *keepers.IdentityKeeper, idTypes.GenesisState{
+		Keyrings:   []idTypes.Keyring{defaultKr},
+		Workspaces: []idTypes.Workspace{defaultWs},
+	})
+	treasury.InitGenesis(ctx, *tk, types.GenesisState{
+		Keys: []types.Key{key, newKey},
+	})
+
+	oldAddr := types.WalletTransferAddresses(&key)[0]
+	newAddr := types.WalletTransferAddresses(&newKey)[0]
+	resolver := tk.InternalAddresses(ctx, map[string][]byte{"TXWORKSPACE": []byte(defaultWs.Address)})
+	require.True(t, resolver.IsInternalAddress(oldAddr))
+	require.True(t, resolver.IsInternalAddress(newAddr))
+
+	// the wallets of other workspaces, or of transfers without a workspace,
+	// aren't internal
+	require.False(t, tk.InternalAddresses(ctx, map[string][]byte{"TXWORKSPACE": []byte("otherWorkspace")}).IsInternalAddress(oldAddr))
+	require.False(t, tk.InternalAddresses(ctx, nil).IsInternalAddress(oldAddr))
+
+	_, err := keeper.NewMsgServerImpl(*tk).RotateWalletKey(sdk.WrapSDKContext(ctx), types.NewMsgRotateWalletKey("testOwner", key.Id, newKey.Id, 100))
+	require.NoError(t, err)
+
+	// the wallets of the rotated key now have the addresses of the new key,
+	// which are still the addresses of the new key itself
+	require.False(t, resolver.IsInternalAddress(oldAddr))
+	require.True(t, resolver.IsInternalAddress(newAddr))
+}
@@ -24,6 +24,10 @@ type (
 		identityKeeper types.IdentityKeeper
 		policyKeeper   *policy.Keeper
 		bankKeeper     bank.Keeper
+
+		// preSignHooks are run before a signature is requested for a
+		// transaction, see AddPreSignHooks.
+		preSignHooks []types.PreSignHook
 	}
 )
 
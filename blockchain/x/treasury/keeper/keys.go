@@ -15,6 +15,7 @@ func (k Keeper) appendKey(ctx sdk.Context, key *types.Key, keyRequest *types.Key
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.KeyKey))
 	newValue := k.cdc.MustMarshal(key)
 	store.Set(sdk.Uint64ToBigEndian(key.Id), newValue)
+	k.indexKeyAddresses(ctx, key)
 }
 
 func (k Keeper) GetKey(ctx sdk.Context, id uint64) (*types.Key, bool) {
@@ -48,9 +49,26 @@ func (k Keeper) GetKeyCount(ctx sdk.Context) uint64 {
 	return sdk.BigEndianToUint64(bz)
 }
 
+// SetKey stores key and updates the index of the internal addresses with
+// the addresses of its wallets, replacing the ones of its previous version.
 func (k Keeper) SetKey(ctx sdk.Context, key *types.Key) {
+	if previous, found := k.GetKey(ctx, key.Id); found {
+		k.unindexKeyAddresses(ctx, previous)
+	}
+	k.indexKeyAddresses(ctx, key)
+
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.KeyKey))
 	newValue := k.cdc.MustMarshal(key)
 	keyID := strconv.FormatUint(key.Id, 10)
 	store.Set([]byte(keyID), newValue)
 }
+
+// rotateKey replaces the public key of key with the one of newKey, keeping
+// the previous public key in its history. Signature requests for key are
+// then fulfilled by the keyring using the key material of newKey.
+func (k Keeper) rotateKey(ctx sdk.Context, key, newKey *types.Key) {
+	key.PreviousPublicKeys = append(key.PreviousPublicKeys, key.PublicKey)
+	key.PublicKey = newKey.PublicKey
+	key.SigningKeyId = newKey.SigningKeyID()
+	k.SetKey(ctx, key)
+}
@@ -0,0 +1,50 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/qredo/fusionchain/x/treasury/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates the store from consensus version 1 to 2. It seeds
+// the default supported chains, which chains started before version 2
+// didn't get from their genesis. Chains already in the store are kept.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	for _, chain := range types.DefaultSupportedChains() {
+		chain := chain
+		if _, found := m.keeper.GetSupportedChain(ctx, chain.ChainId); found {
+			continue
+		}
+		m.keeper.SetSupportedChain(ctx, &chain)
+	}
+	return nil
+}
+
+// Migrate2to3 migrates the store from consensus version 2 to 3. It indexes
+// the addresses of the wallets of the existing keys, which are resolved as
+// internal addresses from version 3 on.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	store := prefix.NewStore(ctx.KVStore(m.keeper.storeKey), types.KeyPrefix(types.KeyKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var key types.Key
+		m.keeper.cdc.MustUnmarshal(iterator.Value(), &key)
+		m.keeper.indexKeyAddresses(ctx, &key)
+	}
+	return nil
+}
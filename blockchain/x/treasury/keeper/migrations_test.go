@@ -0,0 +1,50 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/qredo/fusionchain/testutil/keeper"
+	"github.com/qredo/fusionchain/x/treasury"
+	"github.com/qredo/fusionchain/x/treasury/keeper"
+	"github.com/qredo/fusionchain/x/treasury/types"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	keepers := keepertest.NewTest(t)
+	tk := keepers.TreasuryKeeper
+	ctx := keepers.Ctx
+
+	// a chain configured by governance before the migration is kept
+	polygon := types.EVMChainPolygon.SupportedChain()
+	polygon.Name = "Polygon PoS"
+	tk.SetSupportedChain(ctx, &polygon)
+
+	require.NoError(t, keeper.NewMigrator(*tk).Migrate1to2(ctx))
+
+	require.Len(t, tk.GetAllSupportedChains(ctx), len(types.EVMChains))
+	got, found := tk.GetSupportedChain(ctx, types.EVMChainEthereum.ID)
+	require.True(t, found)
+	require.Equal(t, types.EVMChainEthereum.SupportedChain(), *got)
+	got, found = tk.GetSupportedChain(ctx, types.EVMChainPolygon.ID)
+	require.True(t, found)
+	require.Equal(t, polygon, *got)
+}
+
+func TestMigrate2to3(t *testing.T) {
+	keepers := keepertest.NewTest(t)
+	tk := keepers.TreasuryKeeper
+	ctx := keepers.Ctx
+
+	key := defaultECDSAKey
+	key.WorkspaceAddr = defaultWs.Address
+	treasury.InitGenesis(ctx, *tk, types.GenesisState{Keys: []types.Key{key}})
+
+	require.NoError(t, keeper.NewMigrator(*tk).Migrate2to3(ctx))
+
+	resolver := tk.InternalAddresses(ctx, map[string][]byte{"TXWORKSPACE": []byte(defaultWs.Address)})
+	for _, addr := range types.WalletTransferAddresses(&key) {
+		require.True(t, resolver.IsInternalAddress(addr))
+	}
+}
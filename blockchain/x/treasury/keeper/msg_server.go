@@ -47,6 +47,45 @@ func NewMsgServerImpl(keeper Keeper) types.MsgServer {
 		s.NewSignTransactionRequestPolicyGenerator,
 	)
 
+	policy.RegisterActionHandler(
+		keeper.policyKeeper,
+		"/fusionchain.treasury.MsgRotateWalletKey",
+		s.RotateWalletKeyActionHandler,
+	)
+	policy.RegisterPolicyGeneratorHandler(
+		keeper.policyKeeper,
+		"/fusionchain.treasury.MsgRotateWalletKey",
+		s.RotateWalletKeyPolicyGenerator,
+	)
+
+	policy.RegisterActionHandler(
+		keeper.policyKeeper,
+		"/fusionchain.treasury.MsgResetWalletNonce",
+		s.ResetWalletNonceActionHandler,
+	)
+	policy.RegisterPolicyGeneratorHandler(
+		keeper.policyKeeper,
+		"/fusionchain.treasury.MsgResetWalletNonce",
+		s.ResetWalletNoncePolicyGenerator,
+	)
+
+	policy.RegisterActionHandler(
+		keeper.policyKeeper,
+		"/fusionchain.treasury.MsgImportWalletPolicies",
+		s.ImportWalletPoliciesActionHandler,
+	)
+	policy.RegisterPolicyGeneratorHandler(
+		keeper.policyKeeper,
+		"/fusionchain.treasury.MsgImportWalletPolicies",
+		s.ImportWalletPoliciesPolicyGenerator,
+	)
+
+	policy.RegisterInternalAddressResolver(
+		keeper.policyKeeper,
+		types.ModuleName,
+		keeper.InternalAddresses,
+	)
+
 	return s
 }
 
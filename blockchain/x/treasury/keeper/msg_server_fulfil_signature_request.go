@@ -52,6 +52,7 @@ func (k msgServer) FulfilSignatureRequest(goCtx context.Context, msg *types.MsgF
 			SignedData: sigData,
 		}
 		k.SignatureRequestsRepo().Set(ctx, req)
+		k.completePendingSignedTransaction(ctx, req)
 
 		return &types.MsgFulfilSignatureRequestResponse{}, nil
 
@@ -61,6 +62,7 @@ func (k msgServer) FulfilSignatureRequest(goCtx context.Context, msg *types.MsgF
 			RejectReason: msg.Result.(*types.MsgFulfilSignatureRequest_RejectReason).RejectReason,
 		}
 		k.SignatureRequestsRepo().Set(ctx, req)
+		k.completePendingSignedTransaction(ctx, req)
 
 	default:
 		return nil, fmt.Errorf("invalid status field, should be either fulfilled/rejected")
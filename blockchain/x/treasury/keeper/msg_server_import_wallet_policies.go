@@ -0,0 +1,74 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/qredo/fusionchain/policy"
+	bbird "github.com/qredo/fusionchain/x/policy/keeper"
+	bbirdtypes "github.com/qredo/fusionchain/x/policy/types"
+	"github.com/qredo/fusionchain/x/treasury/types"
+)
+
+// ImportWalletPolicies sets the policies of a signed bundle, see
+// ExportWalletPolicies, as the admin and sign policies of a workspace, i.e.
+// of all the wallets of the keys of the workspace. The bundle is checked
+// before the action is created, and the import must be approved by the
+// admin policy of the workspace.
+func (k msgServer) ImportWalletPolicies(goCtx context.Context, msg *types.MsgImportWalletPolicies) (*types.MsgImportWalletPoliciesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	ws := k.identityKeeper.GetWorkspace(ctx, msg.WorkspaceAddr)
+	if ws == nil {
+		return nil, fmt.Errorf("workspace not found")
+	}
+
+	if _, err := types.ParseWalletPolicyBundle(msg.Bundle, msg.Signer); err != nil {
+		return nil, err
+	}
+
+	act, err := k.policyKeeper.AddAction(ctx, msg.Creator, msg, ws.AdminPolicyId, msg.Btl, nil)
+	if err != nil {
+		return nil, err
+	}
+	return k.ImportWalletPoliciesActionHandler(ctx, act, &cdctypes.Any{})
+}
+
+func (k msgServer) ImportWalletPoliciesPolicyGenerator(ctx sdk.Context, msg *types.MsgImportWalletPolicies) (policy.Policy, error) {
+	ws := k.identityKeeper.GetWorkspace(ctx, msg.WorkspaceAddr)
+	if ws == nil {
+		return nil, fmt.Errorf("workspace not found")
+	}
+
+	pol := ws.PolicyImportWalletPolicies()
+	return pol, nil
+}
+
+func (k msgServer) ImportWalletPoliciesActionHandler(ctx sdk.Context, act *bbirdtypes.Action, payload *cdctypes.Any) (*types.MsgImportWalletPoliciesResponse, error) {
+	return bbird.TryExecuteAction(
+		k.policyKeeper,
+		k.cdc,
+		ctx,
+		act,
+		payload,
+		func(ctx sdk.Context, msg *types.MsgImportWalletPolicies) (*types.MsgImportWalletPoliciesResponse, error) {
+			ws := k.identityKeeper.GetWorkspace(ctx, msg.WorkspaceAddr)
+			if ws == nil {
+				return nil, fmt.Errorf("workspace not found")
+			}
+			bundle, err := types.ParseWalletPolicyBundle(msg.Bundle, msg.Signer)
+			if err != nil {
+				return nil, err
+			}
+			if err := k.importWorkspacePolicies(ctx, ws, bundle); err != nil {
+				return nil, err
+			}
+			return &types.MsgImportWalletPoliciesResponse{}, nil
+		},
+	)
+}
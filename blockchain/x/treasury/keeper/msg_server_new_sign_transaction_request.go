@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -32,32 +33,14 @@ func (k msgServer) NewSignTransactionRequest(goCtx context.Context, msg *types.M
 		return nil, fmt.Errorf("problem with keyring found:%v, IsActive:%v", found, keyring.IsActive)
 	}
 
-	// use wallet to parse unsigned transaction
-	w, err := types.NewWallet(key, msg.WalletType)
+	w, tx, err := k.parseTransaction(ctx, key, msg)
 	if err != nil {
 		return nil, err
 	}
 
-	parser, ok := w.(types.TxParser)
-	if !ok {
-		return nil, fmt.Errorf("wallet does not implement TxParser")
-	}
-
-	var meta types.Metadata
-	if err := k.cdc.UnpackAny(msg.Metadata, &meta); err != nil {
-		return nil, fmt.Errorf("failed to unpack metadata: %w", err)
-	}
-	tx, err := parser.ParseTx(msg.UnsignedTransaction, meta)
-	if err != nil {
-		return nil, fmt.Errorf("failed to parse tx: %w", err)
-	}
-
 	ctx.Logger().Debug("parsed layer 1 tx", "wallet", w, "tx", tx)
 
-	act, err := k.policyKeeper.AddAction(ctx, msg.Creator, msg, ws.SignPolicyId, msg.Btl, map[string][]byte{
-		"TXVALUE":         []byte(tx.Amount.String()),
-		dataForSigningKey: tx.DataForSigning,
-	})
+	act, err := k.policyKeeper.AddAction(ctx, msg.Creator, msg, ws.SignPolicyId, msg.Btl, transferPolicyData(key, tx))
 	if err != nil {
 		return nil, err
 	}
@@ -92,17 +75,32 @@ func (k msgServer) NewSignTransactionRequestActionHandler(ctx sdk.Context, act *
 				return nil, fmt.Errorf("key not found")
 			}
 
+			w, tx, err := k.parseTransaction(ctx, key, msg)
+			if err != nil {
+				return nil, err
+			}
+			if err := k.runPreSignHooks(ctx, w, tx); err != nil {
+				return nil, err
+			}
+			chainID, trackNonce, err := k.checkNonce(ctx, key, msg)
+			if err != nil {
+				return nil, err
+			}
+
 			dataForSigning := act.GetPolicyDataMap()[dataForSigningKey]
 
 			// generate signature request
 			signatureRequest := &types.SignRequest{
 				Creator:        msg.Creator,
-				KeyId:          msg.KeyId,
+				KeyId:          key.SigningKeyID(),
 				KeyType:        key.Type,
 				DataForSigning: dataForSigning,
 				Status:         types.SignRequestStatus_SIGN_REQUEST_STATUS_PENDING,
 			}
 			signRequestID := k.SignatureRequestsRepo().Append(ctx, signatureRequest)
+			if trackNonce {
+				k.setPendingSignedTransaction(ctx, signRequestID, chainID, msg.UnsignedTransaction)
+			}
 
 			id := k.SignTransactionRequestsRepo().Append(ctx, &types.SignTransactionRequest{
 				Creator:             msg.Creator,
@@ -116,3 +114,53 @@ func (k msgServer) NewSignTransactionRequestActionHandler(ctx sdk.Context, act *
 		},
 	)
 }
+
+// transferPolicyData returns the policy data of the action signing the
+// transaction of transfer with key, checked by the policies.
+func transferPolicyData(key *types.Key, transfer types.Transfer) map[string][]byte {
+	policyData := map[string][]byte{
+		"TXVALUE":         []byte(transfer.Amount.String()),
+		"TXCOIN":          transfer.CoinIdentifier,
+		dataForSigningKey: transfer.DataForSigning,
+		workspaceDataKey:  []byte(key.WorkspaceAddr),
+	}
+	if transfer.MaxFee != nil {
+		policyData["TXMAXFEE"] = []byte(transfer.MaxFee.String())
+	}
+	if len(transfer.To) > 0 {
+		policyData["TXTO"] = transfer.To
+	}
+	if label, ok := transfer.Metadata[types.MetadataToLabel]; ok {
+		policyData["TXTOLABEL"] = []byte(label)
+	}
+	// set for every transfer, so that policies can require that there is
+	// no code delegation
+	policyData["TXDELEGATIONS"] = []byte(strconv.Itoa(len(transfer.Delegations)))
+	return policyData
+}
+
+// parseTransaction parses the unsigned transaction of msg with the wallet
+// of key. The recipient is labelled with the address labels of the store.
+func (k Keeper) parseTransaction(ctx sdk.Context, key *types.Key, msg *types.MsgNewSignTransactionRequest) (types.Wallet, types.Transfer, error) {
+	var meta types.Metadata
+	if err := k.cdc.UnpackAny(msg.Metadata, &meta); err != nil {
+		return nil, types.Transfer{}, fmt.Errorf("failed to unpack metadata: %w", err)
+	}
+
+	// use wallet to parse unsigned transaction
+	w, err := k.newWallet(ctx, key, msg.WalletType, meta)
+	if err != nil {
+		return nil, types.Transfer{}, err
+	}
+
+	parser, ok := w.(types.TxParser)
+	if !ok {
+		return nil, types.Transfer{}, fmt.Errorf("wallet does not implement TxParser")
+	}
+
+	tx, err := parser.ParseTx(msg.UnsignedTransaction, meta)
+	if err != nil {
+		return nil, types.Transfer{}, fmt.Errorf("failed to parse tx: %w", err)
+	}
+	return w, tx.WithAddressLabels(k.AddressLabels(ctx)), nil
+}
@@ -93,7 +93,7 @@ func (k msgServer) NewSignatureRequestActionHandler(ctx sdk.Context, act *bbirdt
 
 			req := &types.SignRequest{
 				Creator:        msg.Creator,
-				KeyId:          msg.KeyId,
+				KeyId:          key.SigningKeyID(),
 				KeyType:        key.Type,
 				DataForSigning: msg.DataForSigning,
 				Status:         types.SignRequestStatus_SIGN_REQUEST_STATUS_PENDING,
@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/qredo/fusionchain/policy"
+	bbird "github.com/qredo/fusionchain/x/policy/keeper"
+	bbirdtypes "github.com/qredo/fusionchain/x/policy/types"
+	"github.com/qredo/fusionchain/x/treasury/types"
+)
+
+func (k msgServer) ResetWalletNonce(goCtx context.Context, msg *types.MsgResetWalletNonce) (*types.MsgResetWalletNonceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	key, found := k.GetKey(ctx, msg.KeyId)
+	if !found {
+		return nil, fmt.Errorf("key not found")
+	}
+
+	ws := k.identityKeeper.GetWorkspace(ctx, key.WorkspaceAddr)
+	if ws == nil {
+		return nil, fmt.Errorf("workspace not found")
+	}
+
+	act, err := k.policyKeeper.AddAction(ctx, msg.Creator, msg, ws.AdminPolicyId, msg.Btl, nil)
+	if err != nil {
+		return nil, err
+	}
+	return k.ResetWalletNonceActionHandler(ctx, act, &cdctypes.Any{})
+}
+
+func (k msgServer) ResetWalletNoncePolicyGenerator(ctx sdk.Context, msg *types.MsgResetWalletNonce) (policy.Policy, error) {
+	key, found := k.GetKey(ctx, msg.KeyId)
+	if !found {
+		return nil, fmt.Errorf("key not found")
+	}
+
+	ws := k.identityKeeper.GetWorkspace(ctx, key.WorkspaceAddr)
+	if ws == nil {
+		return nil, fmt.Errorf("workspace not found")
+	}
+
+	pol := ws.PolicyResetWalletNonce()
+	return pol, nil
+}
+
+func (k msgServer) ResetWalletNonceActionHandler(ctx sdk.Context, act *bbirdtypes.Action, payload *cdctypes.Any) (*types.MsgResetWalletNonceResponse, error) {
+	return bbird.TryExecuteAction(
+		k.policyKeeper,
+		k.cdc,
+		ctx,
+		act,
+		payload,
+		func(ctx sdk.Context, msg *types.MsgResetWalletNonce) (*types.MsgResetWalletNonceResponse, error) {
+			key, found := k.GetKey(ctx, msg.KeyId)
+			if !found {
+				return nil, fmt.Errorf("key not found")
+			}
+			k.ResetLastSignedTransaction(ctx, key.SigningKeyID(), msg.ChainId)
+			return &types.MsgResetWalletNonceResponse{}, nil
+		},
+	)
+}
@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/qredo/fusionchain/policy"
+	bbird "github.com/qredo/fusionchain/x/policy/keeper"
+	bbirdtypes "github.com/qredo/fusionchain/x/policy/types"
+	"github.com/qredo/fusionchain/x/treasury/types"
+)
+
+func (k msgServer) RotateWalletKey(goCtx context.Context, msg *types.MsgRotateWalletKey) (*types.MsgRotateWalletKeyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	key, _, err := k.rotationKeys(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	ws := k.identityKeeper.GetWorkspace(ctx, key.WorkspaceAddr)
+	if ws == nil {
+		return nil, fmt.Errorf("workspace not found")
+	}
+
+	act, err := k.policyKeeper.AddAction(ctx, msg.Creator, msg, ws.AdminPolicyId, msg.Btl, nil)
+	if err != nil {
+		return nil, err
+	}
+	return k.RotateWalletKeyActionHandler(ctx, act, &cdctypes.Any{})
+}
+
+func (k msgServer) RotateWalletKeyPolicyGenerator(ctx sdk.Context, msg *types.MsgRotateWalletKey) (policy.Policy, error) {
+	key, found := k.GetKey(ctx, msg.KeyId)
+	if !found {
+		return nil, fmt.Errorf("key not found")
+	}
+
+	ws := k.identityKeeper.GetWorkspace(ctx, key.WorkspaceAddr)
+	if ws == nil {
+		return nil, fmt.Errorf("workspace not found")
+	}
+
+	pol := ws.PolicyRotateWalletKey()
+	return pol, nil
+}
+
+func (k msgServer) RotateWalletKeyActionHandler(ctx sdk.Context, act *bbirdtypes.Action, payload *cdctypes.Any) (*types.MsgRotateWalletKeyResponse, error) {
+	return bbird.TryExecuteAction(
+		k.policyKeeper,
+		k.cdc,
+		ctx,
+		act,
+		payload,
+		func(ctx sdk.Context, msg *types.MsgRotateWalletKey) (*types.MsgRotateWalletKeyResponse, error) {
+			key, newKey, err := k.rotationKeys(ctx, msg)
+			if err != nil {
+				return nil, err
+			}
+			k.rotateKey(ctx, key, newKey)
+			return &types.MsgRotateWalletKeyResponse{}, nil
+		},
+	)
+}
+
+// rotationKeys returns the key being rotated and the key it is rotated to,
+// checking that they belong to the same workspace and have the same type.
+func (k msgServer) rotationKeys(ctx sdk.Context, msg *types.MsgRotateWalletKey) (*types.Key, *types.Key, error) {
+	key, found := k.GetKey(ctx, msg.KeyId)
+	if !found {
+		return nil, nil, fmt.Errorf("key not found")
+	}
+
+	newKey, found := k.GetKey(ctx, msg.NewKeyId)
+	if !found {
+		return nil, nil, fmt.Errorf("new key not found")
+	}
+
+	if newKey.WorkspaceAddr != key.WorkspaceAddr {
+		return nil, nil, fmt.Errorf("key %d doesn't belong to workspace %s", newKey.Id, key.WorkspaceAddr)
+	}
+
+	if newKey.Type != key.Type {
+		return nil, nil, fmt.Errorf("key type mismatch: key %d is %s, key %d is %s", key.Id, key.Type, newKey.Id, newKey.Type)
+	}
+	return key, newKey, nil
+}
@@ -0,0 +1,233 @@
+// Copyright 2023 Qredo Ltd.
+// This file is part of the Fusion library.
+//
+// The Fusion library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Fusion library. If not, see https://github.com/qredo/fusionchain/blob/main/LICENSE
+package keeper_test
+
+import (
+	"reflect"
+	"testing"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	keepertest "github.com/qredo/fusionchain/testutil/keeper"
+	"github.com/qredo/fusionchain/x/identity"
+	idTypes "github.com/qredo/fusionchain/x/identity/types"
+	policyKeeper "github.com/qredo/fusionchain/x/policy/keeper"
+	policyTypes "github.com/qredo/fusionchain/x/policy/types"
+	"github.com/qredo/fusionchain/x/treasury"
+	"github.com/qredo/fusionchain/x/treasury/keeper"
+	"github.com/qredo/fusionchain/x/treasury/types"
+)
+
+func Test_msgServer_RotateWalletKey(t *testing.T) {
+	priv, err := crypto.HexToECDSA("4c0883a69102937d6231471b5dbb6204fe5129617082792ae468d01a3f362318")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	oldKey := types.Key{
+		Id:            1,
+		WorkspaceAddr: defaultWs.Address,
+		KeyringAddr:   defaultKr.Address,
+		Type:          types.KeyType_KEY_TYPE_ECDSA_SECP256K1,
+		PublicKey:     defaultECDSAKey.PublicKey,
+	}
+	newKey := types.Key{
+		Id:            2,
+		WorkspaceAddr: defaultWs.Address,
+		KeyringAddr:   defaultKr.Address,
+		Type:          types.KeyType_KEY_TYPE_ECDSA_SECP256K1,
+		PublicKey:     crypto.CompressPubkey(&priv.PublicKey),
+	}
+	otherWorkspaceKey := types.Key{
+		Id:            3,
+		WorkspaceAddr: "otherWorkspace",
+		KeyringAddr:   defaultKr.Address,
+		Type:          types.KeyType_KEY_TYPE_ECDSA_SECP256K1,
+		PublicKey:     crypto.CompressPubkey(&priv.PublicKey),
+	}
+	eddsaKey := defaultEdDSAKey
+	eddsaKey.Id = 4
+	eddsaKey.WorkspaceAddr = defaultWs.Address
+
+	// requires the approval of a second owner
+	twoOwnersWs := defaultWs
+	twoOwnersWs.Owners = []string{"testOwner", "otherOwner"}
+	twoOwnersWs.AdminPolicyId = 1
+	twoOwnersPolicy, err := codectypes.NewAnyWithValue(&policyTypes.BoolparserPolicy{
+		Definition: "t1 + t2 > 1",
+		Participants: []*policyTypes.PolicyParticipant{
+			{Abbreviation: "t1", Address: "testOwner"},
+			{Abbreviation: "t2", Address: "otherOwner"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rotatedKey := oldKey
+	rotatedKey.PublicKey = newKey.PublicKey
+	rotatedKey.PreviousPublicKeys = [][]byte{oldKey.PublicKey}
+	rotatedKey.SigningKeyId = newKey.Id
+
+	tests := []struct {
+		name      string
+		workspace idTypes.Workspace
+		msg       *types.MsgRotateWalletKey
+		want      *types.MsgRotateWalletKeyResponse
+		wantKey   *types.Key
+		wantErr   bool
+	}{
+		{
+			name:      "PASS: key rotated",
+			workspace: defaultWs,
+			msg:       types.NewMsgRotateWalletKey("testOwner", 1, 2, 100),
+			want:      &types.MsgRotateWalletKeyResponse{},
+			wantKey:   &rotatedKey,
+		},
+		{
+			name:      "PASS: key not rotated without policy approval",
+			workspace: twoOwnersWs,
+			msg:       types.NewMsgRotateWalletKey("testOwner", 1, 2, 100),
+			want:      &types.MsgRotateWalletKeyResponse{},
+			wantKey:   &oldKey,
+		},
+		{
+			name:      "FAIL: creator is not a workspace owner",
+			workspace: defaultWs,
+			msg:       types.NewMsgRotateWalletKey("notAnOwner", 1, 2, 100),
+			wantErr:   true,
+		},
+		{
+			name:      "FAIL: new key not found",
+			workspace: defaultWs,
+			msg:       types.NewMsgRotateWalletKey("testOwner", 1, 10, 100),
+			wantErr:   true,
+		},
+		{
+			name:      "FAIL: new key in another workspace",
+			workspace: defaultWs,
+			msg:       types.NewMsgRotateWalletKey("testOwner", 1, 3, 100),
+			wantErr:   true,
+		},
+		{
+			name:      "FAIL: new key of another type",
+			workspace: defaultWs,
+			msg:       types.NewMsgRotateWalletKey("testOwner", 1, 4, 100),
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			keepers := keepertest.NewTest(t)
+			ik := keepers.IdentityKeeper
+			pk := keepers.PolicyKeeper
+			tk := keepers.TreasuryKeeper
+			ctx := keepers.Ctx
+			goCtx := sdk.WrapSDKContext(ctx)
+
+			identity.InitGenesis(ctx, *ik, idTypes.GenesisState{
+				Keyrings:   []idTypes.Keyring{defaultKr},
+				Workspaces: []idTypes.Workspace{tt.workspace},
+			})
+			treasury.InitGenesis(ctx, *tk, types.GenesisState{
+				Keys: []types.Key{oldKey, newKey, otherWorkspaceKey, eddsaKey},
+			})
+
+			msgPolSer := policyKeeper.NewMsgServerImpl(*pk)
+			if _, err := msgPolSer.NewPolicy(goCtx, policyTypes.NewMsgNewPolicy("testOwner", "twoOwners", twoOwnersPolicy)); err != nil {
+				t.Fatalf("NewPolicy() error = %v", err)
+			}
+
+			msgSer := keeper.NewMsgServerImpl(*tk)
+			got, err := msgSer.RotateWalletKey(goCtx, tt.msg)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("RotateWalletKey() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("RotateWalletKey() got = %v, want %v", got, tt.want)
+			}
+
+			gotKey, _ := tk.GetKey(ctx, tt.msg.KeyId)
+			if !reflect.DeepEqual(gotKey, tt.wantKey) {
+				t.Fatalf("GetKey() got = %v, want %v", gotKey, tt.wantKey)
+			}
+		})
+	}
+}
+
+func Test_msgServer_RotateWalletKey_Addresses(t *testing.T) {
+	keepers := keepertest.NewTest(t)
+	ik := keepers.IdentityKeeper
+	tk := keepers.TreasuryKeeper
+	ctx := keepers.Ctx
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	priv, err := crypto.HexToECDSA("4c0883a69102937d6231471b5dbb6204fe5129617082792ae468d01a3f362318")
+	if err != nil {
+		t.Fatal(err)
+	}
+	oldKey := defaultECDSAKey
+	oldKey.WorkspaceAddr = defaultWs.Address
+	oldKey.KeyringAddr = defaultKr.Address
+	newKey := oldKey
+	newKey.Id = 2
+	newKey.PublicKey = crypto.CompressPubkey(&priv.PublicKey)
+
+	identity.InitGenesis(ctx, *ik, idTypes.GenesisState{
+		Keyrings:   []idTypes.Keyring{defaultKr},
+		Workspaces: []idTypes.Workspace{defaultWs},
+	})
+	treasury.InitGenesis(ctx, *tk, types.GenesisState{Keys: []types.Key{oldKey, newKey}})
+
+	before, err := tk.KeyAddresses(goCtx, &types.QueryKeyAddressesRequest{KeyId: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	msgSer := keeper.NewMsgServerImpl(*tk)
+	if _, err := msgSer.RotateWalletKey(goCtx, types.NewMsgRotateWalletKey("testOwner", 1, 2, 100)); err != nil {
+		t.Fatalf("RotateWalletKey() error = %v", err)
+	}
+
+	after, err := tk.KeyAddresses(goCtx, &types.QueryKeyAddressesRequest{KeyId: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := tk.KeyAddresses(goCtx, &types.QueryKeyAddressesRequest{KeyId: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if reflect.DeepEqual(after.Wallets, before.Wallets) {
+		t.Fatalf("addresses didn't change after the rotation: %v", after.Wallets)
+	}
+	if !reflect.DeepEqual(after.Wallets, want.Wallets) {
+		t.Fatalf("KeyAddresses() got = %v, want the addresses of the new key %v", after.Wallets, want.Wallets)
+	}
+	if !reflect.DeepEqual(after.PreviousWallets, before.Wallets) {
+		t.Fatalf("KeyAddresses() previous wallets = %v, want %v", after.PreviousWallets, before.Wallets)
+	}
+
+	// signatures for the rotated key are requested to the new key material
+	sigReq, err := msgSer.NewSignatureRequest(goCtx, types.NewMsgNewSignatureRequest("testOwner", 1, make([]byte, 32), 100))
+	if err != nil {
+		t.Fatalf("NewSignatureRequest() error = %v", err)
+	}
+	req, _ := tk.SignatureRequestsRepo().Get(ctx, sigReq.Id)
+	if req.KeyId != newKey.Id {
+		t.Fatalf("signature request key = %d, want %d", req.KeyId, newKey.Id)
+	}
+}
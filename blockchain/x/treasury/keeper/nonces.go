@@ -0,0 +1,81 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/qredo/fusionchain/x/treasury/types"
+)
+
+// GetLastSignedTransaction returns the last unsigned Ethereum transaction
+// signed with the key material of signingKeyID on the chain, or nil if
+// there was none. The next transaction must have the following nonce, or
+// replace it (see types.CheckNextNonce).
+func (k Keeper) GetLastSignedTransaction(ctx sdk.Context, signingKeyID, chainID uint64) []byte {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.LastSignedTransactionKey))
+	return store.Get(lastSignedTransactionKey(signingKeyID, chainID))
+}
+
+func (k Keeper) SetLastSignedTransaction(ctx sdk.Context, signingKeyID, chainID uint64, unsignedTx []byte) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.LastSignedTransactionKey))
+	store.Set(lastSignedTransactionKey(signingKeyID, chainID), unsignedTx)
+}
+
+// ResetLastSignedTransaction forgets the last transaction signed with the
+// key material of signingKeyID on the chain, so that the nonce of the next
+// one isn't checked.
+func (k Keeper) ResetLastSignedTransaction(ctx sdk.Context, signingKeyID, chainID uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.LastSignedTransactionKey))
+	store.Delete(lastSignedTransactionKey(signingKeyID, chainID))
+}
+
+// setPendingSignedTransaction keeps the unsigned Ethereum transaction of the
+// signature request signRequestID until the request is fulfilled, when it
+// becomes the last signed transaction of the chain, or rejected.
+func (k Keeper) setPendingSignedTransaction(ctx sdk.Context, signRequestID, chainID uint64, unsignedTx []byte) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PendingSignedTransactionKey))
+	store.Set(sdk.Uint64ToBigEndian(signRequestID), append(sdk.Uint64ToBigEndian(chainID), unsignedTx...))
+}
+
+// completePendingSignedTransaction removes the pending transaction of the
+// signature request req, if any, and records it as the last signed
+// transaction if req was fulfilled.
+func (k Keeper) completePendingSignedTransaction(ctx sdk.Context, req *types.SignRequest) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PendingSignedTransactionKey))
+	v := store.Get(sdk.Uint64ToBigEndian(req.Id))
+	if v == nil {
+		return
+	}
+	store.Delete(sdk.Uint64ToBigEndian(req.Id))
+	if req.Status == types.SignRequestStatus_SIGN_REQUEST_STATUS_FULFILLED {
+		k.SetLastSignedTransaction(ctx, req.KeyId, sdk.BigEndianToUint64(v[:8]), v[8:])
+	}
+}
+
+func lastSignedTransactionKey(signingKeyID, chainID uint64) []byte {
+	return append(sdk.Uint64ToBigEndian(signingKeyID), sdk.Uint64ToBigEndian(chainID)...)
+}
+
+// checkNonce checks the nonce of the Ethereum transaction of msg against
+// the last one signed by key on the same chain, and returns the chain ID.
+// It returns false for other wallet types, whose nonces aren't tracked.
+func (k Keeper) checkNonce(ctx sdk.Context, key *types.Key, msg *types.MsgNewSignTransactionRequest) (chainID uint64, tracked bool, err error) {
+	if msg.WalletType != types.WalletType_WALLET_TYPE_ETH {
+		return 0, false, nil
+	}
+	var meta types.Metadata
+	if err := k.cdc.UnpackAny(msg.Metadata, &meta); err != nil {
+		return 0, false, err
+	}
+	ethMeta, ok := meta.(*types.MetadataEthereum)
+	if !ok || ethMeta == nil {
+		return 0, false, nil
+	}
+	last := k.GetLastSignedTransaction(ctx, key.SigningKeyID(), ethMeta.ChainId)
+	if _, err := types.CheckNextNonce(last, msg.UnsignedTransaction); err != nil {
+		return 0, false, err
+	}
+	return ethMeta.ChainId, true, nil
+}
@@ -0,0 +1,217 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package keeper_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+	keepertest "github.com/qredo/fusionchain/testutil/keeper"
+	"github.com/qredo/fusionchain/x/identity"
+	idTypes "github.com/qredo/fusionchain/x/identity/types"
+	"github.com/qredo/fusionchain/x/treasury"
+	"github.com/qredo/fusionchain/x/treasury/keeper"
+	"github.com/qredo/fusionchain/x/treasury/types"
+	"github.com/stretchr/testify/require"
+)
+
+func unsignedEthTransferWithNonce(t *testing.T, nonce uint64, gasFeeCap int64) []byte {
+	t.Helper()
+	to := common.HexToAddress("0x48c04ed5691981C42154C6167398f95e8f38a7fF")
+	b, err := rlp.EncodeToBytes(&types.DynamicFeeTxWithoutSignature{
+		ChainID:   big.NewInt(1),
+		Nonce:     nonce,
+		GasTipCap: big.NewInt(gasFeeCap / 30),
+		GasFeeCap: big.NewInt(gasFeeCap),
+		Gas:       21_000,
+		To:        &to,
+		Value:     big.NewInt(1_000),
+	})
+	require.NoError(t, err)
+	return append([]byte{ethtypes.DynamicFeeTxType}, b...)
+}
+
+// nonceStep is a transaction submitted for signing, whose signature request
+// is then fulfilled unless it's rejected or left pending.
+type nonceStep struct {
+	tx      []byte
+	reject  bool
+	pending bool
+}
+
+func Test_Keeper_NewSignTransactionRequest_Nonce(t *testing.T) {
+	metadata, err := cdctypes.NewAnyWithValue(&types.MetadataEthereum{ChainId: 1})
+	require.NoError(t, err)
+
+	key := defaultECDSAKey
+	key.WorkspaceAddr = defaultWs.Address
+	key.KeyringAddr = defaultKr.Address
+
+	tests := []struct {
+		name     string
+		steps    []nonceStep
+		wantLast []byte
+		wantErr  error
+	}{
+		{
+			name: "PASS: nonces in order",
+			steps: []nonceStep{
+				{tx: unsignedEthTransferWithNonce(t, 3, 30_000_000_000)},
+				{tx: unsignedEthTransferWithNonce(t, 4, 30_000_000_000)},
+			},
+			wantLast: unsignedEthTransferWithNonce(t, 4, 30_000_000_000),
+		},
+		{
+			name: "FAIL: nonce gap",
+			steps: []nonceStep{
+				{tx: unsignedEthTransferWithNonce(t, 3, 30_000_000_000)},
+				{tx: unsignedEthTransferWithNonce(t, 5, 30_000_000_000)},
+			},
+			wantErr: types.ErrNonceGap,
+		},
+		{
+			name: "PASS: same nonce replacing the last transaction",
+			steps: []nonceStep{
+				{tx: unsignedEthTransferWithNonce(t, 3, 30_000_000_000)},
+				{tx: unsignedEthTransferWithNonce(t, 3, 40_000_000_000)},
+				{tx: unsignedEthTransferWithNonce(t, 4, 30_000_000_000)},
+			},
+			wantLast: unsignedEthTransferWithNonce(t, 4, 30_000_000_000),
+		},
+		{
+			name: "FAIL: same nonce without replacement",
+			steps: []nonceStep{
+				{tx: unsignedEthTransferWithNonce(t, 3, 30_000_000_000)},
+				{tx: unsignedEthTransferWithNonce(t, 3, 30_000_000_000)},
+			},
+			wantErr: types.ErrNonceReused,
+		},
+		{
+			name: "PASS: retry at the same nonce after a rejection",
+			steps: []nonceStep{
+				{tx: unsignedEthTransferWithNonce(t, 3, 30_000_000_000)},
+				{tx: unsignedEthTransferWithNonce(t, 4, 30_000_000_000), reject: true},
+				{tx: unsignedEthTransferWithNonce(t, 4, 30_000_000_000)},
+			},
+			wantLast: unsignedEthTransferWithNonce(t, 4, 30_000_000_000),
+		},
+		{
+			name: "PASS: retry at the same nonce while pending",
+			steps: []nonceStep{
+				{tx: unsignedEthTransferWithNonce(t, 3, 30_000_000_000)},
+				{tx: unsignedEthTransferWithNonce(t, 4, 30_000_000_000), pending: true},
+				{tx: unsignedEthTransferWithNonce(t, 4, 30_000_000_000), pending: true},
+			},
+			wantLast: unsignedEthTransferWithNonce(t, 3, 30_000_000_000),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			keepers := keepertest.NewTest(t)
+			ik := keepers.IdentityKeeper
+			tk := keepers.TreasuryKeeper
+			ctx := keepers.Ctx
+			goCtx := sdk.WrapSDKContext(ctx)
+
+			identity.InitGenesis(ctx, *ik, idTypes.GenesisState{
+				Keyrings:   []idTypes.Keyring{defaultKr},
+				Workspaces: []idTypes.Workspace{defaultWs},
+			})
+			treasury.InitGenesis(ctx, *tk, types.GenesisState{
+				Keys:            []types.Key{key},
+				SupportedChains: types.DefaultSupportedChains(),
+			})
+
+			msgSer := keeper.NewMsgServerImpl(*tk)
+			var err error
+			for i, step := range tt.steps {
+				msg := types.NewMsgNewSignTransactionRequest("testOwner", key.Id, types.WalletType_WALLET_TYPE_ETH, step.tx, 100, metadata)
+				var res *types.MsgNewSignTransactionRequestResponse
+				if res, err = msgSer.NewSignTransactionRequest(goCtx, msg); err != nil {
+					require.Equal(t, len(tt.steps)-1, i, "only the last transaction can fail")
+					break
+				}
+				if !step.pending {
+					fulfilSignRequest(t, msgSer, goCtx, res.SignatureRequestId, step.reject)
+				}
+			}
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				require.Equal(t, uint64(len(tt.steps)-1), tk.SignatureRequestsRepo().GetCount(ctx))
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, uint64(len(tt.steps)), tk.SignatureRequestsRepo().GetCount(ctx))
+			require.Equal(t, tt.wantLast, tk.GetLastSignedTransaction(ctx, key.SigningKeyID(), 1))
+		})
+	}
+}
+
+func Test_Keeper_ResetWalletNonce(t *testing.T) {
+	metadata, err := cdctypes.NewAnyWithValue(&types.MetadataEthereum{ChainId: 1})
+	require.NoError(t, err)
+
+	key := defaultECDSAKey
+	key.WorkspaceAddr = defaultWs.Address
+	key.KeyringAddr = defaultKr.Address
+
+	keepers := keepertest.NewTest(t)
+	ik := keepers.IdentityKeeper
+	tk := keepers.TreasuryKeeper
+	ctx := keepers.Ctx
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	identity.InitGenesis(ctx, *ik, idTypes.GenesisState{
+		Keyrings:   []idTypes.Keyring{defaultKr},
+		Workspaces: []idTypes.Workspace{defaultWs},
+	})
+	treasury.InitGenesis(ctx, *tk, types.GenesisState{
+		Keys:            []types.Key{key},
+		SupportedChains: types.DefaultSupportedChains(),
+	})
+	msgSer := keeper.NewMsgServerImpl(*tk)
+
+	newRequest := func(tx []byte) error {
+		res, err := msgSer.NewSignTransactionRequest(goCtx, types.NewMsgNewSignTransactionRequest("testOwner", key.Id, types.WalletType_WALLET_TYPE_ETH, tx, 100, metadata))
+		if err != nil {
+			return err
+		}
+		fulfilSignRequest(t, msgSer, goCtx, res.SignatureRequestId, false)
+		return nil
+	}
+
+	require.NoError(t, newRequest(unsignedEthTransferWithNonce(t, 3, 30_000_000_000)))
+	require.ErrorIs(t, newRequest(unsignedEthTransferWithNonce(t, 7, 30_000_000_000)), types.ErrNonceGap)
+
+	// only the workspace admin policy can reset the nonce
+	_, err = msgSer.ResetWalletNonce(goCtx, types.NewMsgResetWalletNonce("notAnOwner", key.Id, 1, 100))
+	require.Error(t, err)
+	require.NotNil(t, tk.GetLastSignedTransaction(ctx, key.SigningKeyID(), 1))
+
+	_, err = msgSer.ResetWalletNonce(goCtx, types.NewMsgResetWalletNonce("testOwner", 2, 1, 100))
+	require.Error(t, err)
+
+	_, err = msgSer.ResetWalletNonce(goCtx, types.NewMsgResetWalletNonce("testOwner", key.Id, 1, 100))
+	require.NoError(t, err)
+	require.Nil(t, tk.GetLastSignedTransaction(ctx, key.SigningKeyID(), 1))
+
+	require.NoError(t, newRequest(unsignedEthTransferWithNonce(t, 7, 30_000_000_000)))
+	require.Equal(t, unsignedEthTransferWithNonce(t, 7, 30_000_000_000), tk.GetLastSignedTransaction(ctx, key.SigningKeyID(), 1))
+}
+
+// fulfilSignRequest fulfils, or rejects, the signature request id.
+func fulfilSignRequest(t *testing.T, msgSer types.MsgServer, goCtx context.Context, id uint64, reject bool) {
+	t.Helper()
+	msg := types.NewMsgFulfilSignatureRequest("testKeyring", id, types.SignRequestStatus_SIGN_REQUEST_STATUS_FULFILLED, types.NewMsgFulfilSignatureRequestPayload(make([]byte, 65)))
+	if reject {
+		msg = types.NewMsgFulfilSignatureRequest("testKeyring", id, types.SignRequestStatus_SIGN_REQUEST_STATUS_REJECTED, types.NewMsgFulfilSignatureRequestReject("rejected"))
+	}
+	_, err := msgSer.FulfilSignatureRequest(goCtx, msg)
+	require.NoError(t, err)
+}
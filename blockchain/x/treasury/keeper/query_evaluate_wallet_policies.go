@@ -0,0 +1,78 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/qredo/fusionchain/policy"
+	"github.com/qredo/fusionchain/x/treasury/types"
+)
+
+// EvaluateWalletPolicies verifies the policies of the wallet of the key
+// against the candidate transfer and approvers of req, and returns the
+// result of each of them. They are the sign policy of the workspace of the
+// key, or its default policy if it has none, followed by the additional
+// policies of req.
+//
+// All the policies are evaluated, even after a failure, so that the result
+// shows every policy that would block the transfer.
+func (k Keeper) EvaluateWalletPolicies(goCtx context.Context, req *types.QueryEvaluateWalletPoliciesRequest) (*types.QueryEvaluateWalletPoliciesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	key, found := k.GetKey(ctx, req.KeyId)
+	if !found {
+		return nil, fmt.Errorf("key %d not found", req.KeyId)
+	}
+	ws := k.identityKeeper.GetWorkspace(ctx, key.WorkspaceAddr)
+	if ws == nil {
+		return nil, fmt.Errorf("workspace not found")
+	}
+
+	_, transfer, err := k.parseTransaction(ctx, key, &types.MsgNewSignTransactionRequest{
+		KeyId:               req.KeyId,
+		WalletType:          req.WalletType,
+		UnsignedTransaction: req.UnsignedTransaction,
+		Metadata:            req.Metadata,
+	})
+	if err != nil {
+		return nil, err
+	}
+	policyData := transferPolicyData(key, transfer)
+
+	signPolicy := ws.PolicyNewSignTransactionRequest()
+	if ws.SignPolicyId != 0 {
+		if signPolicy, err = k.policyKeeper.GetPolicy(ctx, ws.SignPolicyId); err != nil {
+			return nil, err
+		}
+	}
+	evaluations := []*types.PolicyEvaluation{
+		k.evaluatePolicy(ctx, ws.SignPolicyId, signPolicy, req.Approvers, policyData),
+	}
+	for _, id := range req.PolicyIds {
+		pol, err := k.policyKeeper.GetPolicy(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		evaluations = append(evaluations, k.evaluatePolicy(ctx, id, pol, req.Approvers, policyData))
+	}
+	return &types.QueryEvaluateWalletPoliciesResponse{Evaluations: evaluations}, nil
+}
+
+func (k Keeper) evaluatePolicy(ctx sdk.Context, id uint64, pol policy.Policy, approvers []string, policyData map[string][]byte) *types.PolicyEvaluation {
+	evaluation := &types.PolicyEvaluation{PolicyId: id, Passed: true}
+	if err := k.policyKeeper.EvaluatePolicy(ctx, id, pol, approvers, policyData); err != nil {
+		evaluation.Passed = false
+		evaluation.Reason = err.Error()
+	}
+	return evaluation
+}
@@ -0,0 +1,131 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/qredo/fusionchain/testutil/keeper"
+	"github.com/qredo/fusionchain/x/identity"
+	idTypes "github.com/qredo/fusionchain/x/identity/types"
+	policytypes "github.com/qredo/fusionchain/x/policy/types"
+	"github.com/qredo/fusionchain/x/treasury"
+	"github.com/qredo/fusionchain/x/treasury/types"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Keeper_EvaluateWalletPolicies(t *testing.T) {
+	metadata, err := cdctypes.NewAnyWithValue(&types.MetadataEthereum{ChainId: 1})
+	require.NoError(t, err)
+	participants := []*policytypes.PolicyParticipant{
+		{Abbreviation: "a", Address: "qredo1a"},
+		{Abbreviation: "b", Address: "qredo1b"},
+	}
+
+	keepers := keepertest.NewTest(t)
+	ik := keepers.IdentityKeeper
+	pk := keepers.PolicyKeeper
+	tk := keepers.TreasuryKeeper
+	ctx := keepers.Ctx
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	appendPolicy := func(name string, p *policytypes.BoolparserPolicy) uint64 {
+		wrapped, err := cdctypes.NewAnyWithValue(p)
+		require.NoError(t, err)
+		return pk.PolicyRepo().Append(ctx, &policytypes.Policy{Name: name, Policy: wrapped})
+	}
+	threshold := appendPolicy("2 of 2", &policytypes.BoolparserPolicy{Definition: "a + b > 1", Participants: participants})
+	allowlist := appendPolicy("small transfers", &policytypes.BoolparserPolicy{Definition: "(a > 0) & (TXVALUE < 100)", Participants: participants})
+
+	ws := defaultWs
+	ws.SignPolicyId = threshold
+	otherWs := defaultWs
+	otherWs.Address = "qredoworkspace1other"
+	key := defaultECDSAKey
+	key.WorkspaceAddr = ws.Address
+	key.KeyringAddr = defaultKr.Address
+	defaultPolicyKey := key
+	defaultPolicyKey.Id = 2
+	defaultPolicyKey.WorkspaceAddr = otherWs.Address
+
+	identity.InitGenesis(ctx, *ik, idTypes.GenesisState{
+		Keyrings:   []idTypes.Keyring{defaultKr},
+		Workspaces: []idTypes.Workspace{ws, otherWs},
+	})
+	treasury.InitGenesis(ctx, *tk, types.GenesisState{
+		Keys:            []types.Key{key, defaultPolicyKey},
+		SupportedChains: types.DefaultSupportedChains(),
+	})
+
+	tests := []struct {
+		name      string
+		keyID     uint64
+		approvers []string
+		policyIDs []uint64
+		want      []*types.PolicyEvaluation
+		wantErr   bool
+	}{
+		{
+			name:      "PASS: threshold passes, allowlist fails",
+			keyID:     key.Id,
+			approvers: []string{"qredo1a", "qredo1b"},
+			policyIDs: []uint64{allowlist},
+			want: []*types.PolicyEvaluation{
+				{PolicyId: threshold, Passed: true},
+				{PolicyId: allowlist, Passed: false, Reason: "expression not satisfied"},
+			},
+		},
+		{
+			name:      "PASS: approvers that aren't participants are ignored",
+			keyID:     key.Id,
+			approvers: []string{"qredo1a", "qredo1c"},
+			want: []*types.PolicyEvaluation{
+				{PolicyId: threshold, Passed: false, Reason: "expression not satisfied"},
+			},
+		},
+		{
+			name:      "PASS: default policy of the workspace",
+			keyID:     defaultPolicyKey.Id,
+			approvers: []string{"testOwner"},
+			want: []*types.PolicyEvaluation{
+				{PolicyId: 0, Passed: true},
+			},
+		},
+		{
+			name:      "FAIL: unknown policy",
+			keyID:     key.Id,
+			approvers: []string{"qredo1a"},
+			policyIDs: []uint64{42},
+			wantErr:   true,
+		},
+		{
+			name:    "FAIL: unknown key",
+			keyID:   3,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := tk.EvaluateWalletPolicies(goCtx, &types.QueryEvaluateWalletPoliciesRequest{
+				KeyId:               tt.keyID,
+				WalletType:          types.WalletType_WALLET_TYPE_ETH,
+				UnsignedTransaction: unsignedEthTransfer(t, big.NewInt(1_000)),
+				Metadata:            metadata,
+				Approvers:           tt.approvers,
+				PolicyIds:           tt.policyIDs,
+			})
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, res.Evaluations)
+		})
+	}
+
+	// evaluating doesn't create any action
+	require.Zero(t, pk.GetActionCount(ctx))
+}
@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/qredo/fusionchain/x/treasury/types"
+)
+
+func (k Keeper) KeyAddresses(goCtx context.Context, req *types.QueryKeyAddressesRequest) (*types.QueryKeyAddressesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	key, found := k.GetKey(ctx, req.KeyId)
+	if !found {
+		return nil, fmt.Errorf("key %d not found", req.KeyId)
+	}
+
+	return &types.QueryKeyAddressesResponse{
+		Wallets:         types.WalletAddresses(key),
+		PreviousWallets: types.PreviousWalletAddresses(key),
+	}, nil
+}
@@ -0,0 +1,100 @@
+// Copyright 2023 Qredo Ltd.
+// This file is part of the Fusion library.
+//
+// The Fusion library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Fusion library. If not, see https://github.com/qredo/fusionchain/blob/main/LICENSE
+package keeper_test
+
+import (
+	"reflect"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/qredo/fusionchain/testutil/keeper"
+	"github.com/qredo/fusionchain/x/treasury"
+	"github.com/qredo/fusionchain/x/treasury/types"
+)
+
+func TestKeeper_KeyAddresses(t *testing.T) {
+
+	type args struct {
+		key *types.Key
+		req *types.QueryKeyAddressesRequest
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *types.QueryKeyAddressesResponse
+		wantErr bool
+	}{
+		{
+			name: "PASS: ecdsa - return addresses on every ecdsa chain",
+			args: args{
+				key: &defaultECDSAKey,
+				req: &types.QueryKeyAddressesRequest{KeyId: 1},
+			},
+			want: &types.QueryKeyAddressesResponse{
+				Wallets: []*types.WalletKeyResponse{
+					{Address: "qredo18wvrcug8acpwn3py30cyjmmtfgqxh7n04d4ygs", Type: types.WalletType_WALLET_TYPE_FUSION},
+					{Address: "0x185Ac2b596EB99f2a31Ad637320746354e7dC3f9", Type: types.WalletType_WALLET_TYPE_ETH},
+					{Address: "celestia18wvrcug8acpwn3py30cyjmmtfgqxh7n0mszfg0", Type: types.WalletType_WALLET_TYPE_CELESTIA},
+				},
+			},
+		},
+		{
+			name: "PASS: eddsa - omit chains that need an ecdsa key",
+			args: args{
+				key: &defaultEdDSAKey,
+				req: &types.QueryKeyAddressesRequest{KeyId: 1},
+			},
+			want: &types.QueryKeyAddressesResponse{
+				Wallets: []*types.WalletKeyResponse{
+					{Address: "0x9061c905900bb96457ac7d73832697686bc64e2a102c38f6fe6bff8ba8002bf0", Type: types.WalletType_WALLET_TYPE_SUI},
+				},
+			},
+		},
+		{
+			name: "FAIL: key not found",
+			args: args{
+				key: &defaultECDSAKey,
+				req: &types.QueryKeyAddressesRequest{KeyId: 2},
+			},
+			wantErr: true,
+		},
+		{
+			name: "FAIL: invalid request",
+			args: args{
+				key: &defaultECDSAKey,
+				req: nil,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			keepers := keepertest.NewTest(t)
+			tk := keepers.TreasuryKeeper
+			ctx := keepers.Ctx
+			goCtx := sdk.WrapSDKContext(ctx)
+
+			genesis := types.GenesisState{
+				Keys: []types.Key{*tt.args.key},
+			}
+			treasury.InitGenesis(ctx, *tk, genesis)
+
+			got, err := tk.KeyAddresses(goCtx, tt.args.req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("KeyAddresses() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("KeyAddresses() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,52 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/qredo/fusionchain/x/treasury/types"
+)
+
+// SigningRequest parses the unsigned transaction of req as
+// NewSignTransactionRequest does, and returns the data to sign for it with
+// the key that must sign it and the address of the wallet. The key is the
+// one whose key material signs for the requested key, which differs from it
+// after a rotation. It's the hand-off to external signers, which sign
+// exactly the returned data and can check the signature against the
+// address.
+func (k Keeper) SigningRequest(goCtx context.Context, req *types.QuerySigningRequestRequest) (*types.QuerySigningRequestResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	key, found := k.GetKey(ctx, req.KeyId)
+	if !found {
+		return nil, fmt.Errorf("key %d not found", req.KeyId)
+	}
+
+	w, transfer, err := k.parseTransaction(ctx, key, &types.MsgNewSignTransactionRequest{
+		KeyId:               req.KeyId,
+		WalletType:          req.WalletType,
+		UnsignedTransaction: req.UnsignedTransaction,
+		Metadata:            req.Metadata,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QuerySigningRequestResponse{
+		DataForSigning: transfer.DataForSigning,
+		KeyId:          key.SigningKeyID(),
+		KeyType:        key.Type,
+		WalletType:     req.WalletType,
+		Address:        w.Address(),
+	}, nil
+}
@@ -0,0 +1,172 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+	keepertest "github.com/qredo/fusionchain/testutil/keeper"
+	"github.com/qredo/fusionchain/x/identity"
+	idTypes "github.com/qredo/fusionchain/x/identity/types"
+	"github.com/qredo/fusionchain/x/treasury"
+	"github.com/qredo/fusionchain/x/treasury/keeper"
+	"github.com/qredo/fusionchain/x/treasury/types"
+	"github.com/stretchr/testify/require"
+)
+
+// unsignedERC20Transfer builds an unsigned EIP-1559 transaction
+// transferring amount USDT.
+func unsignedERC20Transfer(t *testing.T, amount int64) []byte {
+	t.Helper()
+	usdt := common.HexToAddress("0xdAC17F958D2ee523a2206206994597C13D831ec7")
+	to := common.HexToAddress("0x48c04ed5691981C42154C6167398f95e8f38a7fF")
+	data := append(hexutil.MustDecode("0xa9059cbb"), common.LeftPadBytes(to.Bytes(), 32)...)
+	data = append(data, common.LeftPadBytes(big.NewInt(amount).Bytes(), 32)...)
+	b, err := rlp.EncodeToBytes(&types.DynamicFeeTxWithoutSignature{
+		ChainID:   big.NewInt(1),
+		GasTipCap: big.NewInt(1_000_000_000),
+		GasFeeCap: big.NewInt(30_000_000_000),
+		Gas:       100_000,
+		To:        &usdt,
+		Value:     big.NewInt(0),
+		Data:      data,
+	})
+	require.NoError(t, err)
+	return append([]byte{ethtypes.DynamicFeeTxType}, b...)
+}
+
+func Test_Keeper_SigningRequest(t *testing.T) {
+	metadata, err := cdctypes.NewAnyWithValue(&types.MetadataEthereum{ChainId: 1})
+	require.NoError(t, err)
+	unsupportedChain, err := cdctypes.NewAnyWithValue(&types.MetadataEthereum{ChainId: 424242})
+	require.NoError(t, err)
+
+	key := defaultECDSAKey
+	key.WorkspaceAddr = defaultWs.Address
+	key.KeyringAddr = defaultKr.Address
+	newKey := key
+	newKey.Id = 2
+	newKey.PublicKey = newPublicKey(t)
+
+	keepers := keepertest.NewTest(t)
+	ik := keepers.IdentityKeeper
+	pk := keepers.PolicyKeeper
+	tk := keepers.TreasuryKeeper
+	ctx := keepers.Ctx
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	identity.InitGenesis(ctx, *ik, idTypes.GenesisState{
+		Keyrings:   []idTypes.Keyring{defaultKr},
+		Workspaces: []idTypes.Workspace{defaultWs},
+	})
+	treasury.InitGenesis(ctx, *tk, types.GenesisState{
+		Keys:            []types.Key{key, newKey},
+		SupportedChains: types.DefaultSupportedChains(),
+	})
+
+	wallet, err := types.NewEthereumWalletForChain(&key, types.EVMChainEthereum)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		keyID    uint64
+		tx       []byte
+		metadata *cdctypes.Any
+		wantErr  bool
+	}{
+		{
+			name:     "PASS: ETH transfer",
+			keyID:    key.Id,
+			tx:       unsignedEthTransfer(t, big.NewInt(1_000)),
+			metadata: metadata,
+		},
+		{
+			name:     "PASS: ERC-20 transfer",
+			keyID:    key.Id,
+			tx:       unsignedERC20Transfer(t, 1_000_000),
+			metadata: metadata,
+		},
+		{
+			name:     "FAIL: unknown key",
+			keyID:    3,
+			tx:       unsignedEthTransfer(t, big.NewInt(1_000)),
+			metadata: metadata,
+			wantErr:  true,
+		},
+		{
+			name:     "FAIL: unsupported chain",
+			keyID:    key.Id,
+			tx:       unsignedEthTransfer(t, big.NewInt(1_000)),
+			metadata: unsupportedChain,
+			wantErr:  true,
+		},
+		{
+			name:     "FAIL: malformed transaction",
+			keyID:    key.Id,
+			tx:       []byte{ethtypes.DynamicFeeTxType, 0x01},
+			metadata: metadata,
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := tk.SigningRequest(goCtx, &types.QuerySigningRequestRequest{
+				KeyId:               tt.keyID,
+				WalletType:          types.WalletType_WALLET_TYPE_ETH,
+				UnsignedTransaction: tt.tx,
+				Metadata:            tt.metadata,
+			})
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			transfer, err := wallet.ParseTx(tt.tx, &types.MetadataEthereum{ChainId: 1})
+			require.NoError(t, err)
+			require.Equal(t, &types.QuerySigningRequestResponse{
+				DataForSigning: transfer.DataForSigning,
+				KeyId:          key.Id,
+				KeyType:        types.KeyType_KEY_TYPE_ECDSA_SECP256K1,
+				WalletType:     types.WalletType_WALLET_TYPE_ETH,
+				Address:        wallet.Address(),
+			}, res)
+		})
+	}
+
+	// querying doesn't create any action
+	require.Zero(t, pk.GetActionCount(ctx))
+
+	t.Run("PASS: rotated key", func(t *testing.T) {
+		_, err := keeper.NewMsgServerImpl(*tk).RotateWalletKey(goCtx, types.NewMsgRotateWalletKey("testOwner", key.Id, newKey.Id, 100))
+		require.NoError(t, err)
+		rotated, err := types.NewEthereumWalletForChain(&newKey, types.EVMChainEthereum)
+		require.NoError(t, err)
+
+		tx := unsignedEthTransfer(t, big.NewInt(1_000))
+		res, err := tk.SigningRequest(goCtx, &types.QuerySigningRequestRequest{
+			KeyId:               key.Id,
+			WalletType:          types.WalletType_WALLET_TYPE_ETH,
+			UnsignedTransaction: tx,
+			Metadata:            metadata,
+		})
+		require.NoError(t, err)
+
+		transfer, err := rotated.ParseTx(tx, &types.MetadataEthereum{ChainId: 1})
+		require.NoError(t, err)
+		require.Equal(t, &types.QuerySigningRequestResponse{
+			DataForSigning: transfer.DataForSigning,
+			KeyId:          newKey.Id,
+			KeyType:        types.KeyType_KEY_TYPE_ECDSA_SECP256K1,
+			WalletType:     types.WalletType_WALLET_TYPE_ETH,
+			Address:        rotated.Address(),
+		}, res)
+	})
+}
@@ -1,8 +1,6 @@
 package keeper
 
 import (
-	"strconv"
-
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -26,21 +24,16 @@ func (k Keeper) SetSignRequestCount(ctx sdk.Context, c uint64) {
 	store.Set(byteKey, bz)
 }
 
+// GetSignRequest returns the signature request id, or nil if it doesn't
+// exist. Signature requests are stored by SignatureRequestsRepo.
 func (k Keeper) GetSignRequest(ctx sdk.Context, id uint64) *types.SignRequest {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SignRequestKey))
-	signReqID := strconv.FormatUint(id, 10)
-	bz := store.Get([]byte(signReqID))
-	if bz == nil {
+	signRequest, found := k.SignatureRequestsRepo().Get(ctx, id)
+	if !found {
 		return nil
 	}
-	var signRequest types.SignRequest
-	k.cdc.MustUnmarshal(bz, &signRequest)
-	return &signRequest
+	return signRequest
 }
 
 func (k Keeper) SetSignRequest(ctx sdk.Context, signRequest *types.SignRequest) {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SignRequestKey))
-	newValue := k.cdc.MustMarshal(signRequest)
-	signReqID := strconv.FormatUint(signRequest.Id, 10)
-	store.Set([]byte(signReqID), newValue)
+	k.SignatureRequestsRepo().Set(ctx, signRequest)
 }
@@ -0,0 +1,81 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	identitytypes "github.com/qredo/fusionchain/x/identity/types"
+	"github.com/qredo/fusionchain/x/treasury/types"
+)
+
+// ExportWalletPolicies returns the bundle of the policies of the wallet of
+// the key with the given ID, i.e. the admin and sign policies of the
+// workspace of the key, see types.WalletPolicyBundle. Policies that aren't
+// set are omitted. The bundle is unsigned: it must be signed with
+// types.SignWalletPolicyBundle to be imported with MsgImportWalletPolicies.
+func (k Keeper) ExportWalletPolicies(ctx sdk.Context, walletID uint64) ([]byte, error) {
+	ws, err := k.walletWorkspace(ctx, walletID)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []types.WalletPolicyEntry
+	for _, role := range []struct {
+		name string
+		id   uint64
+	}{
+		{types.PolicyRoleAdmin, ws.AdminPolicyId},
+		{types.PolicyRoleSign, ws.SignPolicyId},
+	} {
+		if role.id == 0 {
+			continue
+		}
+		name, b, err := k.policyKeeper.ExportPolicy(ctx, role.id)
+		if err != nil {
+			return nil, fmt.Errorf("exporting %s policy: %w", role.name, err)
+		}
+		entries = append(entries, types.WalletPolicyEntry{Role: role.name, Name: name, Policy: b})
+	}
+	return types.NewWalletPolicyBundle(entries)
+}
+
+// importWorkspacePolicies creates the policies of a parsed bundle and sets
+// them as the policies of ws. As the policies of a wallet are the ones of the
+// workspace of its key, this replaces the policies of all the wallets of the
+// workspace. Each policy is validated by the ImportPolicy of the policy
+// keeper, and nothing is changed if any of them is invalid. The roles missing from the
+// bundle keep their current policy.
+func (k Keeper) importWorkspacePolicies(ctx sdk.Context, ws *identitytypes.Workspace, bundle *types.WalletPolicyBundle) error {
+	cacheCtx, write := ctx.CacheContext()
+	for _, entry := range bundle.Policies {
+		id, err := k.policyKeeper.ImportPolicy(cacheCtx, entry.Name, entry.Policy)
+		if err != nil {
+			return fmt.Errorf("importing %s policy: %w", entry.Role, err)
+		}
+		switch entry.Role {
+		case types.PolicyRoleAdmin:
+			ws.AdminPolicyId = id
+		case types.PolicyRoleSign:
+			ws.SignPolicyId = id
+		}
+	}
+	write()
+	k.identityKeeper.SetWorkspace(ctx, ws)
+	return nil
+}
+
+// walletWorkspace returns the workspace of the key with the given ID.
+func (k Keeper) walletWorkspace(ctx sdk.Context, walletID uint64) (*identitytypes.Workspace, error) {
+	key, found := k.GetKey(ctx, walletID)
+	if !found {
+		return nil, fmt.Errorf("key %d not found", walletID)
+	}
+	ws := k.identityKeeper.GetWorkspace(ctx, key.WorkspaceAddr)
+	if ws == nil {
+		return nil, fmt.Errorf("workspace not found")
+	}
+	return ws, nil
+}
@@ -0,0 +1,248 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package keeper_test
+
+import (
+	"crypto/ecdsa"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	keepertest "github.com/qredo/fusionchain/testutil/keeper"
+	"github.com/qredo/fusionchain/x/identity"
+	idTypes "github.com/qredo/fusionchain/x/identity/types"
+	policytypes "github.com/qredo/fusionchain/x/policy/types"
+	"github.com/qredo/fusionchain/x/treasury"
+	"github.com/qredo/fusionchain/x/treasury/keeper"
+	"github.com/qredo/fusionchain/x/treasury/types"
+	"github.com/stretchr/testify/require"
+)
+
+// signBundle signs an exported bundle with key.
+func signBundle(t *testing.T, b []byte, key *ecdsa.PrivateKey) []byte {
+	t.Helper()
+	signed, err := types.SignWalletPolicyBundle(b, key)
+	require.NoError(t, err)
+	return signed
+}
+
+func Test_Keeper_ExportImportWalletPolicies(t *testing.T) {
+	participants := []*policytypes.PolicyParticipant{
+		{Abbreviation: "a", Address: "qredo1a"},
+		{Abbreviation: "b", Address: "qredo1b"},
+	}
+	key := defaultECDSAKey
+	key.WorkspaceAddr = defaultWs.Address
+	key.KeyringAddr = defaultKr.Address
+	operator, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	// newWallet returns keepers with the key in a workspace with the given
+	// policies
+	newWallet := func(policies ...policytypes.Policy) *keepertest.KeeperTest {
+		keepers := keepertest.NewTest(t)
+		ws := defaultWs
+		for i, p := range policies {
+			id := keepers.PolicyKeeper.PolicyRepo().Append(keepers.Ctx, &p)
+			if i == 0 {
+				ws.AdminPolicyId = id
+			} else {
+				ws.SignPolicyId = id
+			}
+		}
+		identity.InitGenesis(keepers.Ctx, *keepers.IdentityKeeper, idTypes.GenesisState{
+			Keyrings:   []idTypes.Keyring{defaultKr},
+			Workspaces: []idTypes.Workspace{ws},
+		})
+		treasury.InitGenesis(keepers.Ctx, *keepers.TreasuryKeeper, types.GenesisState{
+			Keys:            []types.Key{key},
+			SupportedChains: types.DefaultSupportedChains(),
+		})
+		return keepers
+	}
+	wrap := func(name string, p proto.Message) policytypes.Policy {
+		wrapped, err := cdctypes.NewAnyWithValue(p)
+		require.NoError(t, err)
+		return policytypes.Policy{Name: name, Policy: wrapped}
+	}
+	admin := wrap("admins", &policytypes.BoolparserPolicy{Definition: "a + b > 1", Participants: participants})
+	sign := wrap("internal only", &policytypes.InternalOnlyPolicy{Participants: participants})
+
+	source := newWallet(admin, sign)
+	bundle, err := source.TreasuryKeeper.ExportWalletPolicies(source.Ctx, key.Id)
+	require.NoError(t, err)
+
+	signed := signBundle(t, bundle, operator)
+	signer := crypto.CompressPubkey(&operator.PublicKey)
+
+	// the import waits for the approval of the admin policy of the target
+	// workspace
+	pending := newWallet(wrap("owners", &policytypes.BoolparserPolicy{
+		Definition: "t1 + t2 > 1",
+		Participants: []*policytypes.PolicyParticipant{
+			{Abbreviation: "t1", Address: "testOwner"},
+			{Abbreviation: "t2", Address: "otherOwner"},
+		},
+	}))
+	_, err = keeper.NewMsgServerImpl(*pending.TreasuryKeeper).ImportWalletPolicies(sdk.WrapSDKContext(pending.Ctx),
+		types.NewMsgImportWalletPolicies("testOwner", defaultWs.Address, signed, signer, 100))
+	require.NoError(t, err)
+	ws := pending.IdentityKeeper.GetWorkspace(pending.Ctx, defaultWs.Address)
+	require.Equal(t, uint64(1), ws.AdminPolicyId)
+	require.Zero(t, ws.SignPolicyId)
+	require.Equal(t, uint64(1), pending.PolicyKeeper.PolicyRepo().GetCount(pending.Ctx))
+
+	target := newWallet()
+	_, err = keeper.NewMsgServerImpl(*target.TreasuryKeeper).ImportWalletPolicies(sdk.WrapSDKContext(target.Ctx),
+		types.NewMsgImportWalletPolicies("testOwner", defaultWs.Address, signed, signer, 100))
+	require.NoError(t, err)
+
+	ws = target.IdentityKeeper.GetWorkspace(target.Ctx, defaultWs.Address)
+	require.NotZero(t, ws.AdminPolicyId)
+	require.NotZero(t, ws.SignPolicyId)
+	for _, tt := range []struct {
+		id   uint64
+		want policytypes.Policy
+	}{
+		{ws.AdminPolicyId, admin},
+		{ws.SignPolicyId, sign},
+	} {
+		got, found := target.PolicyKeeper.PolicyRepo().Get(target.Ctx, tt.id)
+		require.True(t, found)
+		require.Equal(t, tt.want.Name, got.Name)
+		require.Equal(t, tt.want.Policy.TypeUrl, got.Policy.TypeUrl)
+		require.Equal(t, tt.want.Policy.Value, got.Policy.Value)
+	}
+
+	// exporting the imported policies gives the same bundle
+	reexported, err := target.TreasuryKeeper.ExportWalletPolicies(target.Ctx, key.Id)
+	require.NoError(t, err)
+	require.Equal(t, bundle, reexported)
+}
+
+func Test_Keeper_ImportWalletPolicies_Invalid(t *testing.T) {
+	keepers := keepertest.NewTest(t)
+	identity.InitGenesis(keepers.Ctx, *keepers.IdentityKeeper, idTypes.GenesisState{
+		Keyrings:   []idTypes.Keyring{defaultKr},
+		Workspaces: []idTypes.Workspace{defaultWs},
+	})
+	keepers.PolicyKeeper.SetParams(keepers.Ctx, policytypes.NewParams(1))
+	goCtx := sdk.WrapSDKContext(keepers.Ctx)
+	msgSer := keeper.NewMsgServerImpl(*keepers.TreasuryKeeper)
+
+	operator, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	signer := crypto.CompressPubkey(&operator.PublicKey)
+	other, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	valid, err := cdctypes.NewAnyWithValue(&policytypes.BoolparserPolicy{Definition: "a > 0", Participants: []*policytypes.PolicyParticipant{{Abbreviation: "a", Address: "qredo1a"}}})
+	require.NoError(t, err)
+	validBytes, err := valid.Marshal()
+	require.NoError(t, err)
+	invalid, err := cdctypes.NewAnyWithValue(&policytypes.InternalOnlyPolicy{})
+	require.NoError(t, err)
+	invalidBytes, err := invalid.Marshal()
+	require.NoError(t, err)
+	// 2 of foo and bar, above the maximum threshold of 1
+	aboveMax, err := cdctypes.NewAnyWithValue(&policytypes.BlackbirdPolicy{
+		Data: hexutil.MustDecode("0x080210021a0708032203666f6f1a0708032203626172"),
+		Participants: []*policytypes.PolicyParticipant{
+			{Abbreviation: "foo", Address: "qredo1a"},
+			{Abbreviation: "bar", Address: "qredo1b"},
+		},
+	})
+	require.NoError(t, err)
+	aboveMaxBytes, err := aboveMax.Marshal()
+	require.NoError(t, err)
+	unsatisfiable, err := cdctypes.NewAnyWithValue(&policytypes.BoolparserPolicy{Definition: "a + b > 1", Participants: []*policytypes.PolicyParticipant{{Abbreviation: "a", Address: "qredo1a"}}})
+	require.NoError(t, err)
+	unsatisfiableBytes, err := unsatisfiable.Marshal()
+	require.NoError(t, err)
+
+	newBundle := func(entries ...types.WalletPolicyEntry) []byte {
+		b, err := types.NewWalletPolicyBundle(entries)
+		require.NoError(t, err)
+		return b
+	}
+	withVersion := func(b []byte, version uint32) []byte {
+		var bundle types.WalletPolicyBundle
+		require.NoError(t, json.Unmarshal(b, &bundle))
+		bundle.Version = version
+		b, err := json.Marshal(bundle)
+		require.NoError(t, err)
+		return b
+	}
+	signEntry := types.WalletPolicyEntry{Role: types.PolicyRoleSign, Name: "sign", Policy: validBytes}
+	signed := signBundle(t, newBundle(signEntry), operator)
+
+	tests := []struct {
+		name      string
+		creator   string
+		workspace string
+		bundle    []byte
+		wantErr   error
+	}{
+		{name: "FAIL: workspace not found", workspace: "otherWorkspace", bundle: signed},
+		{name: "FAIL: creator is not a workspace owner", creator: "notAnOwner", bundle: signed},
+		{name: "FAIL: not JSON", bundle: []byte("policies")},
+		{name: "FAIL: unsigned", bundle: newBundle(signEntry), wantErr: types.ErrPolicyBundleSignature},
+		{name: "FAIL: signed by another key", bundle: signBundle(t, newBundle(signEntry), other), wantErr: types.ErrPolicyBundleSignature},
+		{
+			name:    "FAIL: edited after signing",
+			bundle:  []byte(strings.Replace(string(signed), `"name":"sign"`, `"name":"edited"`, 1)),
+			wantErr: types.ErrPolicyBundleSignature,
+		},
+		{name: "FAIL: version edited after signing", bundle: withVersion(signed, types.PolicyBundleVersion+1), wantErr: types.ErrPolicyBundleVersion},
+		{name: "FAIL: newer version", bundle: signBundle(t, withVersion(signed, types.PolicyBundleVersion+1), operator), wantErr: types.ErrPolicyBundleVersion},
+		{name: "FAIL: checksummed version", bundle: signBundle(t, withVersion(signed, 1), operator), wantErr: types.ErrPolicyBundleVersion},
+		{name: "FAIL: missing version", bundle: signBundle(t, withVersion(signed, 0), operator), wantErr: types.ErrPolicyBundleVersion},
+		{name: "FAIL: unknown role", bundle: signBundle(t, newBundle(types.WalletPolicyEntry{Role: "owner", Policy: validBytes}), operator)},
+		{name: "FAIL: duplicate role", bundle: signBundle(t, newBundle(signEntry, signEntry), operator)},
+		{
+			name:    "FAIL: malformed policy",
+			bundle:  signBundle(t, newBundle(types.WalletPolicyEntry{Role: types.PolicyRoleSign, Policy: []byte{0xff}}), operator),
+			wantErr: policytypes.ErrInvalidPolicyEncoding,
+		},
+		{
+			name:    "FAIL: invalid policy",
+			bundle:  signBundle(t, newBundle(signEntry, types.WalletPolicyEntry{Role: types.PolicyRoleAdmin, Policy: invalidBytes}), operator),
+			wantErr: policytypes.ErrInvalidPolicy,
+		},
+		{
+			name:   "FAIL: threshold above the maximum",
+			bundle: signBundle(t, newBundle(types.WalletPolicyEntry{Role: types.PolicyRoleAdmin, Name: "admins", Policy: aboveMaxBytes}), operator),
+		},
+		{
+			name:   "FAIL: unsatisfiable admin policy",
+			bundle: signBundle(t, newBundle(types.WalletPolicyEntry{Role: types.PolicyRoleAdmin, Name: "admins", Policy: unsatisfiableBytes}), operator),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg := types.NewMsgImportWalletPolicies("testOwner", defaultWs.Address, tt.bundle, signer, 100)
+			if tt.creator != "" {
+				msg.Creator = tt.creator
+			}
+			if tt.workspace != "" {
+				msg.WorkspaceAddr = tt.workspace
+			}
+			_, err := msgSer.ImportWalletPolicies(goCtx, msg)
+			require.Error(t, err)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+			}
+
+			// nothing is imported
+			ws := keepers.IdentityKeeper.GetWorkspace(keepers.Ctx, defaultWs.Address)
+			require.Zero(t, ws.SignPolicyId)
+			require.Zero(t, ws.AdminPolicyId)
+			require.Zero(t, keepers.PolicyKeeper.PolicyRepo().GetCount(keepers.Ctx))
+		})
+	}
+}
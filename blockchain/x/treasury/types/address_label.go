@@ -0,0 +1,66 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package types
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// MetadataToLabel is the key of Transfer.Metadata holding the label of the
+// recipient, if it's a known address.
+const MetadataToLabel = "to_label"
+
+// AddressLabelResolver returns the human readable label of known addresses,
+// e.g. from the labels registered in the treasury genesis. Addresses are in
+// the format of Transfer.To for the chain of the transfer.
+type AddressLabelResolver interface {
+	AddressLabel(addr []byte) (string, bool)
+}
+
+// WithAddressLabels returns a copy of t whose Metadata labels the recipient,
+// if resolver knows it. A nil resolver leaves t unchanged.
+func (t Transfer) WithAddressLabels(resolver AddressLabelResolver) Transfer {
+	if resolver == nil || len(t.To) == 0 {
+		return t
+	}
+	label, ok := resolver.AddressLabel(t.To)
+	if !ok {
+		return t
+	}
+
+	metadata := make(map[string]string, len(t.Metadata)+1)
+	for k, v := range t.Metadata {
+		metadata[k] = v
+	}
+	metadata[MetadataToLabel] = label
+	t.Metadata = metadata
+	return t
+}
+
+// Validate returns an error if the address or the label is missing.
+func (l AddressLabel) Validate() error {
+	if len(l.Address) == 0 {
+		return fmt.Errorf("missing address")
+	}
+	if l.Label == "" {
+		return fmt.Errorf("address %x: missing label", l.Address)
+	}
+	return nil
+}
+
+// ValidateAddressLabels validates every label, returning an error if the
+// same address is labelled more than once.
+func ValidateAddressLabels(labels []AddressLabel) error {
+	for i, l := range labels {
+		if err := l.Validate(); err != nil {
+			return err
+		}
+		for _, other := range labels[:i] {
+			if bytes.Equal(other.Address, l.Address) {
+				return fmt.Errorf("duplicate label for address %x", l.Address)
+			}
+		}
+	}
+	return nil
+}
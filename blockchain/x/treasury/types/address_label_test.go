@@ -0,0 +1,64 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+type addressLabelMap map[string]string
+
+func (m addressLabelMap) AddressLabel(addr []byte) (string, bool) {
+	label, ok := m[string(addr)]
+	return label, ok
+}
+
+func Test_Transfer_WithAddressLabels(t *testing.T) {
+	exchange := common.HexToAddress("0x71660c4005BA85c37ccec55d0C4493E66Fe775d3")
+	unknown := common.HexToAddress("0x48c04ed5691981C42154C6167398f95e8f38a7fF")
+	resolver := addressLabelMap{string(exchange.Bytes()): "Coinbase hot wallet"}
+
+	tests := []struct {
+		name     string
+		to       []byte
+		resolver AddressLabelResolver
+		want     map[string]string
+	}{
+		{name: "labelled", to: exchange.Bytes(), resolver: resolver, want: map[string]string{MetadataToLabel: "Coinbase hot wallet"}},
+		{name: "unlabelled", to: unknown.Bytes(), resolver: resolver, want: nil},
+		{name: "no recipient", to: nil, resolver: resolver, want: nil},
+		{name: "no resolver", to: exchange.Bytes(), resolver: nil, want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transfer := Transfer{To: tt.to, Amount: big.NewInt(1)}
+			require.Equal(t, tt.want, transfer.WithAddressLabels(tt.resolver).Metadata)
+		})
+	}
+}
+
+func Test_Transfer_WithAddressLabels_CopiesMetadata(t *testing.T) {
+	exchange := common.HexToAddress("0x71660c4005BA85c37ccec55d0C4493E66Fe775d3")
+	metadata := map[string]string{"memo": "payout"}
+	transfer := Transfer{To: exchange.Bytes(), Metadata: metadata}
+
+	labelled := transfer.WithAddressLabels(addressLabelMap{string(exchange.Bytes()): "Coinbase hot wallet"})
+	require.Equal(t, map[string]string{"memo": "payout", MetadataToLabel: "Coinbase hot wallet"}, labelled.Metadata)
+	require.Equal(t, map[string]string{"memo": "payout"}, metadata)
+}
+
+func Test_ValidateAddressLabels(t *testing.T) {
+	a := common.HexToAddress("0x71660c4005BA85c37ccec55d0C4493E66Fe775d3").Bytes()
+	b := common.HexToAddress("0x48c04ed5691981C42154C6167398f95e8f38a7fF").Bytes()
+
+	require.NoError(t, ValidateAddressLabels(nil))
+	require.NoError(t, ValidateAddressLabels([]AddressLabel{{Address: a, Label: "exchange"}, {Address: b, Label: "treasury"}}))
+	require.Error(t, ValidateAddressLabels([]AddressLabel{{Address: a, Label: "exchange"}, {Address: a, Label: "treasury"}}))
+	require.Error(t, ValidateAddressLabels([]AddressLabel{{Label: "exchange"}}))
+	require.Error(t, ValidateAddressLabels([]AddressLabel{{Address: a}}))
+}
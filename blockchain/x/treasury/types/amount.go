@@ -0,0 +1,86 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package types
+
+import (
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// CoinInfo contains the information needed to display amounts of a coin.
+type CoinInfo struct {
+	// Ticker is the ticker of the coin (e.g. "USDC").
+	Ticker string
+
+	// Decimals is the number of decimals of the coin, i.e. one unit of the
+	// coin is 10^Decimals base units.
+	Decimals uint8
+}
+
+// knownCoins maps the serialized form of common coin identifiers to their
+// display information.
+var knownCoins = map[string]CoinInfo{
+	NativeCoin("BTC").String():          {Ticker: "BTC", Decimals: 8},
+	NativeCoin(ethereumSymbol).String(): {Ticker: "ETH", Decimals: 18},
+	TokenCoin(ethereumSymbol, common.HexToAddress("0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48").Bytes()).String(): {Ticker: "USDC", Decimals: 6},
+	TokenCoin(ethereumSymbol, common.HexToAddress("0xdAC17F958D2ee523a2206206994597C13D831ec7").Bytes()).String(): {Ticker: "USDT", Decimals: 6},
+}
+
+// LookupCoinInfo returns the display information of a coin given its
+// serialized CoinIdentifier, if the coin is known.
+func LookupCoinInfo(coinIdentifier []byte) (CoinInfo, bool) {
+	coin, err := ParseCoinIdentifier(coinIdentifier)
+	if err != nil {
+		return CoinInfo{}, false
+	}
+	info, found := knownCoins[coin.String()]
+	return info, found
+}
+
+// FormatAmount returns the exact decimal representation of the amount,
+// converted to human units using the given number of decimals of the coin.
+// Trailing zeros of the fractional part are omitted, e.g. 1500000 with 6
+// decimals is formatted as "1.5".
+func (t Transfer) FormatAmount(decimals uint8) string {
+	if t.Amount == nil {
+		return "0"
+	}
+
+	digits := new(big.Int).Abs(t.Amount).String()
+	if len(digits) <= int(decimals) {
+		digits = strings.Repeat("0", int(decimals)-len(digits)+1) + digits
+	}
+	integer := digits[:len(digits)-int(decimals)]
+	fraction := strings.TrimRight(digits[len(digits)-int(decimals):], "0")
+
+	var sb strings.Builder
+	if t.Amount.Sign() < 0 {
+		sb.WriteString("-")
+	}
+	sb.WriteString(integer)
+	if len(fraction) > 0 {
+		sb.WriteString(".")
+		sb.WriteString(fraction)
+	}
+	return sb.String()
+}
+
+// DisplayAmount returns the amount in human units followed by the ticker of
+// the coin (e.g. "1.5 ETH"), using Token if set and LookupCoinInfo
+// otherwise. If the coin is unknown, the amount is returned in base units
+// followed by the serialized coin identifier.
+func (t Transfer) DisplayAmount() string {
+	if t.Token != nil {
+		return t.FormatAmount(t.Token.Decimals) + " " + t.Token.Ticker
+	}
+	if info, found := LookupCoinInfo(t.CoinIdentifier); found {
+		return t.FormatAmount(info.Decimals) + " " + info.Ticker
+	}
+	amount := t.FormatAmount(0)
+	if len(t.CoinIdentifier) == 0 {
+		return amount
+	}
+	return amount + " " + string(t.CoinIdentifier)
+}
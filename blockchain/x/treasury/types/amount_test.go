@@ -0,0 +1,82 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Transfer_FormatAmount(t *testing.T) {
+	tests := []struct {
+		name     string
+		amount   *big.Int
+		decimals uint8
+		want     string
+	}{
+		{name: "1.5 ETH", amount: big.NewInt(1_500_000_000_000_000_000), decimals: 18, want: "1.5"},
+		{name: "1 wei", amount: big.NewInt(1), decimals: 18, want: "0.000000000000000001"},
+		{name: "25 USDC", amount: big.NewInt(25_000_000), decimals: 6, want: "25"},
+		{name: "USDC with trailing zeros", amount: big.NewInt(1_230_500), decimals: 6, want: "1.2305"},
+		{name: "sub-cent USDC", amount: big.NewInt(4_000), decimals: 6, want: "0.004"},
+		{name: "1 BTC", amount: big.NewInt(100_000_000), decimals: 8, want: "1"},
+		{name: "BTC with trailing zeros", amount: big.NewInt(1_050_000_000), decimals: 8, want: "10.5"},
+		{name: "1 satoshi", amount: big.NewInt(1), decimals: 8, want: "0.00000001"},
+		{name: "no decimals", amount: big.NewInt(1_000), decimals: 0, want: "1000"},
+		{name: "zero", amount: big.NewInt(0), decimals: 18, want: "0"},
+		{name: "nil", amount: nil, decimals: 18, want: "0"},
+		{name: "negative", amount: big.NewInt(-1_500_000), decimals: 6, want: "-1.5"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, Transfer{Amount: tt.amount}.FormatAmount(tt.decimals))
+		})
+	}
+}
+
+func Test_Transfer_DisplayAmount(t *testing.T) {
+	usdc := common.HexToAddress("0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48")
+	unknown := common.HexToAddress("0x1f9840a85d5aF5bf1D1762F925BDADdC4201F984")
+
+	tests := []struct {
+		name     string
+		transfer Transfer
+		want     string
+	}{
+		{
+			name:     "ETH",
+			transfer: Transfer{Amount: big.NewInt(1_500_000_000_000_000_000), CoinIdentifier: NativeCoin("ETH").Bytes()},
+			want:     "1.5 ETH",
+		},
+		{
+			name:     "USDC",
+			transfer: Transfer{Amount: big.NewInt(25_000_000), CoinIdentifier: TokenCoin("ETH", usdc.Bytes()).Bytes()},
+			want:     "25 USDC",
+		},
+		{
+			name:     "BTC",
+			transfer: Transfer{Amount: big.NewInt(150_000_000), CoinIdentifier: NativeCoin("BTC").Bytes()},
+			want:     "1.5 BTC",
+		},
+		{
+			name:     "unknown token",
+			transfer: Transfer{Amount: big.NewInt(1_000), CoinIdentifier: TokenCoin("ETH", unknown.Bytes()).Bytes()},
+			want:     "1000 ETH/0x1f9840a85d5af5bf1d1762f925bdaddc4201f984",
+		},
+		{
+			name:     "no coin identifier",
+			transfer: Transfer{Amount: big.NewInt(1_000)},
+			want:     "1000",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, tt.transfer.DisplayAmount())
+		})
+	}
+}
@@ -15,6 +15,9 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgNewSignatureRequest{}, "treasury/NewSignatureRequest", nil)
 	cdc.RegisterConcrete(&MsgFulfilSignatureRequest{}, "treasury/FulfilSignatureRequest", nil)
 	cdc.RegisterConcrete(&MsgNewSignTransactionRequest{}, "treasury/MsgNewSignTransactionRequest", nil)
+	cdc.RegisterConcrete(&MsgRotateWalletKey{}, "treasury/RotateWalletKey", nil)
+	cdc.RegisterConcrete(&MsgResetWalletNonce{}, "treasury/ResetWalletNonce", nil)
+	cdc.RegisterConcrete(&MsgImportWalletPolicies{}, "treasury/ImportWalletPolicies", nil)
 	// this line is used by starport scaffolding # 2
 }
 
@@ -36,6 +39,15 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgNewSignTransactionRequest{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgRotateWalletKey{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgResetWalletNonce{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgImportWalletPolicies{},
+	)
 	// this line is used by starport scaffolding # 3
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
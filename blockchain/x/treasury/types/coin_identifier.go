@@ -0,0 +1,138 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package types
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// CoinIdentifier uniquely identifies a coin being transferred on a Layer 1
+// blockchain, either the native currency of the chain or a token.
+//
+// Its serialized form, used for Transfer.CoinIdentifier, is:
+//
+//	<symbol>[/<contract>]
+//
+// where symbol is the ticker of the native currency of the chain (e.g. "ETH")
+// and contract is the 0x-prefixed hex encoding of the token contract address.
+// The contract is omitted for the native currency. The identifier is checked
+// by the policies, so it doesn't carry the display information of the token
+// (see Transfer.Token).
+//
+// Cosmos chains don't have token contracts, the contract of their tokens is
+// the denom (e.g. "ibc/27394…"). Tokens sent over IBC are identified by the
+// source channel and the denom, see IBCCoin.
+//
+// ERC-721 tokens use the "ERC721" symbol instead of the native currency, see
+// ERC721Coin. ERC-1155 tokens use the "ERC1155" symbol, and the third
+// segment is the decimal id of the token:
+//
+//	ERC1155/<contract>/<token id>
+//
+// see ERC1155Coin.
+type CoinIdentifier struct {
+	// Symbol is the ticker of the native currency of the chain.
+	Symbol string
+
+	// Contract is the address of the token contract, or nil for the native
+	// currency.
+	Contract []byte
+
+	// TokenID is the id of an ERC-1155 token within its contract, nil for
+	// the other coins.
+	TokenID *big.Int
+}
+
+const coinIdentifierSeparator = "/"
+
+// NativeCoin returns the identifier of the native currency of a chain.
+func NativeCoin(symbol string) CoinIdentifier {
+	return CoinIdentifier{Symbol: symbol}
+}
+
+// TokenCoin returns the identifier of a token deployed at the contract
+// address on the chain whose native currency is symbol.
+func TokenCoin(symbol string, contract []byte) CoinIdentifier {
+	return CoinIdentifier{Symbol: symbol, Contract: contract}
+}
+
+// ibcChannelSeparator separates the source channel from the denom in the
+// contract of IBCCoin identifiers.
+const ibcChannelSeparator = "|"
+
+// IBCCoin returns the identifier of the tokens with the given denom sent
+// over an IBC channel, from the chain whose native currency is symbol. Its
+// contract is "<channel>|<denom>".
+func IBCCoin(symbol, channel, denom string) CoinIdentifier {
+	return CoinIdentifier{Symbol: symbol, Contract: []byte(channel + ibcChannelSeparator + denom)}
+}
+
+// IBCChannel returns the source channel and denom of an identifier created
+// by IBCCoin.
+func (c CoinIdentifier) IBCChannel() (channel, denom string, ok bool) {
+	return strings.Cut(string(c.Contract), ibcChannelSeparator)
+}
+
+// IsNative returns true if the identifier refers to the native currency of
+// the chain.
+func (c CoinIdentifier) IsNative() bool {
+	return len(c.Contract) == 0
+}
+
+// String returns the serialized form of the identifier.
+func (c CoinIdentifier) String() string {
+	if c.IsNative() {
+		return c.Symbol
+	}
+	s := c.Symbol + coinIdentifierSeparator + hexutil.Encode(c.Contract)
+	if c.TokenID != nil {
+		return s + coinIdentifierSeparator + c.TokenID.String()
+	}
+	return s
+}
+
+// Bytes returns the serialized form of the identifier, as used in
+// Transfer.CoinIdentifier.
+func (c CoinIdentifier) Bytes() []byte {
+	return []byte(c.String())
+}
+
+// ParseCoinIdentifier parses the serialized form of a CoinIdentifier.
+func ParseCoinIdentifier(b []byte) (CoinIdentifier, error) {
+	parts := strings.Split(string(b), coinIdentifierSeparator)
+	if len(parts) > 3 {
+		return CoinIdentifier{}, fmt.Errorf("invalid coin identifier %q: too many segments", b)
+	}
+	if len(parts[0]) == 0 {
+		return CoinIdentifier{}, fmt.Errorf("invalid coin identifier %q: empty symbol", b)
+	}
+	if len(parts) == 1 {
+		return NativeCoin(parts[0]), nil
+	}
+
+	contract, err := hexutil.Decode(parts[1])
+	if err != nil {
+		return CoinIdentifier{}, fmt.Errorf("invalid coin identifier %q: contract: %w", b, err)
+	}
+	if len(contract) == 0 {
+		return CoinIdentifier{}, fmt.Errorf("invalid coin identifier %q: empty contract", b)
+	}
+	coin := TokenCoin(parts[0], contract)
+	if len(parts) == 2 {
+		return coin, nil
+	}
+
+	if parts[0] != erc1155Symbol {
+		return CoinIdentifier{}, fmt.Errorf("invalid coin identifier %q: too many segments", b)
+	}
+	tokenID, ok := new(big.Int).SetString(parts[2], 10)
+	if !ok || tokenID.Sign() < 0 {
+		return CoinIdentifier{}, fmt.Errorf("invalid coin identifier %q: invalid token id", b)
+	}
+	coin.TokenID = tokenID
+	return coin, nil
+}
@@ -0,0 +1,76 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CoinIdentifier_RoundTrip(t *testing.T) {
+	tests := []struct {
+		name string
+		id   CoinIdentifier
+		want string
+	}{
+		{
+			name: "native",
+			id:   NativeCoin("ETH"),
+			want: "ETH",
+		},
+		{
+			name: "token",
+			id:   TokenCoin("ETH", hexutil.MustDecode("0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48")),
+			want: "ETH/0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48",
+		},
+		{
+			// contract bytes that would clash with the separator if they
+			// weren't encoded
+			name: "token with separator byte in contract",
+			id:   TokenCoin("BTC", []byte("a/b")),
+			want: "BTC/0x612f62",
+		},
+		{
+			name: "ERC-1155 token",
+			id:   ERC1155Coin(common.HexToAddress("0x76be3b62873462d2142405439777e971754e8e77"), big.NewInt(10_324)),
+			want: "ERC1155/0x76be3b62873462d2142405439777e971754e8e77/10324",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, string(tt.id.Bytes()))
+
+			parsed, err := ParseCoinIdentifier(tt.id.Bytes())
+			require.NoError(t, err)
+			require.Equal(t, tt.id, parsed)
+		})
+	}
+}
+
+func Test_ParseCoinIdentifier_Invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		b    string
+	}{
+		{name: "empty", b: ""},
+		{name: "empty symbol", b: "/0x01"},
+		{name: "empty contract", b: "ETH/"},
+		{name: "contract not hex", b: "ETH/abcd"},
+		{name: "token display information", b: "ETH/0x01/USDC:6"},
+		{name: "too many segments", b: "ERC1155/0x01/1/0x02"},
+		{name: "ERC-1155 token id not a number", b: "ERC1155/0x01/USDC:6"},
+		{name: "negative ERC-1155 token id", b: "ERC1155/0x01/-1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseCoinIdentifier([]byte(tt.b))
+			require.Error(t, err)
+		})
+	}
+}
@@ -23,5 +23,6 @@ type BankKeeper interface {
 
 type IdentityKeeper interface {
 	GetWorkspace(ctx sdk.Context, addr string) *identitytypes.Workspace
+	SetWorkspace(ctx sdk.Context, workspace *identitytypes.Workspace)
 	GetKeyring(ctx sdk.Context, addr string) *identitytypes.Keyring
 }
@@ -11,7 +11,8 @@ const DefaultIndex uint64 = 1
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
 		// this line is used by starport scaffolding # genesis/types/default
-		Params: DefaultParams(),
+		Params:          DefaultParams(),
+		SupportedChains: DefaultSupportedChains(),
 	}
 }
 
@@ -19,6 +20,12 @@ func DefaultGenesis() *GenesisState {
 // failure.
 func (gs GenesisState) Validate() error {
 	// this line is used by starport scaffolding # genesis/types/validate
+	if err := ValidateSupportedChains(gs.SupportedChains); err != nil {
+		return err
+	}
+	if err := ValidateAddressLabels(gs.AddressLabels); err != nil {
+		return err
+	}
 
 	return gs.Params.Validate()
 }
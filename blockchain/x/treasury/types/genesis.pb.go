@@ -29,6 +29,11 @@ type GenesisState struct {
 	Keys         []Key         `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys"`
 	KeyRequests  []KeyRequest  `protobuf:"bytes,3,rep,name=key_requests,json=keyRequests,proto3" json:"key_requests"`
 	SignRequests []SignRequest `protobuf:"bytes,4,rep,name=sign_requests,json=signRequests,proto3" json:"sign_requests"`
+	// EVM chains Ethereum wallets can be used on. Transactions for other
+	// chain IDs are rejected.
+	SupportedChains []SupportedChain `protobuf:"bytes,5,rep,name=supported_chains,json=supportedChains,proto3" json:"supported_chains"`
+	// Labels of known addresses, shown to the approvers of transfers.
+	AddressLabels []AddressLabel `protobuf:"bytes,6,rep,name=address_labels,json=addressLabels,proto3" json:"address_labels"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -92,6 +97,20 @@ func (m *GenesisState) GetSignRequests() []SignRequest {
 	return nil
 }
 
+func (m *GenesisState) GetSupportedChains() []SupportedChain {
+	if m != nil {
+		return m.SupportedChains
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAddressLabels() []AddressLabel {
+	if m != nil {
+		return m.AddressLabels
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "fusionchain.treasury.GenesisState")
 }
@@ -101,26 +120,31 @@ func init() {
 }
 
 var fileDescriptor_acebfe64ea42de8c = []byte{
-	// 302 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x90, 0xb1, 0x6e, 0xea, 0x30,
-	0x14, 0x86, 0x13, 0x40, 0x0c, 0x86, 0xbb, 0x44, 0x0c, 0x5c, 0x54, 0xb9, 0xc0, 0xc4, 0xd2, 0x44,
-	0x82, 0xad, 0x23, 0x0b, 0xaa, 0xda, 0xa1, 0x82, 0xad, 0x4b, 0x15, 0xd2, 0x53, 0x63, 0x45, 0x89,
-	0x83, 0x8f, 0x23, 0xd5, 0x6f, 0xd1, 0xb1, 0x8f, 0xc4, 0xc8, 0xd8, 0xa9, 0xaa, 0x92, 0x17, 0xa9,
-	0x70, 0xd2, 0x86, 0x4a, 0xee, 0x66, 0x1d, 0x7f, 0xff, 0xe7, 0xe3, 0x9f, 0x4c, 0x9f, 0x73, 0xe4,
-	0x22, 0x8d, 0x76, 0x21, 0x4f, 0x03, 0x25, 0x21, 0xc4, 0x5c, 0xea, 0x80, 0x41, 0x0a, 0xc8, 0xd1,
-	0xcf, 0xa4, 0x50, 0xc2, 0x1b, 0x9c, 0x31, 0xfe, 0x37, 0x33, 0x1a, 0x30, 0xc1, 0x84, 0x01, 0x82,
-	0xd3, 0xa9, 0x62, 0x47, 0x13, 0xab, 0x2f, 0x0b, 0x65, 0x98, 0xd4, 0xba, 0x11, 0xb5, 0x22, 0x31,
-	0xe8, 0xfa, 0xde, 0xbe, 0x52, 0x92, 0x45, 0xc8, 0x59, 0x5a, 0x31, 0xd3, 0xb7, 0x16, 0xe9, 0xaf,
-	0xaa, 0x25, 0x37, 0x2a, 0x54, 0xe0, 0x5d, 0x93, 0x6e, 0xf5, 0xc8, 0xd0, 0x1d, 0xbb, 0xb3, 0xde,
-	0xfc, 0xc2, 0xb7, 0x2d, 0xed, 0xdf, 0x1b, 0x66, 0xd9, 0x39, 0x7c, 0x5c, 0x3a, 0xeb, 0x3a, 0xe1,
-	0x2d, 0x48, 0x27, 0x06, 0x8d, 0xc3, 0xd6, 0xb8, 0x3d, 0xeb, 0xcd, 0xff, 0xdb, 0x93, 0xb7, 0xa0,
-	0xeb, 0x98, 0x81, 0xbd, 0x1b, 0xd2, 0x8f, 0x41, 0x3f, 0x4a, 0xd8, 0xe7, 0x80, 0x0a, 0x87, 0x6d,
-	0x13, 0x1e, 0xff, 0x19, 0x5e, 0x57, 0x60, 0xed, 0xe8, 0xc5, 0x3f, 0x13, 0xf4, 0xee, 0xc8, 0xbf,
-	0xd3, 0xd7, 0x1a, 0x57, 0xc7, 0xb8, 0x26, 0x76, 0xd7, 0x86, 0xb3, 0xf4, 0xb7, 0xac, 0x8f, 0xcd,
-	0x08, 0x97, 0xab, 0x43, 0x41, 0xdd, 0x63, 0x41, 0xdd, 0xcf, 0x82, 0xba, 0xaf, 0x25, 0x75, 0x8e,
-	0x25, 0x75, 0xde, 0x4b, 0xea, 0x3c, 0x5c, 0x31, 0xae, 0x76, 0xf9, 0xd6, 0x8f, 0x44, 0x12, 0xec,
-	0x25, 0x3c, 0x89, 0xe0, 0xbc, 0xe9, 0x97, 0xa6, 0x6b, 0xa5, 0x33, 0xc0, 0x6d, 0xd7, 0x54, 0xbd,
-	0xf8, 0x0a, 0x00, 0x00, 0xff, 0xff, 0x60, 0x94, 0x5d, 0xaa, 0x23, 0x02, 0x00, 0x00,
+	// 377 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xb1, 0x6e, 0xe2, 0x40,
+	0x10, 0x86, 0xed, 0x83, 0xa3, 0x58, 0xe0, 0xee, 0x64, 0x51, 0xf8, 0xd0, 0xc9, 0x67, 0x50, 0x0a,
+	0x9a, 0xd8, 0x12, 0x74, 0xe9, 0x42, 0x0a, 0x14, 0x05, 0x29, 0x11, 0x28, 0x4d, 0x1a, 0xb4, 0xe0,
+	0x8d, 0xb1, 0x0c, 0x5e, 0xb3, 0xb3, 0x96, 0xe2, 0xb7, 0xc8, 0x23, 0xa5, 0xa4, 0xa4, 0x4c, 0x15,
+	0x45, 0xf0, 0x22, 0x91, 0x77, 0x97, 0x00, 0xd2, 0xd2, 0x59, 0xbf, 0xbf, 0xff, 0xd3, 0x78, 0x3c,
+	0xa8, 0xfd, 0x9c, 0x41, 0x44, 0x93, 0xd9, 0x1c, 0x47, 0x89, 0xcf, 0x19, 0xc1, 0x90, 0xb1, 0xdc,
+	0x0f, 0x49, 0x42, 0x20, 0x02, 0x2f, 0x65, 0x94, 0x53, 0xab, 0x71, 0xc4, 0x78, 0x7b, 0xa6, 0xd9,
+	0x08, 0x69, 0x48, 0x05, 0xe0, 0x17, 0x4f, 0x92, 0x6d, 0xb6, 0xb4, 0xbe, 0x14, 0x33, 0xbc, 0x54,
+	0xba, 0xa6, 0xa3, 0x45, 0x62, 0x92, 0xab, 0xf7, 0xfa, 0x91, 0x96, 0xe9, 0x0c, 0xa2, 0x30, 0x51,
+	0x8c, 0xab, 0x65, 0xe4, 0x84, 0x92, 0xe8, 0x68, 0x09, 0x1c, 0x04, 0x8c, 0x00, 0x4c, 0x16, 0x78,
+	0x4a, 0x16, 0x92, 0x6c, 0xbf, 0x95, 0x50, 0x6d, 0x20, 0x3f, 0x78, 0xcc, 0x31, 0x27, 0xd6, 0x15,
+	0xaa, 0xc8, 0x81, 0x6d, 0xd3, 0x35, 0x3b, 0xd5, 0xee, 0x3f, 0x4f, 0xb7, 0x00, 0xef, 0x41, 0x30,
+	0xfd, 0xf2, 0xfa, 0xe3, 0xbf, 0x31, 0x52, 0x0d, 0xab, 0x87, 0xca, 0x31, 0xc9, 0xc1, 0xfe, 0xe1,
+	0x96, 0x3a, 0xd5, 0xee, 0x5f, 0x7d, 0xf3, 0x8e, 0xe4, 0xaa, 0x26, 0x60, 0xeb, 0x16, 0xd5, 0x62,
+	0x92, 0x4f, 0x18, 0x59, 0x65, 0x04, 0x38, 0xd8, 0x25, 0x51, 0x76, 0xcf, 0x96, 0x47, 0x12, 0x54,
+	0x8e, 0x6a, 0xfc, 0x9d, 0x80, 0x35, 0x44, 0xf5, 0x62, 0x4d, 0x07, 0x57, 0x59, 0xb8, 0x5a, 0x7a,
+	0xd7, 0x38, 0x0a, 0x93, 0x53, 0x59, 0x0d, 0x0e, 0x11, 0x58, 0x8f, 0xe8, 0x0f, 0x64, 0x69, 0x4a,
+	0x19, 0x27, 0xc1, 0x44, 0x74, 0xc1, 0xfe, 0x29, 0x84, 0x17, 0x67, 0x84, 0x7b, 0xfa, 0xa6, 0xc8,
+	0x95, 0xf3, 0x37, 0x9c, 0xa4, 0x60, 0xdd, 0xa3, 0x5f, 0x27, 0x3f, 0x02, 0xec, 0x8a, 0x90, 0xb6,
+	0xf5, 0xd2, 0x6b, 0xc9, 0x0e, 0x0b, 0x54, 0x29, 0xeb, 0xf8, 0x28, 0x83, 0xfe, 0x60, 0xbd, 0x75,
+	0xcc, 0xcd, 0xd6, 0x31, 0x3f, 0xb7, 0x8e, 0xf9, 0xba, 0x73, 0x8c, 0xcd, 0xce, 0x31, 0xde, 0x77,
+	0x8e, 0xf1, 0x74, 0x19, 0x46, 0x7c, 0x9e, 0x4d, 0xbd, 0x19, 0x5d, 0xfa, 0x2b, 0x46, 0x02, 0xea,
+	0x1f, 0xdf, 0xc5, 0xcb, 0xe1, 0x32, 0x78, 0x9e, 0x12, 0x98, 0x56, 0xc4, 0x49, 0xf4, 0xbe, 0x06,
+	0x00, 0x52, 0x98, 0x50, 0xac, 0x17, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -143,6 +167,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AddressLabels) > 0 {
+		for iNdEx := len(m.AddressLabels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AddressLabels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.SupportedChains) > 0 {
+		for iNdEx := len(m.SupportedChains) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SupportedChains[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.SignRequests) > 0 {
 		for iNdEx := len(m.SignRequests) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -235,6 +287,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.SupportedChains) > 0 {
+		for _, e := range m.SupportedChains {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AddressLabels) > 0 {
+		for _, e := range m.AddressLabels {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -408,6 +472,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupportedChains", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SupportedChains = append(m.SupportedChains, SupportedChain{})
+			if err := m.SupportedChains[len(m.SupportedChains)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressLabels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddressLabels = append(m.AddressLabels, AddressLabel{})
+			if err := m.AddressLabels[len(m.AddressLabels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -28,6 +28,82 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: true,
 		},
+		{
+			desc: "supported chains",
+			genState: &types.GenesisState{
+				SupportedChains: []types.SupportedChain{
+					{ChainId: 1, Name: "Ethereum", NativeSymbol: "ETH", Decimals: 18},
+					{ChainId: 11155111, Name: "Sepolia", NativeSymbol: "ETH", Decimals: 18},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "address labels",
+			genState: &types.GenesisState{
+				AddressLabels: []types.AddressLabel{
+					{Address: []byte{0x01}, Label: "Coinbase hot wallet"},
+					{Address: []byte{0x02}, Label: "internal treasury"},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicate address label",
+			genState: &types.GenesisState{
+				AddressLabels: []types.AddressLabel{
+					{Address: []byte{0x01}, Label: "Coinbase hot wallet"},
+					{Address: []byte{0x01}, Label: "internal treasury"},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicate chain ID",
+			genState: &types.GenesisState{
+				SupportedChains: []types.SupportedChain{
+					{ChainId: 137, Name: "Polygon", NativeSymbol: "MATIC", Decimals: 18},
+					{ChainId: 137, Name: "Polygon PoS", NativeSymbol: "POL", Decimals: 18},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "chain without ID",
+			genState: &types.GenesisState{
+				SupportedChains: []types.SupportedChain{
+					{Name: "Ethereum", NativeSymbol: "ETH", Decimals: 18},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "chain without native symbol",
+			genState: &types.GenesisState{
+				SupportedChains: []types.SupportedChain{
+					{ChainId: 1, Name: "Ethereum", Decimals: 18},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "native symbol with separator",
+			genState: &types.GenesisState{
+				SupportedChains: []types.SupportedChain{
+					{ChainId: 1, Name: "Ethereum", NativeSymbol: "ETH/1", Decimals: 18},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "decimals out of range",
+			genState: &types.GenesisState{
+				SupportedChains: []types.SupportedChain{
+					{ChainId: 1, Name: "Ethereum", NativeSymbol: "ETH", Decimals: 256},
+				},
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
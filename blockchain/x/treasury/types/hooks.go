@@ -0,0 +1,11 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package types
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+// PreSignHook is a check run right before a signature is requested for a
+// transaction, once the policy of the workspace is satisfied, e.g. risk
+// scoring or sanctions screening. Returning an error aborts the signature
+// request.
+type PreSignHook func(ctx sdk.Context, wallet Wallet, transfer Transfer) error
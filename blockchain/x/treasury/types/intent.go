@@ -0,0 +1,57 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// Intent is a chain agnostic description of the action performed by a
+// transaction, suitable for an activity feed spanning multiple chains.
+type Intent struct {
+	// Chain is the name of the chain the transaction is for.
+	Chain string
+
+	// Action is the kind of action performed by the transaction.
+	Action TxKind
+
+	// From is the sender of the transaction. Transfers don't carry the
+	// sender, so this is left empty by Transfer.ToIntent and can be filled
+	// in by the caller.
+	From string
+
+	// To is the hex encoded recipient of the transfer.
+	To string
+
+	// Amount is the decimal representation of the amount transferred, in
+	// the smallest unit of the coin.
+	Amount string
+
+	// Symbol is the ticker of the native currency of the chain.
+	Symbol string
+
+	// Asset is the serialized CoinIdentifier of the coin transferred.
+	Asset string
+}
+
+// ToIntent converts the transfer into an Intent for the given chain.
+func (t Transfer) ToIntent(chain string) Intent {
+	intent := Intent{
+		Chain:  chain,
+		Action: t.Kind,
+		Asset:  string(t.CoinIdentifier),
+	}
+	if len(intent.Action) == 0 {
+		intent.Action = TxKindTransfer
+	}
+	if len(t.To) > 0 {
+		intent.To = hexutil.Encode(t.To)
+	}
+	if t.Amount != nil {
+		intent.Amount = t.Amount.String()
+	}
+	if coin, err := ParseCoinIdentifier(t.CoinIdentifier); err == nil {
+		intent.Symbol = coin.Symbol
+	}
+	return intent
+}
@@ -0,0 +1,57 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package types
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Transfer_ToIntent(t *testing.T) {
+	wallet := ethereumWallet(t)
+
+	tests := []struct {
+		name string
+		b    []byte
+		want Intent
+	}{
+		{
+			name: "ETH transfer",
+			b:    hexutil.MustDecode("0xeb80843b9aca0082520894ea223ca8968ca59e0bc79ba331c2f6f636a3fb82880de0b6b3a764000080808080"),
+			want: Intent{
+				Chain:  "ethereum",
+				Action: TxKindTransfer,
+				To:     "0xea223ca8968ca59e0bc79ba331c2f6f636a3fb82",
+				Amount: "1000000000000000000",
+				Symbol: "ETH",
+				Asset:  "ETH",
+			},
+		},
+		{
+			name: "ERC-20 transfer",
+			b:    hexutil.MustDecode("0xf8aa80850b68a0aa0083010d6b94a0b86991c6218b36c1d19d4a2e9eb0ce3606eb4880b844a9059cbb00000000000000000000000048c04ed5691981c42154c6167398f95e8f38a7ff00000000000000000000000000000000000000000000000000000000017d784026a01ad4a933da06f76b08a20784661b2ccc55a8f25492bbc6b66d4b84ef97e1db47a01ab2ff0cd0fb01e2990dd4196412baf173484d91c7f836727d554cdf1cd70c64"),
+			want: Intent{
+				Chain:  "ethereum",
+				Action: TxKindTransfer,
+				To:     "0x48c04ed5691981c42154c6167398f95e8f38a7ff",
+				Amount: "25000000",
+				Symbol: "ETH",
+				Asset:  "ETH/0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transfer, err := wallet.ParseTx(tt.b, &MetadataEthereum{ChainId: 1})
+			require.NoError(t, err)
+			require.Equal(t, tt.want, transfer.ToIntent("ethereum"))
+		})
+	}
+}
+
+func Test_Transfer_ToIntent_Empty(t *testing.T) {
+	require.Equal(t, Intent{Chain: "ethereum", Action: TxKindTransfer}, Transfer{}.ToIntent("ethereum"))
+}
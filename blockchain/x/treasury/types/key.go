@@ -18,6 +18,15 @@ func (k *Key) SetId(id uint64) { k.Id = id }
 // revive:disable-next-line var-naming
 func (kr *KeyRequest) SetId(id uint64) { kr.Id = id }
 
+// SigningKeyID returns the ID of the key holding the key material for k,
+// which differs from k's ID after a rotation.
+func (k *Key) SigningKeyID() uint64 {
+	if k.SigningKeyId != 0 {
+		return k.SigningKeyId
+	}
+	return k.Id
+}
+
 // NewMsgUpdateKeyRequestKey is a utility function to generate a new successful
 // UpdateKeyRequest result.
 func NewMsgUpdateKeyRequestKey(publicKey []byte) isMsgUpdateKeyRequest_Result {
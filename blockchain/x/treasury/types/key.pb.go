@@ -192,6 +192,12 @@ type Key struct {
 	KeyringAddr   string  `protobuf:"bytes,3,opt,name=keyring_addr,json=keyringAddr,proto3" json:"keyring_addr,omitempty"`
 	Type          KeyType `protobuf:"varint,4,opt,name=type,proto3,enum=fusionchain.treasury.KeyType" json:"type,omitempty"`
 	PublicKey     []byte  `protobuf:"bytes,5,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	// Public keys used by this key before each rotation, oldest first. They
+	// are kept to derive the addresses used in the past.
+	PreviousPublicKeys [][]byte `protobuf:"bytes,6,rep,name=previous_public_keys,json=previousPublicKeys,proto3" json:"previous_public_keys,omitempty"`
+	// ID of the key whose key material is used for signing, if this key has
+	// been rotated. Zero if the key was never rotated.
+	SigningKeyId uint64 `protobuf:"varint,7,opt,name=signing_key_id,json=signingKeyId,proto3" json:"signing_key_id,omitempty"`
 }
 
 func (m *Key) Reset()         { *m = Key{} }
@@ -262,6 +268,20 @@ func (m *Key) GetPublicKey() []byte {
 	return nil
 }
 
+func (m *Key) GetPreviousPublicKeys() [][]byte {
+	if m != nil {
+		return m.PreviousPublicKeys
+	}
+	return nil
+}
+
+func (m *Key) GetSigningKeyId() uint64 {
+	if m != nil {
+		return m.SigningKeyId
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("fusionchain.treasury.KeyRequestStatus", KeyRequestStatus_name, KeyRequestStatus_value)
 	proto.RegisterEnum("fusionchain.treasury.KeyType", KeyType_name, KeyType_value)
@@ -272,39 +292,41 @@ func init() {
 func init() { proto.RegisterFile("fusionchain/treasury/key.proto", fileDescriptor_c4c8664de1491f4e) }
 
 var fileDescriptor_c4c8664de1491f4e = []byte{
-	// 497 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x4f, 0x8b, 0xd3, 0x40,
-	0x18, 0xc6, 0x3b, 0x69, 0x6d, 0xdd, 0xd7, 0x6e, 0x09, 0xc3, 0x22, 0x61, 0xdd, 0x8d, 0xb5, 0xa2,
-	0x94, 0x05, 0x53, 0x5a, 0xa9, 0xe8, 0x45, 0xa8, 0xcd, 0x74, 0xa9, 0x29, 0xa5, 0x26, 0xe9, 0x61,
-	0x05, 0x09, 0x69, 0x32, 0xee, 0xc6, 0x68, 0x93, 0x9d, 0x24, 0xe8, 0x7c, 0x0b, 0xaf, 0x9e, 0xfc,
-	0x0c, 0x7e, 0x0b, 0x8f, 0x7b, 0xf4, 0x28, 0xed, 0x17, 0x91, 0x4e, 0xbb, 0x7f, 0xd8, 0x56, 0xe8,
-	0x6d, 0xe6, 0x79, 0x7e, 0x2f, 0xbc, 0xcf, 0x03, 0x2f, 0xa8, 0x1f, 0xb3, 0x24, 0x88, 0xa6, 0xde,
-	0x99, 0x1b, 0x4c, 0x1b, 0x29, 0xa3, 0x6e, 0x92, 0x31, 0xde, 0x08, 0x29, 0xd7, 0x62, 0x16, 0xa5,
-	0x11, 0xde, 0xbb, 0xe1, 0x6b, 0x97, 0x7e, 0xed, 0xa7, 0x04, 0x60, 0x50, 0x6e, 0xd2, 0xf3, 0x8c,
-	0x26, 0x29, 0xae, 0x80, 0x14, 0xf8, 0x0a, 0xaa, 0xa2, 0x7a, 0xc1, 0x94, 0x02, 0x1f, 0x2b, 0x50,
-	0xf2, 0x18, 0x75, 0xd3, 0x88, 0x29, 0x52, 0x15, 0xd5, 0x77, 0xcc, 0xcb, 0x2f, 0x7e, 0x02, 0x95,
-	0xaf, 0x11, 0x0b, 0x93, 0xd8, 0xf5, 0xa8, 0xe3, 0xfa, 0x3e, 0x53, 0xf2, 0x02, 0xd8, 0xbd, 0x52,
-	0x3b, 0xbe, 0xcf, 0xf0, 0x23, 0x28, 0x87, 0x94, 0xb3, 0x60, 0x7a, 0xba, 0x84, 0x0a, 0x02, 0xba,
-	0xb7, 0xd2, 0x04, 0xf2, 0x12, 0xee, 0x86, 0x94, 0x3b, 0x29, 0x8f, 0xa9, 0x72, 0xa7, 0x8a, 0xea,
-	0x95, 0xd6, 0xa1, 0xb6, 0x69, 0x57, 0xcd, 0xa0, 0xdc, 0xe6, 0x31, 0x35, 0x4b, 0xe1, 0xf2, 0x81,
-	0x5f, 0x43, 0x31, 0x49, 0xdd, 0x34, 0x4b, 0x94, 0xa2, 0x98, 0x7b, 0xfa, 0xdf, 0xb9, 0x55, 0x3e,
-	0x4b, 0xd0, 0xe6, 0x6a, 0x0a, 0x3f, 0x86, 0x5d, 0x46, 0x3f, 0x51, 0x2f, 0x75, 0x16, 0x68, 0x34,
-	0x55, 0x4a, 0x62, 0xbb, 0xf2, 0x52, 0x34, 0x85, 0x56, 0xfb, 0x85, 0x20, 0x6f, 0x50, 0xbe, 0x56,
-	0xcd, 0x7a, 0x01, 0xd2, 0x36, 0x05, 0xe4, 0xd7, 0x0b, 0x68, 0x42, 0x41, 0x84, 0x2f, 0x6c, 0x13,
-	0x5e, 0xa0, 0xf8, 0x10, 0x20, 0xce, 0x26, 0x9f, 0x03, 0xcf, 0x09, 0x29, 0x17, 0xad, 0x95, 0xcd,
-	0x9d, 0xa5, 0x62, 0x50, 0x7e, 0xf4, 0x03, 0x81, 0x7c, 0x3b, 0x35, 0xae, 0x81, 0x6a, 0x90, 0x13,
-	0xc7, 0x24, 0xef, 0xc6, 0xc4, 0xb2, 0x1d, 0xcb, 0xee, 0xd8, 0x63, 0xcb, 0x19, 0x0f, 0xad, 0x11,
-	0xe9, 0xf6, 0x7b, 0x7d, 0xa2, 0xcb, 0x39, 0xac, 0xc2, 0xfe, 0x06, 0x66, 0x44, 0x86, 0x7a, 0x7f,
-	0x78, 0x2c, 0x23, 0x5c, 0x85, 0x83, 0x0d, 0x7e, 0x6f, 0x3c, 0xe8, 0xf5, 0x07, 0x03, 0xa2, 0xcb,
-	0x12, 0x7e, 0x08, 0x0f, 0x36, 0x10, 0x26, 0x79, 0x4b, 0xba, 0x36, 0xd1, 0xe5, 0xfc, 0xd1, 0x07,
-	0x28, 0xad, 0xb2, 0x60, 0x05, 0xf6, 0x16, 0xac, 0x7d, 0x32, 0x22, 0xb7, 0xf6, 0x38, 0x00, 0xe5,
-	0xca, 0x21, 0x5d, 0xdd, 0xea, 0x38, 0x16, 0xe9, 0x8e, 0x5a, 0xed, 0x17, 0x46, 0x53, 0x46, 0x78,
-	0x1f, 0xee, 0x5f, 0xbb, 0xfa, 0xc2, 0x25, 0x7a, 0xab, 0xdd, 0x6e, 0xbe, 0x92, 0xa5, 0x37, 0xc7,
-	0xbf, 0x67, 0x2a, 0xba, 0x98, 0xa9, 0xe8, 0xef, 0x4c, 0x45, 0xdf, 0xe7, 0x6a, 0xee, 0x62, 0xae,
-	0xe6, 0xfe, 0xcc, 0xd5, 0xdc, 0xfb, 0x67, 0xa7, 0x41, 0x7a, 0x96, 0x4d, 0x34, 0x2f, 0xfa, 0xd2,
-	0x38, 0x67, 0xd4, 0x8f, 0x1a, 0x37, 0x2f, 0xe6, 0xdb, 0xf5, 0xcd, 0x2c, 0x1a, 0x4e, 0x26, 0x45,
-	0x71, 0x36, 0xcf, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0x70, 0xf3, 0xdd, 0x53, 0x58, 0x03, 0x00,
-	0x00,
+	// 539 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x4f, 0x8f, 0xd2, 0x5c,
+	0x14, 0xc6, 0x69, 0xe1, 0x85, 0x77, 0x8e, 0x0c, 0x69, 0x6e, 0x88, 0x69, 0xc6, 0x99, 0x8a, 0xf8,
+	0x27, 0x64, 0x12, 0x41, 0x30, 0x18, 0xdd, 0x98, 0x20, 0xbd, 0x4c, 0xb0, 0x84, 0x60, 0x5b, 0x16,
+	0x63, 0x62, 0x9a, 0xd2, 0x5e, 0x99, 0x5a, 0xa5, 0x9d, 0xdb, 0x56, 0xbd, 0x1f, 0xc1, 0x9d, 0x5b,
+	0x57, 0x7e, 0x1d, 0x97, 0xb3, 0x74, 0x69, 0xe0, 0x8b, 0x98, 0x5e, 0x60, 0x98, 0x0c, 0x98, 0xcc,
+	0xae, 0x7d, 0x9e, 0xdf, 0x49, 0x9e, 0xf3, 0xdc, 0x1c, 0x50, 0xde, 0x27, 0x91, 0x17, 0xcc, 0x9c,
+	0x33, 0xdb, 0x9b, 0x35, 0x62, 0x4a, 0xec, 0x28, 0xa1, 0xac, 0xe1, 0x13, 0x56, 0x0f, 0x69, 0x10,
+	0x07, 0xa8, 0x7c, 0xc5, 0xaf, 0xaf, 0xfd, 0xea, 0x4f, 0x11, 0x40, 0x23, 0x4c, 0x27, 0xe7, 0x09,
+	0x89, 0x62, 0x54, 0x02, 0xd1, 0x73, 0x65, 0xa1, 0x22, 0xd4, 0x72, 0xba, 0xe8, 0xb9, 0x48, 0x86,
+	0x82, 0x43, 0x89, 0x1d, 0x07, 0x54, 0x16, 0x2b, 0x42, 0x6d, 0x4f, 0x5f, 0xff, 0xa2, 0x87, 0x50,
+	0xfa, 0x12, 0x50, 0x3f, 0x0a, 0x6d, 0x87, 0x58, 0xb6, 0xeb, 0x52, 0x39, 0xcb, 0x81, 0xfd, 0x4b,
+	0xb5, 0xe3, 0xba, 0x14, 0xdd, 0x83, 0xa2, 0x4f, 0x18, 0xf5, 0x66, 0xd3, 0x25, 0x94, 0xe3, 0xd0,
+	0xad, 0x95, 0xc6, 0x91, 0xe7, 0xf0, 0xbf, 0x4f, 0x98, 0x15, 0xb3, 0x90, 0xc8, 0xff, 0x55, 0x84,
+	0x5a, 0xa9, 0x75, 0x54, 0xdf, 0x95, 0xb5, 0xae, 0x11, 0x66, 0xb2, 0x90, 0xe8, 0x05, 0x7f, 0xf9,
+	0x81, 0x5e, 0x42, 0x3e, 0x8a, 0xed, 0x38, 0x89, 0xe4, 0x3c, 0x9f, 0x7b, 0xf4, 0xcf, 0xb9, 0xd5,
+	0x7e, 0x06, 0xa7, 0xf5, 0xd5, 0x14, 0xba, 0x0f, 0xfb, 0x94, 0x7c, 0x20, 0x4e, 0x6c, 0xa5, 0x68,
+	0x30, 0x93, 0x0b, 0x3c, 0x5d, 0x71, 0x29, 0xea, 0x5c, 0xab, 0x7e, 0x13, 0x21, 0xab, 0x11, 0xb6,
+	0x55, 0xcd, 0x76, 0x01, 0xe2, 0x4d, 0x0a, 0xc8, 0x6e, 0x17, 0xd0, 0x84, 0x1c, 0x5f, 0x3e, 0x77,
+	0x93, 0xe5, 0x39, 0x8a, 0x8e, 0x00, 0xc2, 0x64, 0xf2, 0xd1, 0x73, 0x2c, 0x9f, 0x30, 0xde, 0x5a,
+	0x51, 0xdf, 0x5b, 0x2a, 0x69, 0xd6, 0x27, 0x50, 0x0e, 0x29, 0xf9, 0xec, 0x05, 0x49, 0x64, 0x6d,
+	0xb8, 0xb4, 0xa6, 0x6c, 0xad, 0xa8, 0xa3, 0xb5, 0x37, 0x5a, 0x0f, 0x44, 0xe8, 0x01, 0x94, 0x22,
+	0x6f, 0x3a, 0x4b, 0x63, 0xa6, 0x8f, 0xe1, 0xb9, 0xbc, 0x8b, 0x9c, 0x5e, 0x5c, 0xa9, 0x1a, 0x61,
+	0x7d, 0xf7, 0xf8, 0x87, 0x00, 0xd2, 0xf5, 0x36, 0x51, 0x15, 0x14, 0x0d, 0x9f, 0x5a, 0x3a, 0x7e,
+	0x33, 0xc6, 0x86, 0x69, 0x19, 0x66, 0xc7, 0x1c, 0x1b, 0xd6, 0x78, 0x68, 0x8c, 0x70, 0xb7, 0xdf,
+	0xeb, 0x63, 0x55, 0xca, 0x20, 0x05, 0x0e, 0x76, 0x30, 0x23, 0x3c, 0x54, 0xfb, 0xc3, 0x13, 0x49,
+	0x40, 0x15, 0x38, 0xdc, 0xe1, 0xf7, 0xc6, 0x83, 0x5e, 0x7f, 0x30, 0xc0, 0xaa, 0x24, 0xa2, 0xbb,
+	0x70, 0x67, 0x07, 0xa1, 0xe3, 0xd7, 0xb8, 0x6b, 0x62, 0x55, 0xca, 0x1e, 0xbf, 0x83, 0xc2, 0xaa,
+	0x23, 0x24, 0x43, 0x39, 0x65, 0xcd, 0xd3, 0x11, 0xbe, 0x96, 0xe3, 0x10, 0xe4, 0x4b, 0x07, 0x77,
+	0x55, 0xa3, 0x63, 0x19, 0xb8, 0x3b, 0x6a, 0xb5, 0x9f, 0x69, 0x4d, 0x49, 0x40, 0x07, 0x70, 0x7b,
+	0xe3, 0xaa, 0xa9, 0x8b, 0xd5, 0x56, 0xbb, 0xdd, 0x7c, 0x21, 0x89, 0xaf, 0x4e, 0x7e, 0xcd, 0x15,
+	0xe1, 0x62, 0xae, 0x08, 0x7f, 0xe6, 0x8a, 0xf0, 0x7d, 0xa1, 0x64, 0x2e, 0x16, 0x4a, 0xe6, 0xf7,
+	0x42, 0xc9, 0xbc, 0x7d, 0x3c, 0xf5, 0xe2, 0xb3, 0x64, 0x52, 0x77, 0x82, 0x4f, 0x8d, 0x73, 0x4a,
+	0xdc, 0xa0, 0x71, 0xf5, 0x12, 0xbf, 0x6e, 0x6e, 0x31, 0x7d, 0xb9, 0x68, 0x92, 0xe7, 0xe7, 0xf8,
+	0xf4, 0xef, 0x00, 0xb1, 0x82, 0xf1, 0xb5, 0xb0, 0x03, 0x00, 0x00,
 }
 
 func (m *KeyRequest) Marshal() (dAtA []byte, err error) {
@@ -393,6 +415,20 @@ func (m *Key) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SigningKeyId != 0 {
+		i = encodeVarintKey(dAtA, i, uint64(m.SigningKeyId))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.PreviousPublicKeys) > 0 {
+		for iNdEx := len(m.PreviousPublicKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PreviousPublicKeys[iNdEx])
+			copy(dAtA[i:], m.PreviousPublicKeys[iNdEx])
+			i = encodeVarintKey(dAtA, i, uint64(len(m.PreviousPublicKeys[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.PublicKey) > 0 {
 		i -= len(m.PublicKey)
 		copy(dAtA[i:], m.PublicKey)
@@ -496,6 +532,15 @@ func (m *Key) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovKey(uint64(l))
 	}
+	if len(m.PreviousPublicKeys) > 0 {
+		for _, b := range m.PreviousPublicKeys {
+			l = len(b)
+			n += 1 + l + sovKey(uint64(l))
+		}
+	}
+	if m.SigningKeyId != 0 {
+		n += 1 + sovKey(uint64(m.SigningKeyId))
+	}
 	return n
 }
 
@@ -905,6 +950,57 @@ func (m *Key) Unmarshal(dAtA []byte) error {
 				m.PublicKey = []byte{}
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousPublicKeys", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthKey
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousPublicKeys = append(m.PreviousPublicKeys, make([]byte, postIndex-iNdEx))
+			copy(m.PreviousPublicKeys[len(m.PreviousPublicKeys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningKeyId", wireType)
+			}
+			m.SigningKeyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SigningKeyId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipKey(dAtA[iNdEx:])
@@ -0,0 +1,100 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcutil/hdkeychain"
+	"github.com/btcsuite/btcd/chaincfg"
+)
+
+// ErrHardenedDerivation is returned when deriving a hardened child key. Keys
+// only hold public key material, the private key is kept by the keyring, so
+// only non-hardened BIP-32 derivation is possible.
+var ErrHardenedDerivation = errors.New("hardened derivation requires the private key")
+
+// Derive returns the child of k at path, derived with BIP-32 public key
+// derivation (CKDpub) using chainCode as the chain code of k.
+//
+// path is relative to k, e.g. "m/0/5" or "0/5". Since only the public key is
+// available, all the indices must be non-hardened: the hardened levels of a
+// BIP-44 path (purpose, coin type and account) must be derived by the keyring
+// that holds the private key, which then exports the account's public key and
+// chain code.
+//
+// Only secp256k1 keys are supported, SLIP-0010 doesn't define non-hardened
+// derivation for Ed25519.
+//
+// The returned key has the same ID, workspace and keyring as k, with the
+// compressed public key of the child.
+func (k *Key) Derive(chainCode []byte, path string) (*Key, error) {
+	if k.Type != KeyType_KEY_TYPE_ECDSA_SECP256K1 {
+		return nil, fmt.Errorf("invalid key type, expected %s, got %s", KeyType_KEY_TYPE_ECDSA_SECP256K1, k.Type)
+	}
+	if len(chainCode) != 32 {
+		return nil, fmt.Errorf("invalid chain code length, expected 32, got %d", len(chainCode))
+	}
+	indices, err := parseDerivationPath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	pk, err := k.ToECDSASecp256k1()
+	if err != nil {
+		return nil, err
+	}
+	compressed := make([]byte, 33)
+	compressed[0] = 0x02 + byte(pk.Y.Bit(0))
+	pk.X.FillBytes(compressed[1:])
+
+	ext := hdkeychain.NewExtendedKey(chaincfg.MainNetParams.HDPublicKeyID[:], compressed, chainCode, []byte{0, 0, 0, 0}, 0, 0, false)
+	for _, i := range indices {
+		ext, err = ext.Derive(i)
+		if err != nil {
+			return nil, fmt.Errorf("deriving child %d: %w", i, err)
+		}
+	}
+	child, err := ext.ECPubKey()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Key{
+		Id:            k.Id,
+		WorkspaceAddr: k.WorkspaceAddr,
+		KeyringAddr:   k.KeyringAddr,
+		Type:          k.Type,
+		PublicKey:     child.SerializeCompressed(),
+		SigningKeyId:  k.SigningKeyId,
+	}, nil
+}
+
+// parseDerivationPath parses a BIP-32 path such as "m/0/5" into its child
+// indices. The "m" prefix is optional.
+func parseDerivationPath(path string) ([]uint32, error) {
+	path = strings.TrimPrefix(strings.TrimPrefix(path, "m"), "/")
+	if path == "" {
+		return nil, nil
+	}
+
+	parts := strings.Split(path, "/")
+	indices := make([]uint32, 0, len(parts))
+	for _, part := range parts {
+		if strings.HasSuffix(part, "'") || strings.HasSuffix(part, "h") || strings.HasSuffix(part, "H") {
+			return nil, fmt.Errorf("%w: %q", ErrHardenedDerivation, part)
+		}
+		i, err := strconv.ParseUint(part, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid derivation path %q: %w", path, err)
+		}
+		if i >= hdkeychain.HardenedKeyStart {
+			return nil, fmt.Errorf("%w: %d", ErrHardenedDerivation, i)
+		}
+		indices = append(indices, uint32(i))
+	}
+	return indices, nil
+}
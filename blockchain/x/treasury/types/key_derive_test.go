@@ -0,0 +1,94 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package types
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/btcutil/hdkeychain"
+	"github.com/stretchr/testify/require"
+)
+
+// extendedPublicKey returns the key and chain code of the BIP-32 extended
+// public key xpub.
+func extendedPublicKey(t *testing.T, xpub string) (*Key, []byte) {
+	ext, err := hdkeychain.NewKeyFromString(xpub)
+	require.NoError(t, err)
+	pk, err := ext.ECPubKey()
+	require.NoError(t, err)
+	return &Key{Id: 1, Type: KeyType_KEY_TYPE_ECDSA_SECP256K1, PublicKey: pk.SerializeCompressed()}, ext.ChainCode()
+}
+
+func Test_Key_Derive(t *testing.T) {
+	// BIP-32 test vector 1
+	tests := []struct {
+		name   string
+		parent string
+		path   string
+		want   string
+	}{
+		{
+			name:   "m/0H/1",
+			parent: "xpub68Gmy5EdvgibQVfPdqkBBCHxA5htiqg55crXYuXoQRKfDBFA1WEjWgP6LHhwBZeNK1VTsfTFUHCdrfp1bgwQ9xv5ski8PX9rL2dZXvgGDnw",
+			path:   "m/1",
+			want:   "xpub6ASuArnXKPbfEwhqN6e3mwBcDTgzisQN1wXN9BJcM47sSikHjJf3UFHKkNAWbWMiGj7Wf5uMash7SyYq527Hqck2AxYysAA7xmALppuCkwQ",
+		},
+		{
+			name:   "m/0H/1/2H/2/1000000000",
+			parent: "xpub6D4BDPcP2GT577Vvch3R8wDkScZWzQzMMUm3PWbmWvVJrZwQY4VUNgqFJPMM3No2dFDFGTsxxpG5uJh7n7epu4trkrX7x7DogT5Uv6fcLW5",
+			path:   "2/1000000000",
+			want:   "xpub6H1LXWLaKsWFhvm6RVpEL9P4KfRZSW7abD2ttkWP3SSQvnyA8FSVqNTEcYFgJS2UaFcxupHiYkro49S8yGasTvXEYBVPamhGW6cFJodrTHy",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parent, chainCode := extendedPublicKey(t, tt.parent)
+			want, _ := extendedPublicKey(t, tt.want)
+
+			child, err := parent.Derive(chainCode, tt.path)
+			require.NoError(t, err)
+			require.Equal(t, want.PublicKey, child.PublicKey)
+			require.Equal(t, parent.Id, child.Id)
+			require.Equal(t, parent.Type, child.Type)
+		})
+	}
+}
+
+func Test_Key_Derive_Errors(t *testing.T) {
+	parent, chainCode := extendedPublicKey(t, "xpub68Gmy5EdvgibQVfPdqkBBCHxA5htiqg55crXYuXoQRKfDBFA1WEjWgP6LHhwBZeNK1VTsfTFUHCdrfp1bgwQ9xv5ski8PX9rL2dZXvgGDnw")
+
+	_, err := parent.Derive(chainCode, "m/44'/60'/0'/0/0")
+	require.ErrorIs(t, err, ErrHardenedDerivation)
+
+	_, err = parent.Derive(chainCode, "m/2147483648")
+	require.ErrorIs(t, err, ErrHardenedDerivation)
+
+	_, err = parent.Derive(chainCode, "m/x")
+	require.Error(t, err)
+
+	_, err = parent.Derive(chainCode[:31], "m/0")
+	require.Error(t, err)
+
+	eddsa := &Key{Type: KeyType_KEY_TYPE_EDDSA_ED25519, PublicKey: make([]byte, 32)}
+	_, err = eddsa.Derive(chainCode, "m/0")
+	require.Error(t, err)
+}
+
+func Test_NewDerivedEthereumWallet(t *testing.T) {
+	parent, chainCode := extendedPublicKey(t, "xpub68Gmy5EdvgibQVfPdqkBBCHxA5htiqg55crXYuXoQRKfDBFA1WEjWgP6LHhwBZeNK1VTsfTFUHCdrfp1bgwQ9xv5ski8PX9rL2dZXvgGDnw")
+	child, _ := extendedPublicKey(t, "xpub6ASuArnXKPbfEwhqN6e3mwBcDTgzisQN1wXN9BJcM47sSikHjJf3UFHKkNAWbWMiGj7Wf5uMash7SyYq527Hqck2AxYysAA7xmALppuCkwQ")
+
+	derived, err := NewDerivedEthereumWallet(parent, chainCode, "m/1")
+	require.NoError(t, err)
+	want, err := NewEthereumWallet(child)
+	require.NoError(t, err)
+	require.Equal(t, want.Address(), derived.Address())
+
+	// the root path is the key itself
+	root, err := NewDerivedEthereumWallet(parent, chainCode, "m")
+	require.NoError(t, err)
+	wallet, err := NewEthereumWallet(parent)
+	require.NoError(t, err)
+	require.Equal(t, wallet.Address(), root.Address())
+}
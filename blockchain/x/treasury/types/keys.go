@@ -34,6 +34,16 @@ const (
 	SignTransactionRequestKey = "sign_transaction_request/value/"
 
 	SignTransactionRequestCountKey = "sign_transaction_request/count"
+
+	SupportedChainKey = "supported_chain/value/"
+
+	AddressLabelKey = "address_label/value/"
+
+	LastSignedTransactionKey = "last_signed_transaction/value/"
+
+	PendingSignedTransactionKey = "pending_signed_transaction/value/"
+
+	InternalAddressKey = "internal_address/value/"
 )
 
 func KeyPrefix(p string) []byte {
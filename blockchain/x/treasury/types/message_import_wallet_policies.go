@@ -0,0 +1,58 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgImportWalletPolicies = "import_wallet_policies"
+
+var _ sdk.Msg = &MsgImportWalletPolicies{}
+
+func NewMsgImportWalletPolicies(creator, workspaceAddr string, bundle, signer []byte, btl uint64) *MsgImportWalletPolicies {
+	return &MsgImportWalletPolicies{
+		Creator:       creator,
+		WorkspaceAddr: workspaceAddr,
+		Bundle:        bundle,
+		Signer:        signer,
+		Btl:           btl,
+	}
+}
+
+func (msg *MsgImportWalletPolicies) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgImportWalletPolicies) Type() string {
+	return TypeMsgImportWalletPolicies
+}
+
+func (msg *MsgImportWalletPolicies) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgImportWalletPolicies) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgImportWalletPolicies) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if len(msg.Bundle) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "missing bundle")
+	}
+	if len(msg.Signer) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "missing signer")
+	}
+	return nil
+}
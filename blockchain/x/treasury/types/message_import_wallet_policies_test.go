@@ -0,0 +1,60 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/qredo/fusionchain/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgImportWalletPolicies_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgImportWalletPolicies
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgImportWalletPolicies{
+				Creator: "invalid_address",
+				Bundle:  []byte("{}"),
+				Signer:  []byte{0x02},
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "missing bundle",
+			msg: MsgImportWalletPolicies{
+				Creator: sample.AccAddress(),
+				Signer:  []byte{0x02},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "missing signer",
+			msg: MsgImportWalletPolicies{
+				Creator: sample.AccAddress(),
+				Bundle:  []byte("{}"),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid",
+			msg: MsgImportWalletPolicies{
+				Creator: sample.AccAddress(),
+				Bundle:  []byte("{}"),
+				Signer:  []byte{0x02},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
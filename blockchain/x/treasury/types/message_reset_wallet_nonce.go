@@ -0,0 +1,54 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgResetWalletNonce = "reset_wallet_nonce"
+
+var _ sdk.Msg = &MsgResetWalletNonce{}
+
+func NewMsgResetWalletNonce(creator string, keyID, chainID, btl uint64) *MsgResetWalletNonce {
+	return &MsgResetWalletNonce{
+		Creator: creator,
+		KeyId:   keyID,
+		ChainId: chainID,
+		Btl:     btl,
+	}
+}
+
+func (msg *MsgResetWalletNonce) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgResetWalletNonce) Type() string {
+	return TypeMsgResetWalletNonce
+}
+
+func (msg *MsgResetWalletNonce) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgResetWalletNonce) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgResetWalletNonce) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.ChainId == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "missing chain ID")
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/qredo/fusionchain/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgResetWalletNonce_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgResetWalletNonce
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgResetWalletNonce{
+				Creator: "invalid_address",
+				KeyId:   1,
+				ChainId: 1,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "missing chain ID",
+			msg: MsgResetWalletNonce{
+				Creator: sample.AccAddress(),
+				KeyId:   1,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid",
+			msg: MsgResetWalletNonce{
+				Creator: sample.AccAddress(),
+				KeyId:   1,
+				ChainId: 1,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgRotateWalletKey = "rotate_wallet_key"
+
+var _ sdk.Msg = &MsgRotateWalletKey{}
+
+func NewMsgRotateWalletKey(creator string, keyID, newKeyID, btl uint64) *MsgRotateWalletKey {
+	return &MsgRotateWalletKey{
+		Creator:  creator,
+		KeyId:    keyID,
+		NewKeyId: newKeyID,
+		Btl:      btl,
+	}
+}
+
+func (msg *MsgRotateWalletKey) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRotateWalletKey) Type() string {
+	return TypeMsgRotateWalletKey
+}
+
+func (msg *MsgRotateWalletKey) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgRotateWalletKey) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRotateWalletKey) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.KeyId == msg.NewKeyId {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "key %d can't be rotated to itself", msg.KeyId)
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/qredo/fusionchain/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgRotateWalletKey_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgRotateWalletKey
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgRotateWalletKey{
+				Creator:  "invalid_address",
+				KeyId:    1,
+				NewKeyId: 2,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "rotation to the same key",
+			msg: MsgRotateWalletKey{
+				Creator:  sample.AccAddress(),
+				KeyId:    1,
+				NewKeyId: 1,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid",
+			msg: MsgRotateWalletKey{
+				Creator:  sample.AccAddress(),
+				KeyId:    1,
+				NewKeyId: 2,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
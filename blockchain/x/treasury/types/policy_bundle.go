@@ -0,0 +1,128 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package types
+
+import (
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// PolicyBundleVersion is the version of the bundles written by
+// NewWalletPolicyBundle. Version 1 bundles were only checksummed, not
+// signed, and are rejected by ParseWalletPolicyBundle like newer versions.
+const PolicyBundleVersion = 2
+
+// Roles of the policies of a wallet, which are the policies of the
+// workspace of its key.
+const (
+	PolicyRoleAdmin = "admin"
+	PolicyRoleSign  = "sign"
+)
+
+var (
+	// ErrPolicyBundleVersion is returned for bundles written by an
+	// unsupported version.
+	ErrPolicyBundleVersion = errors.New("unsupported policy bundle version")
+
+	// ErrPolicyBundleSignature is returned for bundles that aren't signed by
+	// the expected key, e.g. unsigned, corrupted or edited bundles.
+	ErrPolicyBundleSignature = errors.New("invalid policy bundle signature")
+)
+
+// WalletPolicyBundle is the portable, JSON encoded, export of the policies
+// of a wallet, used to restore them on another chain.
+type WalletPolicyBundle struct {
+	Version  uint32              `json:"version"`
+	Policies []WalletPolicyEntry `json:"policies"`
+
+	// Signature is the secp256k1 signature of the hash of the version and
+	// policies of the bundle, see SignWalletPolicyBundle. It's empty in the
+	// bundles returned by NewWalletPolicyBundle.
+	Signature []byte `json:"signature,omitempty"`
+}
+
+// WalletPolicyEntry is a policy of a WalletPolicyBundle.
+type WalletPolicyEntry struct {
+	// Role is the role of the policy in the workspace, PolicyRoleAdmin or
+	// PolicyRoleSign.
+	Role string `json:"role"`
+	Name string `json:"name"`
+
+	// Policy is the canonical encoding of the policy, see
+	// policytypes.CanonicalBytes.
+	Policy []byte `json:"policy"`
+}
+
+// NewWalletPolicyBundle returns the encoded, unsigned, bundle of the
+// policies.
+func NewWalletPolicyBundle(policies []WalletPolicyEntry) ([]byte, error) {
+	return json.Marshal(WalletPolicyBundle{
+		Version:  PolicyBundleVersion,
+		Policies: policies,
+	})
+}
+
+// SignWalletPolicyBundle signs an encoded bundle with key, replacing any
+// previous signature. Bundles must be signed before being imported, by the
+// key given in MsgImportWalletPolicies.
+func SignWalletPolicyBundle(b []byte, key *ecdsa.PrivateKey) ([]byte, error) {
+	var bundle WalletPolicyBundle
+	if err := json.Unmarshal(b, &bundle); err != nil {
+		return nil, fmt.Errorf("invalid policy bundle: %w", err)
+	}
+	sig, err := crypto.Sign(bundle.hash(), key)
+	if err != nil {
+		return nil, err
+	}
+	bundle.Signature = sig
+	return json.Marshal(bundle)
+}
+
+// ParseWalletPolicyBundle decodes a bundle and checks its version, its
+// signature by the compressed secp256k1 public key signer and its roles.
+// The policies themselves are validated when imported.
+func ParseWalletPolicyBundle(b []byte, signer []byte) (*WalletPolicyBundle, error) {
+	var bundle WalletPolicyBundle
+	if err := json.Unmarshal(b, &bundle); err != nil {
+		return nil, fmt.Errorf("invalid policy bundle: %w", err)
+	}
+	if bundle.Version != PolicyBundleVersion {
+		return nil, fmt.Errorf("%w: %d, expected %d", ErrPolicyBundleVersion, bundle.Version, PolicyBundleVersion)
+	}
+	if len(bundle.Signature) != crypto.SignatureLength ||
+		!crypto.VerifySignature(signer, bundle.hash(), bundle.Signature[:crypto.RecoveryIDOffset]) {
+		return nil, ErrPolicyBundleSignature
+	}
+
+	seen := make(map[string]bool, len(bundle.Policies))
+	for _, entry := range bundle.Policies {
+		if entry.Role != PolicyRoleAdmin && entry.Role != PolicyRoleSign {
+			return nil, fmt.Errorf("invalid policy bundle: unknown role %q", entry.Role)
+		}
+		if seen[entry.Role] {
+			return nil, fmt.Errorf("invalid policy bundle: duplicate role %q", entry.Role)
+		}
+		seen[entry.Role] = true
+	}
+	return &bundle, nil
+}
+
+// hash returns the SHA-256 hash of the version of the bundle followed by
+// the length-prefixed role, name and policy of each entry, in order.
+func (b *WalletPolicyBundle) hash() []byte {
+	h := sha256.New()
+	_ = binary.Write(h, binary.BigEndian, b.Version)
+	for _, entry := range b.Policies {
+		for _, field := range [][]byte{[]byte(entry.Role), []byte(entry.Name), entry.Policy} {
+			_ = binary.Write(h, binary.BigEndian, uint64(len(field)))
+			h.Write(field)
+		}
+	}
+	return h.Sum(nil)
+}
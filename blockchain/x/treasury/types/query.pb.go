@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -545,6 +546,106 @@ func (m *WalletKeyResponse) GetType() WalletType {
 	return WalletType_WALLET_TYPE_UNSPECIFIED
 }
 
+type QueryKeyAddressesRequest struct {
+	KeyId uint64 `protobuf:"varint,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+}
+
+func (m *QueryKeyAddressesRequest) Reset()         { *m = QueryKeyAddressesRequest{} }
+func (m *QueryKeyAddressesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryKeyAddressesRequest) ProtoMessage()    {}
+func (*QueryKeyAddressesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dfc42e3ec3cc822d, []int{10}
+}
+func (m *QueryKeyAddressesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryKeyAddressesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryKeyAddressesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryKeyAddressesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryKeyAddressesRequest.Merge(m, src)
+}
+func (m *QueryKeyAddressesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryKeyAddressesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryKeyAddressesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryKeyAddressesRequest proto.InternalMessageInfo
+
+func (m *QueryKeyAddressesRequest) GetKeyId() uint64 {
+	if m != nil {
+		return m.KeyId
+	}
+	return 0
+}
+
+type QueryKeyAddressesResponse struct {
+	// wallets contains an entry for each wallet type that can be derived from
+	// the key, ordered by type.
+	Wallets []*WalletKeyResponse `protobuf:"bytes,1,rep,name=wallets,proto3" json:"wallets,omitempty"`
+	// previous_wallets contains the addresses derived from the public keys
+	// used by the key before it was rotated, oldest first.
+	PreviousWallets []*WalletKeyResponse `protobuf:"bytes,2,rep,name=previous_wallets,json=previousWallets,proto3" json:"previous_wallets,omitempty"`
+}
+
+func (m *QueryKeyAddressesResponse) Reset()         { *m = QueryKeyAddressesResponse{} }
+func (m *QueryKeyAddressesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryKeyAddressesResponse) ProtoMessage()    {}
+func (*QueryKeyAddressesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dfc42e3ec3cc822d, []int{11}
+}
+func (m *QueryKeyAddressesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryKeyAddressesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryKeyAddressesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryKeyAddressesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryKeyAddressesResponse.Merge(m, src)
+}
+func (m *QueryKeyAddressesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryKeyAddressesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryKeyAddressesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryKeyAddressesResponse proto.InternalMessageInfo
+
+func (m *QueryKeyAddressesResponse) GetWallets() []*WalletKeyResponse {
+	if m != nil {
+		return m.Wallets
+	}
+	return nil
+}
+
+func (m *QueryKeyAddressesResponse) GetPreviousWallets() []*WalletKeyResponse {
+	if m != nil {
+		return m.PreviousWallets
+	}
+	return nil
+}
+
 type QuerySignatureRequestsRequest struct {
 	Pagination  *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 	KeyringAddr string             `protobuf:"bytes,2,opt,name=keyring_addr,json=keyringAddr,proto3" json:"keyring_addr,omitempty"`
@@ -555,7 +656,7 @@ func (m *QuerySignatureRequestsRequest) Reset()         { *m = QuerySignatureReq
 func (m *QuerySignatureRequestsRequest) String() string { return proto.CompactTextString(m) }
 func (*QuerySignatureRequestsRequest) ProtoMessage()    {}
 func (*QuerySignatureRequestsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dfc42e3ec3cc822d, []int{10}
+	return fileDescriptor_dfc42e3ec3cc822d, []int{12}
 }
 func (m *QuerySignatureRequestsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -614,7 +715,7 @@ func (m *QuerySignatureRequestsResponse) Reset()         { *m = QuerySignatureRe
 func (m *QuerySignatureRequestsResponse) String() string { return proto.CompactTextString(m) }
 func (*QuerySignatureRequestsResponse) ProtoMessage()    {}
 func (*QuerySignatureRequestsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dfc42e3ec3cc822d, []int{11}
+	return fileDescriptor_dfc42e3ec3cc822d, []int{13}
 }
 func (m *QuerySignatureRequestsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -665,7 +766,7 @@ func (m *QuerySignatureRequestByIdRequest) Reset()         { *m = QuerySignature
 func (m *QuerySignatureRequestByIdRequest) String() string { return proto.CompactTextString(m) }
 func (*QuerySignatureRequestByIdRequest) ProtoMessage()    {}
 func (*QuerySignatureRequestByIdRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dfc42e3ec3cc822d, []int{12}
+	return fileDescriptor_dfc42e3ec3cc822d, []int{14}
 }
 func (m *QuerySignatureRequestByIdRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -709,7 +810,7 @@ func (m *QuerySignatureRequestByIdResponse) Reset()         { *m = QuerySignatur
 func (m *QuerySignatureRequestByIdResponse) String() string { return proto.CompactTextString(m) }
 func (*QuerySignatureRequestByIdResponse) ProtoMessage()    {}
 func (*QuerySignatureRequestByIdResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dfc42e3ec3cc822d, []int{13}
+	return fileDescriptor_dfc42e3ec3cc822d, []int{15}
 }
 func (m *QuerySignatureRequestByIdResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -756,7 +857,7 @@ func (m *QuerySignTransactionRequestsRequest) Reset()         { *m = QuerySignTr
 func (m *QuerySignTransactionRequestsRequest) String() string { return proto.CompactTextString(m) }
 func (*QuerySignTransactionRequestsRequest) ProtoMessage()    {}
 func (*QuerySignTransactionRequestsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dfc42e3ec3cc822d, []int{14}
+	return fileDescriptor_dfc42e3ec3cc822d, []int{16}
 }
 func (m *QuerySignTransactionRequestsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -822,7 +923,7 @@ func (m *SignTransactionRequestResponse) Reset()         { *m = SignTransactionR
 func (m *SignTransactionRequestResponse) String() string { return proto.CompactTextString(m) }
 func (*SignTransactionRequestResponse) ProtoMessage()    {}
 func (*SignTransactionRequestResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dfc42e3ec3cc822d, []int{15}
+	return fileDescriptor_dfc42e3ec3cc822d, []int{17}
 }
 func (m *SignTransactionRequestResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -874,7 +975,7 @@ func (m *QuerySignTransactionRequestsResponse) Reset()         { *m = QuerySignT
 func (m *QuerySignTransactionRequestsResponse) String() string { return proto.CompactTextString(m) }
 func (*QuerySignTransactionRequestsResponse) ProtoMessage()    {}
 func (*QuerySignTransactionRequestsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dfc42e3ec3cc822d, []int{16}
+	return fileDescriptor_dfc42e3ec3cc822d, []int{18}
 }
 func (m *QuerySignTransactionRequestsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -927,7 +1028,7 @@ func (m *QuerySignTransactionRequestByIdRequest) Reset() {
 func (m *QuerySignTransactionRequestByIdRequest) String() string { return proto.CompactTextString(m) }
 func (*QuerySignTransactionRequestByIdRequest) ProtoMessage()    {}
 func (*QuerySignTransactionRequestByIdRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dfc42e3ec3cc822d, []int{17}
+	return fileDescriptor_dfc42e3ec3cc822d, []int{19}
 }
 func (m *QuerySignTransactionRequestByIdRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -973,7 +1074,7 @@ func (m *QuerySignTransactionRequestByIdResponse) Reset() {
 func (m *QuerySignTransactionRequestByIdResponse) String() string { return proto.CompactTextString(m) }
 func (*QuerySignTransactionRequestByIdResponse) ProtoMessage()    {}
 func (*QuerySignTransactionRequestByIdResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dfc42e3ec3cc822d, []int{18}
+	return fileDescriptor_dfc42e3ec3cc822d, []int{20}
 }
 func (m *QuerySignTransactionRequestByIdResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1009,859 +1110,1005 @@ func (m *QuerySignTransactionRequestByIdResponse) GetSignTransactionRequest() *S
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "fusionchain.treasury.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "fusionchain.treasury.QueryParamsResponse")
-	proto.RegisterType((*QueryKeyRequestsRequest)(nil), "fusionchain.treasury.QueryKeyRequestsRequest")
-	proto.RegisterType((*QueryKeyRequestsResponse)(nil), "fusionchain.treasury.QueryKeyRequestsResponse")
-	proto.RegisterType((*QueryKeyRequestByIdRequest)(nil), "fusionchain.treasury.QueryKeyRequestByIdRequest")
-	proto.RegisterType((*QueryKeyRequestByIdResponse)(nil), "fusionchain.treasury.QueryKeyRequestByIdResponse")
-	proto.RegisterType((*QueryKeysRequest)(nil), "fusionchain.treasury.QueryKeysRequest")
-	proto.RegisterType((*QueryKeysResponse)(nil), "fusionchain.treasury.QueryKeysResponse")
-	proto.RegisterType((*KeyResponse)(nil), "fusionchain.treasury.KeyResponse")
-	proto.RegisterType((*WalletKeyResponse)(nil), "fusionchain.treasury.WalletKeyResponse")
-	proto.RegisterType((*QuerySignatureRequestsRequest)(nil), "fusionchain.treasury.QuerySignatureRequestsRequest")
-	proto.RegisterType((*QuerySignatureRequestsResponse)(nil), "fusionchain.treasury.QuerySignatureRequestsResponse")
-	proto.RegisterType((*QuerySignatureRequestByIdRequest)(nil), "fusionchain.treasury.QuerySignatureRequestByIdRequest")
-	proto.RegisterType((*QuerySignatureRequestByIdResponse)(nil), "fusionchain.treasury.QuerySignatureRequestByIdResponse")
-	proto.RegisterType((*QuerySignTransactionRequestsRequest)(nil), "fusionchain.treasury.QuerySignTransactionRequestsRequest")
-	proto.RegisterType((*SignTransactionRequestResponse)(nil), "fusionchain.treasury.SignTransactionRequestResponse")
-	proto.RegisterType((*QuerySignTransactionRequestsResponse)(nil), "fusionchain.treasury.QuerySignTransactionRequestsResponse")
-	proto.RegisterType((*QuerySignTransactionRequestByIdRequest)(nil), "fusionchain.treasury.QuerySignTransactionRequestByIdRequest")
-	proto.RegisterType((*QuerySignTransactionRequestByIdResponse)(nil), "fusionchain.treasury.QuerySignTransactionRequestByIdResponse")
+type QueryEvaluateWalletPoliciesRequest struct {
+	KeyId      uint64     `protobuf:"varint,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	WalletType WalletType `protobuf:"varint,2,opt,name=wallet_type,json=walletType,proto3,enum=fusionchain.treasury.WalletType" json:"wallet_type,omitempty"`
+	// Candidate transfer, as in MsgNewSignTransactionRequest.
+	UnsignedTransaction []byte     `protobuf:"bytes,3,opt,name=unsigned_transaction,json=unsignedTransaction,proto3" json:"unsigned_transaction,omitempty"`
+	Metadata            *types.Any `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// Addresses of the approvers. Addresses that aren't participants of a
+	// policy are ignored for that policy.
+	Approvers []string `protobuf:"bytes,5,rep,name=approvers,proto3" json:"approvers,omitempty"`
+	// IDs of policies to evaluate in addition to the sign policy of the
+	// workspace of the key, e.g. before attaching them.
+	PolicyIds []uint64 `protobuf:"varint,6,rep,packed,name=policy_ids,json=policyIds,proto3" json:"policy_ids,omitempty"`
 }
 
-func init() { proto.RegisterFile("fusionchain/treasury/query.proto", fileDescriptor_dfc42e3ec3cc822d) }
-
-var fileDescriptor_dfc42e3ec3cc822d = []byte{
-	// 1089 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0x4f, 0x6f, 0xdc, 0x44,
-	0x14, 0xcf, 0x6c, 0xb6, 0xa9, 0xfa, 0x36, 0x8d, 0xc8, 0x10, 0xc8, 0xd6, 0x04, 0xb3, 0x19, 0x42,
-	0x76, 0x5b, 0x12, 0x9b, 0x6c, 0xb7, 0x2d, 0x54, 0x05, 0x94, 0x80, 0x5a, 0x55, 0x5c, 0x5a, 0xb7,
-	0x12, 0x12, 0x97, 0xc5, 0xbb, 0x9e, 0xba, 0xd6, 0x26, 0xb6, 0xe3, 0xf1, 0x12, 0x2c, 0xc4, 0x05,
-	0x2e, 0x1c, 0x41, 0xbd, 0xf0, 0x09, 0x10, 0x57, 0x24, 0xce, 0x9c, 0x8b, 0x90, 0xaa, 0x4a, 0x1c,
-	0xe0, 0x84, 0x20, 0xe1, 0x06, 0x1f, 0x02, 0x79, 0x3c, 0xde, 0x75, 0xb2, 0x63, 0x6f, 0x36, 0x0a,
-	0x70, 0xcb, 0x8e, 0xdf, 0x9b, 0xdf, 0x9f, 0xf7, 0xe6, 0xcd, 0x28, 0x50, 0x7b, 0xd0, 0x67, 0x8e,
-	0xe7, 0x76, 0x1f, 0x9a, 0x8e, 0xab, 0x87, 0x01, 0x35, 0x59, 0x3f, 0x88, 0xf4, 0xdd, 0x3e, 0x0d,
-	0x22, 0xcd, 0x0f, 0xbc, 0xd0, 0xc3, 0x0b, 0x99, 0x08, 0x2d, 0x8d, 0x50, 0x16, 0x6c, 0xcf, 0xf6,
-	0x78, 0x80, 0x1e, 0xff, 0x95, 0xc4, 0x2a, 0x4b, 0xb6, 0xe7, 0xd9, 0xdb, 0x54, 0x37, 0x7d, 0x47,
-	0x37, 0x5d, 0xd7, 0x0b, 0xcd, 0xd0, 0xf1, 0x5c, 0x26, 0xbe, 0x5e, 0xea, 0x7a, 0x6c, 0xc7, 0x63,
-	0x7a, 0xc7, 0x64, 0x34, 0x81, 0xd0, 0x3f, 0xda, 0xe8, 0xd0, 0xd0, 0xdc, 0xd0, 0x7d, 0xd3, 0x76,
-	0x5c, 0x1e, 0x2c, 0x62, 0x97, 0xa5, 0xbc, 0x7c, 0x33, 0x30, 0x77, 0xd2, 0xed, 0x54, 0x69, 0x48,
-	0x8f, 0x0a, 0xe2, 0x0a, 0x91, 0x7e, 0xdf, 0xf1, 0xbb, 0xcc, 0xb1, 0x8b, 0x61, 0xf6, 0xcc, 0xed,
-	0x6d, 0x1a, 0x26, 0x21, 0x64, 0x01, 0xf0, 0xdd, 0x98, 0xeb, 0x1d, 0x8e, 0x6d, 0xd0, 0xdd, 0x3e,
-	0x65, 0x21, 0xb9, 0x0b, 0xcf, 0x1e, 0x5a, 0x65, 0xbe, 0xe7, 0x32, 0x8a, 0xaf, 0xc3, 0x4c, 0xc2,
-	0xb1, 0x8a, 0x6a, 0xa8, 0x51, 0x69, 0x2e, 0x69, 0x32, 0xf7, 0xb4, 0x24, 0x6b, 0xab, 0xfc, 0xf8,
-	0xb7, 0x97, 0xa6, 0x0c, 0x91, 0x41, 0xfe, 0x42, 0xb0, 0xc8, 0xf7, 0x7c, 0x8f, 0x46, 0x02, 0x26,
-	0x85, 0xc3, 0x37, 0x01, 0x86, 0x16, 0x89, 0xbd, 0x57, 0xb5, 0xc4, 0x4f, 0x2d, 0xf6, 0x53, 0x4b,
-	0x4a, 0x26, 0xfc, 0xd4, 0xee, 0x98, 0x36, 0x15, 0xb9, 0x46, 0x26, 0x13, 0x2f, 0xc3, 0x6c, 0x8f,
-	0x46, 0x81, 0xe3, 0xda, 0x6d, 0xd3, 0xb2, 0x82, 0x6a, 0xa9, 0x86, 0x1a, 0xe7, 0x8c, 0x8a, 0x58,
-	0xdb, 0xb4, 0xac, 0x00, 0xbf, 0x05, 0x33, 0x2c, 0x34, 0xc3, 0x3e, 0xab, 0x4e, 0xd7, 0x50, 0x63,
-	0xae, 0xb9, 0x2a, 0x97, 0x30, 0x24, 0x79, 0x8f, 0x47, 0x1b, 0x22, 0x0b, 0xbf, 0x02, 0x73, 0x7b,
-	0x5e, 0xd0, 0x63, 0xbe, 0xd9, 0xa5, 0x09, 0x48, 0x99, 0x83, 0x9c, 0x1f, 0xac, 0xc6, 0x30, 0xe4,
-	0x5b, 0x04, 0xd5, 0x51, 0xb5, 0xc2, 0xc6, 0x5b, 0x12, 0xb9, 0xf5, 0xb1, 0x72, 0x93, 0xe4, 0x43,
-	0x7a, 0xdf, 0xe1, 0x7a, 0xdb, 0x81, 0x00, 0xa8, 0x96, 0x6a, 0xd3, 0x8d, 0x4a, 0xb3, 0x36, 0x4e,
-	0x12, 0x77, 0x24, 0x65, 0x45, 0xd6, 0x40, 0x39, 0xc2, 0x74, 0x2b, 0xba, 0x6d, 0xa5, 0xa5, 0x99,
-	0x83, 0x92, 0x63, 0x71, 0x8e, 0x65, 0xa3, 0xe4, 0x58, 0xe4, 0x43, 0x78, 0x41, 0x1a, 0x2d, 0xa4,
-	0x6d, 0x42, 0x25, 0xc3, 0x48, 0x68, 0x1b, 0x4f, 0x08, 0x86, 0x84, 0xc8, 0x13, 0x04, 0xcf, 0xa4,
-	0x10, 0xa7, 0xde, 0x21, 0xa3, 0xe5, 0x2b, 0x49, 0xca, 0x87, 0x5b, 0x50, 0x0e, 0x23, 0x9f, 0x8a,
-	0x1e, 0xc9, 0xe1, 0xff, 0x3e, 0x3f, 0x47, 0xf7, 0x23, 0x9f, 0x1a, 0x3c, 0x1a, 0x3f, 0x07, 0x33,
-	0xb1, 0x78, 0xc7, 0xe2, 0x3d, 0x51, 0x36, 0xce, 0xf4, 0x68, 0x74, 0xdb, 0x22, 0x8f, 0x10, 0xcc,
-	0x67, 0x04, 0x9d, 0x76, 0x13, 0x5c, 0x81, 0x72, 0x8f, 0x46, 0x69, 0xf1, 0x97, 0x0b, 0xbc, 0x16,
-	0xc9, 0x3c, 0x9c, 0x7c, 0x0a, 0x95, 0xcc, 0x22, 0x7e, 0x15, 0xa6, 0x7b, 0x34, 0x12, 0x3c, 0x2e,
-	0xe4, 0x6f, 0x12, 0x47, 0xe1, 0x4d, 0x38, 0x9b, 0x0c, 0x91, 0x14, 0xb5, 0x5e, 0xe4, 0x50, 0x16,
-	0x3b, 0xcd, 0x23, 0x5d, 0x98, 0x1f, 0xf9, 0x8a, 0xab, 0x70, 0x36, 0xae, 0x09, 0x65, 0xc9, 0x80,
-	0x39, 0x67, 0xa4, 0x3f, 0x07, 0x05, 0x29, 0x4d, 0x52, 0x10, 0xf2, 0x13, 0x82, 0x17, 0xb9, 0xf3,
-	0xf7, 0x1c, 0xdb, 0x35, 0xc3, 0x7e, 0x40, 0xff, 0xc7, 0xc9, 0xf3, 0xf6, 0x91, 0xc9, 0x93, 0xe3,
-	0x59, 0x4c, 0x55, 0x3a, 0x7a, 0xc8, 0x77, 0x08, 0xd4, 0x3c, 0x35, 0xa7, 0xdd, 0x54, 0x37, 0xe1,
-	0x7c, 0x7c, 0x8f, 0x1c, 0x1d, 0x2d, 0xcb, 0x63, 0x39, 0x1b, 0xb3, 0x6c, 0xf8, 0x83, 0x91, 0x26,
-	0xd4, 0xa4, 0x94, 0x8b, 0x46, 0x8c, 0x03, 0xcb, 0x05, 0x39, 0x42, 0xe9, 0xbb, 0x30, 0x9b, 0x25,
-	0x28, 0xb4, 0x1e, 0x83, 0x5f, 0x25, 0xc3, 0x8f, 0x7c, 0x51, 0x82, 0x97, 0x07, 0x58, 0xf7, 0x03,
-	0xd3, 0x65, 0x66, 0x37, 0xd6, 0xff, 0x6f, 0xb5, 0xc9, 0x26, 0x54, 0x92, 0x03, 0xd0, 0x9e, 0xa8,
-	0x9b, 0x61, 0x6f, 0xf0, 0x77, 0x66, 0xc8, 0x4c, 0x67, 0x86, 0x4c, 0xa6, 0xbb, 0xca, 0x27, 0xeb,
-	0xae, 0x27, 0x08, 0x54, 0xb9, 0x0b, 0x03, 0xcf, 0x1f, 0x40, 0x95, 0x7b, 0x1e, 0x0e, 0x43, 0x8e,
-	0xf8, 0xbf, 0x96, 0x8f, 0x2a, 0xd9, 0xf7, 0x79, 0x26, 0x5d, 0x1f, 0xa9, 0x6d, 0xe9, 0x44, 0xb5,
-	0xfd, 0x03, 0xc1, 0x4a, 0x71, 0x6d, 0x4f, 0xfb, 0xd0, 0xf8, 0x70, 0x21, 0xcf, 0x9f, 0xf4, 0x00,
-	0xb5, 0x26, 0x32, 0x28, 0x05, 0x59, 0x94, 0x1b, 0xc5, 0xc8, 0xeb, 0xb0, 0x5a, 0x20, 0xb1, 0xe8,
-	0x90, 0x7d, 0x85, 0xa0, 0x3e, 0x36, 0xf5, 0xbf, 0xad, 0x7b, 0xf3, 0x6f, 0x80, 0x33, 0x9c, 0x13,
-	0xfe, 0x1c, 0xc1, 0x4c, 0xf2, 0x8a, 0xc4, 0x0d, 0xf9, 0xd6, 0xa3, 0x8f, 0x56, 0xe5, 0xe2, 0x31,
-	0x22, 0x13, 0x45, 0x64, 0xe5, 0xb3, 0x9f, 0xff, 0x7c, 0x54, 0x52, 0xf1, 0x92, 0x5e, 0xf0, 0x10,
-	0xc7, 0x5f, 0x23, 0x71, 0x47, 0x26, 0x6e, 0xe3, 0xf5, 0x02, 0x80, 0xd1, 0x57, 0xad, 0xa2, 0x1d,
-	0x37, 0x5c, 0x90, 0xba, 0xc4, 0x49, 0xad, 0x60, 0xa2, 0xe7, 0x3d, 0xfd, 0x07, 0xdd, 0x84, 0xbf,
-	0x41, 0x30, 0x77, 0xf8, 0x09, 0x86, 0x5f, 0x3b, 0x16, 0x5c, 0xa6, 0x27, 0x94, 0x8d, 0x09, 0x32,
-	0x04, 0x47, 0x9d, 0x73, 0xbc, 0x88, 0xeb, 0x63, 0x39, 0xb6, 0x3b, 0xf1, 0x90, 0xc2, 0x9f, 0x40,
-	0x39, 0x7e, 0xf6, 0xe0, 0xd5, 0x62, 0xac, 0x81, 0x69, 0xf5, 0xb1, 0x71, 0x82, 0x09, 0xe1, 0x4c,
-	0x96, 0xb0, 0x92, 0xcb, 0x84, 0xe1, 0xef, 0x11, 0xcc, 0x8f, 0x5c, 0x96, 0xf8, 0x72, 0x01, 0x44,
-	0xde, 0x43, 0x41, 0x69, 0x4d, 0x96, 0x24, 0x48, 0xb6, 0x38, 0x49, 0x0d, 0xaf, 0xc9, 0x49, 0xda,
-	0x34, 0x6c, 0xb3, 0x34, 0x79, 0x58, 0xdc, 0x1f, 0x10, 0x2c, 0xc8, 0x2e, 0x3f, 0x7c, 0x75, 0x02,
-	0x12, 0xd9, 0x42, 0x5f, 0x9b, 0x38, 0x4f, 0xf0, 0xbf, 0xc2, 0xf9, 0xeb, 0x78, 0x5d, 0xce, 0x7f,
-	0x84, 0xbb, 0x28, 0xfa, 0x8f, 0x08, 0x16, 0x73, 0xa6, 0x2e, 0x7e, 0x63, 0x0c, 0x97, 0xfc, 0x5b,
-	0x58, 0xb9, 0x7e, 0x92, 0x54, 0xa1, 0xe4, 0x1a, 0x57, 0xb2, 0x81, 0xf5, 0x7c, 0x25, 0xd2, 0xb9,
-	0x8d, 0x7f, 0x41, 0xa0, 0xe4, 0xcf, 0x48, 0x7c, 0x63, 0x62, 0x4e, 0xd9, 0xc2, 0xbc, 0x79, 0xc2,
-	0x6c, 0x21, 0xea, 0x06, 0x17, 0x75, 0x15, 0xb7, 0x26, 0x13, 0x95, 0x54, 0x69, 0xeb, 0xd6, 0xe3,
-	0x7d, 0x15, 0x3d, 0xdd, 0x57, 0xd1, 0xef, 0xfb, 0x2a, 0xfa, 0xf2, 0x40, 0x9d, 0x7a, 0x7a, 0xa0,
-	0x4e, 0xfd, 0x7a, 0xa0, 0x4e, 0x7d, 0xb0, 0x6e, 0x3b, 0xe1, 0xc3, 0x7e, 0x47, 0xeb, 0x7a, 0x3b,
-	0xfa, 0x6e, 0x40, 0x2d, 0xef, 0xd0, 0xfe, 0x1f, 0x0f, 0x11, 0xe2, 0x57, 0x0c, 0xeb, 0xcc, 0xf0,
-	0x7f, 0x25, 0x5c, 0xfe, 0x27, 0x00, 0x00, 0xff, 0xff, 0xdd, 0x16, 0x12, 0xd8, 0x6e, 0x11, 0x00,
-	0x00,
+func (m *QueryEvaluateWalletPoliciesRequest) Reset()         { *m = QueryEvaluateWalletPoliciesRequest{} }
+func (m *QueryEvaluateWalletPoliciesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEvaluateWalletPoliciesRequest) ProtoMessage()    {}
+func (*QueryEvaluateWalletPoliciesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dfc42e3ec3cc822d, []int{21}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Queries a list of KeyRequests items.
-	KeyRequests(ctx context.Context, in *QueryKeyRequestsRequest, opts ...grpc.CallOption) (*QueryKeyRequestsResponse, error)
-	// Queries a single KeyRequest by its id.
-	KeyRequestById(ctx context.Context, in *QueryKeyRequestByIdRequest, opts ...grpc.CallOption) (*QueryKeyRequestByIdResponse, error)
-	// Queries a list of Keys items.
-	Keys(ctx context.Context, in *QueryKeysRequest, opts ...grpc.CallOption) (*QueryKeysResponse, error)
-	// Queries a list of SignatureRequests items.
-	SignatureRequests(ctx context.Context, in *QuerySignatureRequestsRequest, opts ...grpc.CallOption) (*QuerySignatureRequestsResponse, error)
-	// Queries a single SignatureRequest by its id.
-	SignatureRequestById(ctx context.Context, in *QuerySignatureRequestByIdRequest, opts ...grpc.CallOption) (*QuerySignatureRequestByIdResponse, error)
-	// Queries a list of SignTransactionRequests items.
-	SignTransactionRequests(ctx context.Context, in *QuerySignTransactionRequestsRequest, opts ...grpc.CallOption) (*QuerySignTransactionRequestsResponse, error)
-	// Queries a list of SignTransactionRequestById items.
-	SignTransactionRequestById(ctx context.Context, in *QuerySignTransactionRequestByIdRequest, opts ...grpc.CallOption) (*QuerySignTransactionRequestByIdResponse, error)
+func (m *QueryEvaluateWalletPoliciesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryEvaluateWalletPoliciesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEvaluateWalletPoliciesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryEvaluateWalletPoliciesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEvaluateWalletPoliciesRequest.Merge(m, src)
+}
+func (m *QueryEvaluateWalletPoliciesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEvaluateWalletPoliciesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEvaluateWalletPoliciesRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/fusionchain.treasury.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryEvaluateWalletPoliciesRequest proto.InternalMessageInfo
+
+func (m *QueryEvaluateWalletPoliciesRequest) GetKeyId() uint64 {
+	if m != nil {
+		return m.KeyId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) KeyRequests(ctx context.Context, in *QueryKeyRequestsRequest, opts ...grpc.CallOption) (*QueryKeyRequestsResponse, error) {
-	out := new(QueryKeyRequestsResponse)
-	err := c.cc.Invoke(ctx, "/fusionchain.treasury.Query/KeyRequests", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryEvaluateWalletPoliciesRequest) GetWalletType() WalletType {
+	if m != nil {
+		return m.WalletType
 	}
-	return out, nil
+	return WalletType_WALLET_TYPE_UNSPECIFIED
 }
 
-func (c *queryClient) KeyRequestById(ctx context.Context, in *QueryKeyRequestByIdRequest, opts ...grpc.CallOption) (*QueryKeyRequestByIdResponse, error) {
-	out := new(QueryKeyRequestByIdResponse)
-	err := c.cc.Invoke(ctx, "/fusionchain.treasury.Query/KeyRequestById", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryEvaluateWalletPoliciesRequest) GetUnsignedTransaction() []byte {
+	if m != nil {
+		return m.UnsignedTransaction
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) Keys(ctx context.Context, in *QueryKeysRequest, opts ...grpc.CallOption) (*QueryKeysResponse, error) {
-	out := new(QueryKeysResponse)
-	err := c.cc.Invoke(ctx, "/fusionchain.treasury.Query/Keys", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryEvaluateWalletPoliciesRequest) GetMetadata() *types.Any {
+	if m != nil {
+		return m.Metadata
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) SignatureRequests(ctx context.Context, in *QuerySignatureRequestsRequest, opts ...grpc.CallOption) (*QuerySignatureRequestsResponse, error) {
-	out := new(QuerySignatureRequestsResponse)
-	err := c.cc.Invoke(ctx, "/fusionchain.treasury.Query/SignatureRequests", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryEvaluateWalletPoliciesRequest) GetApprovers() []string {
+	if m != nil {
+		return m.Approvers
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) SignatureRequestById(ctx context.Context, in *QuerySignatureRequestByIdRequest, opts ...grpc.CallOption) (*QuerySignatureRequestByIdResponse, error) {
-	out := new(QuerySignatureRequestByIdResponse)
-	err := c.cc.Invoke(ctx, "/fusionchain.treasury.Query/SignatureRequestById", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryEvaluateWalletPoliciesRequest) GetPolicyIds() []uint64 {
+	if m != nil {
+		return m.PolicyIds
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) SignTransactionRequests(ctx context.Context, in *QuerySignTransactionRequestsRequest, opts ...grpc.CallOption) (*QuerySignTransactionRequestsResponse, error) {
-	out := new(QuerySignTransactionRequestsResponse)
-	err := c.cc.Invoke(ctx, "/fusionchain.treasury.Query/SignTransactionRequests", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryEvaluateWalletPoliciesResponse struct {
+	// Results in the order the policies were evaluated: the sign policy of
+	// the workspace first, then the requested ones.
+	Evaluations []*PolicyEvaluation `protobuf:"bytes,1,rep,name=evaluations,proto3" json:"evaluations,omitempty"`
 }
 
-func (c *queryClient) SignTransactionRequestById(ctx context.Context, in *QuerySignTransactionRequestByIdRequest, opts ...grpc.CallOption) (*QuerySignTransactionRequestByIdResponse, error) {
-	out := new(QuerySignTransactionRequestByIdResponse)
-	err := c.cc.Invoke(ctx, "/fusionchain.treasury.Query/SignTransactionRequestById", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryEvaluateWalletPoliciesResponse) Reset()         { *m = QueryEvaluateWalletPoliciesResponse{} }
+func (m *QueryEvaluateWalletPoliciesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEvaluateWalletPoliciesResponse) ProtoMessage()    {}
+func (*QueryEvaluateWalletPoliciesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dfc42e3ec3cc822d, []int{22}
+}
+func (m *QueryEvaluateWalletPoliciesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEvaluateWalletPoliciesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEvaluateWalletPoliciesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryEvaluateWalletPoliciesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEvaluateWalletPoliciesResponse.Merge(m, src)
+}
+func (m *QueryEvaluateWalletPoliciesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEvaluateWalletPoliciesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEvaluateWalletPoliciesResponse.DiscardUnknown(m)
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Queries a list of KeyRequests items.
-	KeyRequests(context.Context, *QueryKeyRequestsRequest) (*QueryKeyRequestsResponse, error)
-	// Queries a single KeyRequest by its id.
-	KeyRequestById(context.Context, *QueryKeyRequestByIdRequest) (*QueryKeyRequestByIdResponse, error)
-	// Queries a list of Keys items.
-	Keys(context.Context, *QueryKeysRequest) (*QueryKeysResponse, error)
-	// Queries a list of SignatureRequests items.
-	SignatureRequests(context.Context, *QuerySignatureRequestsRequest) (*QuerySignatureRequestsResponse, error)
-	// Queries a single SignatureRequest by its id.
-	SignatureRequestById(context.Context, *QuerySignatureRequestByIdRequest) (*QuerySignatureRequestByIdResponse, error)
-	// Queries a list of SignTransactionRequests items.
-	SignTransactionRequests(context.Context, *QuerySignTransactionRequestsRequest) (*QuerySignTransactionRequestsResponse, error)
-	// Queries a list of SignTransactionRequestById items.
-	SignTransactionRequestById(context.Context, *QuerySignTransactionRequestByIdRequest) (*QuerySignTransactionRequestByIdResponse, error)
+var xxx_messageInfo_QueryEvaluateWalletPoliciesResponse proto.InternalMessageInfo
+
+func (m *QueryEvaluateWalletPoliciesResponse) GetEvaluations() []*PolicyEvaluation {
+	if m != nil {
+		return m.Evaluations
+	}
+	return nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+type PolicyEvaluation struct {
+	// ID of the policy, 0 for the default policy of workspaces without a sign
+	// policy.
+	PolicyId uint64 `protobuf:"varint,1,opt,name=policy_id,json=policyId,proto3" json:"policy_id,omitempty"`
+	Passed   bool   `protobuf:"varint,2,opt,name=passed,proto3" json:"passed,omitempty"`
+	// Reason of the failure if the policy didn't pass.
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *PolicyEvaluation) Reset()         { *m = PolicyEvaluation{} }
+func (m *PolicyEvaluation) String() string { return proto.CompactTextString(m) }
+func (*PolicyEvaluation) ProtoMessage()    {}
+func (*PolicyEvaluation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dfc42e3ec3cc822d, []int{23}
 }
-func (*UnimplementedQueryServer) KeyRequests(ctx context.Context, req *QueryKeyRequestsRequest) (*QueryKeyRequestsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method KeyRequests not implemented")
+func (m *PolicyEvaluation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) KeyRequestById(ctx context.Context, req *QueryKeyRequestByIdRequest) (*QueryKeyRequestByIdResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method KeyRequestById not implemented")
-}
-func (*UnimplementedQueryServer) Keys(ctx context.Context, req *QueryKeysRequest) (*QueryKeysResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Keys not implemented")
-}
-func (*UnimplementedQueryServer) SignatureRequests(ctx context.Context, req *QuerySignatureRequestsRequest) (*QuerySignatureRequestsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SignatureRequests not implemented")
+func (m *PolicyEvaluation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PolicyEvaluation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) SignatureRequestById(ctx context.Context, req *QuerySignatureRequestByIdRequest) (*QuerySignatureRequestByIdResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SignatureRequestById not implemented")
+func (m *PolicyEvaluation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PolicyEvaluation.Merge(m, src)
 }
-func (*UnimplementedQueryServer) SignTransactionRequests(ctx context.Context, req *QuerySignTransactionRequestsRequest) (*QuerySignTransactionRequestsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SignTransactionRequests not implemented")
+func (m *PolicyEvaluation) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) SignTransactionRequestById(ctx context.Context, req *QuerySignTransactionRequestByIdRequest) (*QuerySignTransactionRequestByIdResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SignTransactionRequestById not implemented")
+func (m *PolicyEvaluation) XXX_DiscardUnknown() {
+	xxx_messageInfo_PolicyEvaluation.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+var xxx_messageInfo_PolicyEvaluation proto.InternalMessageInfo
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/fusionchain.treasury.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *PolicyEvaluation) GetPolicyId() uint64 {
+	if m != nil {
+		return m.PolicyId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_KeyRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryKeyRequestsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).KeyRequests(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/fusionchain.treasury.Query/KeyRequests",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).KeyRequests(ctx, req.(*QueryKeyRequestsRequest))
+func (m *PolicyEvaluation) GetPassed() bool {
+	if m != nil {
+		return m.Passed
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _Query_KeyRequestById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryKeyRequestByIdRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).KeyRequestById(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/fusionchain.treasury.Query/KeyRequestById",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).KeyRequestById(ctx, req.(*QueryKeyRequestByIdRequest))
+func (m *PolicyEvaluation) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_Keys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryKeysRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Keys(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/fusionchain.treasury.Query/Keys",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Keys(ctx, req.(*QueryKeysRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QuerySigningRequestRequest struct {
+	KeyId      uint64     `protobuf:"varint,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	WalletType WalletType `protobuf:"varint,2,opt,name=wallet_type,json=walletType,proto3,enum=fusionchain.treasury.WalletType" json:"wallet_type,omitempty"`
+	// Transaction to sign, as in MsgNewSignTransactionRequest.
+	UnsignedTransaction []byte     `protobuf:"bytes,3,opt,name=unsigned_transaction,json=unsignedTransaction,proto3" json:"unsigned_transaction,omitempty"`
+	Metadata            *types.Any `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
 }
 
-func _Query_SignatureRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySignatureRequestsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).SignatureRequests(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/fusionchain.treasury.Query/SignatureRequests",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).SignatureRequests(ctx, req.(*QuerySignatureRequestsRequest))
+func (m *QuerySigningRequestRequest) Reset()         { *m = QuerySigningRequestRequest{} }
+func (m *QuerySigningRequestRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySigningRequestRequest) ProtoMessage()    {}
+func (*QuerySigningRequestRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dfc42e3ec3cc822d, []int{24}
+}
+func (m *QuerySigningRequestRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySigningRequestRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySigningRequestRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QuerySigningRequestRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySigningRequestRequest.Merge(m, src)
+}
+func (m *QuerySigningRequestRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySigningRequestRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySigningRequestRequest.DiscardUnknown(m)
 }
 
-func _Query_SignatureRequestById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySignatureRequestByIdRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).SignatureRequestById(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/fusionchain.treasury.Query/SignatureRequestById",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).SignatureRequestById(ctx, req.(*QuerySignatureRequestByIdRequest))
+var xxx_messageInfo_QuerySigningRequestRequest proto.InternalMessageInfo
+
+func (m *QuerySigningRequestRequest) GetKeyId() uint64 {
+	if m != nil {
+		return m.KeyId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_SignTransactionRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySignTransactionRequestsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).SignTransactionRequests(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/fusionchain.treasury.Query/SignTransactionRequests",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).SignTransactionRequests(ctx, req.(*QuerySignTransactionRequestsRequest))
+func (m *QuerySigningRequestRequest) GetWalletType() WalletType {
+	if m != nil {
+		return m.WalletType
 	}
-	return interceptor(ctx, in, info, handler)
+	return WalletType_WALLET_TYPE_UNSPECIFIED
 }
 
-func _Query_SignTransactionRequestById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySignTransactionRequestByIdRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).SignTransactionRequestById(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/fusionchain.treasury.Query/SignTransactionRequestById",
+func (m *QuerySigningRequestRequest) GetUnsignedTransaction() []byte {
+	if m != nil {
+		return m.UnsignedTransaction
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).SignTransactionRequestById(ctx, req.(*QuerySignTransactionRequestByIdRequest))
+	return nil
+}
+
+func (m *QuerySigningRequestRequest) GetMetadata() *types.Any {
+	if m != nil {
+		return m.Metadata
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "fusionchain.treasury.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "KeyRequests",
-			Handler:    _Query_KeyRequests_Handler,
-		},
-		{
-			MethodName: "KeyRequestById",
-			Handler:    _Query_KeyRequestById_Handler,
-		},
-		{
-			MethodName: "Keys",
-			Handler:    _Query_Keys_Handler,
-		},
-		{
-			MethodName: "SignatureRequests",
-			Handler:    _Query_SignatureRequests_Handler,
-		},
-		{
-			MethodName: "SignatureRequestById",
-			Handler:    _Query_SignatureRequestById_Handler,
-		},
-		{
-			MethodName: "SignTransactionRequests",
-			Handler:    _Query_SignTransactionRequests_Handler,
-		},
-		{
-			MethodName: "SignTransactionRequestById",
-			Handler:    _Query_SignTransactionRequestById_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "fusionchain/treasury/query.proto",
+type QuerySigningRequestResponse struct {
+	// Data to sign, as parsed from the unsigned transaction by the wallet.
+	DataForSigning []byte `protobuf:"bytes,1,opt,name=data_for_signing,json=dataForSigning,proto3" json:"data_for_signing,omitempty"`
+	// Key that must sign the data, i.e. the key the requested key was rotated
+	// to if any (see Key.signing_key_id), and its curve.
+	KeyId      uint64     `protobuf:"varint,2,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	KeyType    KeyType    `protobuf:"varint,3,opt,name=key_type,json=keyType,proto3,enum=fusionchain.treasury.KeyType" json:"key_type,omitempty"`
+	WalletType WalletType `protobuf:"varint,4,opt,name=wallet_type,json=walletType,proto3,enum=fusionchain.treasury.WalletType" json:"wallet_type,omitempty"`
+	// Address of the wallet, to verify the signature against.
+	Address string `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuerySigningRequestResponse) Reset()         { *m = QuerySigningRequestResponse{} }
+func (m *QuerySigningRequestResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySigningRequestResponse) ProtoMessage()    {}
+func (*QuerySigningRequestResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dfc42e3ec3cc822d, []int{25}
+}
+func (m *QuerySigningRequestResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySigningRequestResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySigningRequestResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuerySigningRequestResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySigningRequestResponse.Merge(m, src)
+}
+func (m *QuerySigningRequestResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySigningRequestResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySigningRequestResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QuerySigningRequestResponse proto.InternalMessageInfo
+
+func (m *QuerySigningRequestResponse) GetDataForSigning() []byte {
+	if m != nil {
+		return m.DataForSigning
+	}
+	return nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuerySigningRequestResponse) GetKeyId() uint64 {
+	if m != nil {
+		return m.KeyId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuerySigningRequestResponse) GetKeyType() KeyType {
+	if m != nil {
+		return m.KeyType
+	}
+	return KeyType_KEY_TYPE_UNSPECIFIED
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QuerySigningRequestResponse) GetWalletType() WalletType {
+	if m != nil {
+		return m.WalletType
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return WalletType_WALLET_TYPE_UNSPECIFIED
 }
 
-func (m *QueryKeyRequestsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuerySigningRequestResponse) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryKeyRequestsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "fusionchain.treasury.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "fusionchain.treasury.QueryParamsResponse")
+	proto.RegisterType((*QueryKeyRequestsRequest)(nil), "fusionchain.treasury.QueryKeyRequestsRequest")
+	proto.RegisterType((*QueryKeyRequestsResponse)(nil), "fusionchain.treasury.QueryKeyRequestsResponse")
+	proto.RegisterType((*QueryKeyRequestByIdRequest)(nil), "fusionchain.treasury.QueryKeyRequestByIdRequest")
+	proto.RegisterType((*QueryKeyRequestByIdResponse)(nil), "fusionchain.treasury.QueryKeyRequestByIdResponse")
+	proto.RegisterType((*QueryKeysRequest)(nil), "fusionchain.treasury.QueryKeysRequest")
+	proto.RegisterType((*QueryKeysResponse)(nil), "fusionchain.treasury.QueryKeysResponse")
+	proto.RegisterType((*KeyResponse)(nil), "fusionchain.treasury.KeyResponse")
+	proto.RegisterType((*WalletKeyResponse)(nil), "fusionchain.treasury.WalletKeyResponse")
+	proto.RegisterType((*QueryKeyAddressesRequest)(nil), "fusionchain.treasury.QueryKeyAddressesRequest")
+	proto.RegisterType((*QueryKeyAddressesResponse)(nil), "fusionchain.treasury.QueryKeyAddressesResponse")
+	proto.RegisterType((*QuerySignatureRequestsRequest)(nil), "fusionchain.treasury.QuerySignatureRequestsRequest")
+	proto.RegisterType((*QuerySignatureRequestsResponse)(nil), "fusionchain.treasury.QuerySignatureRequestsResponse")
+	proto.RegisterType((*QuerySignatureRequestByIdRequest)(nil), "fusionchain.treasury.QuerySignatureRequestByIdRequest")
+	proto.RegisterType((*QuerySignatureRequestByIdResponse)(nil), "fusionchain.treasury.QuerySignatureRequestByIdResponse")
+	proto.RegisterType((*QuerySignTransactionRequestsRequest)(nil), "fusionchain.treasury.QuerySignTransactionRequestsRequest")
+	proto.RegisterType((*SignTransactionRequestResponse)(nil), "fusionchain.treasury.SignTransactionRequestResponse")
+	proto.RegisterType((*QuerySignTransactionRequestsResponse)(nil), "fusionchain.treasury.QuerySignTransactionRequestsResponse")
+	proto.RegisterType((*QuerySignTransactionRequestByIdRequest)(nil), "fusionchain.treasury.QuerySignTransactionRequestByIdRequest")
+	proto.RegisterType((*QuerySignTransactionRequestByIdResponse)(nil), "fusionchain.treasury.QuerySignTransactionRequestByIdResponse")
+	proto.RegisterType((*QueryEvaluateWalletPoliciesRequest)(nil), "fusionchain.treasury.QueryEvaluateWalletPoliciesRequest")
+	proto.RegisterType((*QueryEvaluateWalletPoliciesResponse)(nil), "fusionchain.treasury.QueryEvaluateWalletPoliciesResponse")
+	proto.RegisterType((*PolicyEvaluation)(nil), "fusionchain.treasury.PolicyEvaluation")
+	proto.RegisterType((*QuerySigningRequestRequest)(nil), "fusionchain.treasury.QuerySigningRequestRequest")
+	proto.RegisterType((*QuerySigningRequestResponse)(nil), "fusionchain.treasury.QuerySigningRequestResponse")
 }
 
-func (m *QueryKeyRequestsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.WorkspaceAddr) > 0 {
-		i -= len(m.WorkspaceAddr)
-		copy(dAtA[i:], m.WorkspaceAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.WorkspaceAddr)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Status != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.KeyringAddr) > 0 {
-		i -= len(m.KeyringAddr)
-		copy(dAtA[i:], m.KeyringAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.KeyringAddr)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func init() { proto.RegisterFile("fusionchain/treasury/query.proto", fileDescriptor_dfc42e3ec3cc822d) }
+
+var fileDescriptor_dfc42e3ec3cc822d = []byte{
+	// 1504 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0x4d, 0x6c, 0x1b, 0x45,
+	0x14, 0xce, 0x38, 0x4e, 0x1a, 0x3f, 0xa7, 0x26, 0x9d, 0x86, 0xd6, 0xd9, 0xa6, 0xc6, 0xd9, 0xb6,
+	0x89, 0xfb, 0x93, 0xdd, 0x3a, 0x4d, 0x7f, 0x55, 0x40, 0x29, 0xd0, 0x52, 0x71, 0x69, 0xb7, 0x95,
+	0x2a, 0x71, 0x31, 0x13, 0xef, 0xc4, 0x5d, 0x39, 0xd9, 0xdd, 0xee, 0xac, 0x53, 0x2c, 0xc4, 0x05,
+	0x2e, 0x1c, 0x41, 0xbd, 0x70, 0xe0, 0xc0, 0x01, 0x21, 0xae, 0x20, 0xce, 0xdc, 0x90, 0x8a, 0x90,
+	0xaa, 0x4a, 0x1c, 0x40, 0x42, 0x42, 0xd0, 0x70, 0xe3, 0xcc, 0x85, 0x13, 0xda, 0xd9, 0xd9, 0xf5,
+	0xda, 0xde, 0x5d, 0xc7, 0x56, 0x00, 0x89, 0x9b, 0x77, 0xf6, 0xbd, 0x79, 0xdf, 0xf7, 0xbd, 0xf7,
+	0x66, 0xdf, 0x18, 0xca, 0x1b, 0x2d, 0x66, 0x58, 0x66, 0xfd, 0x3e, 0x31, 0x4c, 0xd5, 0x75, 0x28,
+	0x61, 0x2d, 0xa7, 0xad, 0x3e, 0x68, 0x51, 0xa7, 0xad, 0xd8, 0x8e, 0xe5, 0x5a, 0x78, 0x36, 0x62,
+	0xa1, 0x04, 0x16, 0xd2, 0x6c, 0xc3, 0x6a, 0x58, 0xdc, 0x40, 0xf5, 0x7e, 0xf9, 0xb6, 0xd2, 0x7c,
+	0xc3, 0xb2, 0x1a, 0x9b, 0x54, 0x25, 0xb6, 0xa1, 0x12, 0xd3, 0xb4, 0x5c, 0xe2, 0x1a, 0x96, 0xc9,
+	0xc4, 0xdb, 0x39, 0xf1, 0x96, 0x3f, 0xad, 0xb7, 0x36, 0x54, 0x62, 0x8a, 0x20, 0xd2, 0xa9, 0xba,
+	0xc5, 0xb6, 0x2c, 0xa6, 0xae, 0x13, 0x46, 0xfd, 0xe8, 0xea, 0x76, 0x75, 0x9d, 0xba, 0xa4, 0xaa,
+	0xda, 0xa4, 0x61, 0x98, 0x7c, 0x1f, 0x61, 0xbb, 0x10, 0x0b, 0xd9, 0x26, 0x0e, 0xd9, 0x0a, 0x22,
+	0x95, 0x62, 0x4d, 0x9a, 0x34, 0x08, 0x27, 0xc7, 0xbe, 0xdf, 0xb2, 0xeb, 0xcc, 0x68, 0xa4, 0x87,
+	0x79, 0x48, 0x36, 0x37, 0xa9, 0xeb, 0x9b, 0xc8, 0xb3, 0x80, 0x6f, 0x7b, 0x58, 0x6f, 0xf1, 0xd8,
+	0x1a, 0x7d, 0xd0, 0xa2, 0xcc, 0x95, 0x6f, 0xc3, 0xc1, 0xae, 0x55, 0x66, 0x5b, 0x26, 0xa3, 0xf8,
+	0x0a, 0x4c, 0xfa, 0x18, 0x8b, 0xa8, 0x8c, 0x2a, 0xf9, 0x95, 0x79, 0x25, 0x4e, 0x58, 0xc5, 0xf7,
+	0xba, 0x96, 0x7d, 0xfc, 0xcb, 0x0b, 0x63, 0x9a, 0xf0, 0x90, 0xff, 0x40, 0x70, 0x98, 0xef, 0xf9,
+	0x06, 0x6d, 0x8b, 0x30, 0x41, 0x38, 0x7c, 0x1d, 0xa0, 0x23, 0x91, 0xd8, 0x7b, 0x51, 0xf1, 0xf5,
+	0x54, 0x3c, 0x3d, 0x15, 0x3f, 0x9b, 0x42, 0x4f, 0xe5, 0x16, 0x69, 0x50, 0xe1, 0xab, 0x45, 0x3c,
+	0xf1, 0x02, 0x4c, 0x37, 0x69, 0xdb, 0x31, 0xcc, 0x46, 0x8d, 0xe8, 0xba, 0x53, 0xcc, 0x94, 0x51,
+	0x25, 0xa7, 0xe5, 0xc5, 0xda, 0x9a, 0xae, 0x3b, 0xf8, 0x25, 0x98, 0x64, 0x2e, 0x71, 0x5b, 0xac,
+	0x38, 0x5e, 0x46, 0x95, 0xc2, 0xca, 0x62, 0x3c, 0x85, 0x0e, 0xc8, 0x3b, 0xdc, 0x5a, 0x13, 0x5e,
+	0xf8, 0x04, 0x14, 0x1e, 0x5a, 0x4e, 0x93, 0xd9, 0xa4, 0x4e, 0xfd, 0x20, 0x59, 0x1e, 0x64, 0x7f,
+	0xb8, 0xea, 0x85, 0x91, 0xbf, 0x40, 0x50, 0xec, 0x67, 0x2b, 0x64, 0xbc, 0x11, 0x43, 0x77, 0x69,
+	0x20, 0x5d, 0xdf, 0xb9, 0x8b, 0xef, 0x2b, 0x9c, 0x6f, 0xcd, 0x11, 0x01, 0x8a, 0x99, 0xf2, 0x78,
+	0x25, 0xbf, 0x52, 0x1e, 0x44, 0x89, 0x2b, 0x22, 0x7e, 0x33, 0xf9, 0x0c, 0x48, 0x3d, 0x48, 0xaf,
+	0xb5, 0x6f, 0xea, 0x41, 0x6a, 0x0a, 0x90, 0x31, 0x74, 0x8e, 0x31, 0xab, 0x65, 0x0c, 0x5d, 0x7e,
+	0x0b, 0x8e, 0xc4, 0x5a, 0x0b, 0x6a, 0x6b, 0x90, 0x8f, 0x20, 0x12, 0xdc, 0x06, 0x03, 0x82, 0x0e,
+	0x20, 0xf9, 0x09, 0x82, 0x99, 0x20, 0xc4, 0x9e, 0x57, 0x48, 0x7f, 0xfa, 0x32, 0x31, 0xe9, 0xc3,
+	0xab, 0x90, 0x75, 0xdb, 0x36, 0x15, 0x35, 0x92, 0x80, 0xff, 0x1e, 0xef, 0xa3, 0xbb, 0x6d, 0x9b,
+	0x6a, 0xdc, 0x1a, 0x3f, 0x0f, 0x93, 0x1e, 0x79, 0x43, 0xe7, 0x35, 0x91, 0xd5, 0x26, 0x9a, 0xb4,
+	0x7d, 0x53, 0x97, 0x1f, 0x21, 0x38, 0x10, 0x21, 0xb4, 0xd7, 0x45, 0x70, 0x1e, 0xb2, 0x4d, 0xda,
+	0x0e, 0x92, 0xbf, 0x90, 0xa2, 0xb5, 0x70, 0xe6, 0xe6, 0xf2, 0xbb, 0x90, 0x8f, 0x2c, 0xe2, 0xd3,
+	0x30, 0xde, 0xa4, 0x6d, 0x81, 0x63, 0x2e, 0x79, 0x13, 0xcf, 0x0a, 0xaf, 0xc1, 0x3e, 0xff, 0x10,
+	0x09, 0xa2, 0x2e, 0xa5, 0x29, 0x14, 0x8d, 0x1d, 0xf8, 0xc9, 0x75, 0x38, 0xd0, 0xf7, 0x16, 0x17,
+	0x61, 0x9f, 0x97, 0x13, 0xca, 0xfc, 0x03, 0x26, 0xa7, 0x05, 0x8f, 0x61, 0x42, 0x32, 0xc3, 0x24,
+	0x44, 0xae, 0x76, 0x9a, 0x70, 0xcd, 0xdf, 0x88, 0x86, 0x15, 0xd5, 0x49, 0x16, 0x8a, 0x26, 0xeb,
+	0x2b, 0x04, 0x73, 0x31, 0x3e, 0x61, 0x79, 0x87, 0xc4, 0xd1, 0x68, 0xc4, 0xb1, 0x06, 0x33, 0xb6,
+	0x43, 0xb7, 0x0d, 0xab, 0xc5, 0x6a, 0x23, 0x8a, 0xf8, 0x5c, 0xb0, 0xc1, 0x3d, 0x21, 0xe6, 0xf7,
+	0x08, 0x8e, 0x72, 0xd0, 0x77, 0x8c, 0x86, 0x49, 0xdc, 0x96, 0x43, 0xff, 0xc3, 0x13, 0xf6, 0xe5,
+	0x9e, 0x13, 0x36, 0x81, 0x96, 0x07, 0x35, 0xf6, 0x88, 0x95, 0xbf, 0x44, 0x50, 0x4a, 0x62, 0xb3,
+	0xd7, 0xcd, 0x73, 0x1d, 0xf6, 0x7b, 0xdf, 0xcb, 0xde, 0x23, 0x74, 0x61, 0x20, 0x66, 0x6d, 0x9a,
+	0x75, 0x1e, 0x98, 0xbc, 0x02, 0xe5, 0x58, 0xc8, 0x69, 0x47, 0xa9, 0x01, 0x0b, 0x29, 0x3e, 0x82,
+	0xe9, 0xab, 0x30, 0x1d, 0x05, 0x28, 0xb8, 0xee, 0x02, 0x5f, 0x3e, 0x82, 0x4f, 0xfe, 0x20, 0x03,
+	0xc7, 0xc2, 0x58, 0x77, 0x1d, 0x62, 0x32, 0x52, 0xf7, 0xf8, 0xff, 0x53, 0x65, 0xb2, 0x06, 0x79,
+	0xbf, 0xb6, 0x6b, 0x43, 0x75, 0x2d, 0x3c, 0x0c, 0x7f, 0x47, 0xfa, 0x73, 0x3c, 0xd2, 0x9f, 0x91,
+	0xea, 0xca, 0x8e, 0x56, 0x5d, 0x4f, 0x10, 0x94, 0xe2, 0x55, 0x08, 0x35, 0xdf, 0x80, 0x22, 0xd7,
+	0xdc, 0xed, 0x98, 0xf4, 0xe8, 0x7f, 0x26, 0x39, 0x6a, 0xcc, 0xbe, 0x87, 0x58, 0xec, 0x7a, 0x5f,
+	0x6e, 0x33, 0x23, 0xe5, 0xf6, 0x37, 0x04, 0xc7, 0xd3, 0x73, 0xbb, 0xd7, 0x4d, 0x63, 0xc3, 0x5c,
+	0x92, 0x3e, 0x41, 0x03, 0xad, 0x0e, 0x25, 0x50, 0x10, 0xe4, 0x70, 0xbc, 0x50, 0x4c, 0xbe, 0x04,
+	0x8b, 0x29, 0x14, 0xd3, 0x9a, 0xec, 0x23, 0x04, 0x4b, 0x03, 0x5d, 0xff, 0xdd, 0xbc, 0xcb, 0x9f,
+	0x66, 0x40, 0xe6, 0x98, 0x5e, 0xdb, 0x26, 0x9b, 0x2d, 0xe2, 0x52, 0xbf, 0x05, 0x6e, 0x59, 0x9b,
+	0x46, 0xdd, 0x18, 0xf4, 0x85, 0xda, 0x8b, 0xde, 0xaa, 0xc2, 0x6c, 0xcb, 0xf4, 0xc0, 0x51, 0x3d,
+	0x4a, 0x96, 0x77, 0xda, 0xb4, 0x76, 0x30, 0x78, 0x17, 0x81, 0x8e, 0xcf, 0xc2, 0xd4, 0x16, 0x75,
+	0x89, 0x4e, 0x5c, 0xc2, 0x3b, 0x2f, 0xbf, 0x32, 0xab, 0xf8, 0x77, 0x21, 0x25, 0xb8, 0x0b, 0x29,
+	0x6b, 0x66, 0x5b, 0x0b, 0xad, 0xf0, 0x3c, 0xe4, 0x88, 0x6d, 0x3b, 0xd6, 0x36, 0x75, 0x58, 0x71,
+	0xa2, 0x3c, 0x5e, 0xc9, 0x69, 0x9d, 0x05, 0x7c, 0x14, 0xc0, 0xf6, 0xf8, 0x7a, 0xfc, 0x58, 0x71,
+	0xb2, 0x3c, 0x5e, 0xc9, 0x6a, 0x39, 0x7f, 0xe5, 0xa6, 0xce, 0x64, 0x0b, 0x8e, 0xa5, 0x2a, 0x24,
+	0x32, 0xf6, 0x3a, 0xe4, 0xa9, 0x6f, 0x61, 0x58, 0x66, 0xf0, 0x4d, 0x4e, 0x18, 0xe9, 0xb9, 0x73,
+	0xb0, 0xa1, 0x97, 0x8d, 0xa8, 0xab, 0x5c, 0x83, 0x99, 0x5e, 0x03, 0x7c, 0x04, 0x72, 0x21, 0x46,
+	0x91, 0x83, 0xa9, 0x00, 0x22, 0x3e, 0xe4, 0xdd, 0x85, 0x18, 0xa3, 0x3a, 0xcf, 0xc0, 0x94, 0x26,
+	0x9e, 0xbc, 0x75, 0x2f, 0xa2, 0x50, 0x33, 0xa7, 0x89, 0x27, 0xf9, 0x67, 0x24, 0xe6, 0x6c, 0xaf,
+	0x58, 0x0c, 0xb3, 0x11, 0xd6, 0xfe, 0xff, 0x21, 0xd9, 0xf2, 0x9f, 0x08, 0x8e, 0xc4, 0xb2, 0x13,
+	0x89, 0xaa, 0xc0, 0x8c, 0x67, 0x57, 0xdb, 0xb0, 0x9c, 0x1a, 0xf3, 0x4d, 0x38, 0xd1, 0x69, 0xad,
+	0xe0, 0xad, 0x5f, 0xb7, 0x1c, 0xe1, 0x18, 0x11, 0x22, 0x13, 0x15, 0xe2, 0x12, 0x4c, 0x79, 0xcb,
+	0x91, 0xa9, 0xfc, 0x68, 0xe2, 0x90, 0xca, 0x25, 0xd8, 0xd7, 0xf4, 0x7f, 0xf4, 0x4a, 0x98, 0x1d,
+	0x41, 0xc2, 0xc8, 0x5c, 0x3a, 0xd1, 0x35, 0x97, 0xae, 0xfc, 0x55, 0x80, 0x09, 0xce, 0x1b, 0xbf,
+	0x8f, 0x60, 0xd2, 0xbf, 0xf8, 0xe2, 0x4a, 0xfc, 0xe6, 0xfd, 0xf7, 0x6c, 0xe9, 0xe4, 0x2e, 0x2c,
+	0x7d, 0x05, 0xe5, 0xe3, 0xef, 0xfd, 0xf0, 0xfb, 0xa3, 0x4c, 0x09, 0xcf, 0xab, 0x29, 0xff, 0x1d,
+	0xe0, 0x8f, 0x91, 0x18, 0xeb, 0xf9, 0xfe, 0x0c, 0x2f, 0xa7, 0x04, 0xe8, 0xbf, 0x88, 0x4b, 0xca,
+	0x6e, 0xcd, 0x05, 0xa8, 0x53, 0x1c, 0xd4, 0x71, 0x2c, 0xab, 0x49, 0xff, 0x56, 0x84, 0x1f, 0x06,
+	0xfc, 0x39, 0x82, 0x42, 0xf7, 0xad, 0x11, 0x9f, 0xdd, 0x55, 0xb8, 0xc8, 0xf1, 0x2e, 0x55, 0x87,
+	0xf0, 0x10, 0x18, 0x55, 0x8e, 0xf1, 0x24, 0x5e, 0x1a, 0x88, 0xb1, 0xb6, 0xee, 0xd5, 0x1d, 0x7e,
+	0x07, 0xb2, 0xde, 0x4d, 0x0d, 0x2f, 0xa6, 0xc7, 0x0a, 0x45, 0x5b, 0x1a, 0x68, 0x27, 0x90, 0xc8,
+	0x1c, 0xc9, 0x3c, 0x96, 0x12, 0x91, 0x30, 0xfc, 0x09, 0x82, 0xe9, 0xe8, 0xd5, 0x03, 0x0f, 0x48,
+	0x49, 0xef, 0xbd, 0x46, 0x52, 0x77, 0x6d, 0x2f, 0x50, 0x9d, 0xe6, 0xa8, 0x4e, 0xe0, 0x63, 0xc9,
+	0xfa, 0x90, 0x10, 0xcd, 0xd7, 0x08, 0x0e, 0xf4, 0x8d, 0xe5, 0xf8, 0x5c, 0x4a, 0xcc, 0xa4, 0x2b,
+	0x89, 0xb4, 0x3a, 0x9c, 0x93, 0x40, 0xbb, 0xca, 0xd1, 0x2a, 0xf8, 0x4c, 0x3c, 0xda, 0x06, 0x75,
+	0x6b, 0x2c, 0x70, 0xee, 0xd4, 0xde, 0x37, 0x08, 0x66, 0xe3, 0xc6, 0x6c, 0x7c, 0x61, 0x08, 0x10,
+	0xd1, 0x3a, 0xbc, 0x38, 0xb4, 0x9f, 0xc0, 0x7f, 0x9e, 0xe3, 0x57, 0xf1, 0x72, 0x3c, 0xfe, 0x3e,
+	0xec, 0xa2, 0x26, 0xbf, 0x43, 0x70, 0x38, 0x61, 0xbe, 0xc3, 0x97, 0x07, 0x60, 0x49, 0x9e, 0xf7,
+	0xa5, 0x2b, 0xa3, 0xb8, 0x0a, 0x26, 0x17, 0x39, 0x93, 0x2a, 0x56, 0x93, 0x99, 0xc4, 0x4e, 0x88,
+	0xf8, 0x47, 0x04, 0x52, 0xf2, 0x34, 0x86, 0xaf, 0x0e, 0x8d, 0x29, 0x9a, 0x98, 0x17, 0x47, 0xf4,
+	0x16, 0xa4, 0xae, 0x72, 0x52, 0x17, 0xf0, 0xea, 0x70, 0xa4, 0x44, 0x96, 0xbe, 0x45, 0x70, 0x28,
+	0x7e, 0x62, 0xc1, 0x97, 0x52, 0x70, 0xa5, 0x8e, 0x81, 0xd2, 0xe5, 0x11, 0x3c, 0x05, 0x9b, 0x0b,
+	0x9c, 0xcd, 0x59, 0xac, 0xc4, 0xb3, 0x11, 0xf3, 0x0f, 0x15, 0xff, 0x43, 0xd4, 0xec, 0x00, 0xec,
+	0x67, 0x08, 0x0a, 0xdd, 0x1f, 0xf2, 0xd4, 0xa3, 0x3a, 0x76, 0xa2, 0x91, 0xaa, 0x43, 0x78, 0x08,
+	0xbc, 0xcb, 0x1c, 0xef, 0x12, 0x3e, 0x91, 0xac, 0xbe, 0xf7, 0xcf, 0x83, 0x10, 0xfd, 0xda, 0x8d,
+	0xc7, 0xcf, 0x4a, 0xe8, 0xe9, 0xb3, 0x12, 0xfa, 0xf5, 0x59, 0x09, 0x7d, 0xb8, 0x53, 0x1a, 0x7b,
+	0xba, 0x53, 0x1a, 0xfb, 0x69, 0xa7, 0x34, 0xf6, 0xe6, 0x72, 0xc3, 0x70, 0xef, 0xb7, 0xd6, 0x95,
+	0xba, 0xb5, 0xa5, 0x3e, 0x70, 0xa8, 0x6e, 0x75, 0x6d, 0xf8, 0x76, 0x67, 0x4b, 0x6f, 0x24, 0x60,
+	0xeb, 0x93, 0x7c, 0xaa, 0x39, 0xf7, 0xf7, 0x00, 0x73, 0x0a, 0x3d, 0x83, 0x4a, 0x18, 0x00, 0x00,
 }
 
-func (m *QueryKeyRequestsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Queries a list of KeyRequests items.
+	KeyRequests(ctx context.Context, in *QueryKeyRequestsRequest, opts ...grpc.CallOption) (*QueryKeyRequestsResponse, error)
+	// Queries a single KeyRequest by its id.
+	KeyRequestById(ctx context.Context, in *QueryKeyRequestByIdRequest, opts ...grpc.CallOption) (*QueryKeyRequestByIdResponse, error)
+	// Queries a list of Keys items.
+	Keys(ctx context.Context, in *QueryKeysRequest, opts ...grpc.CallOption) (*QueryKeysResponse, error)
+	// Queries the addresses controlled by a key on every supported chain.
+	KeyAddresses(ctx context.Context, in *QueryKeyAddressesRequest, opts ...grpc.CallOption) (*QueryKeyAddressesResponse, error)
+	// Queries a list of SignatureRequests items.
+	SignatureRequests(ctx context.Context, in *QuerySignatureRequestsRequest, opts ...grpc.CallOption) (*QuerySignatureRequestsResponse, error)
+	// Queries a single SignatureRequest by its id.
+	SignatureRequestById(ctx context.Context, in *QuerySignatureRequestByIdRequest, opts ...grpc.CallOption) (*QuerySignatureRequestByIdResponse, error)
+	// Queries a list of SignTransactionRequests items.
+	SignTransactionRequests(ctx context.Context, in *QuerySignTransactionRequestsRequest, opts ...grpc.CallOption) (*QuerySignTransactionRequestsResponse, error)
+	// Queries a list of SignTransactionRequestById items.
+	SignTransactionRequestById(ctx context.Context, in *QuerySignTransactionRequestByIdRequest, opts ...grpc.CallOption) (*QuerySignTransactionRequestByIdResponse, error)
+	// Evaluates the policies of a wallet against a candidate transfer and
+	// set of approvers, without creating an action.
+	EvaluateWalletPolicies(ctx context.Context, in *QueryEvaluateWalletPoliciesRequest, opts ...grpc.CallOption) (*QueryEvaluateWalletPoliciesResponse, error)
+	// Returns the data to sign for an unsigned transaction of a wallet, with
+	// the key that must sign it, for the hand-off to an external signer.
+	SigningRequest(ctx context.Context, in *QuerySigningRequestRequest, opts ...grpc.CallOption) (*QuerySigningRequestResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/fusionchain.treasury.Query/Params", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryKeyRequestsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) KeyRequests(ctx context.Context, in *QueryKeyRequestsRequest, opts ...grpc.CallOption) (*QueryKeyRequestsResponse, error) {
+	out := new(QueryKeyRequestsResponse)
+	err := c.cc.Invoke(ctx, "/fusionchain.treasury.Query/KeyRequests", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryKeyRequestsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.KeyRequests) > 0 {
-		for iNdEx := len(m.KeyRequests) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.KeyRequests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+func (c *queryClient) KeyRequestById(ctx context.Context, in *QueryKeyRequestByIdRequest, opts ...grpc.CallOption) (*QueryKeyRequestByIdResponse, error) {
+	out := new(QueryKeyRequestByIdResponse)
+	err := c.cc.Invoke(ctx, "/fusionchain.treasury.Query/KeyRequestById", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *queryClient) Keys(ctx context.Context, in *QueryKeysRequest, opts ...grpc.CallOption) (*QueryKeysResponse, error) {
+	out := new(QueryKeysResponse)
+	err := c.cc.Invoke(ctx, "/fusionchain.treasury.Query/Keys", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryKeyRequestByIdRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) KeyAddresses(ctx context.Context, in *QueryKeyAddressesRequest, opts ...grpc.CallOption) (*QueryKeyAddressesResponse, error) {
+	out := new(QueryKeyAddressesResponse)
+	err := c.cc.Invoke(ctx, "/fusionchain.treasury.Query/KeyAddresses", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryKeyRequestByIdRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) SignatureRequests(ctx context.Context, in *QuerySignatureRequestsRequest, opts ...grpc.CallOption) (*QuerySignatureRequestsResponse, error) {
+	out := new(QuerySignatureRequestsResponse)
+	err := c.cc.Invoke(ctx, "/fusionchain.treasury.Query/SignatureRequests", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryKeyRequestByIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+func (c *queryClient) SignatureRequestById(ctx context.Context, in *QuerySignatureRequestByIdRequest, opts ...grpc.CallOption) (*QuerySignatureRequestByIdResponse, error) {
+	out := new(QuerySignatureRequestByIdResponse)
+	err := c.cc.Invoke(ctx, "/fusionchain.treasury.Query/SignatureRequestById", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryKeyRequestByIdResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) SignTransactionRequests(ctx context.Context, in *QuerySignTransactionRequestsRequest, opts ...grpc.CallOption) (*QuerySignTransactionRequestsResponse, error) {
+	out := new(QuerySignTransactionRequestsResponse)
+	err := c.cc.Invoke(ctx, "/fusionchain.treasury.Query/SignTransactionRequests", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryKeyRequestByIdResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) SignTransactionRequestById(ctx context.Context, in *QuerySignTransactionRequestByIdRequest, opts ...grpc.CallOption) (*QuerySignTransactionRequestByIdResponse, error) {
+	out := new(QuerySignTransactionRequestByIdResponse)
+	err := c.cc.Invoke(ctx, "/fusionchain.treasury.Query/SignTransactionRequestById", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryKeyRequestByIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.KeyRequest != nil {
-		{
-			size, err := m.KeyRequest.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) EvaluateWalletPolicies(ctx context.Context, in *QueryEvaluateWalletPoliciesRequest, opts ...grpc.CallOption) (*QueryEvaluateWalletPoliciesResponse, error) {
+	out := new(QueryEvaluateWalletPoliciesResponse)
+	err := c.cc.Invoke(ctx, "/fusionchain.treasury.Query/EvaluateWalletPolicies", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryKeysRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) SigningRequest(ctx context.Context, in *QuerySigningRequestRequest, opts ...grpc.CallOption) (*QuerySigningRequestResponse, error) {
+	out := new(QuerySigningRequestResponse)
+	err := c.cc.Invoke(ctx, "/fusionchain.treasury.Query/SigningRequest", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryKeysRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Queries a list of KeyRequests items.
+	KeyRequests(context.Context, *QueryKeyRequestsRequest) (*QueryKeyRequestsResponse, error)
+	// Queries a single KeyRequest by its id.
+	KeyRequestById(context.Context, *QueryKeyRequestByIdRequest) (*QueryKeyRequestByIdResponse, error)
+	// Queries a list of Keys items.
+	Keys(context.Context, *QueryKeysRequest) (*QueryKeysResponse, error)
+	// Queries the addresses controlled by a key on every supported chain.
+	KeyAddresses(context.Context, *QueryKeyAddressesRequest) (*QueryKeyAddressesResponse, error)
+	// Queries a list of SignatureRequests items.
+	SignatureRequests(context.Context, *QuerySignatureRequestsRequest) (*QuerySignatureRequestsResponse, error)
+	// Queries a single SignatureRequest by its id.
+	SignatureRequestById(context.Context, *QuerySignatureRequestByIdRequest) (*QuerySignatureRequestByIdResponse, error)
+	// Queries a list of SignTransactionRequests items.
+	SignTransactionRequests(context.Context, *QuerySignTransactionRequestsRequest) (*QuerySignTransactionRequestsResponse, error)
+	// Queries a list of SignTransactionRequestById items.
+	SignTransactionRequestById(context.Context, *QuerySignTransactionRequestByIdRequest) (*QuerySignTransactionRequestByIdResponse, error)
+	// Evaluates the policies of a wallet against a candidate transfer and
+	// set of approvers, without creating an action.
+	EvaluateWalletPolicies(context.Context, *QueryEvaluateWalletPoliciesRequest) (*QueryEvaluateWalletPoliciesResponse, error)
+	// Returns the data to sign for an unsigned transaction of a wallet, with
+	// the key that must sign it, for the hand-off to an external signer.
+	SigningRequest(context.Context, *QuerySigningRequestRequest) (*QuerySigningRequestResponse, error)
 }
 
-func (m *QueryKeysRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.KeyId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.KeyId))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.Type != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Type))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.WorkspaceAddr) > 0 {
-		i -= len(m.WorkspaceAddr)
-		copy(dAtA[i:], m.WorkspaceAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.WorkspaceAddr)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QueryKeysResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) KeyRequests(ctx context.Context, req *QueryKeyRequestsRequest) (*QueryKeyRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KeyRequests not implemented")
+}
+func (*UnimplementedQueryServer) KeyRequestById(ctx context.Context, req *QueryKeyRequestByIdRequest) (*QueryKeyRequestByIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KeyRequestById not implemented")
+}
+func (*UnimplementedQueryServer) Keys(ctx context.Context, req *QueryKeysRequest) (*QueryKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Keys not implemented")
+}
+func (*UnimplementedQueryServer) KeyAddresses(ctx context.Context, req *QueryKeyAddressesRequest) (*QueryKeyAddressesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KeyAddresses not implemented")
+}
+func (*UnimplementedQueryServer) SignatureRequests(ctx context.Context, req *QuerySignatureRequestsRequest) (*QuerySignatureRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignatureRequests not implemented")
+}
+func (*UnimplementedQueryServer) SignatureRequestById(ctx context.Context, req *QuerySignatureRequestByIdRequest) (*QuerySignatureRequestByIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignatureRequestById not implemented")
+}
+func (*UnimplementedQueryServer) SignTransactionRequests(ctx context.Context, req *QuerySignTransactionRequestsRequest) (*QuerySignTransactionRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignTransactionRequests not implemented")
+}
+func (*UnimplementedQueryServer) SignTransactionRequestById(ctx context.Context, req *QuerySignTransactionRequestByIdRequest) (*QuerySignTransactionRequestByIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignTransactionRequestById not implemented")
+}
+func (*UnimplementedQueryServer) EvaluateWalletPolicies(ctx context.Context, req *QueryEvaluateWalletPoliciesRequest) (*QueryEvaluateWalletPoliciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EvaluateWalletPolicies not implemented")
+}
+func (*UnimplementedQueryServer) SigningRequest(ctx context.Context, req *QuerySigningRequestRequest) (*QuerySigningRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SigningRequest not implemented")
 }
 
-func (m *QueryKeysResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *QueryKeysResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Keys) > 0 {
-		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Keys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fusionchain.treasury.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *KeyResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_KeyRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryKeyRequestsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *KeyResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).KeyRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fusionchain.treasury.Query/KeyRequests",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).KeyRequests(ctx, req.(*QueryKeyRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *KeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Wallets) > 0 {
-		for iNdEx := len(m.Wallets) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Wallets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+func _Query_KeyRequestById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryKeyRequestByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Key != nil {
-		{
-			size, err := m.Key.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).KeyRequestById(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fusionchain.treasury.Query/KeyRequestById",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).KeyRequestById(ctx, req.(*QueryKeyRequestByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *WalletKeyResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_Keys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryKeysRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).Keys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fusionchain.treasury.Query/Keys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Keys(ctx, req.(*QueryKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *WalletKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+func _Query_KeyAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryKeyAddressesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).KeyAddresses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fusionchain.treasury.Query/KeyAddresses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).KeyAddresses(ctx, req.(*QueryKeyAddressesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SignatureRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySignatureRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SignatureRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fusionchain.treasury.Query/SignatureRequests",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SignatureRequests(ctx, req.(*QuerySignatureRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SignatureRequestById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySignatureRequestByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SignatureRequestById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fusionchain.treasury.Query/SignatureRequestById",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SignatureRequestById(ctx, req.(*QuerySignatureRequestByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SignTransactionRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySignTransactionRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SignTransactionRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fusionchain.treasury.Query/SignTransactionRequests",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SignTransactionRequests(ctx, req.(*QuerySignTransactionRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SignTransactionRequestById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySignTransactionRequestByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SignTransactionRequestById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fusionchain.treasury.Query/SignTransactionRequestById",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SignTransactionRequestById(ctx, req.(*QuerySignTransactionRequestByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EvaluateWalletPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEvaluateWalletPoliciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EvaluateWalletPolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fusionchain.treasury.Query/EvaluateWalletPolicies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EvaluateWalletPolicies(ctx, req.(*QueryEvaluateWalletPoliciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SigningRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySigningRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SigningRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fusionchain.treasury.Query/SigningRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SigningRequest(ctx, req.(*QuerySigningRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "fusionchain.treasury.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "KeyRequests",
+			Handler:    _Query_KeyRequests_Handler,
+		},
+		{
+			MethodName: "KeyRequestById",
+			Handler:    _Query_KeyRequestById_Handler,
+		},
+		{
+			MethodName: "Keys",
+			Handler:    _Query_Keys_Handler,
+		},
+		{
+			MethodName: "KeyAddresses",
+			Handler:    _Query_KeyAddresses_Handler,
+		},
+		{
+			MethodName: "SignatureRequests",
+			Handler:    _Query_SignatureRequests_Handler,
+		},
+		{
+			MethodName: "SignatureRequestById",
+			Handler:    _Query_SignatureRequestById_Handler,
+		},
+		{
+			MethodName: "SignTransactionRequests",
+			Handler:    _Query_SignTransactionRequests_Handler,
+		},
+		{
+			MethodName: "SignTransactionRequestById",
+			Handler:    _Query_SignTransactionRequestById_Handler,
+		},
+		{
+			MethodName: "EvaluateWalletPolicies",
+			Handler:    _Query_EvaluateWalletPolicies_Handler,
+		},
+		{
+			MethodName: "SigningRequest",
+			Handler:    _Query_SigningRequest_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "fusionchain/treasury/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *WalletKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Type != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Type))
-		i--
-		dAtA[i] = 0x10
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QuerySignatureRequestsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryKeyRequestsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1871,16 +2118,23 @@ func (m *QuerySignatureRequestsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QuerySignatureRequestsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryKeyRequestsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySignatureRequestsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryKeyRequestsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.WorkspaceAddr) > 0 {
+		i -= len(m.WorkspaceAddr)
+		copy(dAtA[i:], m.WorkspaceAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.WorkspaceAddr)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.Status != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
 		i--
@@ -1908,7 +2162,7 @@ func (m *QuerySignatureRequestsRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *QuerySignatureRequestsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryKeyRequestsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1918,20 +2172,20 @@ func (m *QuerySignatureRequestsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QuerySignatureRequestsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryKeyRequestsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySignatureRequestsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryKeyRequestsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.SignRequests) > 0 {
-		for iNdEx := len(m.SignRequests) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.KeyRequests) > 0 {
+		for iNdEx := len(m.KeyRequests) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.SignRequests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.KeyRequests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -1957,7 +2211,7 @@ func (m *QuerySignatureRequestsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *QuerySignatureRequestByIdRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryKeyRequestByIdRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1967,12 +2221,12 @@ func (m *QuerySignatureRequestByIdRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QuerySignatureRequestByIdRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryKeyRequestByIdRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySignatureRequestByIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryKeyRequestByIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1985,7 +2239,7 @@ func (m *QuerySignatureRequestByIdRequest) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func (m *QuerySignatureRequestByIdResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryKeyRequestByIdResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1995,19 +2249,19 @@ func (m *QuerySignatureRequestByIdResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QuerySignatureRequestByIdResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryKeyRequestByIdResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySignatureRequestByIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryKeyRequestByIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.SignRequest != nil {
+	if m.KeyRequest != nil {
 		{
-			size, err := m.SignRequest.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.KeyRequest.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -2020,7 +2274,7 @@ func (m *QuerySignatureRequestByIdResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
-func (m *QuerySignTransactionRequestsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryKeysRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2030,30 +2284,32 @@ func (m *QuerySignTransactionRequestsRequest) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *QuerySignTransactionRequestsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryKeysRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySignTransactionRequestsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryKeysRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Status != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+	if m.KeyId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.KeyId))
 		i--
 		dAtA[i] = 0x20
 	}
-	if m.KeyId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.KeyId))
+	if m.Type != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Type))
 		i--
 		dAtA[i] = 0x18
 	}
-	if m.WalletType != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.WalletType))
+	if len(m.WorkspaceAddr) > 0 {
+		i -= len(m.WorkspaceAddr)
+		copy(dAtA[i:], m.WorkspaceAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.WorkspaceAddr)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
 	if m.Pagination != nil {
 		{
@@ -2070,7 +2326,7 @@ func (m *QuerySignTransactionRequestsRequest) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func (m *SignTransactionRequestResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryKeysResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2080,31 +2336,33 @@ func (m *SignTransactionRequestResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SignTransactionRequestResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryKeysResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SignTransactionRequestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryKeysResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.SignRequest != nil {
-		{
-			size, err := m.SignRequest.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.Keys) > 0 {
+		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Keys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x12
 		}
-		i--
-		dAtA[i] = 0x12
 	}
-	if m.SignTransactionRequest != nil {
+	if m.Pagination != nil {
 		{
-			size, err := m.SignTransactionRequest.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -2117,7 +2375,7 @@ func (m *SignTransactionRequestResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *QuerySignTransactionRequestsResponse) Marshal() (dAtA []byte, err error) {
+func (m *KeyResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2127,20 +2385,20 @@ func (m *QuerySignTransactionRequestsResponse) Marshal() (dAtA []byte, err error
 	return dAtA[:n], nil
 }
 
-func (m *QuerySignTransactionRequestsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *KeyResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySignTransactionRequestsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *KeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.SignTransactionRequests) > 0 {
-		for iNdEx := len(m.SignTransactionRequests) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Wallets) > 0 {
+		for iNdEx := len(m.Wallets) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.SignTransactionRequests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Wallets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2151,9 +2409,9 @@ func (m *QuerySignTransactionRequestsResponse) MarshalToSizedBuffer(dAtA []byte)
 			dAtA[i] = 0x12
 		}
 	}
-	if m.Pagination != nil {
+	if m.Key != nil {
 		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Key.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -2166,7 +2424,7 @@ func (m *QuerySignTransactionRequestsResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func (m *QuerySignTransactionRequestByIdRequest) Marshal() (dAtA []byte, err error) {
+func (m *WalletKeyResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2176,25 +2434,32 @@ func (m *QuerySignTransactionRequestByIdRequest) Marshal() (dAtA []byte, err err
 	return dAtA[:n], nil
 }
 
-func (m *QuerySignTransactionRequestByIdRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *WalletKeyResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySignTransactionRequestByIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *WalletKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+	if m.Type != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Type))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QuerySignTransactionRequestByIdResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryKeyAddressesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2204,359 +2469,1188 @@ func (m *QuerySignTransactionRequestByIdResponse) Marshal() (dAtA []byte, err er
 	return dAtA[:n], nil
 }
 
-func (m *QuerySignTransactionRequestByIdResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryKeyAddressesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySignTransactionRequestByIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryKeyAddressesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.SignTransactionRequest != nil {
-		{
-			size, err := m.SignTransactionRequest.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
+	if m.KeyId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.KeyId))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryKeyAddressesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryKeyAddressesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryKeyRequestsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryKeyAddressesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.KeyringAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Status != 0 {
-		n += 1 + sovQuery(uint64(m.Status))
+	if len(m.PreviousWallets) > 0 {
+		for iNdEx := len(m.PreviousWallets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PreviousWallets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	l = len(m.WorkspaceAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Wallets) > 0 {
+		for iNdEx := len(m.Wallets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Wallets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryKeyRequestsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySignatureRequestsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySignatureRequestsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySignatureRequestsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x18
 	}
-	if len(m.KeyRequests) > 0 {
-		for _, e := range m.KeyRequests {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.KeyringAddr) > 0 {
+		i -= len(m.KeyringAddr)
+		copy(dAtA[i:], m.KeyringAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.KeyringAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryKeyRequestByIdRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
+func (m *QuerySignatureRequestsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryKeyRequestByIdResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QuerySignatureRequestsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySignatureRequestsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.KeyRequest != nil {
-		l = m.KeyRequest.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryKeysRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.SignRequests) > 0 {
+		for iNdEx := len(m.SignRequests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SignRequests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	var l int
-	_ = l
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.WorkspaceAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Type != 0 {
-		n += 1 + sovQuery(uint64(m.Type))
-	}
-	if m.KeyId != 0 {
-		n += 1 + sovQuery(uint64(m.KeyId))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryKeysResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySignatureRequestByIdRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySignatureRequestByIdRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySignatureRequestByIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if len(m.Keys) > 0 {
-		for _, e := range m.Keys {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *KeyResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySignatureRequestByIdResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySignatureRequestByIdResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySignatureRequestByIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Key != nil {
-		l = m.Key.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if len(m.Wallets) > 0 {
-		for _, e := range m.Wallets {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.SignRequest != nil {
+		{
+			size, err := m.SignRequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *WalletKeyResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Type != 0 {
-		n += 1 + sovQuery(uint64(m.Type))
+func (m *QuerySignTransactionRequestsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QuerySignatureRequestsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QuerySignTransactionRequestsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySignTransactionRequestsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.KeyringAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
 	if m.Status != 0 {
-		n += 1 + sovQuery(uint64(m.Status))
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x20
 	}
-	return n
-}
-
-func (m *QuerySignatureRequestsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.KeyId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.KeyId))
+		i--
+		dAtA[i] = 0x18
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.WalletType != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.WalletType))
+		i--
+		dAtA[i] = 0x10
 	}
-	if len(m.SignRequests) > 0 {
-		for _, e := range m.SignRequests {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QuerySignatureRequestByIdRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
+func (m *SignTransactionRequestResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QuerySignatureRequestByIdResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.SignRequest != nil {
-		l = m.SignRequest.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *SignTransactionRequestResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySignTransactionRequestsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *SignTransactionRequestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.SignRequest != nil {
+		{
+			size, err := m.SignRequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.WalletType != 0 {
-		n += 1 + sovQuery(uint64(m.WalletType))
+	if m.SignTransactionRequest != nil {
+		{
+			size, err := m.SignTransactionRequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.KeyId != 0 {
-		n += 1 + sovQuery(uint64(m.KeyId))
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySignTransactionRequestsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.Status != 0 {
-		n += 1 + sovQuery(uint64(m.Status))
+	return dAtA[:n], nil
+}
+
+func (m *QuerySignTransactionRequestsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySignTransactionRequestsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SignTransactionRequests) > 0 {
+		for iNdEx := len(m.SignTransactionRequests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SignTransactionRequests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	return n
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *SignTransactionRequestResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySignTransactionRequestByIdRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySignTransactionRequestByIdRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySignTransactionRequestByIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.SignTransactionRequest != nil {
-		l = m.SignTransactionRequest.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
 	}
-	if m.SignRequest != nil {
-		l = m.SignRequest.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySignTransactionRequestByIdResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QuerySignTransactionRequestsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySignTransactionRequestByIdResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySignTransactionRequestByIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SignTransactionRequest != nil {
+		{
+			size, err := m.SignTransactionRequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEvaluateWalletPoliciesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEvaluateWalletPoliciesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEvaluateWalletPoliciesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.PolicyIds) > 0 {
+		dAtA17 := make([]byte, len(m.PolicyIds)*10)
+		var j16 int
+		for _, num := range m.PolicyIds {
+			for num >= 1<<7 {
+				dAtA17[j16] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j16++
+			}
+			dAtA17[j16] = uint8(num)
+			j16++
+		}
+		i -= j16
+		copy(dAtA[i:], dAtA17[:j16])
+		i = encodeVarintQuery(dAtA, i, uint64(j16))
+		i--
+		dAtA[i] = 0x32
 	}
-	if len(m.SignTransactionRequests) > 0 {
-		for _, e := range m.SignTransactionRequests {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.Approvers) > 0 {
+		for iNdEx := len(m.Approvers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Approvers[iNdEx])
+			copy(dAtA[i:], m.Approvers[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Approvers[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
 		}
 	}
-	return n
+	if m.Metadata != nil {
+		{
+			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.UnsignedTransaction) > 0 {
+		i -= len(m.UnsignedTransaction)
+		copy(dAtA[i:], m.UnsignedTransaction)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.UnsignedTransaction)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.WalletType != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.WalletType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.KeyId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.KeyId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QuerySignTransactionRequestByIdRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryEvaluateWalletPoliciesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEvaluateWalletPoliciesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEvaluateWalletPoliciesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
+	if len(m.Evaluations) > 0 {
+		for iNdEx := len(m.Evaluations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Evaluations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QuerySignTransactionRequestByIdResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PolicyEvaluation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PolicyEvaluation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PolicyEvaluation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.SignTransactionRequest != nil {
-		l = m.SignTransactionRequest.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	if m.Passed {
+		i--
+		if m.Passed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PolicyId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PolicyId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QuerySigningRequestRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QuerySigningRequestRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySigningRequestRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		{
+			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.UnsignedTransaction) > 0 {
+		i -= len(m.UnsignedTransaction)
+		copy(dAtA[i:], m.UnsignedTransaction)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.UnsignedTransaction)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.WalletType != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.WalletType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.KeyId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.KeyId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySigningRequestResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySigningRequestResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySigningRequestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.WalletType != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.WalletType))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.KeyType != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.KeyType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.KeyId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.KeyId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.DataForSigning) > 0 {
+		i -= len(m.DataForSigning)
+		copy(dAtA[i:], m.DataForSigning)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DataForSigning)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryKeyRequestsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.KeyringAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	l = len(m.WorkspaceAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryKeyRequestsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.KeyRequests) > 0 {
+		for _, e := range m.KeyRequests {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryKeyRequestByIdRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryKeyRequestByIdResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.KeyRequest != nil {
+		l = m.KeyRequest.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryKeysRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.WorkspaceAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovQuery(uint64(m.Type))
+	}
+	if m.KeyId != 0 {
+		n += 1 + sovQuery(uint64(m.KeyId))
+	}
+	return n
+}
+
+func (m *QueryKeysResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Keys) > 0 {
+		for _, e := range m.Keys {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *KeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Key != nil {
+		l = m.Key.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Wallets) > 0 {
+		for _, e := range m.Wallets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *WalletKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovQuery(uint64(m.Type))
+	}
+	return n
+}
+
+func (m *QueryKeyAddressesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.KeyId != 0 {
+		n += 1 + sovQuery(uint64(m.KeyId))
+	}
+	return n
+}
+
+func (m *QueryKeyAddressesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Wallets) > 0 {
+		for _, e := range m.Wallets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.PreviousWallets) > 0 {
+		for _, e := range m.PreviousWallets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySignatureRequestsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.KeyringAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *QuerySignatureRequestsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.SignRequests) > 0 {
+		for _, e := range m.SignRequests {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySignatureRequestByIdRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QuerySignatureRequestByIdResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SignRequest != nil {
+		l = m.SignRequest.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySignTransactionRequestsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.WalletType != 0 {
+		n += 1 + sovQuery(uint64(m.WalletType))
+	}
+	if m.KeyId != 0 {
+		n += 1 + sovQuery(uint64(m.KeyId))
+	}
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *SignTransactionRequestResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SignTransactionRequest != nil {
+		l = m.SignTransactionRequest.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.SignRequest != nil {
+		l = m.SignRequest.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySignTransactionRequestsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.SignTransactionRequests) > 0 {
+		for _, e := range m.SignTransactionRequests {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySignTransactionRequestByIdRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QuerySignTransactionRequestByIdResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SignTransactionRequest != nil {
+		l = m.SignTransactionRequest.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEvaluateWalletPoliciesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.KeyId != 0 {
+		n += 1 + sovQuery(uint64(m.KeyId))
+	}
+	if m.WalletType != 0 {
+		n += 1 + sovQuery(uint64(m.WalletType))
+	}
+	l = len(m.UnsignedTransaction)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Approvers) > 0 {
+		for _, s := range m.Approvers {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.PolicyIds) > 0 {
+		l = 0
+		for _, e := range m.PolicyIds {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *QueryEvaluateWalletPoliciesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Evaluations) > 0 {
+		for _, e := range m.Evaluations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PolicyEvaluation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PolicyId != 0 {
+		n += 1 + sovQuery(uint64(m.PolicyId))
+	}
+	if m.Passed {
+		n += 2
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySigningRequestRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.KeyId != 0 {
+		n += 1 + sovQuery(uint64(m.KeyId))
+	}
+	if m.WalletType != 0 {
+		n += 1 + sovQuery(uint64(m.WalletType))
+	}
+	l = len(m.UnsignedTransaction)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySigningRequestResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DataForSigning)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.KeyId != 0 {
+		n += 1 + sovQuery(uint64(m.KeyId))
+	}
+	if m.KeyType != 0 {
+		n += 1 + sovQuery(uint64(m.KeyType))
+	}
+	if m.WalletType != 0 {
+		n += 1 + sovQuery(uint64(m.WalletType))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
 func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
@@ -2568,25 +3662,552 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowQuery
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryKeyRequestsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryKeyRequestsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryKeyRequestsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyringAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyringAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= KeyRequestStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkspaceAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkspaceAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryKeyRequestsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryKeyRequestsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryKeyRequestsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyRequests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyRequests = append(m.KeyRequests, &KeyRequest{})
+			if err := m.KeyRequests[len(m.KeyRequests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryKeyRequestByIdRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryKeyRequestByIdRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryKeyRequestByIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryKeyRequestByIdResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryKeyRequestByIdResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryKeyRequestByIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyRequest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.KeyRequest == nil {
+				m.KeyRequest = &KeyRequest{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			if err := m.KeyRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2608,7 +4229,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryKeysRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2631,15 +4252,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryKeysRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryKeysRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2666,10 +4287,83 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkspaceAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkspaceAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= WalletType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyId", wireType)
+			}
+			m.KeyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeyId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2691,7 +4385,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryKeyRequestsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryKeysResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2714,10 +4408,10 @@ func (m *QueryKeyRequestsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryKeyRequestsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryKeysResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryKeyRequestsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryKeysResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2750,7 +4444,7 @@ func (m *QueryKeyRequestsRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+				m.Pagination = &query.PageResponse{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -2758,9 +4452,9 @@ func (m *QueryKeyRequestsRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyringAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2770,29 +4464,81 @@ func (m *QueryKeyRequestsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KeyringAddr = string(dAtA[iNdEx:postIndex])
+			m.Keys = append(m.Keys, &KeyResponse{})
+			if err := m.Keys[len(m.Keys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.Status = 0
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KeyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KeyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2802,16 +4548,33 @@ func (m *QueryKeyRequestsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= KeyRequestStatus(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Key == nil {
+				m.Key = &Key{}
+			}
+			if err := m.Key.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkspaceAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Wallets", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2821,23 +4584,25 @@ func (m *QueryKeyRequestsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WorkspaceAddr = string(dAtA[iNdEx:postIndex])
+			m.Wallets = append(m.Wallets, &WalletKeyResponse{})
+			if err := m.Wallets[len(m.Wallets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2860,7 +4625,7 @@ func (m *QueryKeyRequestsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryKeyRequestsResponse) Unmarshal(dAtA []byte) error {
+func (m *WalletKeyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2883,17 +4648,17 @@ func (m *QueryKeyRequestsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryKeyRequestsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: WalletKeyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryKeyRequestsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WalletKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2903,33 +4668,29 @@ func (m *QueryKeyRequestsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyRequests", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var msglen int
+			m.Type = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2939,26 +4700,11 @@ func (m *QueryKeyRequestsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Type |= WalletType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.KeyRequests = append(m.KeyRequests, &KeyRequest{})
-			if err := m.KeyRequests[len(m.KeyRequests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2980,7 +4726,7 @@ func (m *QueryKeyRequestsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryKeyRequestByIdRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryKeyAddressesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3003,17 +4749,17 @@ func (m *QueryKeyRequestByIdRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryKeyRequestByIdRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryKeyAddressesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryKeyRequestByIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryKeyAddressesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyId", wireType)
 			}
-			m.Id = 0
+			m.KeyId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3023,7 +4769,7 @@ func (m *QueryKeyRequestByIdRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.KeyId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3049,7 +4795,7 @@ func (m *QueryKeyRequestByIdRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryKeyRequestByIdResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryKeyAddressesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3072,15 +4818,15 @@ func (m *QueryKeyRequestByIdResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryKeyRequestByIdResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryKeyAddressesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryKeyRequestByIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryKeyAddressesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyRequest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Wallets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3107,10 +4853,42 @@ func (m *QueryKeyRequestByIdResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.KeyRequest == nil {
-				m.KeyRequest = &KeyRequest{}
+			m.Wallets = append(m.Wallets, &WalletKeyResponse{})
+			if err := m.Wallets[len(m.Wallets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.KeyRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousWallets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousWallets = append(m.PreviousWallets, &WalletKeyResponse{})
+			if err := m.PreviousWallets[len(m.PreviousWallets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3135,7 +4913,7 @@ func (m *QueryKeyRequestByIdResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryKeysRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySignatureRequestsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3158,10 +4936,10 @@ func (m *QueryKeysRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryKeysRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySignatureRequestsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryKeysRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySignatureRequestsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3202,7 +4980,7 @@ func (m *QueryKeysRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkspaceAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyringAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3230,32 +5008,13 @@ func (m *QueryKeysRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WorkspaceAddr = string(dAtA[iNdEx:postIndex])
+			m.KeyringAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
-			}
-			m.Type = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Type |= WalletType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			m.KeyId = 0
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3265,7 +5024,7 @@ func (m *QueryKeysRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.KeyId |= uint64(b&0x7F) << shift
+				m.Status |= SignRequestStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3291,7 +5050,7 @@ func (m *QueryKeysRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryKeysResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySignatureRequestsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3314,10 +5073,10 @@ func (m *QueryKeysResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryKeysResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySignatureRequestsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryKeysResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySignatureRequestsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3358,7 +5117,7 @@ func (m *QueryKeysResponse) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SignRequests", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3385,8 +5144,8 @@ func (m *QueryKeysResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Keys = append(m.Keys, &KeyResponse{})
-			if err := m.Keys[len(m.Keys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SignRequests = append(m.SignRequests, &SignRequest{})
+			if err := m.SignRequests[len(m.SignRequests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3411,7 +5170,7 @@ func (m *QueryKeysResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KeyResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySignatureRequestByIdRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3434,17 +5193,17 @@ func (m *KeyResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KeyResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySignatureRequestByIdRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySignatureRequestByIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3454,31 +5213,64 @@ func (m *KeyResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Key == nil {
-				m.Key = &Key{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySignatureRequestByIdResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			if err := m.Key.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 2:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySignatureRequestByIdResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySignatureRequestByIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Wallets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SignRequest", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3505,8 +5297,10 @@ func (m *KeyResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Wallets = append(m.Wallets, &WalletKeyResponse{})
-			if err := m.Wallets[len(m.Wallets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.SignRequest == nil {
+				m.SignRequest = &SignRequest{}
+			}
+			if err := m.SignRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3531,7 +5325,7 @@ func (m *KeyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WalletKeyResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySignTransactionRequestsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3554,17 +5348,17 @@ func (m *WalletKeyResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WalletKeyResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySignTransactionRequestsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WalletKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySignTransactionRequestsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3574,29 +5368,71 @@ func (m *WalletKeyResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WalletType", wireType)
+			}
+			m.WalletType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WalletType |= WalletType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyId", wireType)
+			}
+			m.KeyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeyId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			m.Type = 0
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3606,7 +5442,7 @@ func (m *WalletKeyResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= WalletType(b&0x7F) << shift
+				m.Status |= SignRequestStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3632,7 +5468,7 @@ func (m *WalletKeyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySignatureRequestsRequest) Unmarshal(dAtA []byte) error {
+func (m *SignTransactionRequestResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3655,15 +5491,15 @@ func (m *QuerySignatureRequestsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySignatureRequestsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SignTransactionRequestResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySignatureRequestsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SignTransactionRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SignTransactionRequest", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3690,18 +5526,18 @@ func (m *QuerySignatureRequestsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			if m.SignTransactionRequest == nil {
+				m.SignTransactionRequest = &SignTransactionRequest{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SignTransactionRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyringAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SignRequest", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3711,43 +5547,28 @@ func (m *QuerySignatureRequestsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KeyringAddr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if m.SignRequest == nil {
+				m.SignRequest = &SignRequest{}
 			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= SignRequestStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.SignRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3769,7 +5590,7 @@ func (m *QuerySignatureRequestsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySignatureRequestsResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySignTransactionRequestsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3792,10 +5613,10 @@ func (m *QuerySignatureRequestsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySignatureRequestsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySignTransactionRequestsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySignatureRequestsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySignTransactionRequestsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3836,7 +5657,7 @@ func (m *QuerySignatureRequestsResponse) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignRequests", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SignTransactionRequests", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3863,8 +5684,8 @@ func (m *QuerySignatureRequestsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SignRequests = append(m.SignRequests, &SignRequest{})
-			if err := m.SignRequests[len(m.SignRequests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SignTransactionRequests = append(m.SignTransactionRequests, &SignTransactionRequestResponse{})
+			if err := m.SignTransactionRequests[len(m.SignTransactionRequests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3889,7 +5710,7 @@ func (m *QuerySignatureRequestsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySignatureRequestByIdRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySignTransactionRequestByIdRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3912,10 +5733,10 @@ func (m *QuerySignatureRequestByIdRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySignatureRequestByIdRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySignTransactionRequestByIdRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySignatureRequestByIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySignTransactionRequestByIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3958,7 +5779,7 @@ func (m *QuerySignatureRequestByIdRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySignatureRequestByIdResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySignTransactionRequestByIdResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3981,15 +5802,15 @@ func (m *QuerySignatureRequestByIdResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySignatureRequestByIdResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySignTransactionRequestByIdResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySignatureRequestByIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySignTransactionRequestByIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignRequest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SignTransactionRequest", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4016,10 +5837,10 @@ func (m *QuerySignatureRequestByIdResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SignRequest == nil {
-				m.SignRequest = &SignRequest{}
+			if m.SignTransactionRequest == nil {
+				m.SignTransactionRequest = &SignTransactionRequest{}
 			}
-			if err := m.SignRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SignTransactionRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4044,7 +5865,7 @@ func (m *QuerySignatureRequestByIdResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySignTransactionRequestsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryEvaluateWalletPoliciesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4067,17 +5888,55 @@ func (m *QuerySignTransactionRequestsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySignTransactionRequestsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEvaluateWalletPoliciesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySignTransactionRequestsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEvaluateWalletPoliciesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyId", wireType)
+			}
+			m.KeyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeyId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WalletType", wireType)
+			}
+			m.WalletType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WalletType |= WalletType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnsignedTransaction", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4087,33 +5946,31 @@ func (m *QuerySignTransactionRequestsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.UnsignedTransaction = append(m.UnsignedTransaction[:0], dAtA[iNdEx:postIndex]...)
+			if m.UnsignedTransaction == nil {
+				m.UnsignedTransaction = []byte{}
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WalletType", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
-			m.WalletType = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4123,16 +5980,33 @@ func (m *QuerySignTransactionRequestsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.WalletType |= WalletType(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyId", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			m.KeyId = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &types.Any{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approvers", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4142,16 +6016,155 @@ func (m *QuerySignTransactionRequestsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.KeyId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Approvers = append(m.Approvers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PolicyIds = append(m.PolicyIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PolicyIds) == 0 {
+					m.PolicyIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PolicyIds = append(m.PolicyIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEvaluateWalletPoliciesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEvaluateWalletPoliciesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEvaluateWalletPoliciesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Evaluations", wireType)
 			}
-			m.Status = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4161,11 +6174,26 @@ func (m *QuerySignTransactionRequestsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= SignRequestStatus(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Evaluations = append(m.Evaluations, &PolicyEvaluation{})
+			if err := m.Evaluations[len(m.Evaluations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4187,7 +6215,7 @@ func (m *QuerySignTransactionRequestsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SignTransactionRequestResponse) Unmarshal(dAtA []byte) error {
+func (m *PolicyEvaluation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4210,17 +6238,17 @@ func (m *SignTransactionRequestResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SignTransactionRequestResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PolicyEvaluation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SignTransactionRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PolicyEvaluation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignTransactionRequest", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyId", wireType)
 			}
-			var msglen int
+			m.PolicyId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4230,33 +6258,36 @@ func (m *SignTransactionRequestResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PolicyId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.SignTransactionRequest == nil {
-				m.SignTransactionRequest = &SignTransactionRequest{}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Passed", wireType)
 			}
-			if err := m.SignTransactionRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 2:
+			m.Passed = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignRequest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4266,27 +6297,23 @@ func (m *SignTransactionRequestResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SignRequest == nil {
-				m.SignRequest = &SignRequest{}
-			}
-			if err := m.SignRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4309,7 +6336,7 @@ func (m *SignTransactionRequestResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySignTransactionRequestsResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySigningRequestRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4332,17 +6359,55 @@ func (m *QuerySignTransactionRequestsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySignTransactionRequestsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySigningRequestRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySignTransactionRequestsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySigningRequestRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyId", wireType)
+			}
+			m.KeyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeyId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WalletType", wireType)
+			}
+			m.WalletType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WalletType |= WalletType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnsignedTransaction", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4352,31 +6417,29 @@ func (m *QuerySignTransactionRequestsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.UnsignedTransaction = append(m.UnsignedTransaction[:0], dAtA[iNdEx:postIndex]...)
+			if m.UnsignedTransaction == nil {
+				m.UnsignedTransaction = []byte{}
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignTransactionRequests", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4403,8 +6466,10 @@ func (m *QuerySignTransactionRequestsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SignTransactionRequests = append(m.SignTransactionRequests, &SignTransactionRequestResponse{})
-			if err := m.SignTransactionRequests[len(m.SignTransactionRequests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Metadata == nil {
+				m.Metadata = &types.Any{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4429,7 +6494,7 @@ func (m *QuerySignTransactionRequestsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySignTransactionRequestByIdRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySigningRequestResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4452,17 +6517,17 @@ func (m *QuerySignTransactionRequestByIdRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySignTransactionRequestByIdRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySigningRequestResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySignTransactionRequestByIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySigningRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataForSigning", wireType)
 			}
-			m.Id = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4472,66 +6537,88 @@ func (m *QuerySignTransactionRequestByIdRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QuerySignTransactionRequestByIdResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.DataForSigning = append(m.DataForSigning[:0], dAtA[iNdEx:postIndex]...)
+			if m.DataForSigning == nil {
+				m.DataForSigning = []byte{}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyId", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.KeyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeyId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySignTransactionRequestByIdResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySignTransactionRequestByIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyType", wireType)
+			}
+			m.KeyType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeyType |= KeyType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WalletType", wireType)
+			}
+			m.WalletType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WalletType |= WalletType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignTransactionRequest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4541,27 +6628,23 @@ func (m *QuerySignTransactionRequestByIdResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SignTransactionRequest == nil {
-				m.SignTransactionRequest = &SignTransactionRequest{}
-			}
-			if err := m.SignTransactionRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
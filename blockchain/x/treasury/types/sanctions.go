@@ -0,0 +1,19 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package types
+
+// SanctionList is a list of sanctioned addresses, provided by the caller
+// (e.g. loaded from a compliance provider). Addresses are in the format of
+// Transfer.To for the chain of the transfer.
+type SanctionList interface {
+	Contains(addr []byte) bool
+}
+
+// IsSanctioned returns true if the recipient of the transfer is in list. A
+// nil list contains no address.
+func (t Transfer) IsSanctioned(list SanctionList) bool {
+	if list == nil || len(t.To) == 0 {
+		return false
+	}
+	return list.Contains(t.To)
+}
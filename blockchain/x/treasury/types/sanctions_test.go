@@ -0,0 +1,49 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+type sanctionListSet map[string]bool
+
+func (s sanctionListSet) Contains(addr []byte) bool {
+	return s[string(addr)]
+}
+
+func Test_Transfer_IsSanctioned(t *testing.T) {
+	sanctioned := common.HexToAddress("0x8589427373D6D84E98730D7795D8f6f8731FDA16")
+	clean := common.HexToAddress("0x48c04ed5691981C42154C6167398f95e8f38a7fF")
+	list := sanctionListSet{string(sanctioned.Bytes()): true}
+
+	tests := []struct {
+		name string
+		to   []byte
+		list SanctionList
+		want bool
+	}{
+		{name: "sanctioned", to: sanctioned.Bytes(), list: list, want: true},
+		{name: "clean", to: clean.Bytes(), list: list, want: false},
+		{name: "no recipient", to: nil, list: list, want: false},
+		{name: "no list", to: sanctioned.Bytes(), list: nil, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transfer := Transfer{To: tt.to, Amount: big.NewInt(1)}
+			require.Equal(t, tt.want, transfer.IsSanctioned(tt.list))
+		})
+	}
+
+	// the recipient of a parsed ERC-20 transfer is the token recipient
+	usdt := common.HexToAddress("0xdAC17F958D2ee523a2206206994597C13D831ec7")
+	data := append(append(append([]byte{}, transferMethodID...), common.LeftPadBytes(sanctioned.Bytes(), 32)...), common.LeftPadBytes([]byte{1}, 32)...)
+	tx, err := ParseEthereumTransaction(unsignedDynamicFeeTx(t, &usdt, big.NewInt(0), data), big.NewInt(1))
+	require.NoError(t, err)
+	require.True(t, tx.Transfer().IsSanctioned(list))
+}
@@ -507,7 +507,6 @@ type MsgFulfilSignatureRequest struct {
 	// If status is rejected, the result will contain the reason.
 	//
 	// Types that are valid to be assigned to Result:
-	//
 	//	*MsgFulfilSignatureRequest_Payload
 	//	*MsgFulfilSignatureRequest_RejectReason
 	Result isMsgFulfilSignatureRequest_Result `protobuf_oneof:"result"`
@@ -829,6 +828,329 @@ func (m *MetadataEthereum) GetChainId() uint64 {
 	return 0
 }
 
+type MsgRotateWalletKey struct {
+	Creator  string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	KeyId    uint64 `protobuf:"varint,2,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	NewKeyId uint64 `protobuf:"varint,3,opt,name=new_key_id,json=newKeyId,proto3" json:"new_key_id,omitempty"`
+	Btl      uint64 `protobuf:"varint,4,opt,name=btl,proto3" json:"btl,omitempty"`
+}
+
+func (m *MsgRotateWalletKey) Reset()         { *m = MsgRotateWalletKey{} }
+func (m *MsgRotateWalletKey) String() string { return proto.CompactTextString(m) }
+func (*MsgRotateWalletKey) ProtoMessage()    {}
+func (*MsgRotateWalletKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b5f7e7b3c14eb6e0, []int{13}
+}
+func (m *MsgRotateWalletKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRotateWalletKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRotateWalletKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRotateWalletKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRotateWalletKey.Merge(m, src)
+}
+func (m *MsgRotateWalletKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRotateWalletKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRotateWalletKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRotateWalletKey proto.InternalMessageInfo
+
+func (m *MsgRotateWalletKey) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgRotateWalletKey) GetKeyId() uint64 {
+	if m != nil {
+		return m.KeyId
+	}
+	return 0
+}
+
+func (m *MsgRotateWalletKey) GetNewKeyId() uint64 {
+	if m != nil {
+		return m.NewKeyId
+	}
+	return 0
+}
+
+func (m *MsgRotateWalletKey) GetBtl() uint64 {
+	if m != nil {
+		return m.Btl
+	}
+	return 0
+}
+
+type MsgRotateWalletKeyResponse struct {
+}
+
+func (m *MsgRotateWalletKeyResponse) Reset()         { *m = MsgRotateWalletKeyResponse{} }
+func (m *MsgRotateWalletKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRotateWalletKeyResponse) ProtoMessage()    {}
+func (*MsgRotateWalletKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b5f7e7b3c14eb6e0, []int{14}
+}
+func (m *MsgRotateWalletKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRotateWalletKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRotateWalletKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRotateWalletKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRotateWalletKeyResponse.Merge(m, src)
+}
+func (m *MsgRotateWalletKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRotateWalletKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRotateWalletKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRotateWalletKeyResponse proto.InternalMessageInfo
+
+type MsgResetWalletNonce struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	KeyId   uint64 `protobuf:"varint,2,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	ChainId uint64 `protobuf:"varint,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Btl     uint64 `protobuf:"varint,4,opt,name=btl,proto3" json:"btl,omitempty"`
+}
+
+func (m *MsgResetWalletNonce) Reset()         { *m = MsgResetWalletNonce{} }
+func (m *MsgResetWalletNonce) String() string { return proto.CompactTextString(m) }
+func (*MsgResetWalletNonce) ProtoMessage()    {}
+func (*MsgResetWalletNonce) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b5f7e7b3c14eb6e0, []int{15}
+}
+func (m *MsgResetWalletNonce) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResetWalletNonce) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResetWalletNonce.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgResetWalletNonce) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResetWalletNonce.Merge(m, src)
+}
+func (m *MsgResetWalletNonce) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResetWalletNonce) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResetWalletNonce.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResetWalletNonce proto.InternalMessageInfo
+
+func (m *MsgResetWalletNonce) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgResetWalletNonce) GetKeyId() uint64 {
+	if m != nil {
+		return m.KeyId
+	}
+	return 0
+}
+
+func (m *MsgResetWalletNonce) GetChainId() uint64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *MsgResetWalletNonce) GetBtl() uint64 {
+	if m != nil {
+		return m.Btl
+	}
+	return 0
+}
+
+type MsgResetWalletNonceResponse struct {
+}
+
+func (m *MsgResetWalletNonceResponse) Reset()         { *m = MsgResetWalletNonceResponse{} }
+func (m *MsgResetWalletNonceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgResetWalletNonceResponse) ProtoMessage()    {}
+func (*MsgResetWalletNonceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b5f7e7b3c14eb6e0, []int{16}
+}
+func (m *MsgResetWalletNonceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResetWalletNonceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResetWalletNonceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgResetWalletNonceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResetWalletNonceResponse.Merge(m, src)
+}
+func (m *MsgResetWalletNonceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResetWalletNonceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResetWalletNonceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResetWalletNonceResponse proto.InternalMessageInfo
+
+type MsgImportWalletPolicies struct {
+	Creator       string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	WorkspaceAddr string `protobuf:"bytes,2,opt,name=workspace_addr,json=workspaceAddr,proto3" json:"workspace_addr,omitempty"`
+	// Bundle returned by ExportWalletPolicies and signed by signer, see
+	// SignWalletPolicyBundle.
+	Bundle []byte `protobuf:"bytes,3,opt,name=bundle,proto3" json:"bundle,omitempty"`
+	// Compressed secp256k1 public key that signed the bundle.
+	Signer []byte `protobuf:"bytes,4,opt,name=signer,proto3" json:"signer,omitempty"`
+	Btl    uint64 `protobuf:"varint,5,opt,name=btl,proto3" json:"btl,omitempty"`
+}
+
+func (m *MsgImportWalletPolicies) Reset()         { *m = MsgImportWalletPolicies{} }
+func (m *MsgImportWalletPolicies) String() string { return proto.CompactTextString(m) }
+func (*MsgImportWalletPolicies) ProtoMessage()    {}
+func (*MsgImportWalletPolicies) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b5f7e7b3c14eb6e0, []int{17}
+}
+func (m *MsgImportWalletPolicies) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgImportWalletPolicies) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgImportWalletPolicies.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgImportWalletPolicies) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgImportWalletPolicies.Merge(m, src)
+}
+func (m *MsgImportWalletPolicies) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgImportWalletPolicies) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgImportWalletPolicies.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgImportWalletPolicies proto.InternalMessageInfo
+
+func (m *MsgImportWalletPolicies) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgImportWalletPolicies) GetWorkspaceAddr() string {
+	if m != nil {
+		return m.WorkspaceAddr
+	}
+	return ""
+}
+
+func (m *MsgImportWalletPolicies) GetBundle() []byte {
+	if m != nil {
+		return m.Bundle
+	}
+	return nil
+}
+
+func (m *MsgImportWalletPolicies) GetSigner() []byte {
+	if m != nil {
+		return m.Signer
+	}
+	return nil
+}
+
+func (m *MsgImportWalletPolicies) GetBtl() uint64 {
+	if m != nil {
+		return m.Btl
+	}
+	return 0
+}
+
+type MsgImportWalletPoliciesResponse struct {
+}
+
+func (m *MsgImportWalletPoliciesResponse) Reset()         { *m = MsgImportWalletPoliciesResponse{} }
+func (m *MsgImportWalletPoliciesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgImportWalletPoliciesResponse) ProtoMessage()    {}
+func (*MsgImportWalletPoliciesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b5f7e7b3c14eb6e0, []int{18}
+}
+func (m *MsgImportWalletPoliciesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgImportWalletPoliciesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgImportWalletPoliciesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgImportWalletPoliciesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgImportWalletPoliciesResponse.Merge(m, src)
+}
+func (m *MsgImportWalletPoliciesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgImportWalletPoliciesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgImportWalletPoliciesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgImportWalletPoliciesResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgNewKeyRequest)(nil), "fusionchain.treasury.MsgNewKeyRequest")
 	proto.RegisterType((*MsgNewKeyRequestResponse)(nil), "fusionchain.treasury.MsgNewKeyRequestResponse")
@@ -843,67 +1165,83 @@ func init() {
 	proto.RegisterType((*MsgNewSignTransactionRequest)(nil), "fusionchain.treasury.MsgNewSignTransactionRequest")
 	proto.RegisterType((*MsgNewSignTransactionRequestResponse)(nil), "fusionchain.treasury.MsgNewSignTransactionRequestResponse")
 	proto.RegisterType((*MetadataEthereum)(nil), "fusionchain.treasury.MetadataEthereum")
+	proto.RegisterType((*MsgRotateWalletKey)(nil), "fusionchain.treasury.MsgRotateWalletKey")
+	proto.RegisterType((*MsgRotateWalletKeyResponse)(nil), "fusionchain.treasury.MsgRotateWalletKeyResponse")
+	proto.RegisterType((*MsgResetWalletNonce)(nil), "fusionchain.treasury.MsgResetWalletNonce")
+	proto.RegisterType((*MsgResetWalletNonceResponse)(nil), "fusionchain.treasury.MsgResetWalletNonceResponse")
+	proto.RegisterType((*MsgImportWalletPolicies)(nil), "fusionchain.treasury.MsgImportWalletPolicies")
+	proto.RegisterType((*MsgImportWalletPoliciesResponse)(nil), "fusionchain.treasury.MsgImportWalletPoliciesResponse")
 }
 
 func init() { proto.RegisterFile("fusionchain/treasury/tx.proto", fileDescriptor_b5f7e7b3c14eb6e0) }
 
 var fileDescriptor_b5f7e7b3c14eb6e0 = []byte{
-	// 865 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x96, 0xcf, 0x6f, 0xe3, 0x44,
-	0x14, 0xc7, 0xe3, 0x34, 0x9b, 0x36, 0xaf, 0x69, 0x14, 0x4d, 0xcb, 0x2a, 0x0d, 0xc4, 0xdb, 0x7a,
-	0xd9, 0xa5, 0xac, 0x58, 0xa7, 0x9b, 0x45, 0x02, 0x71, 0x60, 0xd5, 0x15, 0x2c, 0xad, 0xaa, 0x70,
-	0x98, 0x2e, 0x42, 0xe2, 0x62, 0x4d, 0x3c, 0x53, 0xd7, 0xc4, 0xb5, 0xbd, 0x33, 0x63, 0x75, 0x7d,
-	0x45, 0x42, 0x08, 0x71, 0xe1, 0x6f, 0xe2, 0xc4, 0x71, 0x8f, 0x1c, 0x51, 0x7b, 0xe7, 0xc4, 0x1f,
-	0x80, 0x3c, 0xfe, 0xd1, 0x92, 0xd8, 0x9b, 0x66, 0x6f, 0xf6, 0x9b, 0xcf, 0x9b, 0xf7, 0xde, 0xf7,
-	0x3d, 0x3f, 0x19, 0x06, 0xa7, 0x91, 0x70, 0x03, 0xdf, 0x3e, 0x23, 0xae, 0x3f, 0x94, 0x9c, 0x11,
-	0x11, 0xf1, 0x78, 0x28, 0x5f, 0x9b, 0x21, 0x0f, 0x64, 0x80, 0xb6, 0x6e, 0x1c, 0x9b, 0xf9, 0x71,
-	0x7f, 0xdb, 0x09, 0x02, 0xc7, 0x63, 0x43, 0xc5, 0x4c, 0xa2, 0xd3, 0x21, 0xf1, 0xe3, 0xd4, 0xa1,
-	0xaf, 0x97, 0xde, 0x37, 0x65, 0xf9, 0xf9, 0x6e, 0xe9, 0xf9, 0x05, 0xf1, 0x3c, 0x26, 0x33, 0xc4,
-	0x28, 0x45, 0xce, 0x43, 0x5b, 0xb8, 0x8e, 0x9f, 0x32, 0xc6, 0x1f, 0x1a, 0x74, 0xc7, 0xc2, 0xf9,
-	0x96, 0x5d, 0x1c, 0xb3, 0x18, 0xb3, 0x57, 0x11, 0x13, 0x12, 0xf5, 0x60, 0xd5, 0xe6, 0x8c, 0xc8,
-	0x80, 0xf7, 0xb4, 0x1d, 0x6d, 0xaf, 0x85, 0xf3, 0x57, 0xf4, 0x00, 0x3a, 0x17, 0x01, 0x9f, 0x8a,
-	0x90, 0xd8, 0xcc, 0x22, 0x94, 0xf2, 0x5e, 0x5d, 0x01, 0x1b, 0x85, 0xf5, 0x80, 0x52, 0x8e, 0x76,
-	0xa1, 0x3d, 0x65, 0x31, 0x77, 0x7d, 0x27, 0x85, 0x56, 0x14, 0xb4, 0x9e, 0xd9, 0x14, 0xf2, 0x39,
-	0xac, 0x4d, 0x59, 0x6c, 0xc9, 0x38, 0x64, 0xbd, 0xc6, 0x8e, 0xb6, 0xd7, 0x19, 0x0d, 0xcc, 0x32,
-	0x8d, 0xcc, 0x63, 0x16, 0xbf, 0x8c, 0x43, 0x86, 0x57, 0xa7, 0xe9, 0x03, 0xea, 0xc2, 0xca, 0x44,
-	0x7a, 0xbd, 0x3b, 0x3b, 0xda, 0x5e, 0x03, 0x27, 0x8f, 0xc6, 0x23, 0xe8, 0xcd, 0xd6, 0x80, 0x99,
-	0x08, 0x03, 0x5f, 0x30, 0xd4, 0x81, 0xba, 0x4b, 0x55, 0x19, 0x0d, 0x5c, 0x77, 0xa9, 0xf1, 0x08,
-	0x5a, 0x05, 0x8b, 0x06, 0x00, 0x61, 0x34, 0xf1, 0x5c, 0xdb, 0x9a, 0xb2, 0x58, 0x41, 0x6d, 0xdc,
-	0x4a, 0x2d, 0xc7, 0x2c, 0x36, 0xfe, 0xd5, 0x60, 0x73, 0x2c, 0x9c, 0xef, 0x42, 0x4a, 0x24, 0xbb,
-	0x95, 0x3e, 0x03, 0x00, 0x9e, 0x42, 0x96, 0x4b, 0x95, 0x36, 0x0d, 0xdc, 0xca, 0x2c, 0x47, 0x14,
-	0x7d, 0x09, 0x4d, 0x21, 0x89, 0x8c, 0x84, 0x52, 0xa4, 0x33, 0x7a, 0x58, 0x59, 0x72, 0x16, 0xea,
-	0x44, 0xd1, 0x38, 0xf3, 0x42, 0x4f, 0x61, 0x25, 0x49, 0x34, 0xd1, 0x6b, 0x7d, 0x74, 0xaf, 0xdc,
-	0xb9, 0xa8, 0xee, 0xb0, 0x86, 0x13, 0x1a, 0x3d, 0x80, 0x0d, 0xce, 0x7e, 0x64, 0xb6, 0xb4, 0x12,
-	0x24, 0xf0, 0x95, 0x72, 0xad, 0xc3, 0x1a, 0x6e, 0xa7, 0x66, 0xac, 0xac, 0xcf, 0xd7, 0xa0, 0xc9,
-	0x99, 0x88, 0x3c, 0x69, 0x0c, 0xe0, 0xfd, 0x92, 0xaa, 0x73, 0x45, 0x8d, 0x9f, 0x35, 0xb8, 0x9b,
-	0x06, 0x39, 0x71, 0x1d, 0x9f, 0xc8, 0x88, 0xb3, 0xc5, 0xc2, 0xbc, 0x07, 0xcd, 0xa4, 0xdd, 0x85,
-	0x28, 0x77, 0xa6, 0x2c, 0x3e, 0xa2, 0x68, 0x0f, 0xba, 0x94, 0x48, 0x62, 0x9d, 0x06, 0xdc, 0x4a,
-	0xa6, 0xd2, 0xf5, 0x1d, 0x25, 0x4d, 0x1b, 0x77, 0x12, 0xfb, 0x8b, 0x80, 0x9f, 0xa4, 0xd6, 0xbc,
-	0xeb, 0x8d, 0xeb, 0xae, 0xef, 0x83, 0x5e, 0x9e, 0x46, 0x65, 0xef, 0xf7, 0x61, 0x63, 0x2c, 0x9c,
-	0x04, 0x67, 0xf4, 0x2b, 0x22, 0x09, 0xba, 0x07, 0xeb, 0x42, 0xbd, 0x59, 0x49, 0xb4, 0x6c, 0x00,
-	0x40, 0x14, 0x80, 0xf1, 0x4b, 0x1d, 0xb6, 0xc7, 0xc2, 0x79, 0x11, 0x79, 0xa7, 0xae, 0xb7, 0x44,
-	0xb9, 0x0b, 0xe6, 0xe0, 0xd9, 0xcc, 0x1c, 0x7c, 0x54, 0xde, 0xca, 0x24, 0x60, 0xf9, 0x20, 0x3c,
-	0x83, 0xd5, 0x90, 0xc4, 0x5e, 0x40, 0x68, 0x36, 0x0c, 0xf7, 0x2b, 0x87, 0xe1, 0xba, 0xdc, 0xc3,
-	0x1a, 0xce, 0xbd, 0x96, 0x1f, 0x8a, 0xfb, 0xb0, 0x5b, 0x29, 0x44, 0x31, 0x1a, 0xbf, 0xd6, 0xe1,
-	0x83, 0xeb, 0x9e, 0xbc, 0xe4, 0xc4, 0x17, 0xc4, 0x96, 0x6e, 0xe0, 0xbf, 0xf3, 0x80, 0x1c, 0xc0,
-	0x7a, 0xba, 0xd3, 0xd2, 0x4d, 0x91, 0xca, 0xb5, 0x53, 0x5e, 0xec, 0xf7, 0x0a, 0x54, 0xcb, 0x02,
-	0x2e, 0x8a, 0x67, 0xf4, 0x04, 0xb6, 0x22, 0x3f, 0x6b, 0xb3, 0xbc, 0x4e, 0x49, 0x09, 0xd7, 0xc6,
-	0x9b, 0xf9, 0xd9, 0x8d, 0x6c, 0xe7, 0x57, 0x0c, 0xda, 0x87, 0xb5, 0x73, 0x26, 0x89, 0x1a, 0x93,
-	0xa6, 0x52, 0x7c, 0xcb, 0x4c, 0x97, 0xb7, 0x99, 0x2f, 0x6f, 0xf3, 0xc0, 0x8f, 0x71, 0x41, 0x19,
-	0x67, 0xf0, 0xe1, 0xdb, 0xa4, 0xa8, 0x1a, 0x52, 0xb4, 0x0f, 0x5b, 0x22, 0xd7, 0xd7, 0x9a, 0x1b,
-	0x22, 0x24, 0x66, 0xb4, 0x3f, 0xa2, 0xc6, 0x63, 0xe8, 0x8e, 0xb3, 0xa8, 0x5f, 0xcb, 0x33, 0xc6,
-	0x59, 0x74, 0x8e, 0xb6, 0x61, 0x4d, 0xa9, 0x63, 0x15, 0x77, 0xaf, 0xaa, 0xf7, 0x23, 0x3a, 0xfa,
-	0xa7, 0x01, 0x2b, 0x63, 0xe1, 0x20, 0x07, 0x36, 0xfe, 0xbf, 0xf6, 0x1f, 0x2e, 0x58, 0x28, 0x19,
-	0xd7, 0x37, 0x6f, 0xc7, 0x15, 0x15, 0x86, 0xd0, 0x9d, 0x5b, 0xa1, 0x1f, 0x57, 0xde, 0x31, 0x8b,
-	0xf6, 0x9f, 0xdc, 0x1a, 0x2d, 0x22, 0xc6, 0xb0, 0x59, 0xb6, 0x9e, 0x3e, 0x79, 0x5b, 0xe2, 0xb3,
-	0x74, 0xff, 0xd3, 0x65, 0xe8, 0x22, 0xf4, 0x4f, 0x1a, 0xdc, 0xad, 0x58, 0x17, 0xc3, 0xca, 0x0b,
-	0xcb, 0x1d, 0xfa, 0x9f, 0x2d, 0xe9, 0x50, 0x24, 0xf1, 0x9b, 0x06, 0xdb, 0xd5, 0x1f, 0xe1, 0x68,
-	0x51, 0x61, 0xf3, 0x3e, 0xfd, 0x2f, 0x96, 0xf7, 0xc9, 0xb3, 0x79, 0xfe, 0xcd, 0x9f, 0x97, 0xba,
-	0xf6, 0xe6, 0x52, 0xd7, 0xfe, 0xbe, 0xd4, 0xb5, 0xdf, 0xaf, 0xf4, 0xda, 0x9b, 0x2b, 0xbd, 0xf6,
-	0xd7, 0x95, 0x5e, 0xfb, 0xe1, 0xb1, 0xe3, 0xca, 0xb3, 0x68, 0x62, 0xda, 0xc1, 0xf9, 0xf0, 0x15,
-	0x67, 0x34, 0x18, 0xde, 0xfc, 0x65, 0x79, 0x7d, 0xe3, 0x3f, 0x2a, 0x0e, 0x99, 0x98, 0x34, 0xd5,
-	0xa7, 0xf6, 0xf4, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe2, 0xb6, 0xb8, 0x67, 0x6c, 0x09, 0x00,
-	0x00,
+	// 1031 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0x4f, 0x6f, 0xe3, 0x44,
+	0x14, 0x8f, 0x9b, 0x6c, 0xfe, 0xbc, 0xa6, 0x21, 0x72, 0x43, 0x49, 0x4d, 0x93, 0xb6, 0x5e, 0x76,
+	0x09, 0x2b, 0x9a, 0xa4, 0x59, 0x10, 0x88, 0x03, 0xab, 0xae, 0x60, 0x69, 0x54, 0x65, 0x85, 0xdc,
+	0x45, 0x48, 0x5c, 0xac, 0x89, 0x3d, 0x75, 0x4d, 0x1c, 0xdb, 0x3b, 0x33, 0x56, 0xd6, 0x12, 0x27,
+	0x24, 0x84, 0x10, 0x17, 0x3e, 0x00, 0x9f, 0x86, 0x13, 0x17, 0xa4, 0x3d, 0x72, 0x44, 0xed, 0x57,
+	0xe0, 0x03, 0x20, 0x8f, 0xff, 0x34, 0x9b, 0xd8, 0xdb, 0x84, 0xbd, 0x79, 0xde, 0xfc, 0xde, 0xbc,
+	0xf7, 0x7e, 0xef, 0xd7, 0xf7, 0x1a, 0x68, 0x5d, 0x78, 0xd4, 0x74, 0x6c, 0xed, 0x12, 0x99, 0x76,
+	0x8f, 0x11, 0x8c, 0xa8, 0x47, 0xfc, 0x1e, 0x7b, 0xd1, 0x75, 0x89, 0xc3, 0x1c, 0xb1, 0x31, 0x77,
+	0xdd, 0x8d, 0xaf, 0xa5, 0x5d, 0xc3, 0x71, 0x0c, 0x0b, 0xf7, 0x38, 0x66, 0xec, 0x5d, 0xf4, 0x90,
+	0xed, 0x87, 0x0e, 0x52, 0x3b, 0xf5, 0xbd, 0x09, 0x8e, 0xef, 0x0f, 0x53, 0xef, 0x67, 0xc8, 0xb2,
+	0x30, 0x8b, 0x20, 0x72, 0x2a, 0x64, 0xea, 0x6a, 0xd4, 0x34, 0xec, 0x10, 0x23, 0xff, 0x21, 0x40,
+	0x7d, 0x44, 0x8d, 0xa7, 0x78, 0x76, 0x86, 0x7d, 0x05, 0x3f, 0xf7, 0x30, 0x65, 0x62, 0x13, 0x4a,
+	0x1a, 0xc1, 0x88, 0x39, 0xa4, 0x29, 0x1c, 0x08, 0x9d, 0x8a, 0x12, 0x1f, 0xc5, 0x7b, 0x50, 0x9b,
+	0x39, 0x64, 0x42, 0x5d, 0xa4, 0x61, 0x15, 0xe9, 0x3a, 0x69, 0x6e, 0x70, 0xc0, 0x56, 0x62, 0x3d,
+	0xd1, 0x75, 0x22, 0x1e, 0x42, 0x75, 0x82, 0x7d, 0x62, 0xda, 0x46, 0x08, 0xca, 0x73, 0xd0, 0x66,
+	0x64, 0xe3, 0x90, 0x4f, 0xa1, 0x3c, 0xc1, 0xbe, 0xca, 0x7c, 0x17, 0x37, 0x0b, 0x07, 0x42, 0xa7,
+	0x36, 0x68, 0x75, 0xd3, 0x38, 0xea, 0x9e, 0x61, 0xff, 0x99, 0xef, 0x62, 0xa5, 0x34, 0x09, 0x3f,
+	0xc4, 0x3a, 0xe4, 0xc7, 0xcc, 0x6a, 0xde, 0x39, 0x10, 0x3a, 0x05, 0x25, 0xf8, 0x94, 0x1f, 0x40,
+	0x73, 0xb1, 0x06, 0x05, 0x53, 0xd7, 0xb1, 0x29, 0x16, 0x6b, 0xb0, 0x61, 0xea, 0xbc, 0x8c, 0x82,
+	0xb2, 0x61, 0xea, 0xf2, 0x03, 0xa8, 0x24, 0x58, 0xb1, 0x05, 0xe0, 0x7a, 0x63, 0xcb, 0xd4, 0xd4,
+	0x09, 0xf6, 0x39, 0xa8, 0xaa, 0x54, 0x42, 0xcb, 0x19, 0xf6, 0xe5, 0x7f, 0x05, 0xd8, 0x1e, 0x51,
+	0xe3, 0x1b, 0x57, 0x47, 0x0c, 0xaf, 0xc4, 0x4f, 0x0b, 0x80, 0x84, 0x20, 0xd5, 0xd4, 0x39, 0x37,
+	0x05, 0xa5, 0x12, 0x59, 0x86, 0xba, 0xf8, 0x39, 0x14, 0x29, 0x43, 0xcc, 0xa3, 0x9c, 0x91, 0xda,
+	0xe0, 0x7e, 0x66, 0xc9, 0x51, 0xa8, 0x73, 0x8e, 0x56, 0x22, 0x2f, 0xf1, 0x21, 0xe4, 0x83, 0x44,
+	0x03, 0xbe, 0x36, 0x07, 0xfb, 0xe9, 0xce, 0x49, 0x75, 0xa7, 0x39, 0x25, 0x40, 0x8b, 0xf7, 0x60,
+	0x8b, 0xe0, 0xef, 0xb1, 0xc6, 0xd4, 0x00, 0xe2, 0xd8, 0x9c, 0xb9, 0xca, 0x69, 0x4e, 0xa9, 0x86,
+	0x66, 0x85, 0x5b, 0x1f, 0x97, 0xa1, 0x48, 0x30, 0xf5, 0x2c, 0x26, 0xb7, 0xe0, 0xdd, 0x94, 0xaa,
+	0x63, 0x46, 0xe5, 0x9f, 0x04, 0xd8, 0x09, 0x83, 0x9c, 0x9b, 0x86, 0x8d, 0x98, 0x47, 0xf0, 0xed,
+	0xc4, 0xbc, 0x0d, 0xc5, 0xa0, 0xdd, 0x09, 0x29, 0x77, 0x26, 0xd8, 0x1f, 0xea, 0x62, 0x07, 0xea,
+	0x3a, 0x62, 0x48, 0xbd, 0x70, 0x88, 0x1a, 0xa8, 0xd2, 0xb4, 0x0d, 0x4e, 0x4d, 0x55, 0xa9, 0x05,
+	0xf6, 0x27, 0x0e, 0x39, 0x0f, 0xad, 0x71, 0xd7, 0x0b, 0x37, 0x5d, 0xef, 0x43, 0x3b, 0x3d, 0x8d,
+	0xcc, 0xde, 0xf7, 0x61, 0x6b, 0x44, 0x8d, 0x00, 0x8e, 0xf5, 0x2f, 0x10, 0x43, 0xe2, 0x3e, 0x6c,
+	0x52, 0x7e, 0x52, 0x83, 0x68, 0x91, 0x00, 0x80, 0x26, 0x00, 0xf9, 0xe7, 0x0d, 0xd8, 0x1d, 0x51,
+	0xe3, 0x89, 0x67, 0x5d, 0x98, 0xd6, 0x1a, 0xe5, 0xde, 0xa2, 0x83, 0x47, 0x0b, 0x3a, 0x78, 0x3f,
+	0xbd, 0x95, 0x41, 0xc0, 0x74, 0x21, 0x3c, 0x82, 0x92, 0x8b, 0x7c, 0xcb, 0x41, 0x7a, 0x24, 0x86,
+	0xbb, 0x99, 0x62, 0xb8, 0x29, 0xf7, 0x34, 0xa7, 0xc4, 0x5e, 0xeb, 0x8b, 0xe2, 0x2e, 0x1c, 0x66,
+	0x12, 0x91, 0x48, 0xe3, 0x97, 0x0d, 0xd8, 0xbb, 0xe9, 0xc9, 0x33, 0x82, 0x6c, 0x8a, 0x34, 0x66,
+	0x3a, 0xf6, 0xff, 0x16, 0xc8, 0x09, 0x6c, 0x86, 0x33, 0x2d, 0x9c, 0x14, 0x21, 0x5d, 0x07, 0xe9,
+	0xc5, 0x7e, 0xcb, 0x81, 0x7c, 0x58, 0xc0, 0x2c, 0xf9, 0x16, 0x8f, 0xa1, 0xe1, 0xd9, 0x51, 0x9b,
+	0xd9, 0x4d, 0x4a, 0x9c, 0xb8, 0xaa, 0xb2, 0x1d, 0xdf, 0xcd, 0x65, 0xbb, 0x3c, 0x62, 0xc4, 0x3e,
+	0x94, 0xa7, 0x98, 0x21, 0x2e, 0x93, 0x22, 0x67, 0xbc, 0xd1, 0x0d, 0x87, 0x77, 0x37, 0x1e, 0xde,
+	0xdd, 0x13, 0xdb, 0x57, 0x12, 0x94, 0x7c, 0x09, 0xef, 0xbd, 0x8e, 0x8a, 0x2c, 0x91, 0x8a, 0x7d,
+	0x68, 0xd0, 0x98, 0x5f, 0x75, 0x49, 0x44, 0x22, 0x5d, 0xe0, 0x7e, 0xa8, 0xcb, 0x47, 0x50, 0x1f,
+	0x45, 0x51, 0xbf, 0x64, 0x97, 0x98, 0x60, 0x6f, 0x2a, 0xee, 0x42, 0x99, 0xb3, 0xa3, 0x26, 0x6f,
+	0x97, 0xf8, 0x79, 0xa8, 0xcb, 0x1e, 0x88, 0x23, 0x6a, 0x28, 0x0e, 0x43, 0x0c, 0x87, 0x94, 0x05,
+	0xa3, 0x70, 0xed, 0xce, 0xec, 0x01, 0xd8, 0x78, 0xa6, 0x46, 0x57, 0x79, 0x7e, 0x55, 0xb6, 0xf9,
+	0xe4, 0x19, 0xea, 0x29, 0x7f, 0xae, 0x7b, 0x20, 0x2d, 0x87, 0x4d, 0x94, 0x43, 0xf9, 0xa4, 0x55,
+	0x30, 0xc5, 0x2c, 0xbc, 0x7c, 0xea, 0xd8, 0x1a, 0x5e, 0x3f, 0xab, 0xf9, 0xba, 0xf3, 0xaf, 0xd4,
+	0x9d, 0x92, 0x52, 0x38, 0xe8, 0x16, 0x83, 0x26, 0x39, 0xfd, 0x2e, 0xc0, 0x3b, 0x23, 0x6a, 0x0c,
+	0xa7, 0xae, 0x43, 0x22, 0xc0, 0xd7, 0x8e, 0x65, 0x6a, 0x26, 0xa6, 0x6f, 0xbe, 0x22, 0x77, 0xa0,
+	0x38, 0xf6, 0x6c, 0xdd, 0xc2, 0xd1, 0xbc, 0x8b, 0x4e, 0x81, 0x9d, 0xeb, 0x91, 0x44, 0xfa, 0x8c,
+	0x4e, 0x29, 0x5b, 0xef, 0x10, 0xf6, 0x33, 0xb2, 0x8b, 0x2b, 0x18, 0xfc, 0x55, 0x82, 0xfc, 0x88,
+	0x1a, 0xa2, 0x01, 0x5b, 0xaf, 0x6e, 0xf8, 0xfb, 0xb7, 0xec, 0x8e, 0x08, 0x27, 0x75, 0x57, 0xc3,
+	0x25, 0x62, 0x76, 0xa1, 0xbe, 0xb4, 0x2d, 0x3f, 0xc8, 0x7c, 0x63, 0x11, 0x2a, 0x1d, 0xaf, 0x0c,
+	0x4d, 0x22, 0xfa, 0xb0, 0x9d, 0xb6, 0x89, 0x3e, 0x7c, 0x5d, 0xe2, 0x8b, 0x68, 0xe9, 0xa3, 0x75,
+	0xd0, 0x49, 0xe8, 0x1f, 0x05, 0xd8, 0xc9, 0xd8, 0x0c, 0xbd, 0xcc, 0x07, 0xd3, 0x1d, 0xa4, 0x4f,
+	0xd6, 0x74, 0x48, 0x92, 0xf8, 0x55, 0x80, 0xdd, 0xec, 0x79, 0x3b, 0xb8, 0xad, 0xb0, 0x65, 0x1f,
+	0xe9, 0xb3, 0xf5, 0x7d, 0x92, 0x6c, 0xa6, 0xf0, 0xd6, 0xe2, 0x60, 0xe9, 0x64, 0x3e, 0xb7, 0x80,
+	0x94, 0xfa, 0xab, 0x22, 0xe7, 0xe5, 0xb6, 0x34, 0x32, 0xb2, 0xe5, 0xb6, 0x08, 0x95, 0x8e, 0x57,
+	0x86, 0x26, 0x11, 0x7f, 0x80, 0x46, 0xea, 0x3c, 0x38, 0xca, 0x7c, 0x2a, 0x0d, 0x2e, 0x7d, 0xbc,
+	0x16, 0x3c, 0x8e, 0xfe, 0xf8, 0xab, 0x3f, 0xaf, 0xda, 0xc2, 0xcb, 0xab, 0xb6, 0xf0, 0xcf, 0x55,
+	0x5b, 0xf8, 0xed, 0xba, 0x9d, 0x7b, 0x79, 0xdd, 0xce, 0xfd, 0x7d, 0xdd, 0xce, 0x7d, 0x77, 0x64,
+	0x98, 0xec, 0xd2, 0x1b, 0x77, 0x35, 0x67, 0xda, 0x7b, 0x4e, 0xb0, 0xee, 0xf4, 0xe6, 0xff, 0xf9,
+	0x7f, 0x31, 0xf7, 0x8b, 0xc4, 0x77, 0x31, 0x1d, 0x17, 0xf9, 0xd2, 0x7a, 0xf8, 0xdf, 0x00, 0x13,
+	0xe5, 0xa0, 0x38, 0xb6, 0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -934,6 +1272,20 @@ type MsgClient interface {
 	// parsed by the wallet to apply specific Blackbird policies that depends on
 	// informations contained in the transaction itself (e.g. amount, recipient).
 	NewSignTransactionRequest(ctx context.Context, in *MsgNewSignTransactionRequest, opts ...grpc.CallOption) (*MsgNewSignTransactionRequestResponse, error)
+	// Rotate the key material used by the wallets of a key, replacing its
+	// public key with the one of another key of the same workspace. The
+	// previous public key is kept, so that the addresses used before the
+	// rotation can still be queried.
+	RotateWalletKey(ctx context.Context, in *MsgRotateWalletKey, opts ...grpc.CallOption) (*MsgRotateWalletKeyResponse, error)
+	// Forget the last transaction signed by the wallets of a key on a chain,
+	// so that the nonce of the next transaction isn't checked against it.
+	// This recovers wallets whose tracked nonce diverged from the account,
+	// e.g. after a signed transaction was never broadcast.
+	ResetWalletNonce(ctx context.Context, in *MsgResetWalletNonce, opts ...grpc.CallOption) (*MsgResetWalletNonceResponse, error)
+	// Import a bundle of wallet policies, exported from another chain, as
+	// the admin and sign policies of a workspace. This changes the policies
+	// of all the wallets of the keys of the workspace.
+	ImportWalletPolicies(ctx context.Context, in *MsgImportWalletPolicies, opts ...grpc.CallOption) (*MsgImportWalletPoliciesResponse, error)
 }
 
 type msgClient struct {
@@ -989,6 +1341,33 @@ func (c *msgClient) NewSignTransactionRequest(ctx context.Context, in *MsgNewSig
 	return out, nil
 }
 
+func (c *msgClient) RotateWalletKey(ctx context.Context, in *MsgRotateWalletKey, opts ...grpc.CallOption) (*MsgRotateWalletKeyResponse, error) {
+	out := new(MsgRotateWalletKeyResponse)
+	err := c.cc.Invoke(ctx, "/fusionchain.treasury.Msg/RotateWalletKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ResetWalletNonce(ctx context.Context, in *MsgResetWalletNonce, opts ...grpc.CallOption) (*MsgResetWalletNonceResponse, error) {
+	out := new(MsgResetWalletNonceResponse)
+	err := c.cc.Invoke(ctx, "/fusionchain.treasury.Msg/ResetWalletNonce", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ImportWalletPolicies(ctx context.Context, in *MsgImportWalletPolicies, opts ...grpc.CallOption) (*MsgImportWalletPoliciesResponse, error) {
+	out := new(MsgImportWalletPoliciesResponse)
+	err := c.cc.Invoke(ctx, "/fusionchain.treasury.Msg/ImportWalletPolicies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// Request a new key to the MPC network, the key will belong to the
@@ -1007,6 +1386,20 @@ type MsgServer interface {
 	// parsed by the wallet to apply specific Blackbird policies that depends on
 	// informations contained in the transaction itself (e.g. amount, recipient).
 	NewSignTransactionRequest(context.Context, *MsgNewSignTransactionRequest) (*MsgNewSignTransactionRequestResponse, error)
+	// Rotate the key material used by the wallets of a key, replacing its
+	// public key with the one of another key of the same workspace. The
+	// previous public key is kept, so that the addresses used before the
+	// rotation can still be queried.
+	RotateWalletKey(context.Context, *MsgRotateWalletKey) (*MsgRotateWalletKeyResponse, error)
+	// Forget the last transaction signed by the wallets of a key on a chain,
+	// so that the nonce of the next transaction isn't checked against it.
+	// This recovers wallets whose tracked nonce diverged from the account,
+	// e.g. after a signed transaction was never broadcast.
+	ResetWalletNonce(context.Context, *MsgResetWalletNonce) (*MsgResetWalletNonceResponse, error)
+	// Import a bundle of wallet policies, exported from another chain, as
+	// the admin and sign policies of a workspace. This changes the policies
+	// of all the wallets of the keys of the workspace.
+	ImportWalletPolicies(context.Context, *MsgImportWalletPolicies) (*MsgImportWalletPoliciesResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1028,6 +1421,15 @@ func (*UnimplementedMsgServer) FulfilSignatureRequest(ctx context.Context, req *
 func (*UnimplementedMsgServer) NewSignTransactionRequest(ctx context.Context, req *MsgNewSignTransactionRequest) (*MsgNewSignTransactionRequestResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method NewSignTransactionRequest not implemented")
 }
+func (*UnimplementedMsgServer) RotateWalletKey(ctx context.Context, req *MsgRotateWalletKey) (*MsgRotateWalletKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateWalletKey not implemented")
+}
+func (*UnimplementedMsgServer) ResetWalletNonce(ctx context.Context, req *MsgResetWalletNonce) (*MsgResetWalletNonceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetWalletNonce not implemented")
+}
+func (*UnimplementedMsgServer) ImportWalletPolicies(ctx context.Context, req *MsgImportWalletPolicies) (*MsgImportWalletPoliciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportWalletPolicies not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1123,39 +1525,105 @@ func _Msg_NewSignTransactionRequest_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "fusionchain.treasury.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "NewKeyRequest",
-			Handler:    _Msg_NewKeyRequest_Handler,
-		},
-		{
-			MethodName: "UpdateKeyRequest",
-			Handler:    _Msg_UpdateKeyRequest_Handler,
-		},
-		{
-			MethodName: "NewSignatureRequest",
-			Handler:    _Msg_NewSignatureRequest_Handler,
-		},
-		{
-			MethodName: "FulfilSignatureRequest",
-			Handler:    _Msg_FulfilSignatureRequest_Handler,
-		},
-		{
-			MethodName: "NewSignTransactionRequest",
-			Handler:    _Msg_NewSignTransactionRequest_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "fusionchain/treasury/tx.proto",
-}
-
-func (m *MsgNewKeyRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_RotateWalletKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRotateWalletKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RotateWalletKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fusionchain.treasury.Msg/RotateWalletKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RotateWalletKey(ctx, req.(*MsgRotateWalletKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ResetWalletNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResetWalletNonce)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ResetWalletNonce(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fusionchain.treasury.Msg/ResetWalletNonce",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResetWalletNonce(ctx, req.(*MsgResetWalletNonce))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ImportWalletPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgImportWalletPolicies)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ImportWalletPolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fusionchain.treasury.Msg/ImportWalletPolicies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ImportWalletPolicies(ctx, req.(*MsgImportWalletPolicies))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "fusionchain.treasury.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "NewKeyRequest",
+			Handler:    _Msg_NewKeyRequest_Handler,
+		},
+		{
+			MethodName: "UpdateKeyRequest",
+			Handler:    _Msg_UpdateKeyRequest_Handler,
+		},
+		{
+			MethodName: "NewSignatureRequest",
+			Handler:    _Msg_NewSignatureRequest_Handler,
+		},
+		{
+			MethodName: "FulfilSignatureRequest",
+			Handler:    _Msg_FulfilSignatureRequest_Handler,
+		},
+		{
+			MethodName: "NewSignTransactionRequest",
+			Handler:    _Msg_NewSignTransactionRequest_Handler,
+		},
+		{
+			MethodName: "RotateWalletKey",
+			Handler:    _Msg_RotateWalletKey_Handler,
+		},
+		{
+			MethodName: "ResetWalletNonce",
+			Handler:    _Msg_ResetWalletNonce_Handler,
+		},
+		{
+			MethodName: "ImportWalletPolicies",
+			Handler:    _Msg_ImportWalletPolicies_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "fusionchain/treasury/tx.proto",
+}
+
+func (m *MsgNewKeyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -1708,128 +2176,343 @@ func (m *MetadataEthereum) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgRotateWalletKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgNewKeyRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgRotateWalletKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRotateWalletKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.WorkspaceAddr)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Btl != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Btl))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.KeyringAddr)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.NewKeyId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.NewKeyId))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.KeyType != 0 {
-		n += 1 + sovTx(uint64(m.KeyType))
+	if m.KeyId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.KeyId))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Btl != 0 {
-		n += 1 + sovTx(uint64(m.Btl))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgNewKeyRequestResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
+func (m *MsgRotateWalletKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgNewKey) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgRotateWalletKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRotateWalletKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.PublicKey)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateKeyRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgResetWalletNonce) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResetWalletNonce) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResetWalletNonce) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Btl != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Btl))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.RequestId != 0 {
-		n += 1 + sovTx(uint64(m.RequestId))
+	if m.ChainId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.Status != 0 {
-		n += 1 + sovTx(uint64(m.Status))
+	if m.KeyId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.KeyId))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Result != nil {
-		n += m.Result.Size()
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateKeyRequest_Key) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Key != nil {
-		l = m.Key.Size()
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgResetWalletNonceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
-func (m *MsgUpdateKeyRequest_RejectReason) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.RejectReason)
-	n += 1 + l + sovTx(uint64(l))
-	return n
+
+func (m *MsgResetWalletNonceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *MsgUpdateKeyRequestResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgResetWalletNonceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgNewSignatureRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+func (m *MsgImportWalletPolicies) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgImportWalletPolicies) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgImportWalletPolicies) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Btl != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Btl))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Bundle) > 0 {
+		i -= len(m.Bundle)
+		copy(dAtA[i:], m.Bundle)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Bundle)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.WorkspaceAddr) > 0 {
+		i -= len(m.WorkspaceAddr)
+		copy(dAtA[i:], m.WorkspaceAddr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.WorkspaceAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgImportWalletPoliciesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgImportWalletPoliciesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgImportWalletPoliciesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgNewKeyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.WorkspaceAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.KeyringAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.KeyType != 0 {
+		n += 1 + sovTx(uint64(m.KeyType))
+	}
+	if m.Btl != 0 {
+		n += 1 + sovTx(uint64(m.Btl))
+	}
+	return n
+}
+
+func (m *MsgNewKeyRequestResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgNewKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateKeyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.RequestId != 0 {
+		n += 1 + sovTx(uint64(m.RequestId))
+	}
+	if m.Status != 0 {
+		n += 1 + sovTx(uint64(m.Status))
+	}
+	if m.Result != nil {
+		n += m.Result.Size()
+	}
+	return n
+}
+
+func (m *MsgUpdateKeyRequest_Key) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Key != nil {
+		l = m.Key.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+func (m *MsgUpdateKeyRequest_RejectReason) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RejectReason)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+func (m *MsgUpdateKeyRequestResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgNewSignatureRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	l = len(m.Creator)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
@@ -1982,39 +2665,1019 @@ func (m *MetadataEthereum) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *MsgRotateWalletKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.KeyId != 0 {
+		n += 1 + sovTx(uint64(m.KeyId))
+	}
+	if m.NewKeyId != 0 {
+		n += 1 + sovTx(uint64(m.NewKeyId))
+	}
+	if m.Btl != 0 {
+		n += 1 + sovTx(uint64(m.Btl))
+	}
+	return n
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *MsgRotateWalletKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
 }
-func (m *MsgNewKeyRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgNewKeyRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgNewKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+
+func (m *MsgResetWalletNonce) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.KeyId != 0 {
+		n += 1 + sovTx(uint64(m.KeyId))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovTx(uint64(m.ChainId))
+	}
+	if m.Btl != 0 {
+		n += 1 + sovTx(uint64(m.Btl))
+	}
+	return n
+}
+
+func (m *MsgResetWalletNonceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgImportWalletPolicies) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.WorkspaceAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Bundle)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Btl != 0 {
+		n += 1 + sovTx(uint64(m.Btl))
+	}
+	return n
+}
+
+func (m *MsgImportWalletPoliciesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgNewKeyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgNewKeyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgNewKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkspaceAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkspaceAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyringAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyringAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyType", wireType)
+			}
+			m.KeyType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeyType |= KeyType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Btl", wireType)
+			}
+			m.Btl = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Btl |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgNewKeyRequestResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgNewKeyRequestResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgNewKeyRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgNewKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgNewKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgNewKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateKeyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateKeyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			m.RequestId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= KeyRequestStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MsgNewKey{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Result = &MsgUpdateKeyRequest_Key{v}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Result = &MsgUpdateKeyRequest_RejectReason{string(dAtA[iNdEx:postIndex])}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateKeyRequestResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateKeyRequestResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateKeyRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgNewSignatureRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgNewSignatureRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgNewSignatureRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyId", wireType)
+			}
+			m.KeyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeyId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataForSigning", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataForSigning = append(m.DataForSigning[:0], dAtA[iNdEx:postIndex]...)
+			if m.DataForSigning == nil {
+				m.DataForSigning = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Btl", wireType)
+			}
+			m.Btl = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Btl |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgNewSignatureRequestResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgNewSignatureRequestResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgNewSignatureRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSignedData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSignedData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSignedData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedData", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignedData = append(m.SignedData[:0], dAtA[iNdEx:postIndex]...)
+			if m.SignedData == nil {
+				m.SignedData = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFulfilSignatureRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFulfilSignatureRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFulfilSignatureRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2050,10 +3713,10 @@ func (m *MsgNewKeyRequest) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkspaceAddr", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
 			}
-			var stringLen uint64
+			m.RequestId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2063,29 +3726,16 @@ func (m *MsgNewKeyRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.RequestId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.WorkspaceAddr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyringAddr", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var stringLen uint64
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2095,29 +3745,16 @@ func (m *MsgNewKeyRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Status |= SignRequestStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.KeyringAddr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyType", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
 			}
-			m.KeyType = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2127,16 +3764,32 @@ func (m *MsgNewKeyRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.KeyType |= KeyType(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MsgSignedData{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Result = &MsgFulfilSignatureRequest_Payload{v}
+			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Btl", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectReason", wireType)
 			}
-			m.Btl = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2146,11 +3799,24 @@ func (m *MsgNewKeyRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Btl |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Result = &MsgFulfilSignatureRequest_RejectReason{string(dAtA[iNdEx:postIndex])}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2172,7 +3838,7 @@ func (m *MsgNewKeyRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgNewKeyRequestResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgFulfilSignatureRequestResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2195,31 +3861,12 @@ func (m *MsgNewKeyRequestResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgNewKeyRequestResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFulfilSignatureRequestResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgNewKeyRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFulfilSignatureRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			m.Id = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2241,7 +3888,7 @@ func (m *MsgNewKeyRequestResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgNewKey) Unmarshal(dAtA []byte) error {
+func (m *MsgNewSignTransactionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2264,17 +3911,17 @@ func (m *MsgNewKey) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgNewKey: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgNewSignTransactionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgNewKey: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgNewSignTransactionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2284,81 +3931,29 @@ func (m *MsgNewKey) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.PublicKey == nil {
-				m.PublicKey = []byte{}
-			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgUpdateKeyRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateKeyRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyId", wireType)
 			}
-			var stringLen uint64
+			m.KeyId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2368,29 +3963,35 @@ func (m *MsgUpdateKeyRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.KeyId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WalletType", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.WalletType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WalletType |= WalletType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnsignedTransaction", wireType)
 			}
-			m.RequestId = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2400,16 +4001,31 @@ func (m *MsgUpdateKeyRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RequestId |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnsignedTransaction = append(m.UnsignedTransaction[:0], dAtA[iNdEx:postIndex]...)
+			if m.UnsignedTransaction == nil {
+				m.UnsignedTransaction = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Btl", wireType)
 			}
-			m.Status = 0
+			m.Btl = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2419,14 +4035,14 @@ func (m *MsgUpdateKeyRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= KeyRequestStatus(b&0x7F) << shift
+				m.Btl |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2453,17 +4069,68 @@ func (m *MsgUpdateKeyRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &MsgNewKey{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Metadata == nil {
+				m.Metadata = &types.Any{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Result = &MsgUpdateKeyRequest_Key{v}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RejectReason", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var stringLen uint64
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgNewSignTransactionRequestResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgNewSignTransactionRequestResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgNewSignTransactionRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2473,24 +4140,30 @@ func (m *MsgUpdateKeyRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignatureRequestId", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.SignatureRequestId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignatureRequestId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Result = &MsgUpdateKeyRequest_RejectReason{string(dAtA[iNdEx:postIndex])}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2512,7 +4185,7 @@ func (m *MsgUpdateKeyRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateKeyRequestResponse) Unmarshal(dAtA []byte) error {
+func (m *MetadataEthereum) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2535,12 +4208,31 @@ func (m *MsgUpdateKeyRequestResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateKeyRequestResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MetadataEthereum: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateKeyRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MetadataEthereum: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2562,7 +4254,7 @@ func (m *MsgUpdateKeyRequestResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgNewSignatureRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgRotateWalletKey) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2585,10 +4277,10 @@ func (m *MsgNewSignatureRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgNewSignatureRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRotateWalletKey: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgNewSignatureRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRotateWalletKey: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2643,10 +4335,10 @@ func (m *MsgNewSignatureRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataForSigning", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewKeyId", wireType)
 			}
-			var byteLen int
+			m.NewKeyId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2656,26 +4348,11 @@ func (m *MsgNewSignatureRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.NewKeyId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DataForSigning = append(m.DataForSigning[:0], dAtA[iNdEx:postIndex]...)
-			if m.DataForSigning == nil {
-				m.DataForSigning = []byte{}
-			}
-			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Btl", wireType)
@@ -2716,76 +4393,7 @@ func (m *MsgNewSignatureRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgNewSignatureRequestResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgNewSignatureRequestResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgNewSignatureRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			m.Id = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgSignedData) Unmarshal(dAtA []byte) error {
+func (m *MsgRotateWalletKeyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2808,46 +4416,12 @@ func (m *MsgSignedData) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSignedData: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSignedData: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRotateWalletKeyResponse: wiretype end group for non-group")
 		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignedData", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SignedData = append(m.SignedData[:0], dAtA[iNdEx:postIndex]...)
-			if m.SignedData == nil {
-				m.SignedData = []byte{}
-			}
-			iNdEx = postIndex
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRotateWalletKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2869,7 +4443,7 @@ func (m *MsgSignedData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFulfilSignatureRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgResetWalletNonce) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2892,10 +4466,10 @@ func (m *MsgFulfilSignatureRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFulfilSignatureRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgResetWalletNonce: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFulfilSignatureRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgResetWalletNonce: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2932,9 +4506,9 @@ func (m *MsgFulfilSignatureRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyId", wireType)
 			}
-			m.RequestId = 0
+			m.KeyId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2944,16 +4518,16 @@ func (m *MsgFulfilSignatureRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RequestId |= uint64(b&0x7F) << shift
+				m.KeyId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			m.Status = 0
+			m.ChainId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2963,51 +4537,16 @@ func (m *MsgFulfilSignatureRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= SignRequestStatus(b&0x7F) << shift
+				m.ChainId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &MsgSignedData{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Result = &MsgFulfilSignatureRequest_Payload{v}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RejectReason", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Btl", wireType)
 			}
-			var stringLen uint64
+			m.Btl = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3017,24 +4556,11 @@ func (m *MsgFulfilSignatureRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Btl |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Result = &MsgFulfilSignatureRequest_RejectReason{string(dAtA[iNdEx:postIndex])}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3056,7 +4582,7 @@ func (m *MsgFulfilSignatureRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFulfilSignatureRequestResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgResetWalletNonceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3079,10 +4605,10 @@ func (m *MsgFulfilSignatureRequestResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFulfilSignatureRequestResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgResetWalletNonceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFulfilSignatureRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgResetWalletNonceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3106,7 +4632,7 @@ func (m *MsgFulfilSignatureRequestResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgNewSignTransactionRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgImportWalletPolicies) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3129,10 +4655,10 @@ func (m *MsgNewSignTransactionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgNewSignTransactionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgImportWalletPolicies: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgNewSignTransactionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgImportWalletPolicies: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3168,10 +4694,10 @@ func (m *MsgNewSignTransactionRequest) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkspaceAddr", wireType)
 			}
-			m.KeyId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3181,33 +4707,27 @@ func (m *MsgNewSignTransactionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.KeyId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WalletType", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			m.WalletType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.WalletType |= WalletType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-		case 4:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkspaceAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnsignedTransaction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bundle", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -3234,35 +4754,16 @@ func (m *MsgNewSignTransactionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UnsignedTransaction = append(m.UnsignedTransaction[:0], dAtA[iNdEx:postIndex]...)
-			if m.UnsignedTransaction == nil {
-				m.UnsignedTransaction = []byte{}
+			m.Bundle = append(m.Bundle[:0], dAtA[iNdEx:postIndex]...)
+			if m.Bundle == nil {
+				m.Bundle = []byte{}
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Btl", wireType)
-			}
-			m.Btl = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Btl |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3272,102 +4773,31 @@ func (m *MsgNewSignTransactionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Metadata == nil {
-				m.Metadata = &types.Any{}
-			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Signer = append(m.Signer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signer == nil {
+				m.Signer = []byte{}
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgNewSignTransactionRequestResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgNewSignTransactionRequestResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgNewSignTransactionRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			m.Id = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignatureRequestId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Btl", wireType)
 			}
-			m.SignatureRequestId = 0
+			m.Btl = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3377,7 +4807,7 @@ func (m *MsgNewSignTransactionRequestResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SignatureRequestId |= uint64(b&0x7F) << shift
+				m.Btl |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3403,7 +4833,7 @@ func (m *MsgNewSignTransactionRequestResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MetadataEthereum) Unmarshal(dAtA []byte) error {
+func (m *MsgImportWalletPoliciesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3426,31 +4856,12 @@ func (m *MetadataEthereum) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MetadataEthereum: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgImportWalletPoliciesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MetadataEthereum: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgImportWalletPoliciesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
-			}
-			m.ChainId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ChainId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5,12 +5,22 @@
 package types
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
 	"math/big"
+	"reflect"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
 )
 
 type Wallet interface {
 	Address() string
+
+	// ValidateAddress returns an error if addr is not a valid destination
+	// address on the chain of the wallet.
+	ValidateAddress(addr string) error
 }
 
 var ErrUnknownWalletType = fmt.Errorf("error in NewWallet: unknown wallet type")
@@ -24,11 +34,71 @@ func NewWallet(k *Key, w WalletType) (Wallet, error) {
 	case WalletType_WALLET_TYPE_CELESTIA:
 		return NewCelestiaWallet(k)
 	case WalletType_WALLET_TYPE_SUI:
-		return NewCelestiaWallet(k)
+		return NewSuiWallet(k)
 	}
 	return nil, ErrUnknownWalletType
 }
 
+// WalletAddresses returns the address of the key for every wallet type,
+// ordered by type. Wallet types that can't be derived from the key (e.g. an
+// Ethereum wallet from an Ed25519 key) are omitted.
+func WalletAddresses(k *Key) []*WalletKeyResponse {
+	walletTypes := make([]WalletType, 0, len(WalletType_name))
+	for t := range WalletType_name {
+		if WalletType(t) != WalletType_WALLET_TYPE_UNSPECIFIED {
+			walletTypes = append(walletTypes, WalletType(t))
+		}
+	}
+	sort.Slice(walletTypes, func(i, j int) bool { return walletTypes[i] < walletTypes[j] })
+
+	var wallets []*WalletKeyResponse
+	for _, t := range walletTypes {
+		w, err := NewWallet(k, t)
+		if err != nil {
+			continue
+		}
+		wallets = append(wallets, &WalletKeyResponse{
+			Address: w.Address(),
+			Type:    t,
+		})
+	}
+	return wallets
+}
+
+// WalletTransferAddresses returns the addresses of the wallets of k, encoded
+// as the destination of a Transfer: the 20 bytes of Ethereum addresses, the
+// string of the other ones.
+func WalletTransferAddresses(k *Key) [][]byte {
+	wallets := WalletAddresses(k)
+	addrs := make([][]byte, 0, len(wallets))
+	for _, w := range wallets {
+		transferAddr := []byte(w.Address)
+		if w.Type == WalletType_WALLET_TYPE_ETH {
+			transferAddr = common.HexToAddress(w.Address).Bytes()
+		}
+		addrs = append(addrs, transferAddr)
+	}
+	return addrs
+}
+
+// PreviousWalletAddresses returns the addresses derived from the public keys
+// used by k before it was rotated, oldest first. Each public key contributes
+// an entry per wallet type, as in WalletAddresses.
+func PreviousWalletAddresses(k *Key) []*WalletKeyResponse {
+	var wallets []*WalletKeyResponse
+	for _, publicKey := range k.PreviousPublicKeys {
+		previous := &Key{
+			Id:            k.Id,
+			WorkspaceAddr: k.WorkspaceAddr,
+			KeyringAddr:   k.KeyringAddr,
+			Type:          k.Type,
+			PublicKey:     publicKey,
+		}
+		wallets = append(wallets, WalletAddresses(previous)...)
+	}
+	return wallets
+}
+
 // Transfer represents a generic transfer of tokens on a layer 1 blockchain.
 // Ideally, this will be the object passed to Blackbird for applying policy.
 type Transfer struct {
@@ -41,10 +111,165 @@ type Transfer struct {
 	// CoinIdentifier uniquely identifies the coin being transferred.
 	CoinIdentifier []byte
 
+	// Token is the display information of the token being transferred, if
+	// it was resolved when parsing the transaction (see
+	// TokenMetadataResolver). It's not part of CoinIdentifier, and isn't
+	// checked by the policies.
+	Token *CoinInfo
+
 	// DataForSigning is the data that will be signed by the key.
 	DataForSigning []byte
+
+	// Kind classifies the action performed by the transaction.
+	Kind TxKind
+
+	// Details contains chain specific information about the action, e.g.
+	// the decoded arguments of a contract call. It can be nil.
+	Details any
+
+	// Timeout is the deadline of cross-chain transfers (e.g. IBC), nil for
+	// other transfers.
+	Timeout *TransferTimeout
+
+	// MaxFee is the maximum fee paid by the transaction, in the smallest
+	// unit of the native currency of the chain (e.g. gas * maxFeePerGas on
+	// Ethereum). It's nil if the chain doesn't report it.
+	MaxFee *big.Int
+
+	// AmountIsNominal is true if the recipient can receive less than Amount,
+	// e.g. for tokens taking a fee on transfers. Amount is then an upper
+	// bound of the value received.
+	AmountIsNominal bool
+
+	// Metadata contains human readable information about the transfer for
+	// its approvers, e.g. the label of a known recipient (see
+	// WithAddressLabels). It's nil if there is none.
+	Metadata map[string]string
+
+	// Delegations are the delegations of the code of accounts authorized
+	// by the transaction (EIP-7702 on Ethereum), nil for other
+	// transactions. Delegating the code of an account hands over its
+	// control, so they must be reviewed by the policies.
+	Delegations []CodeDelegation
 }
 
+// CodeDelegation is the authorization, signed by the owner of an account,
+// to run the code of another account in its place.
+type CodeDelegation struct {
+	// Authority is the account delegating its code, recovered from the
+	// signature of the authorization. It's nil if the signature is
+	// invalid, in which case the authorization has no effect.
+	Authority []byte
+
+	// Delegate is the account whose code is delegated to.
+	Delegate []byte
+
+	// ChainID is the chain on which the authorization is valid, zero for
+	// any chain.
+	ChainID *big.Int
+
+	// Nonce is the nonce of the authority when the authorization is valid.
+	Nonce uint64
+}
+
+// displayPrecision is the number of decimal places typically used to display
+// amounts to users.
+const displayPrecision = 2
+
+// IsDustInHumanUnits returns true if the amount is not zero, but it would be
+// displayed as zero (i.e. 0.00) once converted to human units using the
+// given number of decimals of the coin.
+func (t Transfer) IsDustInHumanUnits(decimals uint8) bool {
+	if t.Amount == nil || t.Amount.Sign() <= 0 {
+		return false
+	}
+	if decimals <= displayPrecision {
+		return false
+	}
+
+	// amounts below half of the smallest displayed unit are rounded to zero
+	smallestDisplayed := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals-displayPrecision)), nil)
+	doubled := new(big.Int).Lsh(t.Amount, 1)
+	return doubled.Cmp(smallestDisplayed) < 0
+}
+
+// Equal returns true if t and other describe the same transfer. Amounts are
+// compared by value, so numerically equal amounts held by different big.Int
+// are equal. Details are compared with reflect.DeepEqual.
+func (t Transfer) Equal(other Transfer) bool {
+	return bytes.Equal(t.To, other.To) &&
+		equalBigInts(t.Amount, other.Amount) &&
+		bytes.Equal(t.CoinIdentifier, other.CoinIdentifier) &&
+		bytes.Equal(t.DataForSigning, other.DataForSigning) &&
+		t.Kind == other.Kind &&
+		reflect.DeepEqual(t.Details, other.Details) &&
+		equalTimeouts(t.Timeout, other.Timeout) &&
+		equalBigInts(t.MaxFee, other.MaxFee) &&
+		t.AmountIsNominal == other.AmountIsNominal &&
+		reflect.DeepEqual(t.Delegations, other.Delegations)
+}
+
+// ErrInvalidTransfer is returned by Transfer.Validate for transfers that
+// can't be evaluated by policies, wrapped with the reason.
+var ErrInvalidTransfer = errors.New("invalid transfer")
+
+// maxAmountBits is the size of the largest amount of a transfer, i.e. of
+// uint256 amounts on EVM chains and of sdk.Int amounts on Cosmos chains.
+const maxAmountBits = 256
+
+// Validate returns an error wrapping ErrInvalidTransfer if t is not a well
+// formed transfer: the amount must be set, not negative and fit in 256
+// bits, the coin identifier must be set, and so must the recipient except
+// for contract creations, which have none.
+//
+// Wallets call it at the end of ParseTx, so that malformed transfers never
+// reach the policies.
+func (t Transfer) Validate() error {
+	if t.Amount == nil {
+		return fmt.Errorf("%w: missing amount", ErrInvalidTransfer)
+	}
+	if t.Amount.Sign() < 0 {
+		return fmt.Errorf("%w: negative amount %v", ErrInvalidTransfer, t.Amount)
+	}
+	if t.Amount.BitLen() > maxAmountBits {
+		return fmt.Errorf("%w: amount %v exceeds %d bits", ErrInvalidTransfer, t.Amount, maxAmountBits)
+	}
+	if len(t.CoinIdentifier) == 0 {
+		return fmt.Errorf("%w: missing coin identifier", ErrInvalidTransfer)
+	}
+	if len(t.To) == 0 && t.Kind != TxKindDeploy && t.Kind != TxKindCloneDeploy {
+		return fmt.Errorf("%w: missing recipient", ErrInvalidTransfer)
+	}
+	return nil
+}
+
+// equalBigInts returns true if a and b are both nil or have the same value.
+func equalBigInts(a, b *big.Int) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return a.Cmp(b) == 0
+}
+
+func equalTimeouts(a, b *TransferTimeout) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
+
+// TxKind classifies the action performed by a parsed transaction.
+type TxKind string
+
+const (
+	// TxKindTransfer is a transfer of the native currency or of a token.
+	TxKindTransfer TxKind = "transfer"
+
+	// TxKindContractCall is a contract call that couldn't be classified
+	// further.
+	TxKindContractCall TxKind = "contract_call"
+)
+
 // TxParser can be implemented by wallets that are able to parse unsigned
 // transactions into the common Layer1Tx format.
 //
@@ -54,4 +279,11 @@ type TxParser interface {
 	ParseTx(b []byte, m Metadata) (Transfer, error)
 }
 
+// TxBuilder can be implemented by wallets that are able to apply a signature
+// to an unsigned transaction previously parsed by TxParser, producing the
+// signed transaction ready to be broadcast.
+type TxBuilder interface {
+	BuildSignedTx(original []byte, signature []byte, m Metadata) ([]byte, error)
+}
+
 type Metadata any
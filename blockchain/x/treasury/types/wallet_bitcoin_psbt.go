@@ -0,0 +1,324 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package types
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+)
+
+// BitcoinScriptType is the type of the output script spent by an input of a
+// Bitcoin transaction, which determines how its sighash is computed.
+type BitcoinScriptType string
+
+const (
+	// BitcoinScriptP2WPKH is a native segwit v0 output, signed with the
+	// BIP-143 sighash.
+	BitcoinScriptP2WPKH BitcoinScriptType = "p2wpkh"
+
+	// BitcoinScriptP2SHP2WPKH is a P2WPKH output nested in P2SH, signed
+	// with the BIP-143 sighash.
+	BitcoinScriptP2SHP2WPKH BitcoinScriptType = "p2sh-p2wpkh"
+
+	// BitcoinScriptP2TR is a taproot output spent with the key path, signed
+	// with the BIP-341 sighash.
+	BitcoinScriptP2TR BitcoinScriptType = "p2tr"
+)
+
+// BitcoinInputSighash is the data to be signed for an input of a PSBT.
+type BitcoinInputSighash struct {
+	// Index is the index of the input in the transaction.
+	Index int
+
+	ScriptType BitcoinScriptType
+
+	// SigHashType is the sighash flag the signature must commit to, e.g.
+	// SIGHASH_ALL, or SIGHASH_DEFAULT for taproot inputs.
+	SigHashType txscript.SigHashType
+
+	// Sighash is the 32-byte digest to be signed: with ECDSA for segwit v0
+	// inputs, with a BIP-340 Schnorr signature for taproot inputs.
+	Sighash []byte
+}
+
+// BitcoinPSBT is a partially signed Bitcoin transaction (BIP-174) parsed by
+// ParseBitcoinPSBT.
+type BitcoinPSBT struct {
+	// Tx is the unsigned transaction.
+	Tx *wire.MsgTx
+
+	// PrevOuts are the outputs spent by the inputs of Tx, in order.
+	PrevOuts []*wire.TxOut
+
+	// Inputs contains the sighash of each input, in order. The inputs can
+	// be signed by different keys.
+	Inputs []BitcoinInputSighash
+}
+
+// psbtMagic is the prefix of serialized PSBTs.
+var psbtMagic = []byte{0x70, 0x73, 0x62, 0x74, 0xff}
+
+// PSBT key types used by ParseBitcoinPSBT, the others are ignored.
+const (
+	psbtGlobalUnsignedTx        = 0x00
+	psbtInNonWitnessUTXO        = 0x00
+	psbtInWitnessUTXO           = 0x01
+	psbtInSighashType           = 0x03
+	psbtInRedeemScript          = 0x04
+	maxPSBTFieldSize     uint32 = wire.MaxBlockPayload
+)
+
+// ParseBitcoinPSBT parses a PSBT and computes the sighash of each of its
+// inputs, according to the type of the output it spends: BIP-143 for P2WPKH
+// and P2SH-P2WPKH outputs, BIP-341 for P2TR outputs (key path). Other
+// output types are rejected.
+//
+// Every input must include the output it spends (witness or non-witness
+// UTXO), as taproot sighashes commit to the amounts and scripts of all the
+// inputs, and P2SH inputs must include their redeem script.
+func ParseBitcoinPSBT(b []byte) (*BitcoinPSBT, error) {
+	psbt := &BitcoinPSBT{}
+	err := parsePSBT(bytes.NewReader(b), func(tx *wire.MsgTx, prevOuts []*wire.TxOut) error {
+		psbt.Tx = tx
+		psbt.PrevOuts = prevOuts
+		psbt.Inputs = make([]BitcoinInputSighash, 0, len(tx.TxIn))
+		return nil
+	}, func(input BitcoinInputSighash) error {
+		psbt.Inputs = append(psbt.Inputs, input)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return psbt, nil
+}
+
+// parsePSBT reads a PSBT from r. Once the whole PSBT has been read and
+// validated, it calls onTx with the unsigned transaction and the outputs
+// spent by its inputs, then onInput with the sighash of each input, in
+// order. Errors returned by the callbacks are returned as is.
+//
+// Only the fields needed to compute the sighashes are kept from the input
+// maps, the rest of the PSBT (e.g. the previous transactions of
+// non-witness UTXOs) is discarded as it is read.
+func parsePSBT(r io.Reader, onTx func(tx *wire.MsgTx, prevOuts []*wire.TxOut) error, onInput func(BitcoinInputSighash) error) error {
+	magic := make([]byte, len(psbtMagic))
+	if _, err := io.ReadFull(r, magic); err != nil || !bytes.Equal(magic, psbtMagic) {
+		return fmt.Errorf("invalid PSBT: missing magic bytes")
+	}
+
+	global, err := readPSBTMap(r)
+	if err != nil {
+		return fmt.Errorf("invalid PSBT: global map: %w", err)
+	}
+	rawTx, ok := global[string([]byte{psbtGlobalUnsignedTx})]
+	if !ok {
+		return fmt.Errorf("invalid PSBT: missing unsigned transaction")
+	}
+	tx := wire.NewMsgTx(wire.TxVersion)
+	txReader := bytes.NewReader(rawTx)
+	if err := tx.DeserializeNoWitness(txReader); err != nil || txReader.Len() != 0 {
+		return fmt.Errorf("invalid PSBT: invalid unsigned transaction")
+	}
+	if len(tx.TxIn) == 0 {
+		return fmt.Errorf("invalid PSBT: transaction without inputs")
+	}
+	for i, txIn := range tx.TxIn {
+		if len(txIn.SignatureScript) != 0 || len(txIn.Witness) != 0 {
+			return fmt.Errorf("invalid PSBT: input %d of the unsigned transaction has a signature", i)
+		}
+	}
+
+	inputs := make([]map[string][]byte, len(tx.TxIn))
+	prevOutList := make([]*wire.TxOut, len(tx.TxIn))
+	prevOuts := make(map[wire.OutPoint]*wire.TxOut, len(tx.TxIn))
+	for i, txIn := range tx.TxIn {
+		fields, err := readPSBTMap(r)
+		if err != nil {
+			return fmt.Errorf("invalid PSBT: input %d: %w", i, err)
+		}
+		prevOut, err := psbtInputUTXO(fields, txIn.PreviousOutPoint)
+		if err != nil {
+			return fmt.Errorf("invalid PSBT: input %d: %w", i, err)
+		}
+		inputs[i] = sighashPSBTFields(fields)
+		prevOutList[i] = prevOut
+		prevOuts[txIn.PreviousOutPoint] = prevOut
+	}
+	for i := range tx.TxOut {
+		if _, err := readPSBTMap(r); err != nil {
+			return fmt.Errorf("invalid PSBT: output %d: %w", i, err)
+		}
+	}
+	if n, _ := r.Read(make([]byte, 1)); n != 0 {
+		return fmt.Errorf("invalid PSBT: trailing bytes")
+	}
+	if len(prevOuts) != len(tx.TxIn) {
+		return fmt.Errorf("invalid PSBT: duplicate inputs")
+	}
+
+	if err := onTx(tx, prevOutList); err != nil {
+		return err
+	}
+	fetcher := txscript.NewMultiPrevOutFetcher(prevOuts)
+	sigHashes := txscript.NewTxSigHashes(tx, fetcher)
+	for i := range tx.TxIn {
+		input, err := psbtInputSighash(tx, i, prevOutList[i], inputs[i], sigHashes, fetcher)
+		if err != nil {
+			return fmt.Errorf("input %d: %w", i, err)
+		}
+		inputs[i] = nil
+		if err := onInput(input); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// sighashPSBTFields returns the fields of an input map used by
+// psbtInputSighash, dropping the others.
+func sighashPSBTFields(fields map[string][]byte) map[string][]byte {
+	kept := make(map[string][]byte, 2)
+	for _, key := range []string{string([]byte{psbtInSighashType}), string([]byte{psbtInRedeemScript})} {
+		if v, ok := fields[key]; ok {
+			kept[key] = v
+		}
+	}
+	return kept
+}
+
+// psbtInputSighash computes the sighash of input i of tx, spending prevOut.
+func psbtInputSighash(tx *wire.MsgTx, i int, prevOut *wire.TxOut, fields map[string][]byte, sigHashes *txscript.TxSigHashes, fetcher txscript.PrevOutputFetcher) (BitcoinInputSighash, error) {
+	input := BitcoinInputSighash{Index: i}
+	pkScript := prevOut.PkScript
+	switch {
+	case txscript.IsPayToWitnessPubKeyHash(pkScript):
+		input.ScriptType = BitcoinScriptP2WPKH
+	case txscript.IsPayToScriptHash(pkScript):
+		redeemScript, ok := fields[string([]byte{psbtInRedeemScript})]
+		if !ok {
+			return input, fmt.Errorf("missing redeem script")
+		}
+		if !bytes.Equal(btcutil.Hash160(redeemScript), pkScript[2:22]) {
+			return input, fmt.Errorf("redeem script doesn't match the P2SH output")
+		}
+		if !txscript.IsPayToWitnessPubKeyHash(redeemScript) {
+			return input, fmt.Errorf("unsupported P2SH redeem script")
+		}
+		input.ScriptType = BitcoinScriptP2SHP2WPKH
+		pkScript = redeemScript
+	case txscript.IsPayToTaproot(pkScript):
+		input.ScriptType = BitcoinScriptP2TR
+	default:
+		return input, fmt.Errorf("unsupported output script %x", pkScript)
+	}
+
+	input.SigHashType = txscript.SigHashAll
+	if input.ScriptType == BitcoinScriptP2TR {
+		input.SigHashType = txscript.SigHashDefault
+	}
+	if v, ok := fields[string([]byte{psbtInSighashType})]; ok {
+		if len(v) != 4 {
+			return input, fmt.Errorf("invalid sighash type")
+		}
+		input.SigHashType = txscript.SigHashType(binary.LittleEndian.Uint32(v))
+	}
+	if err := checkSigHashType(input, len(tx.TxOut)); err != nil {
+		return input, err
+	}
+
+	var err error
+	if input.ScriptType == BitcoinScriptP2TR {
+		input.Sighash, err = txscript.CalcTaprootSignatureHash(sigHashes, input.SigHashType, tx, i, fetcher)
+	} else {
+		input.Sighash, err = txscript.CalcWitnessSigHash(pkScript, sigHashes, input.SigHashType, tx, i, prevOut.Value)
+	}
+	if err != nil {
+		return input, err
+	}
+	return input, nil
+}
+
+// checkSigHashType returns an error if the sighash type isn't valid for
+// the input, or is SIGHASH_SINGLE without a corresponding output.
+func checkSigHashType(input BitcoinInputSighash, outputs int) error {
+	switch input.SigHashType &^ txscript.SigHashAnyOneCanPay {
+	case txscript.SigHashAll, txscript.SigHashNone:
+	case txscript.SigHashSingle:
+		if input.Index >= outputs {
+			return fmt.Errorf("SIGHASH_SINGLE without a corresponding output")
+		}
+	case txscript.SigHashDefault:
+		if input.ScriptType != BitcoinScriptP2TR || input.SigHashType != txscript.SigHashDefault {
+			return fmt.Errorf("invalid sighash type 0x%x", uint32(input.SigHashType))
+		}
+	default:
+		return fmt.Errorf("invalid sighash type 0x%x", uint32(input.SigHashType))
+	}
+	return nil
+}
+
+// psbtInputUTXO returns the output spent by an input, from its non-witness
+// UTXO (the previous transaction) or its witness UTXO. If both are present
+// they must match.
+func psbtInputUTXO(fields map[string][]byte, outPoint wire.OutPoint) (*wire.TxOut, error) {
+	var prevOut *wire.TxOut
+	if v, ok := fields[string([]byte{psbtInNonWitnessUTXO})]; ok {
+		var prevTx wire.MsgTx
+		txReader := bytes.NewReader(v)
+		if err := prevTx.Deserialize(txReader); err != nil || txReader.Len() != 0 {
+			return nil, fmt.Errorf("invalid non-witness UTXO")
+		}
+		if prevTx.TxHash() != outPoint.Hash {
+			return nil, fmt.Errorf("non-witness UTXO doesn't match the outpoint")
+		}
+		if int(outPoint.Index) >= len(prevTx.TxOut) {
+			return nil, fmt.Errorf("outpoint index %d out of range", outPoint.Index)
+		}
+		prevOut = prevTx.TxOut[outPoint.Index]
+	}
+	if v, ok := fields[string([]byte{psbtInWitnessUTXO})]; ok {
+		var txOut wire.TxOut
+		txOutReader := bytes.NewReader(v)
+		if err := wire.ReadTxOut(txOutReader, 0, 0, &txOut); err != nil || txOutReader.Len() != 0 {
+			return nil, fmt.Errorf("invalid witness UTXO")
+		}
+		if prevOut != nil && (prevOut.Value != txOut.Value || !bytes.Equal(prevOut.PkScript, txOut.PkScript)) {
+			return nil, fmt.Errorf("witness UTXO doesn't match the non-witness UTXO")
+		}
+		prevOut = &txOut
+	}
+	if prevOut == nil {
+		return nil, fmt.Errorf("missing UTXO")
+	}
+	return prevOut, nil
+}
+
+// readPSBTMap reads a map of a PSBT: key-value pairs terminated by an empty
+// key. Keys include their type byte.
+func readPSBTMap(r io.Reader) (map[string][]byte, error) {
+	m := make(map[string][]byte)
+	for {
+		key, err := wire.ReadVarBytes(r, 0, maxPSBTFieldSize, "key")
+		if err != nil {
+			return nil, err
+		}
+		if len(key) == 0 {
+			return m, nil
+		}
+		value, err := wire.ReadVarBytes(r, 0, maxPSBTFieldSize, "value")
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := m[string(key)]; ok {
+			return nil, fmt.Errorf("duplicate key %x", key)
+		}
+		m[string(key)] = value
+	}
+}
@@ -0,0 +1,70 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package types
+
+import (
+	"io"
+	"math/big"
+
+	"github.com/btcsuite/btcd/wire"
+)
+
+// bitcoinSymbol is the symbol of the native currency of Bitcoin.
+const bitcoinSymbol = "BTC"
+
+// BitcoinPSBTHandler receives the results of StreamBitcoinPSBT. Returning
+// an error from a callback stops the parsing and makes StreamBitcoinPSBT
+// return it.
+type BitcoinPSBTHandler struct {
+	// Transfer is called with the transfer made by each output of the
+	// transaction, in order.
+	Transfer func(output int, transfer Transfer) error
+
+	// Input is called with the sighash of each input of the transaction,
+	// in order.
+	Input func(input BitcoinInputSighash) error
+}
+
+// StreamBitcoinPSBT parses a PSBT read from r like ParseBitcoinPSBT, but
+// passes the transfers of its outputs and the sighashes of its inputs to h
+// one at a time instead of returning them all at once. The input maps are
+// discarded as they are read, except for the outputs they spend, so
+// large PSBTs (e.g. consolidations with hundreds of inputs) don't need to
+// be held in memory. The unsigned transaction itself is kept, as the
+// sighashes commit to all of its inputs and outputs.
+//
+// The callbacks are only called after the whole PSBT has been read and
+// validated, but computing the sighash of an input can still fail: if an
+// error is returned, the values already passed to h must be discarded.
+func StreamBitcoinPSBT(r io.Reader, h BitcoinPSBTHandler) error {
+	return parsePSBT(r, func(tx *wire.MsgTx, _ []*wire.TxOut) error {
+		for i, txOut := range tx.TxOut {
+			if err := h.Transfer(i, bitcoinOutputTransfer(txOut)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}, h.Input)
+}
+
+// Transfers returns the transfers made by the outputs of the transaction,
+// in order.
+func (p *BitcoinPSBT) Transfers() []Transfer {
+	transfers := make([]Transfer, len(p.Tx.TxOut))
+	for i, txOut := range p.Tx.TxOut {
+		transfers[i] = bitcoinOutputTransfer(txOut)
+	}
+	return transfers
+}
+
+// bitcoinOutputTransfer returns the transfer made by an output. The
+// recipient is identified by the output script, which doesn't depend on
+// the network unlike the encoded address.
+func bitcoinOutputTransfer(txOut *wire.TxOut) Transfer {
+	return Transfer{
+		To:             txOut.PkScript,
+		Amount:         big.NewInt(txOut.Value),
+		CoinIdentifier: NativeCoin(bitcoinSymbol).Bytes(),
+		Kind:           TxKindTransfer,
+	}
+}
@@ -0,0 +1,129 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package types
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/stretchr/testify/require"
+)
+
+// consolidationPSBT returns a PSBT with the given number of inputs, spending
+// P2WPKH and P2TR outputs alternately, and of P2WPKH outputs.
+func consolidationPSBT(t testing.TB, inputs, outputs int) []byte {
+	t.Helper()
+	key, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	p2wpkh, err := txscript.NewScriptBuilder().AddOp(txscript.OP_0).
+		AddData(btcutil.Hash160(key.PubKey().SerializeCompressed())).Script()
+	require.NoError(t, err)
+	p2tr, err := txscript.PayToTaprootScript(txscript.ComputeTaprootKeyNoScript(key.PubKey()))
+	require.NoError(t, err)
+
+	tx := wire.NewMsgTx(2)
+	psbtInputs := make([]psbtInput, inputs)
+	for i := range psbtInputs {
+		tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{byte(i), byte(i >> 8)}, uint32(i)), nil, nil))
+		pkScript := p2wpkh
+		if i%2 == 1 {
+			pkScript = p2tr
+		}
+		psbtInputs[i] = psbtInput{prevOut: wire.NewTxOut(int64(10_000+i), pkScript)}
+	}
+	for i := 0; i < outputs; i++ {
+		pkScript := make([]byte, 22)
+		pkScript[0], pkScript[1], pkScript[2], pkScript[3] = txscript.OP_0, 20, byte(i), byte(i>>8)
+		tx.AddTxOut(wire.NewTxOut(int64(1_000+i), pkScript))
+	}
+	return buildPSBT(t, tx, psbtInputs)
+}
+
+func Test_StreamBitcoinPSBT(t *testing.T) {
+	b := consolidationPSBT(t, 50, 500)
+	psbt, err := ParseBitcoinPSBT(b)
+	require.NoError(t, err)
+
+	var transfers []Transfer
+	var inputs []BitcoinInputSighash
+	err = StreamBitcoinPSBT(bytes.NewReader(b), BitcoinPSBTHandler{
+		Transfer: func(output int, transfer Transfer) error {
+			require.Equal(t, len(transfers), output)
+			transfers = append(transfers, transfer)
+			return nil
+		},
+		Input: func(input BitcoinInputSighash) error {
+			inputs = append(inputs, input)
+			return nil
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, transfers, 500)
+	require.Equal(t, psbt.Transfers(), transfers)
+	require.Equal(t, psbt.Inputs, inputs)
+	for _, transfer := range transfers {
+		require.NoError(t, transfer.Validate())
+	}
+	require.Equal(t, []byte("BTC"), transfers[0].CoinIdentifier)
+	require.Equal(t, int64(1_499), transfers[499].Amount.Int64())
+}
+
+func Test_StreamBitcoinPSBT_Errors(t *testing.T) {
+	b := consolidationPSBT(t, 4, 4)
+
+	// errors of the callbacks stop the parsing
+	errStop := errors.New("stop")
+	var calls int
+	err := StreamBitcoinPSBT(bytes.NewReader(b), BitcoinPSBTHandler{
+		Transfer: func(int, Transfer) error { calls++; return nil },
+		Input: func(BitcoinInputSighash) error {
+			calls++
+			return errStop
+		},
+	})
+	require.ErrorIs(t, err, errStop)
+	require.Equal(t, 5, calls)
+
+	// invalid PSBTs are rejected before calling the handler
+	noCalls := BitcoinPSBTHandler{
+		Transfer: func(int, Transfer) error { t.Fatal("unexpected transfer"); return nil },
+		Input:    func(BitcoinInputSighash) error { t.Fatal("unexpected input"); return nil },
+	}
+	require.Error(t, StreamBitcoinPSBT(bytes.NewReader(b[:len(b)-1]), noCalls))
+	require.Error(t, StreamBitcoinPSBT(bytes.NewReader(append(b, 0)), noCalls))
+	require.Error(t, StreamBitcoinPSBT(bytes.NewReader(b[1:]), noCalls))
+}
+
+func Benchmark_ParseBitcoinPSBT(b *testing.B) {
+	psbt := consolidationPSBT(b, 200, 200)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		p, err := ParseBitcoinPSBT(psbt)
+		if err != nil {
+			b.Fatal(err)
+		}
+		_ = p.Transfers()
+	}
+}
+
+func Benchmark_StreamBitcoinPSBT(b *testing.B) {
+	psbt := consolidationPSBT(b, 200, 200)
+	h := BitcoinPSBTHandler{
+		Transfer: func(int, Transfer) error { return nil },
+		Input:    func(BitcoinInputSighash) error { return nil },
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := StreamBitcoinPSBT(bytes.NewReader(psbt), h); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
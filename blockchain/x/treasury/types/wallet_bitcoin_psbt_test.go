@@ -0,0 +1,182 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package types
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/stretchr/testify/require"
+)
+
+// psbtInput is an input of the PSBTs built by buildPSBT.
+type psbtInput struct {
+	prevOut      *wire.TxOut
+	redeemScript []byte
+	sigHashType  *uint32
+	// omitUTXO leaves out the witness UTXO
+	omitUTXO bool
+}
+
+func buildPSBT(t testing.TB, tx *wire.MsgTx, inputs []psbtInput) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	buf.Write(psbtMagic)
+	writePair := func(key, value []byte) {
+		require.NoError(t, wire.WriteVarBytes(&buf, 0, key))
+		require.NoError(t, wire.WriteVarBytes(&buf, 0, value))
+	}
+
+	var rawTx bytes.Buffer
+	require.NoError(t, tx.SerializeNoWitness(&rawTx))
+	writePair([]byte{psbtGlobalUnsignedTx}, rawTx.Bytes())
+	buf.WriteByte(0)
+
+	for _, input := range inputs {
+		if !input.omitUTXO {
+			var txOut bytes.Buffer
+			require.NoError(t, wire.WriteTxOut(&txOut, 0, 0, input.prevOut))
+			writePair([]byte{psbtInWitnessUTXO}, txOut.Bytes())
+		}
+		if input.sigHashType != nil {
+			writePair([]byte{psbtInSighashType}, binary.LittleEndian.AppendUint32(nil, *input.sigHashType))
+		}
+		if input.redeemScript != nil {
+			writePair([]byte{psbtInRedeemScript}, input.redeemScript)
+		}
+		buf.WriteByte(0)
+	}
+	for range tx.TxOut {
+		buf.WriteByte(0)
+	}
+	return buf.Bytes()
+}
+
+func Test_ParseBitcoinPSBT_MixedInputs(t *testing.T) {
+	segwitKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	nestedKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	taprootKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	p2wpkh, err := txscript.NewScriptBuilder().AddOp(txscript.OP_0).
+		AddData(btcutil.Hash160(segwitKey.PubKey().SerializeCompressed())).Script()
+	require.NoError(t, err)
+	nestedRedeem, err := txscript.NewScriptBuilder().AddOp(txscript.OP_0).
+		AddData(btcutil.Hash160(nestedKey.PubKey().SerializeCompressed())).Script()
+	require.NoError(t, err)
+	p2sh, err := txscript.NewScriptBuilder().AddOp(txscript.OP_HASH160).
+		AddData(btcutil.Hash160(nestedRedeem)).AddOp(txscript.OP_EQUAL).Script()
+	require.NoError(t, err)
+	p2tr, err := txscript.PayToTaprootScript(txscript.ComputeTaprootKeyNoScript(taprootKey.PubKey()))
+	require.NoError(t, err)
+
+	prevOuts := []*wire.TxOut{
+		wire.NewTxOut(50_000, p2wpkh),
+		wire.NewTxOut(70_000, p2sh),
+		wire.NewTxOut(90_000, p2tr),
+	}
+	tx := wire.NewMsgTx(2)
+	for i := range prevOuts {
+		tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{byte(i + 1)}, uint32(i)), nil, nil))
+	}
+	tx.AddTxOut(wire.NewTxOut(200_000, p2wpkh))
+
+	psbt, err := ParseBitcoinPSBT(buildPSBT(t, tx, []psbtInput{
+		{prevOut: prevOuts[0]},
+		{prevOut: prevOuts[1], redeemScript: nestedRedeem},
+		{prevOut: prevOuts[2]},
+	}))
+	require.NoError(t, err)
+	require.Equal(t, tx.TxHash(), psbt.Tx.TxHash())
+	require.Len(t, psbt.Inputs, 3)
+	require.Equal(t, BitcoinScriptP2WPKH, psbt.Inputs[0].ScriptType)
+	require.Equal(t, BitcoinScriptP2SHP2WPKH, psbt.Inputs[1].ScriptType)
+	require.Equal(t, BitcoinScriptP2TR, psbt.Inputs[2].ScriptType)
+	require.Equal(t, txscript.SigHashAll, psbt.Inputs[0].SigHashType)
+	require.Equal(t, txscript.SigHashDefault, psbt.Inputs[2].SigHashType)
+
+	// sign each input with its key and check the signed transaction with
+	// the script engine
+	signed := tx.Copy()
+	ecdsaWitness := func(key *btcec.PrivateKey, input BitcoinInputSighash) wire.TxWitness {
+		sig := ecdsa.Sign(key, input.Sighash).Serialize()
+		return wire.TxWitness{append(sig, byte(input.SigHashType)), key.PubKey().SerializeCompressed()}
+	}
+	signed.TxIn[0].Witness = ecdsaWitness(segwitKey, psbt.Inputs[0])
+	signed.TxIn[1].Witness = ecdsaWitness(nestedKey, psbt.Inputs[1])
+	signed.TxIn[1].SignatureScript, err = txscript.NewScriptBuilder().AddData(nestedRedeem).Script()
+	require.NoError(t, err)
+	tweaked := txscript.TweakTaprootPrivKey(*taprootKey, nil)
+	schnorrSig, err := schnorr.Sign(tweaked, psbt.Inputs[2].Sighash)
+	require.NoError(t, err)
+	signed.TxIn[2].Witness = wire.TxWitness{schnorrSig.Serialize()}
+
+	fetcher := txscript.NewMultiPrevOutFetcher(nil)
+	for i, txIn := range tx.TxIn {
+		fetcher.AddPrevOut(txIn.PreviousOutPoint, prevOuts[i])
+	}
+	sigHashes := txscript.NewTxSigHashes(signed, fetcher)
+	for i, prevOut := range prevOuts {
+		engine, err := txscript.NewEngine(prevOut.PkScript, signed, i, txscript.StandardVerifyFlags, nil, sigHashes, prevOut.Value, fetcher)
+		require.NoError(t, err)
+		require.NoError(t, engine.Execute(), "input %d", i)
+	}
+}
+
+func Test_ParseBitcoinPSBT_Invalid(t *testing.T) {
+	key, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	p2wpkh, err := txscript.NewScriptBuilder().AddOp(txscript.OP_0).
+		AddData(btcutil.Hash160(key.PubKey().SerializeCompressed())).Script()
+	require.NoError(t, err)
+	p2pkh, err := txscript.NewScriptBuilder().AddOp(txscript.OP_DUP).AddOp(txscript.OP_HASH160).
+		AddData(btcutil.Hash160(key.PubKey().SerializeCompressed())).
+		AddOp(txscript.OP_EQUALVERIFY).AddOp(txscript.OP_CHECKSIG).Script()
+	require.NoError(t, err)
+	p2sh, err := txscript.NewScriptBuilder().AddOp(txscript.OP_HASH160).
+		AddData(btcutil.Hash160(p2wpkh)).AddOp(txscript.OP_EQUAL).Script()
+	require.NoError(t, err)
+
+	tx := wire.NewMsgTx(2)
+	tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{1}, 0), nil, nil))
+	tx.AddTxOut(wire.NewTxOut(10_000, p2wpkh))
+	twoInputs := tx.Copy()
+	twoInputs.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{2}, 0), nil, nil))
+	sigHashSingleAnyoneCanPay := uint32(txscript.SigHashSingle | txscript.SigHashAnyOneCanPay)
+	sigHashDefault := uint32(txscript.SigHashDefault)
+
+	tests := []struct {
+		name string
+		b    []byte
+	}{
+		{name: "missing magic", b: buildPSBT(t, tx, []psbtInput{{prevOut: wire.NewTxOut(20_000, p2wpkh)}})[1:]},
+		{name: "truncated", b: buildPSBT(t, tx, []psbtInput{{prevOut: wire.NewTxOut(20_000, p2wpkh)}})[:20]},
+		{name: "trailing bytes", b: append(buildPSBT(t, tx, []psbtInput{{prevOut: wire.NewTxOut(20_000, p2wpkh)}}), 0)},
+		{name: "missing UTXO", b: buildPSBT(t, tx, []psbtInput{{prevOut: wire.NewTxOut(20_000, p2wpkh), omitUTXO: true}})},
+		{name: "unsupported script", b: buildPSBT(t, tx, []psbtInput{{prevOut: wire.NewTxOut(20_000, p2pkh)}})},
+		{name: "P2SH without redeem script", b: buildPSBT(t, tx, []psbtInput{{prevOut: wire.NewTxOut(20_000, p2sh)}})},
+		{name: "P2SH with another redeem script", b: buildPSBT(t, tx, []psbtInput{{prevOut: wire.NewTxOut(20_000, p2sh), redeemScript: p2pkh}})},
+		{name: "SIGHASH_DEFAULT for segwit v0", b: buildPSBT(t, tx, []psbtInput{{prevOut: wire.NewTxOut(20_000, p2wpkh), sigHashType: &sigHashDefault}})},
+		{name: "SIGHASH_SINGLE without output", b: buildPSBT(t, twoInputs, []psbtInput{
+			{prevOut: wire.NewTxOut(20_000, p2wpkh)},
+			{prevOut: wire.NewTxOut(20_000, p2wpkh), sigHashType: &sigHashSingleAnyoneCanPay},
+		})},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseBitcoinPSBT(tt.b)
+			require.Error(t, err)
+		})
+	}
+}
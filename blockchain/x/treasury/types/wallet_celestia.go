@@ -5,17 +5,28 @@ package types
 
 import (
 	"crypto/ecdsa"
+	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/crypto"
 )
 
+// celestiaPrefix is the bech32 human readable part of Celestia addresses.
+const celestiaPrefix = "celestia"
+
+// Ticker and denom of the native currency of Celestia.
+const (
+	celestiaSymbol = "TIA"
+	celestiaDenom  = "utia"
+)
+
 type CelestiaWallet struct {
 	key *ecdsa.PublicKey
 }
 
 var _ Wallet = &CelestiaWallet{}
+var _ TxParser = &CelestiaWallet{}
 
 func NewCelestiaWallet(k *Key) (*CelestiaWallet, error) {
 	pubkey, err := k.ToECDSASecp256k1()
@@ -29,6 +40,31 @@ func NewCelestiaWallet(k *Key) (*CelestiaWallet, error) {
 func (w *CelestiaWallet) Address() string {
 	var pubkey secp256k1.PubKey
 	pubkey.Key = crypto.CompressPubkey(w.key)
-	bech32Address := sdk.MustBech32ifyAddressBytes("celestia", pubkey.Address())
+	bech32Address := sdk.MustBech32ifyAddressBytes(celestiaPrefix, pubkey.Address())
 	return bech32Address
 }
+
+// ParseTx implements TxParser. The transaction is a SignDoc containing a
+// single bank MsgSend or IBC MsgTransfer, the metadata is not used.
+func (*CelestiaWallet) ParseTx(b []byte, _ Metadata) (Transfer, error) {
+	transfer, err := parseCosmosSignDoc(b, celestiaSymbol, celestiaDenom)
+	if err != nil {
+		return Transfer{}, err
+	}
+	if err := transfer.Validate(); err != nil {
+		return Transfer{}, err
+	}
+	return transfer, nil
+}
+
+// ValidateAddress implements Wallet.
+func (*CelestiaWallet) ValidateAddress(addr string) error {
+	bz, err := sdk.GetFromBech32(addr, celestiaPrefix)
+	if err != nil {
+		return fmt.Errorf("invalid Celestia address %s: %w", addr, err)
+	}
+	if err := sdk.VerifyAddressFormat(bz); err != nil {
+		return fmt.Errorf("invalid Celestia address %s: %w", addr, err)
+	}
+	return nil
+}
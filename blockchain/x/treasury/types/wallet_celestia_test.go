@@ -5,8 +5,15 @@ package types
 import (
 	"crypto/sha256"
 	"log"
+	"math/big"
 	"testing"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/require"
 )
@@ -16,6 +23,129 @@ func Test_CelestiaWallet_Address(t *testing.T) {
 	require.Equal(t, "celestia1egz60et40xxzm5rhtlj7caskpvqmqujrr77dtp", wallet.Address())
 }
 
+func Test_CelestiaWallet_ValidateAddress(t *testing.T) {
+	wallet := celestiaWallet(t)
+	require.NoError(t, wallet.ValidateAddress(wallet.Address()))
+	require.Error(t, wallet.ValidateAddress("qredo1egz60et40xxzm5rhtlj7caskpvqmqujrdrfqt7"))
+	require.Error(t, wallet.ValidateAddress("celestia1invalid"))
+}
+
+// celestiaSignDoc returns a serialized SignDoc of a transaction containing
+// the given messages.
+func celestiaSignDoc(t *testing.T, msgs ...sdk.Msg) []byte {
+	t.Helper()
+	anys := make([]*codectypes.Any, len(msgs))
+	for i, msg := range msgs {
+		any, err := codectypes.NewAnyWithValue(msg)
+		require.NoError(t, err)
+		anys[i] = any
+	}
+	body, err := (&txtypes.TxBody{Messages: anys}).Marshal()
+	require.NoError(t, err)
+	signDoc, err := (&txtypes.SignDoc{BodyBytes: body, ChainId: "celestia", AccountNumber: 42}).Marshal()
+	require.NoError(t, err)
+	return signDoc
+}
+
+func Test_CelestiaWallet_ParseTx(t *testing.T) {
+	wallet := celestiaWallet(t)
+	recipient := "celestia1y2jmxwa8hqzs8nl0awxz2cmeap2jc9n0n2jd9f"
+
+	t.Run("ibc transfer", func(t *testing.T) {
+		msg := &ibctransfertypes.MsgTransfer{
+			SourcePort:       "transfer",
+			SourceChannel:    "channel-2",
+			Token:            sdk.NewCoin("utia", sdk.NewInt(1_500_000)),
+			Sender:           wallet.Address(),
+			Receiver:         "osmo1y2jmxwa8hqzs8nl0awxz2cmeap2jc9n0a8h5ev",
+			TimeoutHeight:    clienttypes.NewHeight(1, 12_345_678),
+			TimeoutTimestamp: 1_700_000_000_000_000_000,
+			Memo:             `{"wasm":{"contract":"osmo1contract","msg":{}}}`,
+		}
+		signDoc := celestiaSignDoc(t, msg)
+
+		transfer, err := wallet.ParseTx(signDoc, nil)
+		require.NoError(t, err)
+
+		hash := sha256.Sum256(signDoc)
+		require.Equal(t, Transfer{
+			To:             []byte(msg.Receiver),
+			Amount:         big.NewInt(1_500_000),
+			CoinIdentifier: IBCCoin("TIA", "channel-2", "utia").Bytes(),
+			DataForSigning: hash[:],
+			Kind:           TxKindIBCTransfer,
+			Details: &IBCTransferDetails{
+				SourcePort:    "transfer",
+				SourceChannel: "channel-2",
+				Sender:        wallet.Address(),
+				Receiver:      msg.Receiver,
+				Denom:         "utia",
+				Memo:          msg.Memo,
+			},
+			Timeout: &TransferTimeout{
+				RevisionNumber: 1,
+				RevisionHeight: 12_345_678,
+				Timestamp:      1_700_000_000_000_000_000,
+			},
+		}, transfer)
+
+		coin, err := ParseCoinIdentifier(transfer.CoinIdentifier)
+		require.NoError(t, err)
+		channel, denom, ok := coin.IBCChannel()
+		require.True(t, ok)
+		require.Equal(t, "channel-2", channel)
+		require.Equal(t, "utia", denom)
+	})
+
+	t.Run("ibc transfer to a non bech32 receiver", func(t *testing.T) {
+		receiver := "0x48c04ed5691981C42154C6167398f95e8f38a7fF"
+		transfer, err := wallet.ParseTx(celestiaSignDoc(t, &ibctransfertypes.MsgTransfer{
+			SourcePort:    "transfer",
+			SourceChannel: "channel-9",
+			Token:         sdk.NewCoin("utia", sdk.NewInt(1)),
+			Sender:        wallet.Address(),
+			Receiver:      receiver,
+		}), nil)
+		require.NoError(t, err)
+		require.Equal(t, []byte(receiver), transfer.To)
+		require.Equal(t, &TransferTimeout{}, transfer.Timeout)
+	})
+
+	t.Run("native send", func(t *testing.T) {
+		transfer, err := wallet.ParseTx(celestiaSignDoc(t, &banktypes.MsgSend{
+			FromAddress: wallet.Address(),
+			ToAddress:   recipient,
+			Amount:      sdk.NewCoins(sdk.NewCoin("utia", sdk.NewInt(1_000))),
+		}), nil)
+		require.NoError(t, err)
+		require.Equal(t, TxKindTransfer, transfer.Kind)
+		require.Equal(t, []byte(recipient), transfer.To)
+		require.Equal(t, big.NewInt(1_000), transfer.Amount)
+		require.Equal(t, NativeCoin("TIA").Bytes(), transfer.CoinIdentifier)
+		require.Nil(t, transfer.Timeout)
+	})
+
+	t.Run("multiple messages", func(t *testing.T) {
+		send := &banktypes.MsgSend{
+			FromAddress: wallet.Address(),
+			ToAddress:   recipient,
+			Amount:      sdk.NewCoins(sdk.NewCoin("utia", sdk.NewInt(1_000))),
+		}
+		_, err := wallet.ParseTx(celestiaSignDoc(t, send, send), nil)
+		require.Error(t, err)
+	})
+
+	t.Run("unsupported message", func(t *testing.T) {
+		_, err := wallet.ParseTx(celestiaSignDoc(t, &banktypes.MsgMultiSend{}), nil)
+		require.Error(t, err)
+	})
+
+	t.Run("invalid sign doc", func(t *testing.T) {
+		_, err := wallet.ParseTx([]byte{0xff, 0xff}, nil)
+		require.Error(t, err)
+	})
+}
+
 func celestiaWallet(t *testing.T) *CelestiaWallet {
 	t.Helper()
 	hashedSeed := sha256.Sum256([]byte("example seed"))
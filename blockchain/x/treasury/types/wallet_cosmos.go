@@ -0,0 +1,128 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package types
+
+import (
+	"crypto/sha256"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+)
+
+// TxKindIBCTransfer is an ICS-20 transfer of tokens to another chain over
+// an IBC channel.
+const TxKindIBCTransfer TxKind = "ibc_transfer"
+
+// IBCTransferDetails contains the fields of an ICS-20 MsgTransfer that
+// aren't part of the Transfer.
+type IBCTransferDetails struct {
+	// SourcePort and SourceChannel identify the channel the tokens leave
+	// over, e.g. "transfer" and "channel-0".
+	SourcePort    string
+	SourceChannel string
+
+	Sender string
+
+	// Receiver is the recipient on the destination chain. It's not
+	// necessarily a bech32 address.
+	Receiver string
+
+	// Denom is the denomination of the tokens on the source chain.
+	Denom string
+
+	Memo string
+}
+
+// TransferTimeout is the deadline on the destination chain after which a
+// cross-chain transfer is refunded if it hasn't been received. Zero values
+// are not set.
+type TransferTimeout struct {
+	// RevisionNumber and RevisionHeight are the height of the destination
+	// chain.
+	RevisionNumber uint64 `json:"revision_number,omitempty"`
+	RevisionHeight uint64 `json:"revision_height,omitempty"`
+
+	// Timestamp is the unix time of the destination chain, in nanoseconds.
+	Timestamp uint64 `json:"timestamp,omitempty,string"`
+}
+
+// parseCosmosSignDoc parses a Cosmos SDK SignDoc containing a single bank
+// MsgSend or ICS-20 MsgTransfer. The data for signing is the SHA-256 hash
+// of the SignDoc, as signed by secp256k1 keys in SIGN_MODE_DIRECT.
+//
+// The native currency of the chain is identified by symbol and has the
+// given denom, other denoms are treated as tokens.
+func parseCosmosSignDoc(b []byte, symbol, nativeDenom string) (Transfer, error) {
+	var signDoc txtypes.SignDoc
+	if err := signDoc.Unmarshal(b); err != nil {
+		return Transfer{}, fmt.Errorf("invalid sign doc: %w", err)
+	}
+	var body txtypes.TxBody
+	if err := body.Unmarshal(signDoc.BodyBytes); err != nil {
+		return Transfer{}, fmt.Errorf("invalid transaction body: %w", err)
+	}
+	if len(body.Messages) != 1 {
+		return Transfer{}, fmt.Errorf("expected 1 message, got %d", len(body.Messages))
+	}
+	hash := sha256.Sum256(b)
+
+	msg := body.Messages[0]
+	switch msg.TypeUrl {
+	case sdk.MsgTypeURL(&banktypes.MsgSend{}):
+		var send banktypes.MsgSend
+		if err := send.Unmarshal(msg.Value); err != nil {
+			return Transfer{}, fmt.Errorf("invalid MsgSend: %w", err)
+		}
+		if len(send.Amount) != 1 {
+			return Transfer{}, fmt.Errorf("invalid MsgSend: expected 1 coin, got %d", len(send.Amount))
+		}
+		coin := send.Amount[0]
+		if coin.Amount.IsNil() {
+			return Transfer{}, fmt.Errorf("invalid MsgSend: missing amount")
+		}
+		coinIdentifier := NativeCoin(symbol)
+		if coin.Denom != nativeDenom {
+			coinIdentifier = TokenCoin(symbol, []byte(coin.Denom))
+		}
+		return Transfer{
+			To:             []byte(send.ToAddress),
+			Amount:         coin.Amount.BigInt(),
+			CoinIdentifier: coinIdentifier.Bytes(),
+			DataForSigning: hash[:],
+			Kind:           TxKindTransfer,
+		}, nil
+	case sdk.MsgTypeURL(&ibctransfertypes.MsgTransfer{}):
+		var transfer ibctransfertypes.MsgTransfer
+		if err := transfer.Unmarshal(msg.Value); err != nil {
+			return Transfer{}, fmt.Errorf("invalid MsgTransfer: %w", err)
+		}
+		if transfer.Token.Amount.IsNil() {
+			return Transfer{}, fmt.Errorf("invalid MsgTransfer: missing amount")
+		}
+		return Transfer{
+			To:             []byte(transfer.Receiver),
+			Amount:         transfer.Token.Amount.BigInt(),
+			CoinIdentifier: IBCCoin(symbol, transfer.SourceChannel, transfer.Token.Denom).Bytes(),
+			DataForSigning: hash[:],
+			Kind:           TxKindIBCTransfer,
+			Details: &IBCTransferDetails{
+				SourcePort:    transfer.SourcePort,
+				SourceChannel: transfer.SourceChannel,
+				Sender:        transfer.Sender,
+				Receiver:      transfer.Receiver,
+				Denom:         transfer.Token.Denom,
+				Memo:          transfer.Memo,
+			},
+			Timeout: &TransferTimeout{
+				RevisionNumber: transfer.TimeoutHeight.RevisionNumber,
+				RevisionHeight: transfer.TimeoutHeight.RevisionHeight,
+				Timestamp:      transfer.TimeoutTimestamp,
+			},
+		}, nil
+	default:
+		return Transfer{}, fmt.Errorf("unsupported message type %s", msg.TypeUrl)
+	}
+}
@@ -5,23 +5,70 @@ package types
 import (
 	"bytes"
 	"crypto/ecdsa"
+	"errors"
 	"fmt"
 	"math/big"
+	"reflect"
+	"strings"
 
-	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/rlp"
 )
 
+// ethereumSymbol is the ticker of the native currency of Ethereum, used in
+// coin identifiers.
+const ethereumSymbol = "ETH"
+
+const (
+	// TxKindDeploy is a contract creation transaction.
+	TxKindDeploy TxKind = "deploy"
+
+	// TxKindCloneDeploy is a contract creation transaction deploying an
+	// EIP-1167 minimal proxy, delegating all calls to an implementation.
+	TxKindCloneDeploy TxKind = "clone_deploy"
+)
+
+// CloneDeployDetails contains the implementation of an EIP-1167 minimal proxy
+// being deployed.
+type CloneDeployDetails struct {
+	Implementation common.Address
+}
+
 type EthereumWallet struct {
 	key *ecdsa.PublicKey
+
+	// chain the wallet is bound to, nil if the wallet can be used on any
+	// chain.
+	chain *EVMChain
+
+	// tokens resolves the metadata of ERC-20 tokens, nil if not configured.
+	tokens TokenMetadataResolver
+
+	// allowUnprotected enables legacy transactions without replay
+	// protection, see SetAllowUnprotectedTxs.
+	allowUnprotected bool
+
+	// limiter limits the rate of ParseTx calls, nil if not configured.
+	limiter *tokenBucket
+
+	// minAmounts are the minimum amounts of transfers by token, the zero
+	// address for the native currency, see WithMinTransferAmount.
+	minAmounts map[common.Address]*big.Int
+
+	// strictTokens rejects transfers of tokens unknown to tokens, see
+	// SetStrictTokens.
+	strictTokens bool
+
+	// proxies resolves token proxies to their token, nil if not
+	// configured.
+	proxies ProxyResolver
 }
 
 var _ Wallet = &EthereumWallet{}
 var _ TxParser = &EthereumWallet{}
+var _ TxBuilder = &EthereumWallet{}
 
 func NewEthereumWallet(k *Key) (*EthereumWallet, error) {
 	pubkey, err := k.ToECDSASecp256k1()
@@ -31,39 +78,191 @@ func NewEthereumWallet(k *Key) (*EthereumWallet, error) {
 	return &EthereumWallet{key: pubkey}, nil
 }
 
+// NewDerivedEthereumWallet returns the wallet of the child key of k at path,
+// see Key.Derive.
+func NewDerivedEthereumWallet(k *Key, chainCode []byte, path string) (*EthereumWallet, error) {
+	child, err := k.Derive(chainCode, path)
+	if err != nil {
+		return nil, err
+	}
+	return NewEthereumWallet(child)
+}
+
 func (w *EthereumWallet) Address() string {
 	addr := crypto.PubkeyToAddress(*w.key)
 	return addr.Hex()
 }
 
-func (*EthereumWallet) ParseTx(b []byte, m Metadata) (Transfer, error) {
+func (w *EthereumWallet) ParseTx(b []byte, m Metadata) (Transfer, error) {
+	if w.limiter != nil && !w.limiter.allow() {
+		return Transfer{}, ErrRateLimited
+	}
+
 	meta, ok := m.(*MetadataEthereum)
 	if !ok || meta == nil {
 		return Transfer{}, fmt.Errorf("invalid metadata field, expected *MetadataEthereum, got %T", m)
 	}
+	if err := w.checkChain(meta); err != nil {
+		return Transfer{}, err
+	}
 
-	tx, err := ParseEthereumTransaction(b, big.NewInt(int64(meta.ChainId)))
+	self := crypto.PubkeyToAddress(*w.key)
+	tx, err := parseEthereumTransaction(b, big.NewInt(int64(meta.ChainId)), w.allowUnprotected, &self)
 	if err != nil {
 		return Transfer{}, err
 	}
+	if err := w.checkMinAmount(tx); err != nil {
+		return Transfer{}, err
+	}
+	if err := w.checkTokenContract(tx); err != nil {
+		return Transfer{}, err
+	}
+	tx, proxy := w.resolveProxy(tx)
+	transfer := tx.transfer(w.symbol(), w.tokens)
+	if proxy != nil {
+		transfer = transfer.withProxyContract(*proxy)
+	}
+	if err := transfer.Validate(); err != nil {
+		return Transfer{}, err
+	}
+	return transfer, nil
+}
 
-	coinIdentifier := []byte("ETH/")
-	if tx.Contract != nil {
-		coinIdentifier = append(coinIdentifier, tx.Contract.Bytes()...)
+// SetAllowUnprotectedTxs enables ParseTx and BuildSignedTx to accept legacy
+// transactions without replay protection, i.e. encoded without the chain ID
+// as before EIP-155 (as some hardware wallets and legacy tooling still do).
+//
+// Their signature is valid on every chain, so anyone can replay the signed
+// transaction on a different chain where the wallet has the same address.
+// They're rejected by default.
+func (w *EthereumWallet) SetAllowUnprotectedTxs(allow bool) {
+	w.allowUnprotected = allow
+}
+
+// ValidateAddress implements Wallet. Mixed-case addresses must have a valid
+// EIP-55 checksum, all-lowercase and all-uppercase addresses are accepted
+// as they don't carry one.
+func (*EthereumWallet) ValidateAddress(addr string) error {
+	if !common.IsHexAddress(addr) {
+		return fmt.Errorf("invalid Ethereum address: %s", addr)
 	}
 
-	return Transfer{
-		To:             tx.To.Bytes(),
-		Amount:         tx.Amount,
-		CoinIdentifier: coinIdentifier,
-		DataForSigning: tx.DataForSigning,
-	}, nil
+	hexAddr := strings.TrimPrefix(strings.TrimPrefix(addr, "0x"), "0X")
+	if hexAddr == strings.ToLower(hexAddr) || hexAddr == strings.ToUpper(hexAddr) {
+		return nil
+	}
+	if checksummed := common.HexToAddress(addr).Hex(); hexAddr != checksummed[2:] {
+		return fmt.Errorf("invalid EIP-55 checksum for address %s, expected %s", addr, checksummed)
+	}
+	return nil
+}
+
+// BuildSignedTx applies the signature to the unsigned transaction, previously
+// parsed by ParseTx with the same metadata, and returns the signed
+// transaction ready to be broadcast.
+//
+// The signature is expected in the 65 bytes [R || S || V] format, where V is
+// the recovery ID (0 or 1, or 27 or 28). The chain ID is taken from the
+// metadata, as legacy transactions don't carry it.
+func (w *EthereumWallet) BuildSignedTx(original []byte, signature []byte, m Metadata) ([]byte, error) {
+	meta, ok := m.(*MetadataEthereum)
+	if !ok || meta == nil {
+		return nil, fmt.Errorf("invalid metadata field, expected *MetadataEthereum, got %T", m)
+	}
+	if err := w.checkChain(meta); err != nil {
+		return nil, err
+	}
+	if len(signature) != crypto.SignatureLength {
+		return nil, fmt.Errorf("invalid signature length: %d, expected %d", len(signature), crypto.SignatureLength)
+	}
+
+	sig := make([]byte, crypto.SignatureLength)
+	copy(sig, signature)
+	if sig[crypto.RecoveryIDOffset] >= 27 {
+		sig[crypto.RecoveryIDOffset] -= 27
+	}
+	chainID := big.NewInt(int64(meta.ChainId))
+
+	if len(original) > 0 && original[0] == setCodeTxType {
+		signed, sender, err := buildSignedSetCodeTx(original, sig, chainID)
+		if err != nil {
+			return nil, err
+		}
+		if sender != crypto.PubkeyToAddress(*w.key) {
+			return nil, fmt.Errorf("signature is not from the wallet key, recovered sender %s", sender.Hex())
+		}
+		return signed, nil
+	}
+
+	txData, err := DecodeUnsignedPayload(original)
+	if err != nil {
+		return nil, err
+	}
+	tx := types.NewTx(txData)
+
+	signer, err := unsignedPayloadSigner(original, chainID, w.allowUnprotected)
+	if err != nil {
+		return nil, err
+	}
+	if tx.Type() != types.LegacyTxType && tx.ChainId().Cmp(chainID) != 0 {
+		return nil, fmt.Errorf("%w: transaction chain ID %v doesn't match metadata chain ID %v", ErrChainIDMismatch, tx.ChainId(), chainID)
+	}
+
+	signedTx, err := tx.WithSignature(signer, sig)
+	if err != nil {
+		return nil, err
+	}
+
+	sender, err := types.Sender(signer, signedTx)
+	if err != nil {
+		return nil, fmt.Errorf("invalid signature: %w", err)
+	}
+	if sender != crypto.PubkeyToAddress(*w.key) {
+		return nil, fmt.Errorf("signature is not from the wallet key, recovered sender %s", sender.Hex())
+	}
+
+	return signedTx.MarshalBinary()
+}
+
+// VerifySignature checks that the signed transaction was signed by the
+// wallet key, returning an error otherwise.
+func (w *EthereumWallet) VerifySignature(signedTx []byte) error {
+	var tx types.Transaction
+	if err := tx.UnmarshalBinary(signedTx); err != nil {
+		return fmt.Errorf("invalid signed transaction: %w", err)
+	}
+
+	sender, err := types.Sender(signedTxSigner(&tx), &tx)
+	if err != nil {
+		return fmt.Errorf("invalid signature: %w", err)
+	}
+	if addr := w.Address(); sender.Hex() != addr {
+		return fmt.Errorf("transaction signed by %s, expected %s", sender.Hex(), addr)
+	}
+	return nil
+}
+
+// TxHash returns the hash of the signed transaction, i.e. its ID on chain,
+// before it's broadcast. It's the Keccak-256 hash of the RLP encoding of
+// legacy transactions, and of the type byte followed by the RLP encoding of
+// typed transactions (EIP-2718). It differs from the DataForSigning of the
+// transaction, which doesn't include the signature.
+func (*EthereumWallet) TxHash(signedTx []byte) (common.Hash, error) {
+	var tx types.Transaction
+	if err := tx.UnmarshalBinary(signedTx); err != nil {
+		return common.Hash{}, fmt.Errorf("invalid signed transaction: %w", err)
+	}
+	if _, r, s := tx.RawSignatureValues(); r.Sign() == 0 || s.Sign() == 0 {
+		return common.Hash{}, fmt.Errorf("transaction is not signed")
+	}
+	return tx.Hash(), nil
 }
 
 // EthereumTransfer represents an ETH transfer or an ERC-20 transfer on the
 // Ethereum blockchain.
 type EthereumTransfer struct {
-	// To is the destination of the transfer.
+	// To is the destination of the transfer. It's nil for contract
+	// creations.
 	To *common.Address
 
 	// Amount is the amount being transferred.
@@ -75,6 +274,98 @@ type EthereumTransfer struct {
 	Contract *common.Address
 
 	DataForSigning []byte
+
+	// Kind classifies the action performed by the transaction.
+	Kind TxKind
+
+	// Details contains the decoded arguments of the contract call, if the
+	// call was recognised. Its concrete type depends on Kind.
+	Details any
+
+	// MaxFee is the maximum fee paid for the transaction, i.e. its gas
+	// limit times its gas price (legacy transactions) or max fee per gas
+	// (EIP-1559 transactions).
+	MaxFee *big.Int
+
+	// Nonce is the nonce of the transaction in the account of the wallet.
+	Nonce uint64
+
+	// Authorizations is the authorization list of EIP-7702 set-code
+	// transactions, nil for other transactions.
+	Authorizations []SetCodeAuthorization
+}
+
+// Equal returns true if tx and other describe the same transaction.
+// Addresses and amounts are compared by value, Details with
+// reflect.DeepEqual.
+func (tx *EthereumTransfer) Equal(other *EthereumTransfer) bool {
+	if tx == nil || other == nil {
+		return tx == nil && other == nil
+	}
+	return equalAddresses(tx.To, other.To) &&
+		equalBigInts(tx.Amount, other.Amount) &&
+		equalAddresses(tx.Contract, other.Contract) &&
+		bytes.Equal(tx.DataForSigning, other.DataForSigning) &&
+		tx.Kind == other.Kind &&
+		reflect.DeepEqual(tx.Details, other.Details) &&
+		equalBigInts(tx.MaxFee, other.MaxFee) &&
+		tx.Nonce == other.Nonce &&
+		reflect.DeepEqual(tx.Authorizations, other.Authorizations)
+}
+
+// equalAddresses returns true if a and b are both nil or the same address.
+func equalAddresses(a, b *common.Address) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
+
+// Transfer converts the parsed Ethereum transaction into a chain agnostic
+// Transfer.
+func (tx *EthereumTransfer) Transfer() Transfer {
+	return tx.TransferWithTokens(nil)
+}
+
+// TransferWithTokens is like Transfer, but the Token of ERC-20 transfers is
+// set to their ticker and decimals if the resolver knows them, and transfers
+// of fee-on-transfer tokens are marked with AmountIsNominal if the resolver
+// implements FeeOnTransferResolver. The resolver can be nil.
+func (tx *EthereumTransfer) TransferWithTokens(r TokenMetadataResolver) Transfer {
+	return tx.transfer(ethereumSymbol, r)
+}
+
+// transfer is like TransferWithTokens, with coin identifiers of the chain
+// whose native currency is symbol.
+func (tx *EthereumTransfer) transfer(symbol string, r TokenMetadataResolver) Transfer {
+	coinIdentifier := NativeCoin(symbol)
+	var token *CoinInfo
+	var nominal bool
+	if tx.Contract != nil {
+		coinIdentifier = TokenCoin(symbol, tx.Contract.Bytes())
+		if ticker, decimals, ok := resolveTokenMetadata(r, *tx.Contract); ok {
+			token = &CoinInfo{Ticker: ticker, Decimals: decimals}
+		}
+		nominal = isValueTransfer(tx.Kind) && isFeeOnTransfer(r, *tx.Contract)
+	}
+
+	var to []byte
+	if tx.To != nil {
+		to = tx.To.Bytes()
+	}
+
+	return Transfer{
+		To:              to,
+		Amount:          tx.Amount,
+		CoinIdentifier:  coinIdentifier.Bytes(),
+		Token:           token,
+		DataForSigning:  tx.DataForSigning,
+		Kind:            tx.Kind,
+		Details:         tx.Details,
+		MaxFee:          tx.MaxFee,
+		AmountIsNominal: nominal,
+		Delegations:     codeDelegations(tx.Authorizations),
+	}
 }
 
 type DynamicFeeTxWithoutSignature struct {
@@ -89,6 +380,17 @@ type DynamicFeeTxWithoutSignature struct {
 	AccessList types.AccessList
 }
 
+// HomesteadTxWithoutSignature is an unsigned legacy transaction encoded as
+// before EIP-155, whose signature doesn't commit to a chain ID.
+type HomesteadTxWithoutSignature struct {
+	Nonce    uint64
+	GasPrice *big.Int
+	Gas      uint64
+	To       *common.Address `rlp:"nil"`
+	Value    *big.Int
+	Data     []byte
+}
+
 type AccessListTxWithoutSignature struct {
 	ChainID    *big.Int         // destination chain ID
 	Nonce      uint64           // nonce of sender account
@@ -106,12 +408,35 @@ type AccessListTxWithoutSignature struct {
 //	tx.UnmarshalBinary(b)
 //
 // This function is a workaround taken from https://github.com/ethereum/go-ethereum/issues/26236.
+//
+// Payloads that can't be decoded fail with ErrMalformedTx, unknown
+// transaction types with ErrUnsupportedMethod.
 func DecodeUnsignedPayload(msg []byte) (types.TxData, error) {
+	txData, err := decodeUnsignedPayload(msg)
+	if err != nil {
+		return nil, parseError(err)
+	}
+	return txData, nil
+}
+
+func decodeUnsignedPayload(msg []byte) (types.TxData, error) {
 	if len(msg) <= 1 {
 		return nil, fmt.Errorf("found less than 1 byte in %v", msg)
 	}
 	if msg[0] > 0x7f {
 		// Legacy transaction
+		if isUnprotectedPayload(msg) {
+			var res HomesteadTxWithoutSignature
+			err := rlp.DecodeBytes(msg, &res)
+			return &types.LegacyTx{
+				Nonce:    res.Nonce,
+				GasPrice: res.GasPrice,
+				Gas:      res.Gas,
+				To:       res.To,
+				Value:    res.Value,
+				Data:     res.Data,
+			}, err
+		}
 		var res types.LegacyTx
 		err := rlp.DecodeBytes(msg, &res)
 		return &res, err
@@ -130,6 +455,10 @@ func DecodeUnsignedPayload(msg []byte) (types.TxData, error) {
 			Data:       res.Data,
 			AccessList: res.AccessList,
 		}, err
+	case blobTxType:
+		return nil, ErrBlobTxNotSupported
+	case setCodeTxType:
+		return nil, fmt.Errorf("%w: EIP-7702 set-code transaction, see ParseEthereumTransaction", ErrUnsupportedMethod)
 	case types.DynamicFeeTxType:
 		var res DynamicFeeTxWithoutSignature
 		err := rlp.DecodeBytes(msg[1:], &res)
@@ -145,87 +474,201 @@ func DecodeUnsignedPayload(msg []byte) (types.TxData, error) {
 			AccessList: res.AccessList,
 		}, err
 	default:
-		return nil, fmt.Errorf("unsupported transaction type: %v", msg[0])
+		return nil, fmt.Errorf("%w: transaction type %v", ErrUnsupportedMethod, msg[0])
+	}
+}
+
+// Errors returned when parsing Ethereum transactions, wrapped with the
+// details of the failure.
+var (
+	// ErrMalformedTx is returned for transactions or calldata that can't be
+	// decoded, e.g. truncated or non-canonical arguments.
+	ErrMalformedTx = errors.New("malformed transaction")
+
+	// ErrUnsupportedMethod is returned for transaction types and methods
+	// the parser doesn't support. Calls to unknown methods aren't an
+	// error, they are classified as TxKindContractCall.
+	ErrUnsupportedMethod = errors.New("unsupported method")
+
+	// ErrAmbiguousTx is returned for transactions whose effect can't be
+	// described by a single transfer, e.g. a token transfer that also sends
+	// ETH.
+	ErrAmbiguousTx = errors.New("ambiguous transaction")
+
+	// ErrChainIDMismatch is returned when the chain of the metadata doesn't
+	// match the one of the wallet or of the transaction.
+	ErrChainIDMismatch = errors.New("chain ID mismatch")
+)
+
+// parseError wraps err with ErrMalformedTx, unless it already wraps one of
+// the parse errors.
+func parseError(err error) error {
+	for _, sentinel := range []error{ErrMalformedTx, ErrUnsupportedMethod, ErrAmbiguousTx, ErrChainIDMismatch, ErrUnprotectedTx, ErrDustTransfer} {
+		if errors.Is(err, sentinel) {
+			return err
+		}
 	}
+	return fmt.Errorf("%w: %w", ErrMalformedTx, err)
+}
+
+// blobTxType is the type of EIP-4844 blob transactions, which the
+// go-ethereum version in use doesn't know about.
+const blobTxType = 0x03
+
+// ErrBlobTxNotSupported is returned for EIP-4844 blob transactions. Their
+// signing hash commits to the blob fields, so they can't be parsed as
+// EIP-1559 transactions without signing a wrong hash.
+var ErrBlobTxNotSupported = fmt.Errorf("%w: EIP-4844 blob transaction", ErrUnsupportedMethod)
+
+// ErrUnprotectedTx is returned for legacy transactions without replay
+// protection (EIP-155), unless explicitly allowed.
+var ErrUnprotectedTx = errors.New("legacy transaction without replay protection (EIP-155)")
+
+// isUnprotectedPayload returns true if msg is an unsigned legacy transaction
+// encoded as before EIP-155, i.e. as a list of 6 elements without the chain
+// ID and the two empty signature values.
+func isUnprotectedPayload(msg []byte) bool {
+	content, _, err := rlp.SplitList(msg)
+	if err != nil {
+		return false
+	}
+	n, err := rlp.CountValues(content)
+	return err == nil && n == 6
+}
+
+// unsignedPayloadSigner returns the signer for the unsigned transaction msg:
+// HomesteadSigner for unprotected legacy transactions if allowed, otherwise
+// the latest signer for the chain.
+func unsignedPayloadSigner(msg []byte, chainID *big.Int, allowUnprotected bool) (types.Signer, error) {
+	if !isUnprotectedPayload(msg) {
+		return latestSigner(chainID), nil
+	}
+	if !allowUnprotected {
+		return nil, ErrUnprotectedTx
+	}
+	return types.HomesteadSigner{}, nil
 }
 
 // ParseEthereumTransaction parses an unsigned transaction that can be an ETH
-// transfer or a ERC-20 transfer.
+// transfer, a contract call (e.g. an ERC-20 transfer), or a contract creation.
+// EIP-7702 set-code transactions are parsed as EIP-1559 ones, with their
+// authorization list in Authorizations, see parseSetCodeTransaction.
+// Legacy transactions without replay protection are rejected with
+// ErrUnprotectedTx and calls to token contracts at the zero address with
+// ErrInvalidTokenContract, the other failures wrap ErrMalformedTx,
+// ErrUnsupportedMethod or ErrAmbiguousTx.
 func ParseEthereumTransaction(b []byte, chainID *big.Int) (*EthereumTransfer, error) {
+	return parseEthereumTransaction(b, chainID, false, nil)
+}
+
+// parseEthereumTransaction parses an unsigned transaction, see
+// ParseEthereumTransaction. from is the sender of the transaction, if known,
+// see parseEthereumTx.
+func parseEthereumTransaction(b []byte, chainID *big.Int, allowUnprotected bool, from *common.Address) (*EthereumTransfer, error) {
+	if len(b) > 0 && b[0] == setCodeTxType {
+		return parseSetCodeTransaction(b, chainID)
+	}
 	txData, err := DecodeUnsignedPayload(b)
 	if err != nil {
 		return nil, err
 	}
+	signer, err := unsignedPayloadSigner(b, chainID, allowUnprotected)
+	if err != nil {
+		return nil, err
+	}
 	// create new types Transaction from input fields
 	tx := types.NewTx(txData)
+	return parseEthereumTx(tx, signer.Hash(tx), from)
+}
 
+// parseEthereumTx parses an unsigned transaction, see ParseEthereumTransaction.
+// hash is the signing hash of tx. from is the sender of tx, nil if unknown:
+// transfers of zero ETH to the sender itself are accepted, see
+// checkNonZeroAmount.
+func parseEthereumTx(tx *types.Transaction, hash common.Hash, from *common.Address) (*EthereumTransfer, error) {
 	value := tx.Value()
 
-	// Use latest signer for the supplied chainID
-	signer := types.LatestSignerForChainID(chainID)
-
-	hash := signer.Hash(tx)
-
 	transfer := &EthereumTransfer{
 		To:             tx.To(),
 		Amount:         value,
 		DataForSigning: hash.Bytes(),
+		Kind:           TxKindTransfer,
+		MaxFee:         new(big.Int).Mul(new(big.Int).SetUint64(tx.Gas()), tx.GasFeeCap()),
+		Nonce:          tx.Nonce(),
+	}
+
+	if tx.To() == nil {
+		// a contract is being deployed, data is its init code
+		if len(tx.Data()) == 0 {
+			return nil, fmt.Errorf("%w: empty init code", ErrMalformedTx)
+		}
+		transfer.Kind = TxKindDeploy
+		if impl, ok := parseCloneInitCode(tx.Data()); ok {
+			transfer.Kind = TxKindCloneDeploy
+			transfer.Details = &CloneDeployDetails{Implementation: impl}
+		}
+		return transfer, nil
 	}
 
 	if len(tx.Data()) > 0 {
 		// a contract call is being made
 		transfer.Contract = tx.To()
-		callMsg, parsed, err := parseCallData(tx.Data()) // - TODO we should refactor this so that value can be extracted from all known contract calls
+		call, parsed, err := parseCallData(*tx.To(), tx.Data())
 		if err != nil {
-			return nil, err
+			return nil, parseError(err)
 		}
 		if !parsed {
 			// Most contract calls will fall into this category. Over time parseCallData must be improved so that
 			// asset value movements can be tracked over an increasing set of contract types.
+			transfer.Kind = TxKindContractCall
 			return transfer, nil
 		}
-		transfer.To = callMsg.To
-		transfer.Amount = callMsg.Value
-	}
-
-	return transfer, nil
-}
-
-func parseCallData(txData []byte) (call *ethereum.CallMsg, parsed bool, err error) {
-	if len(txData) < 4 {
-		return nil, false, fmt.Errorf("invalid contract call")
+		transfer.Kind = call.Kind
+		transfer.Details = call.Details
+		if call.Kind == TxKindWrap {
+			// the value sent along with the call is the native currency
+			// being wrapped, not a token of the contract
+			transfer.Contract = nil
+		}
+		if call.Contract != nil && *call.Contract == (common.Address{}) {
+			return nil, fmt.Errorf("%w: zero address", ErrInvalidTokenContract)
+		}
+		if call.To != nil {
+			if value.Sign() != 0 {
+				// the value sent to the contract would be hidden by the
+				// transfer of the call
+				return nil, fmt.Errorf("%w: %s call also sends %v wei", ErrAmbiguousTx, call.Kind, value)
+			}
+			transfer.To = call.To
+			transfer.Amount = call.Amount
+			transfer.Contract = call.Contract
+		}
 	}
 
-	// 4 bytes - method signature (transfer: 0xa9059cbb)
-	method := txData[0:4]
-
-	switch {
-	case bytes.Equal(method, transferMethodID):
-		// 32 bytes - recipient address
-		// 32 bytes - amount
-		to, amt, err := rawUnpackERC20Transfer(txData)
-		if err != nil {
-			return nil, false, err
-		}
-		return &ethereum.CallMsg{To: to, Value: amt}, true, nil
-	default:
-		return nil, false, nil
+	if err := checkNonZeroAmount(transfer, from); err != nil {
+		return nil, err
 	}
+	return transfer, nil
 }
 
 var (
-	transferMethodID = crypto.Keccak256Hash([]byte("transfer(address,uint256)")).Bytes()[0:4]
+	// EIP-1167 minimal proxy init code is:
+	//
+	//	cloneInitCodePrefix || implementation address || cloneInitCodeSuffix
+	//
+	// where the first 10 bytes of the prefix copy the runtime code to
+	// memory and return it.
+	cloneInitCodePrefix = common.FromHex("0x3d602d80600a3d3981f3363d3d373d3d3d363d73")
+	cloneInitCodeSuffix = common.FromHex("0x5af43d82803e903d91602b57fd5bf3")
 )
 
-// rawUnpackERC20Transfer Unpack without use of Go ABI package. Assumes correct ERC20 payload formatting
-func rawUnpackERC20Transfer(txData []byte) (to *common.Address, amount *big.Int, err error) {
-	if !bytes.Equal(txData[0:4], transferMethodID) {
-		return nil, nil, fmt.Errorf("wrong method id")
-	}
-	if !bytes.Equal(txData[4:4+12], hexutil.MustDecode("0x000000000000000000000000")) {
-		return nil, nil, fmt.Errorf("invalid ERC-20 transfer: recipient address is not 20 bytes")
+// parseCloneInitCode returns the implementation address if the init code
+// deploys an EIP-1167 minimal proxy.
+func parseCloneInitCode(initCode []byte) (common.Address, bool) {
+	if len(initCode) != len(cloneInitCodePrefix)+common.AddressLength+len(cloneInitCodeSuffix) ||
+		!bytes.HasPrefix(initCode, cloneInitCodePrefix) ||
+		!bytes.HasSuffix(initCode, cloneInitCodeSuffix) {
+		return common.Address{}, false
 	}
-	toAddr := common.BytesToAddress(txData[16:36])
-	amount = new(big.Int).SetBytes(txData[36:68])
-	return &toAddr, amount, nil
+	return common.BytesToAddress(initCode[len(cloneInitCodePrefix) : len(cloneInitCodePrefix)+common.AddressLength]), true
 }
@@ -0,0 +1,94 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package types
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// AddressFormat is a textual format of Ethereum addresses.
+type AddressFormat string
+
+const (
+	// AddressFormatEIP55 is the hex format with the mixed-case checksum of
+	// EIP-55, e.g. 0x52dC504a422f0E2a9E7632A34a50f1A82F8224C7. It's the
+	// default.
+	AddressFormatEIP55 AddressFormat = "eip55"
+
+	// AddressFormatLowercase is the hex format without checksum, e.g.
+	// 0x52dc504a422f0e2a9e7632a34a50f1a82f8224c7.
+	AddressFormatLowercase AddressFormat = "lowercase"
+
+	// AddressFormatICAP is the IBAN-compatible format of the Inter exchange
+	// Client Address Protocol, e.g. XE499OG1EH8ZZI0KXC6N83EKGT1BM97P2O7.
+	AddressFormatICAP AddressFormat = "icap"
+)
+
+// AddressFormatted returns the address of the wallet in the given format,
+// AddressFormatEIP55 if format is empty.
+func (w *EthereumWallet) AddressFormatted(format AddressFormat) (string, error) {
+	return FormatEthereumAddress(crypto.PubkeyToAddress(*w.key), format)
+}
+
+// FormatEthereumAddress returns addr in the given format,
+// AddressFormatEIP55 if format is empty.
+func FormatEthereumAddress(addr common.Address, format AddressFormat) (string, error) {
+	switch format {
+	case AddressFormatEIP55, "":
+		return addr.Hex(), nil
+	case AddressFormatLowercase:
+		return strings.ToLower(addr.Hex()), nil
+	case AddressFormatICAP:
+		return icapAddress(addr), nil
+	default:
+		return "", fmt.Errorf("unsupported address format %q", format)
+	}
+}
+
+// Lengths of the basic bank account number (BBAN) of ICAP addresses.
+const (
+	// icapDirectLength is the length of direct ICAP addresses, which are
+	// valid IBANs, for addresses below 2^155 (e.g. starting with a zero
+	// byte).
+	icapDirectLength = 30
+
+	// icapBasicLength is the length of basic ICAP addresses, which fit any
+	// address but aren't valid IBANs because of their length.
+	icapBasicLength = 31
+)
+
+// icapAddress returns the ICAP address of addr: "XE", the two check digits
+// of ISO 13616 and the address in base 36, left-padded with zeros to 30
+// characters if it fits (direct encoding) or to 31 characters otherwise
+// (basic encoding).
+func icapAddress(addr common.Address) string {
+	bban := strings.ToUpper(new(big.Int).SetBytes(addr.Bytes()).Text(36))
+	length := icapDirectLength
+	if len(bban) > icapDirectLength {
+		length = icapBasicLength
+	}
+	bban = strings.Repeat("0", length-len(bban)) + bban
+	return fmt.Sprintf("XE%02d%s", ibanCheckDigits("XE", bban), bban)
+}
+
+// ibanCheckDigits returns the check digits of the IBAN with the given
+// country code and BBAN, as defined by ISO 13616: 98 minus the remainder
+// of the division by 97 of the BBAN followed by the country code and "00",
+// with letters replaced by numbers (A = 10, ..., Z = 35).
+func ibanCheckDigits(country, bban string) int {
+	var digits strings.Builder
+	for _, c := range bban + country + "00" {
+		if c >= 'A' && c <= 'Z' {
+			fmt.Fprintf(&digits, "%d", c-'A'+10)
+		} else {
+			digits.WriteRune(c)
+		}
+	}
+	n, _ := new(big.Int).SetString(digits.String(), 10)
+	return 98 - int(new(big.Int).Mod(n, big.NewInt(97)).Int64())
+}
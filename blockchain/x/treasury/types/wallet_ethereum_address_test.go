@@ -0,0 +1,60 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_FormatEthereumAddress_ICAP(t *testing.T) {
+	// reference values of web3.js and of the former ICAP implementation of
+	// go-ethereum
+	tests := []struct {
+		name string
+		addr string
+		want string
+	}{
+		{name: "basic", addr: "0x52dc504a422f0e2a9e7632a34a50f1a82f8224c7", want: "XE499OG1EH8ZZI0KXC6N83EKGT1BM97P2O7"},
+		{name: "basic 2", addr: "0x11c5496aee77c1ba1f0854206a26dda82a81d6d8", want: "XE1222Q908LN1QBBU6XUQSO1OHWJIOS46OO"},
+		{name: "direct", addr: "0x00c5496aee77c1ba1f0854206a26dda82a81d6d8", want: "XE7338O073KYGTWWZN0F2WZ0R8PX5ZPPZS"},
+		{name: "direct, padded", addr: "0x0000a5327eab78357cbf2ae8f3d49fd9d90c7d22", want: "XE0600DQK33XDTYUCRI0KYM5ELAKXDWWF6"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FormatEthereumAddress(common.HexToAddress(tt.addr), AddressFormatICAP)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_EthereumWallet_AddressFormatted(t *testing.T) {
+	wallet := ethereumWallet(t)
+
+	eip55, err := wallet.AddressFormatted(AddressFormatEIP55)
+	require.NoError(t, err)
+	require.Equal(t, wallet.Address(), eip55)
+
+	defaultFormat, err := wallet.AddressFormatted("")
+	require.NoError(t, err)
+	require.Equal(t, eip55, defaultFormat)
+
+	lowercase, err := wallet.AddressFormatted(AddressFormatLowercase)
+	require.NoError(t, err)
+	require.Equal(t, "0xdd1d3ff09c5edff1be7d466ca614cb1cf3f78738", lowercase)
+
+	icap, err := wallet.AddressFormatted(AddressFormatICAP)
+	require.NoError(t, err)
+	require.Len(t, icap, 35)
+	require.Equal(t, "XE", icap[:2])
+	decoded, ok := new(big.Int).SetString(icap[4:], 36)
+	require.True(t, ok)
+	require.Equal(t, wallet.Address(), common.BigToAddress(decoded).Hex())
+
+	_, err = wallet.AddressFormatted("base58")
+	require.Error(t, err)
+}
@@ -0,0 +1,66 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package types
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// BuildUnsignedEthereumTransfer returns the encoding of an unsigned EIP-1559
+// transaction sending amount of the native currency to address to, as
+// expected by ParseTx. feeCap and tipCap are the maximum fee per gas and
+// the maximum priority fee per gas.
+func BuildUnsignedEthereumTransfer(to common.Address, amount *big.Int, nonce, gasLimit uint64, feeCap, tipCap *big.Int, chainID *big.Int) ([]byte, error) {
+	return buildUnsignedDynamicFeeTx(to, amount, nil, nonce, gasLimit, feeCap, tipCap, chainID)
+}
+
+// BuildUnsignedERC20Transfer is like BuildUnsignedEthereumTransfer, but the
+// transaction calls transfer(to, amount) on the ERC-20 token contract.
+func BuildUnsignedERC20Transfer(token, to common.Address, amount *big.Int, nonce, gasLimit uint64, feeCap, tipCap *big.Int, chainID *big.Int) ([]byte, error) {
+	if amount == nil || amount.Sign() < 0 {
+		return nil, fmt.Errorf("invalid amount: %v", amount)
+	}
+	if amount.BitLen() > 256 {
+		return nil, fmt.Errorf("invalid amount: %v overflows uint256", amount)
+	}
+	data := make([]byte, 0, 4+2*32)
+	data = append(data, transferMethodID...)
+	data = append(data, common.LeftPadBytes(to.Bytes(), 32)...)
+	data = append(data, common.LeftPadBytes(amount.Bytes(), 32)...)
+	return buildUnsignedDynamicFeeTx(token, new(big.Int), data, nonce, gasLimit, feeCap, tipCap, chainID)
+}
+
+func buildUnsignedDynamicFeeTx(to common.Address, value *big.Int, data []byte, nonce, gasLimit uint64, feeCap, tipCap *big.Int, chainID *big.Int) ([]byte, error) {
+	switch {
+	case chainID == nil || chainID.Sign() <= 0:
+		return nil, fmt.Errorf("invalid chain ID: %v", chainID)
+	case value == nil || value.Sign() < 0:
+		return nil, fmt.Errorf("invalid amount: %v", value)
+	case gasLimit == 0:
+		return nil, fmt.Errorf("missing gas limit")
+	case feeCap == nil || tipCap == nil || feeCap.Sign() < 0 || tipCap.Sign() < 0:
+		return nil, fmt.Errorf("invalid fees: fee cap %v, tip cap %v", feeCap, tipCap)
+	case feeCap.Cmp(tipCap) < 0:
+		return nil, fmt.Errorf("tip cap %v is higher than fee cap %v", tipCap, feeCap)
+	}
+
+	b, err := rlp.EncodeToBytes(&DynamicFeeTxWithoutSignature{
+		ChainID:   chainID,
+		Nonce:     nonce,
+		GasTipCap: tipCap,
+		GasFeeCap: feeCap,
+		Gas:       gasLimit,
+		To:        &to,
+		Value:     value,
+		Data:      data,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte{types.DynamicFeeTxType}, b...), nil
+}
@@ -0,0 +1,82 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_BuildUnsignedEthereumTransfer(t *testing.T) {
+	to := common.HexToAddress("0x48c04ed5691981C42154C6167398f95e8f38a7fF")
+	amount := big.NewInt(1_000_000_000_000_000_000)
+	feeCap, tipCap := big.NewInt(30_000_000_000), big.NewInt(1_000_000_000)
+
+	b, err := BuildUnsignedEthereumTransfer(to, amount, 7, 21_000, feeCap, tipCap, big.NewInt(137))
+	require.NoError(t, err)
+
+	tx, err := ParseEthereumTransaction(b, big.NewInt(137))
+	require.NoError(t, err)
+	require.Equal(t, TxKindTransfer, tx.Kind)
+	require.Equal(t, to, *tx.To)
+	require.Equal(t, 0, amount.Cmp(tx.Amount))
+	require.Nil(t, tx.Contract)
+	require.Equal(t, 0, big.NewInt(21_000*30_000_000_000).Cmp(tx.MaxFee))
+
+	txData, err := DecodeUnsignedPayload(b)
+	require.NoError(t, err)
+	require.Equal(t, uint64(7), types.NewTx(txData).Nonce())
+}
+
+func Test_BuildUnsignedERC20Transfer(t *testing.T) {
+	usdt := common.HexToAddress("0xdAC17F958D2ee523a2206206994597C13D831ec7")
+	to := common.HexToAddress("0x48c04ed5691981C42154C6167398f95e8f38a7fF")
+	amount := big.NewInt(25_000_000)
+
+	b, err := BuildUnsignedERC20Transfer(usdt, to, amount, 3, 100_000, big.NewInt(30_000_000_000), big.NewInt(1_000_000_000), big.NewInt(1))
+	require.NoError(t, err)
+
+	tx, err := ParseEthereumTransaction(b, big.NewInt(1))
+	require.NoError(t, err)
+	require.Equal(t, TxKindTransfer, tx.Kind)
+	require.Equal(t, to, *tx.To)
+	require.Equal(t, 0, amount.Cmp(tx.Amount))
+	require.Equal(t, usdt, *tx.Contract)
+	require.Equal(t, TokenCoin(ethereumSymbol, usdt.Bytes()).Bytes(), tx.Transfer().CoinIdentifier)
+}
+
+func Test_BuildUnsignedEthereumTransfer_Invalid(t *testing.T) {
+	to := common.HexToAddress("0x48c04ed5691981C42154C6167398f95e8f38a7fF")
+	feeCap, tipCap := big.NewInt(30_000_000_000), big.NewInt(1_000_000_000)
+
+	tests := []struct {
+		name    string
+		amount  *big.Int
+		gas     uint64
+		feeCap  *big.Int
+		tipCap  *big.Int
+		chainID *big.Int
+	}{
+		{name: "missing amount", amount: nil, gas: 21_000, feeCap: feeCap, tipCap: tipCap, chainID: big.NewInt(1)},
+		{name: "negative amount", amount: big.NewInt(-1), gas: 21_000, feeCap: feeCap, tipCap: tipCap, chainID: big.NewInt(1)},
+		{name: "missing gas limit", amount: big.NewInt(1), gas: 0, feeCap: feeCap, tipCap: tipCap, chainID: big.NewInt(1)},
+		{name: "missing fee cap", amount: big.NewInt(1), gas: 21_000, feeCap: nil, tipCap: tipCap, chainID: big.NewInt(1)},
+		{name: "tip above fee cap", amount: big.NewInt(1), gas: 21_000, feeCap: tipCap, tipCap: feeCap, chainID: big.NewInt(1)},
+		{name: "missing chain ID", amount: big.NewInt(1), gas: 21_000, feeCap: feeCap, tipCap: tipCap, chainID: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := BuildUnsignedEthereumTransfer(to, tt.amount, 0, tt.gas, tt.feeCap, tt.tipCap, tt.chainID)
+			require.Error(t, err)
+		})
+	}
+
+	tooLarge := new(big.Int).Lsh(big.NewInt(1), 256)
+	_, err := BuildUnsignedERC20Transfer(to, to, tooLarge, 0, 100_000, feeCap, tipCap, big.NewInt(1))
+	require.Error(t, err)
+}
@@ -0,0 +1,1103 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const (
+	// TxKindTransferFrom is an ERC-20 transferFrom call moving tokens out
+	// of an account that approved the caller as spender.
+	TxKindTransferFrom TxKind = "transfer_from"
+
+	// TxKindMint is a mint call of a mintable token, creating new tokens
+	// for the recipient.
+	TxKindMint TxKind = "mint"
+
+	// TxKindApproval is an ERC-20 approve call granting a spender an
+	// allowance over the tokens of the caller.
+	TxKindApproval TxKind = "approval"
+
+	// TxKindApprovalRevoke is an ERC-20 approve call with a zero amount,
+	// revoking any allowance previously granted to the spender.
+	TxKindApprovalRevoke TxKind = "approval_revoke"
+
+	// TxKindPermit2Approval is a call to the approve method of the Uniswap
+	// Permit2 contract, granting a spender an allowance over a token, with
+	// an expiration.
+	TxKindPermit2Approval TxKind = "permit2_approval"
+
+	// TxKindVestingRelease is a call to the release method of a vesting
+	// contract, moving the vested tokens to the beneficiary.
+	TxKindVestingRelease TxKind = "vesting_release"
+
+	// TxKindRewardClaim is a getReward call claiming the rewards accrued on
+	// a staking contract. The amount isn't known from the calldata.
+	TxKindRewardClaim TxKind = "reward_claim"
+
+	// TxKindMerkleClaim is a claim from a Merkle distributor contract, e.g.
+	// for an airdrop or rewards.
+	TxKindMerkleClaim TxKind = "merkle_claim"
+
+	// TxKindGovernanceVote is a vote cast on a proposal of an OpenZeppelin
+	// Governor contract.
+	TxKindGovernanceVote TxKind = "governance_vote"
+
+	// TxKindGovernancePropose is the creation of a proposal on an
+	// OpenZeppelin Governor contract.
+	TxKindGovernancePropose TxKind = "governance_propose"
+
+	// TxKindApprovalForAll is an ERC-721/ERC-1155 setApprovalForAll call,
+	// granting or revoking an operator the right to move every token of the
+	// collection owned by the caller.
+	TxKindApprovalForAll TxKind = "approval_for_all"
+
+	// TxKindMultiSigConfirm is the confirmation of a transaction previously
+	// submitted to a Gnosis MultiSigWallet.
+	TxKindMultiSigConfirm TxKind = "multisig_confirm"
+
+	// TxKindLendingSupply is the supply of an asset to a lending pool (Aave
+	// V3), moving it from the caller to the pool.
+	TxKindLendingSupply TxKind = "lending_supply"
+
+	// TxKindLendingBorrow is a loan taken from a lending pool.
+	TxKindLendingBorrow TxKind = "lending_borrow"
+
+	// TxKindLendingRepay is the repayment of a loan to a lending pool,
+	// moving the asset from the caller to the pool.
+	TxKindLendingRepay TxKind = "lending_repay"
+
+	// TxKindLendingWithdraw is the withdrawal of a previously supplied asset
+	// from a lending pool.
+	TxKindLendingWithdraw TxKind = "lending_withdraw"
+
+	// TxKindStreamCreate is the creation of a payment stream (Sablier),
+	// paying the deposit to the recipient over time.
+	TxKindStreamCreate TxKind = "stream_create"
+
+	// TxKindBridgeDeposit is a deposit of tokens into a bridge, moving
+	// them to another chain.
+	TxKindBridgeDeposit TxKind = "bridge_deposit"
+)
+
+// TransferFromCall contains the arguments of an ERC-20 transferFrom call.
+type TransferFromCall struct {
+	// From is the account the tokens are taken from.
+	From common.Address
+}
+
+// ApprovalCall contains the arguments of an ERC-20 approve call.
+type ApprovalCall struct {
+	// Spender is the address being granted the allowance.
+	Spender common.Address
+
+	// Amount is the allowance granted to the spender, zero for revocations.
+	Amount *big.Int
+}
+
+// Permit2ApprovalCall contains the arguments of a Permit2 approve call.
+type Permit2ApprovalCall struct {
+	// Token is the address of the token the allowance is for.
+	Token common.Address
+
+	// Spender is the address being granted the allowance.
+	Spender common.Address
+
+	// Amount is the allowance granted to the spender.
+	Amount *big.Int
+
+	// Expiration is the unix timestamp at which the allowance expires.
+	Expiration uint64
+}
+
+// VestingReleaseCall contains the arguments of a vesting contract release
+// call.
+type VestingReleaseCall struct {
+	// Token is the address of the token being released, or nil if the
+	// call doesn't specify it (i.e. release()).
+	Token *common.Address
+}
+
+// RewardClaimCall is a getReward call of a staking rewards contract.
+type RewardClaimCall struct {
+	// StakingContract is the address of the contract paying the rewards.
+	StakingContract common.Address
+}
+
+// MerkleClaimCall contains the arguments of a Merkle distributor claim call.
+type MerkleClaimCall struct {
+	// Index is the index of the claim in the Merkle tree.
+	Index *big.Int
+
+	// Account is the address receiving the claimed tokens.
+	Account common.Address
+
+	// Amount is the amount of tokens being claimed.
+	Amount *big.Int
+
+	// ProofLength is the number of hashes in the Merkle proof.
+	ProofLength int
+}
+
+// GovernanceVoteCall contains the arguments of a Governor castVote call.
+type GovernanceVoteCall struct {
+	ProposalID *big.Int
+
+	// Support is the vote: 0 against, 1 for, 2 abstain.
+	Support uint8
+}
+
+// GovernanceProposeCall contains the arguments of a Governor propose call.
+type GovernanceProposeCall struct {
+	// ProposalID is the ID the Governor will assign to the proposal.
+	ProposalID *big.Int
+
+	// Targets, Values and Calldatas describe the calls executed if the
+	// proposal passes.
+	Targets   []common.Address
+	Values    []*big.Int
+	Calldatas [][]byte
+
+	Description string
+}
+
+// LendingCall contains the arguments of an Aave V3 Pool supply, borrow,
+// repay or withdraw call.
+type LendingCall struct {
+	// Asset is the address of the underlying token.
+	Asset common.Address
+
+	// Amount is the amount of the asset. For repay and withdraw, the
+	// maximum uint256 value means the whole debt or balance.
+	Amount *big.Int
+
+	// OnBehalfOf is the account receiving the aTokens (supply), the debt
+	// (borrow) or whose debt is repaid (repay). It's nil for withdraw.
+	OnBehalfOf *common.Address
+
+	// Recipient is the address receiving the withdrawn asset. It's nil
+	// for calls other than withdraw.
+	Recipient *common.Address
+
+	// InterestRateMode is the rate mode of the debt for borrow and repay:
+	// 1 stable, 2 variable.
+	InterestRateMode *big.Int
+}
+
+// StreamCreateCall contains the arguments of a Sablier createStream call.
+type StreamCreateCall struct {
+	// Recipient is the address receiving the stream.
+	Recipient common.Address
+
+	// Deposit is the total amount streamed to the recipient.
+	Deposit *big.Int
+
+	// Token is the address of the token being streamed.
+	Token common.Address
+
+	// StartTime and StopTime are the unix timestamps of the start and the
+	// end of the stream.
+	StartTime *big.Int
+	StopTime  *big.Int
+}
+
+// FlowRate returns the amount of tokens streamed per second, rounded down.
+// It's zero if the stream has no duration.
+func (c *StreamCreateCall) FlowRate() *big.Int {
+	duration := new(big.Int).Sub(c.StopTime, c.StartTime)
+	if duration.Sign() <= 0 {
+		return new(big.Int)
+	}
+	return new(big.Int).Quo(c.Deposit, duration)
+}
+
+// BridgeDepositCall contains the arguments of an OP-Stack standard bridge
+// depositERC20 or depositERC20To call.
+type BridgeDepositCall struct {
+	// L1Token is the address of the token deposited into the bridge.
+	L1Token common.Address
+
+	// L2Token is the address of the corresponding token on the destination
+	// chain.
+	L2Token common.Address
+
+	Amount *big.Int
+
+	// Recipient is the address receiving the tokens on the destination
+	// chain, or nil if it's the sender of the transaction (depositERC20).
+	Recipient *common.Address
+
+	// DestinationChainID is the ID of the chain the bridge deposits to, or
+	// 0 if the bridge is unknown.
+	DestinationChainID uint64
+
+	MinGasLimit uint32
+	ExtraData   []byte
+}
+
+// opStackBridges maps the address of known OP-Stack L1 standard bridges to
+// the ID of their L2 chain.
+var opStackBridges = map[common.Address]uint64{
+	common.HexToAddress("0x99C9fc46f92E8a1c0deC1b1747d010903E884bE1"): 10,   // OP Mainnet
+	common.HexToAddress("0x3154Cf16ccdb4C6d922629664174b904d80F2C35"): 8453, // Base
+}
+
+// SafeExecTransactionCall contains the arguments of a Gnosis Safe
+// execTransaction call, i.e. the transaction executed by the Safe.
+type SafeExecTransactionCall struct {
+	To        common.Address
+	Value     *big.Int
+	Data      []byte
+	Operation SafeOperation
+
+	// InnerDetails contains the decoded arguments of the call executed by
+	// the Safe, if it was recognised.
+	InnerDetails any
+}
+
+// MultiSigSubmitCall contains the arguments of a Gnosis MultiSigWallet
+// submitTransaction call, i.e. the transaction submitted for confirmation.
+type MultiSigSubmitCall struct {
+	Destination common.Address
+	Value       *big.Int
+	Data        []byte
+
+	// InnerDetails contains the decoded arguments of the submitted call, if
+	// it was recognised.
+	InnerDetails any
+}
+
+// MultiSigConfirmCall contains the arguments of a Gnosis MultiSigWallet
+// confirmTransaction call.
+type MultiSigConfirmCall struct {
+	TransactionID *big.Int
+}
+
+// SafeOperation is the type of call executed by a Gnosis Safe.
+type SafeOperation uint8
+
+const (
+	SafeOperationCall         SafeOperation = 0
+	SafeOperationDelegateCall SafeOperation = 1
+)
+
+// ApprovalForAllCall contains the arguments of a setApprovalForAll call.
+type ApprovalForAllCall struct {
+	// Operator is the address being granted (or revoked) the approval.
+	Operator common.Address
+
+	// Approved is true if the approval is being granted, false if it's
+	// being revoked.
+	Approved bool
+}
+
+// ethereumCall is a contract call decoded by parseCallData.
+type ethereumCall struct {
+	Kind TxKind
+
+	// To, Amount and Contract are set only for calls that move value to a
+	// different recipient than the contract being called (e.g. ERC-20
+	// transfers). Contract is the token being moved, or nil for the native
+	// currency.
+	To       *common.Address
+	Amount   *big.Int
+	Contract *common.Address
+
+	// Details contains the decoded arguments of the call.
+	Details any
+}
+
+// parseCallData decodes the data of a call to the contract at address to.
+func parseCallData(to common.Address, txData []byte) (call *ethereumCall, parsed bool, err error) {
+	if len(txData) < 4 {
+		return nil, false, fmt.Errorf("invalid contract call")
+	}
+
+	// 4 bytes - method signature (transfer: 0xa9059cbb)
+	method := txData[0:4]
+	args := txData[4:]
+
+	switch {
+	case bytes.Equal(method, transferMethodID):
+		// 32 bytes - recipient address
+		// 32 bytes - amount
+		recipient, amt, err := rawUnpackERC20Transfer(txData)
+		if err != nil {
+			return nil, false, err
+		}
+		return &ethereumCall{Kind: TxKindTransfer, To: recipient, Amount: amt, Contract: &to}, true, nil
+	case bytes.Equal(method, transferFromMethodID):
+		// 32 bytes - sender address
+		// 32 bytes - recipient address
+		// 32 bytes - amount
+		from, recipient, amt, err := unpackTransferFrom(args)
+		if err != nil {
+			return nil, false, err
+		}
+		return &ethereumCall{Kind: TxKindTransferFrom, To: &recipient, Amount: amt, Contract: &to, Details: &TransferFromCall{From: from}}, true, nil
+	case bytes.Equal(method, mintMethodID):
+		// 32 bytes - recipient address
+		// 32 bytes - amount
+		recipient, amt, err := unpackMint(args)
+		if err != nil {
+			return nil, false, err
+		}
+		return &ethereumCall{Kind: TxKindMint, To: &recipient, Amount: amt, Contract: &to}, true, nil
+	case bytes.Equal(method, approveMethodID):
+		// 32 bytes - spender address
+		// 32 bytes - amount
+		details, err := unpackApproval(args)
+		if err != nil {
+			return nil, false, err
+		}
+		kind := TxKindApproval
+		if details.Amount.Sign() == 0 {
+			kind = TxKindApprovalRevoke
+		}
+		return &ethereumCall{Kind: kind, Details: details}, true, nil
+	case bytes.Equal(method, permit2ApproveMethodID):
+		// 32 bytes - token address
+		// 32 bytes - spender address
+		// 32 bytes - amount (uint160)
+		// 32 bytes - expiration (uint48)
+		details, err := unpackPermit2Approval(args)
+		if err != nil {
+			return nil, false, err
+		}
+		return &ethereumCall{Kind: TxKindPermit2Approval, Details: details}, true, nil
+	case bytes.Equal(method, releaseMethodID):
+		// no arguments
+		return &ethereumCall{Kind: TxKindVestingRelease, Details: &VestingReleaseCall{}}, true, nil
+	case bytes.Equal(method, releaseTokenMethodID) && isPaymentSplitter(to):
+		// 32 bytes - payee address
+		details, err := unpackSplitterRelease(to, false, args)
+		if err != nil {
+			return nil, false, err
+		}
+		return &ethereumCall{Kind: TxKindSplitterRelease, Details: details}, true, nil
+	case bytes.Equal(method, splitterReleaseTokenMethodID):
+		// 32 bytes - token address
+		// 32 bytes - payee address
+		details, err := unpackSplitterRelease(to, true, args)
+		if err != nil {
+			return nil, false, err
+		}
+		return &ethereumCall{Kind: TxKindSplitterRelease, Details: details}, true, nil
+	case bytes.Equal(method, releaseTokenMethodID):
+		// 32 bytes - token address
+		token, err := abiAddress(args, 0)
+		if err != nil {
+			return nil, false, fmt.Errorf("invalid release: %w", err)
+		}
+		return &ethereumCall{Kind: TxKindVestingRelease, Details: &VestingReleaseCall{Token: &token}}, true, nil
+	case bytes.Equal(method, getRewardMethodID):
+		// no arguments, the amount claimed is computed by the contract
+		return &ethereumCall{Kind: TxKindRewardClaim, Details: &RewardClaimCall{StakingContract: to}}, true, nil
+	case bytes.Equal(method, merkleClaimMethodID):
+		// 32 bytes - index
+		// 32 bytes - account address
+		// 32 bytes - amount
+		// 32 bytes - offset of the proof
+		details, err := unpackMerkleClaim(args)
+		if err != nil {
+			return nil, false, err
+		}
+		return &ethereumCall{Kind: TxKindMerkleClaim, Details: details}, true, nil
+	case bytes.Equal(method, castVoteMethodID):
+		// 32 bytes - proposal ID
+		// 32 bytes - support (uint8)
+		details, err := unpackCastVote(args)
+		if err != nil {
+			return nil, false, err
+		}
+		return &ethereumCall{Kind: TxKindGovernanceVote, Details: details}, true, nil
+	case bytes.Equal(method, proposeMethodID):
+		// dynamic arguments - targets, values, calldatas, description
+		details, err := unpackPropose(args)
+		if err != nil {
+			return nil, false, err
+		}
+		return &ethereumCall{Kind: TxKindGovernancePropose, Details: details}, true, nil
+	case bytes.Equal(method, safeExecTransactionMethodID):
+		// dynamic arguments - to, value, data, operation, safeTxGas,
+		// baseGas, gasPrice, gasToken, refundReceiver, signatures
+		return unpackSafeExecTransaction(args)
+	case bytes.Equal(method, multiSigSubmitTransactionMethodID):
+		// dynamic arguments - destination, value, data
+		call, err := unpackMultiSigSubmitTransaction(args)
+		if err != nil {
+			return nil, false, err
+		}
+		return call, true, nil
+	case bytes.Equal(method, multiSigConfirmTransactionMethodID):
+		// 32 bytes - transaction ID
+		id, err := abiUint(args, 0)
+		if err != nil {
+			return nil, false, fmt.Errorf("invalid confirmTransaction: %w", err)
+		}
+		return &ethereumCall{Kind: TxKindMultiSigConfirm, Details: &MultiSigConfirmCall{TransactionID: id}}, true, nil
+	case bytes.Equal(method, aaveSupplyMethodID),
+		bytes.Equal(method, aaveBorrowMethodID),
+		bytes.Equal(method, aaveRepayMethodID),
+		bytes.Equal(method, aaveWithdrawMethodID):
+		return unpackLendingCall(to, method, args)
+	case bytes.Equal(method, routerExecuteMethodID),
+		bytes.Equal(method, routerExecuteNoDeadlineMethodID):
+		details, err := unpackRouterExecute(args, bytes.Equal(method, routerExecuteMethodID))
+		if err != nil {
+			return nil, false, err
+		}
+		return &ethereumCall{Kind: TxKindRouterExecute, Details: details}, true, nil
+	case bytes.Equal(method, createStreamMethodID):
+		// 32 bytes - recipient address
+		// 32 bytes - deposit
+		// 32 bytes - token address
+		// 32 bytes - start time
+		// 32 bytes - stop time
+		details, err := unpackCreateStream(args)
+		if err != nil {
+			return nil, false, err
+		}
+		return &ethereumCall{Kind: TxKindStreamCreate, To: &details.Recipient, Amount: details.Deposit, Contract: &details.Token, Details: details}, true, nil
+	case bytes.Equal(method, depositERC20MethodID), bytes.Equal(method, depositERC20ToMethodID):
+		// dynamic arguments - l1Token, l2Token, [to], amount, minGasLimit,
+		// extraData
+		details, err := unpackBridgeDeposit(to, bytes.Equal(method, depositERC20ToMethodID), args)
+		if err != nil {
+			return nil, false, err
+		}
+		// the tokens are moved from the caller to the bridge
+		return &ethereumCall{
+			Kind:     TxKindBridgeDeposit,
+			To:       &to,
+			Amount:   details.Amount,
+			Contract: &details.L1Token,
+			Details:  details,
+		}, true, nil
+	case bytes.Equal(method, setApprovalForAllMethodID):
+		// 32 bytes - operator address
+		// 32 bytes - approved
+		details, err := unpackApprovalForAll(args)
+		if err != nil {
+			return nil, false, err
+		}
+		return &ethereumCall{Kind: TxKindApprovalForAll, Details: details}, true, nil
+	case bytes.Equal(method, batchTransferFromMethodID):
+		details, err := unpackBatchTransferFrom(to, args)
+		if err != nil {
+			return nil, false, err
+		}
+		return &ethereumCall{Kind: TxKindBatchTransfer, Details: details}, true, nil
+	case bytes.Equal(method, permitAndCallMethodID):
+		// dynamic arguments - permit, action
+		call, err := unpackPermitAndCall(to, args)
+		if err != nil {
+			return nil, false, err
+		}
+		return call, true, nil
+	case bytes.Equal(method, erc1155SafeTransferFromMethodID):
+		// dynamic arguments - from, to, id, amount, data
+		details, err := unpackERC1155SafeTransferFrom(to, args)
+		if err != nil {
+			return nil, false, err
+		}
+		return &ethereumCall{Kind: TxKindERC1155Transfer, Details: details}, true, nil
+	case bytes.Equal(method, erc1155SafeBatchTransferFromMethodID):
+		// dynamic arguments - from, to, ids, amounts, data
+		details, err := unpackERC1155SafeBatchTransferFrom(to, args)
+		if err != nil {
+			return nil, false, err
+		}
+		return &ethereumCall{Kind: TxKindERC1155BatchTransfer, Details: details}, true, nil
+	case bytes.Equal(method, flashLoanMethodID):
+		// dynamic arguments - receiver, assets, amounts, interest rate modes,
+		// on behalf of, params, referral code
+		details, err := unpackFlashLoan(to, args)
+		if err != nil {
+			return nil, false, err
+		}
+		return &ethereumCall{Kind: TxKindFlashLoan, Details: details}, true, nil
+	case bytes.Equal(method, flashLoanSimpleMethodID):
+		// dynamic arguments - receiver, asset, amount, params, referral code
+		details, err := unpackFlashLoanSimple(to, args)
+		if err != nil {
+			return nil, false, err
+		}
+		return &ethereumCall{Kind: TxKindFlashLoan, Details: details}, true, nil
+	case isEscrowDepositMethod(method):
+		// 32 bytes - reference id, the amount is the value of the transaction
+		details, err := unpackEscrowDeposit(to, args)
+		if err != nil {
+			return nil, false, err
+		}
+		return &ethereumCall{Kind: TxKindEscrowDeposit, Details: details}, true, nil
+	case isNFTWrapMethod(method):
+		// 32 bytes - token id
+		details, err := unpackNFTWrap(to, args)
+		if err != nil {
+			return nil, false, err
+		}
+		return &ethereumCall{Kind: TxKindNFTWrap, Details: details}, true, nil
+	case isWrappedNativeToken(to) && (bytes.Equal(method, wrapDepositMethodID) || bytes.Equal(method, wrapWithdrawMethodID)):
+		// deposit: no arguments, the amount is the value of the transaction
+		// withdraw: 32 bytes - amount
+		call, err := unpackWrapCall(to, method, args)
+		if err != nil {
+			return nil, false, err
+		}
+		return call, true, nil
+	default:
+		return nil, false, nil
+	}
+}
+
+var (
+	transferMethodID                   = crypto.Keccak256Hash([]byte("transfer(address,uint256)")).Bytes()[0:4]
+	transferFromMethodID               = crypto.Keccak256Hash([]byte("transferFrom(address,address,uint256)")).Bytes()[0:4]
+	mintMethodID                       = crypto.Keccak256Hash([]byte("mint(address,uint256)")).Bytes()[0:4]
+	approveMethodID                    = crypto.Keccak256Hash([]byte("approve(address,uint256)")).Bytes()[0:4]
+	permit2ApproveMethodID             = crypto.Keccak256Hash([]byte("approve(address,address,uint160,uint48)")).Bytes()[0:4]
+	releaseMethodID                    = crypto.Keccak256Hash([]byte("release()")).Bytes()[0:4]
+	releaseTokenMethodID               = crypto.Keccak256Hash([]byte("release(address)")).Bytes()[0:4]
+	getRewardMethodID                  = crypto.Keccak256Hash([]byte("getReward()")).Bytes()[0:4]
+	merkleClaimMethodID                = crypto.Keccak256Hash([]byte("claim(uint256,address,uint256,bytes32[])")).Bytes()[0:4]
+	castVoteMethodID                   = crypto.Keccak256Hash([]byte("castVote(uint256,uint8)")).Bytes()[0:4]
+	proposeMethodID                    = crypto.Keccak256Hash([]byte("propose(address[],uint256[],bytes[],string)")).Bytes()[0:4]
+	safeExecTransactionMethodID        = crypto.Keccak256Hash([]byte("execTransaction(address,uint256,bytes,uint8,uint256,uint256,uint256,address,address,bytes)")).Bytes()[0:4]
+	multiSigSubmitTransactionMethodID  = crypto.Keccak256Hash([]byte("submitTransaction(address,uint256,bytes)")).Bytes()[0:4]
+	multiSigConfirmTransactionMethodID = crypto.Keccak256Hash([]byte("confirmTransaction(uint256)")).Bytes()[0:4]
+	aaveSupplyMethodID                 = crypto.Keccak256Hash([]byte("supply(address,uint256,address,uint16)")).Bytes()[0:4]
+	aaveBorrowMethodID                 = crypto.Keccak256Hash([]byte("borrow(address,uint256,uint256,uint16,address)")).Bytes()[0:4]
+	aaveRepayMethodID                  = crypto.Keccak256Hash([]byte("repay(address,uint256,uint256,address)")).Bytes()[0:4]
+	aaveWithdrawMethodID               = crypto.Keccak256Hash([]byte("withdraw(address,uint256,address)")).Bytes()[0:4]
+	createStreamMethodID               = crypto.Keccak256Hash([]byte("createStream(address,uint256,address,uint256,uint256)")).Bytes()[0:4]
+	depositERC20MethodID               = crypto.Keccak256Hash([]byte("depositERC20(address,address,uint256,uint32,bytes)")).Bytes()[0:4]
+	depositERC20ToMethodID             = crypto.Keccak256Hash([]byte("depositERC20To(address,address,address,uint256,uint32,bytes)")).Bytes()[0:4]
+	setApprovalForAllMethodID          = crypto.Keccak256Hash([]byte("setApprovalForAll(address,bool)")).Bytes()[0:4]
+)
+
+// rawUnpackERC20Transfer Unpack without use of Go ABI package. Assumes correct ERC20 payload formatting
+//
+// The recipient must be ABI encoded, i.e. left-padded with 12 zero bytes.
+// Tokens compiled with old Solidity versions (e.g. USDT) silently drop
+// non-zero padding while newer ones revert, so such calldata is rejected
+// rather than guessing the recipient. Trailing bytes after the amount are
+// ignored by the token and are accepted, but calldata shorter than 68
+// bytes is not: it would be right-padded with zeros by the EVM, shifting
+// the amount (short address attack).
+func rawUnpackERC20Transfer(txData []byte) (to *common.Address, amount *big.Int, err error) {
+	if !bytes.Equal(txData[0:4], transferMethodID) {
+		return nil, nil, fmt.Errorf("%w: expected ERC-20 transfer", ErrUnsupportedMethod)
+	}
+	args := txData[4:]
+	toAddr, err := abiAddress(args, 0)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid ERC-20 transfer: recipient: %w", err)
+	}
+	amount, err = abiUint(args, 1)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid ERC-20 transfer: amount: %w", err)
+	}
+	return &toAddr, amount, nil
+}
+
+func unpackTransferFrom(args []byte) (from, to common.Address, amount *big.Int, err error) {
+	if from, err = abiAddress(args, 0); err != nil {
+		return from, to, nil, fmt.Errorf("invalid transferFrom: %w", err)
+	}
+	if to, err = abiAddress(args, 1); err != nil {
+		return from, to, nil, fmt.Errorf("invalid transferFrom: %w", err)
+	}
+	if amount, err = abiUint(args, 2); err != nil {
+		return from, to, nil, fmt.Errorf("invalid transferFrom: %w", err)
+	}
+	return from, to, amount, nil
+}
+
+func unpackMint(args []byte) (to common.Address, amount *big.Int, err error) {
+	if len(args) != 2*32 {
+		return to, nil, fmt.Errorf("invalid mint: calldata is %d bytes, expected %d", len(args), 2*32)
+	}
+	if to, err = abiAddress(args, 0); err != nil {
+		return to, nil, fmt.Errorf("invalid mint: %w", err)
+	}
+	if amount, err = abiUint(args, 1); err != nil {
+		return to, nil, fmt.Errorf("invalid mint: %w", err)
+	}
+	return to, amount, nil
+}
+
+func unpackApproval(args []byte) (*ApprovalCall, error) {
+	spender, err := abiAddress(args, 0)
+	if err != nil {
+		return nil, fmt.Errorf("invalid approve: %w", err)
+	}
+	amount, err := abiUint(args, 1)
+	if err != nil {
+		return nil, fmt.Errorf("invalid approve: %w", err)
+	}
+	return &ApprovalCall{
+		Spender: spender,
+		Amount:  amount,
+	}, nil
+}
+
+func unpackPermit2Approval(args []byte) (*Permit2ApprovalCall, error) {
+	if len(args) != 4*32 {
+		return nil, fmt.Errorf("invalid Permit2 approve: calldata is %d bytes, expected %d", len(args), 4*32)
+	}
+	token, err := abiAddress(args, 0)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Permit2 approve: %w", err)
+	}
+	spender, err := abiAddress(args, 1)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Permit2 approve: %w", err)
+	}
+	amount, err := abiUint(args, 2)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Permit2 approve: %w", err)
+	}
+	if amount.BitLen() > 160 {
+		return nil, fmt.Errorf("invalid Permit2 approve: amount overflows uint160")
+	}
+	expiration, err := abiUint(args, 3)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Permit2 approve: %w", err)
+	}
+	if expiration.BitLen() > 48 {
+		return nil, fmt.Errorf("invalid Permit2 approve: expiration overflows uint48")
+	}
+	return &Permit2ApprovalCall{
+		Token:      token,
+		Spender:    spender,
+		Amount:     amount,
+		Expiration: expiration.Uint64(),
+	}, nil
+}
+
+func unpackMerkleClaim(args []byte) (*MerkleClaimCall, error) {
+	index, err := abiUint(args, 0)
+	if err != nil {
+		return nil, fmt.Errorf("invalid claim: %w", err)
+	}
+	account, err := abiAddress(args, 1)
+	if err != nil {
+		return nil, fmt.Errorf("invalid claim: %w", err)
+	}
+	amount, err := abiUint(args, 2)
+	if err != nil {
+		return nil, fmt.Errorf("invalid claim: %w", err)
+	}
+	proofLength, err := abiArrayLength(args, 3, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid claim: %w", err)
+	}
+	return &MerkleClaimCall{
+		Index:       index,
+		Account:     account,
+		Amount:      amount,
+		ProofLength: proofLength,
+	}, nil
+}
+
+func unpackCastVote(args []byte) (*GovernanceVoteCall, error) {
+	proposalID, err := abiUint(args, 0)
+	if err != nil {
+		return nil, fmt.Errorf("invalid castVote: %w", err)
+	}
+	support, err := abiUint(args, 1)
+	if err != nil {
+		return nil, fmt.Errorf("invalid castVote: %w", err)
+	}
+	if support.BitLen() > 8 {
+		return nil, fmt.Errorf("invalid castVote: support overflows uint8")
+	}
+	return &GovernanceVoteCall{
+		ProposalID: proposalID,
+		Support:    uint8(support.Uint64()),
+	}, nil
+}
+
+// proposeArguments are the arguments of Governor.propose, and also the ones
+// hashed (with the description hashed) to obtain the proposal ID.
+var proposeArguments = abi.Arguments{
+	{Type: mustABIType("address[]")},
+	{Type: mustABIType("uint256[]")},
+	{Type: mustABIType("bytes[]")},
+	{Type: mustABIType("string")},
+}
+
+var proposalIDArguments = abi.Arguments{
+	{Type: mustABIType("address[]")},
+	{Type: mustABIType("uint256[]")},
+	{Type: mustABIType("bytes[]")},
+	{Type: mustABIType("bytes32")},
+}
+
+func mustABIType(t string) abi.Type {
+	typ, err := abi.NewType(t, "", nil)
+	if err != nil {
+		panic(err)
+	}
+	return typ
+}
+
+func unpackPropose(args []byte) (*GovernanceProposeCall, error) {
+	values, err := proposeArguments.UnpackValues(args)
+	if err != nil {
+		return nil, fmt.Errorf("invalid propose: %w", err)
+	}
+	call := &GovernanceProposeCall{
+		Targets:     values[0].([]common.Address),
+		Values:      values[1].([]*big.Int),
+		Calldatas:   values[2].([][]byte),
+		Description: values[3].(string),
+	}
+	if len(call.Targets) != len(call.Values) || len(call.Targets) != len(call.Calldatas) {
+		return nil, fmt.Errorf("invalid propose: targets, values and calldatas have different lengths")
+	}
+
+	// proposalId = uint256(keccak256(abi.encode(targets, values, calldatas, keccak256(bytes(description)))))
+	descriptionHash := crypto.Keccak256Hash([]byte(call.Description))
+	encoded, err := proposalIDArguments.Pack(call.Targets, call.Values, call.Calldatas, descriptionHash)
+	if err != nil {
+		return nil, fmt.Errorf("invalid propose: %w", err)
+	}
+	call.ProposalID = new(big.Int).SetBytes(crypto.Keccak256(encoded))
+	return call, nil
+}
+
+var safeExecTransactionArguments = abi.Arguments{
+	{Type: mustABIType("address")}, // to
+	{Type: mustABIType("uint256")}, // value
+	{Type: mustABIType("bytes")},   // data
+	{Type: mustABIType("uint8")},   // operation
+	{Type: mustABIType("uint256")}, // safeTxGas
+	{Type: mustABIType("uint256")}, // baseGas
+	{Type: mustABIType("uint256")}, // gasPrice
+	{Type: mustABIType("address")}, // gasToken
+	{Type: mustABIType("address")}, // refundReceiver
+	{Type: mustABIType("bytes")},   // signatures
+}
+
+var (
+	aaveSupplyArguments = abi.Arguments{
+		{Type: mustABIType("address")}, // asset
+		{Type: mustABIType("uint256")}, // amount
+		{Type: mustABIType("address")}, // onBehalfOf
+		{Type: mustABIType("uint16")},  // referralCode
+	}
+	aaveBorrowArguments = abi.Arguments{
+		{Type: mustABIType("address")}, // asset
+		{Type: mustABIType("uint256")}, // amount
+		{Type: mustABIType("uint256")}, // interestRateMode
+		{Type: mustABIType("uint16")},  // referralCode
+		{Type: mustABIType("address")}, // onBehalfOf
+	}
+	aaveRepayArguments = abi.Arguments{
+		{Type: mustABIType("address")}, // asset
+		{Type: mustABIType("uint256")}, // amount
+		{Type: mustABIType("uint256")}, // interestRateMode
+		{Type: mustABIType("address")}, // onBehalfOf
+	}
+	aaveWithdrawArguments = abi.Arguments{
+		{Type: mustABIType("address")}, // asset
+		{Type: mustABIType("uint256")}, // amount
+		{Type: mustABIType("address")}, // to
+	}
+)
+
+// unpackLendingCall decodes a call to the Aave V3 Pool at address pool.
+// Supply and repay move the asset from the caller to the pool, withdraw
+// moves it from the pool to the recipient.
+func unpackLendingCall(pool common.Address, method []byte, args []byte) (*ethereumCall, bool, error) {
+	var (
+		arguments abi.Arguments
+		kind      TxKind
+		name      string
+	)
+	switch {
+	case bytes.Equal(method, aaveSupplyMethodID):
+		arguments, kind, name = aaveSupplyArguments, TxKindLendingSupply, "supply"
+	case bytes.Equal(method, aaveBorrowMethodID):
+		arguments, kind, name = aaveBorrowArguments, TxKindLendingBorrow, "borrow"
+	case bytes.Equal(method, aaveRepayMethodID):
+		arguments, kind, name = aaveRepayArguments, TxKindLendingRepay, "repay"
+	default:
+		arguments, kind, name = aaveWithdrawArguments, TxKindLendingWithdraw, "withdraw"
+	}
+
+	values, err := arguments.UnpackValues(args)
+	if err != nil {
+		return nil, false, fmt.Errorf("invalid %s: %w", name, err)
+	}
+
+	details := &LendingCall{
+		Asset:  values[0].(common.Address),
+		Amount: values[1].(*big.Int),
+	}
+	call := &ethereumCall{Kind: kind, Details: details}
+
+	switch kind {
+	case TxKindLendingSupply:
+		onBehalfOf := values[2].(common.Address)
+		details.OnBehalfOf = &onBehalfOf
+		call.To, call.Amount, call.Contract = &pool, details.Amount, &details.Asset
+	case TxKindLendingBorrow:
+		onBehalfOf := values[4].(common.Address)
+		details.OnBehalfOf = &onBehalfOf
+		details.InterestRateMode = values[2].(*big.Int)
+	case TxKindLendingRepay:
+		onBehalfOf := values[3].(common.Address)
+		details.OnBehalfOf = &onBehalfOf
+		details.InterestRateMode = values[2].(*big.Int)
+		call.To, call.Amount, call.Contract = &pool, details.Amount, &details.Asset
+	case TxKindLendingWithdraw:
+		recipient := values[2].(common.Address)
+		details.Recipient = &recipient
+		call.To, call.Amount, call.Contract = &recipient, details.Amount, &details.Asset
+	}
+	return call, true, nil
+}
+
+var createStreamArguments = abi.Arguments{
+	{Type: mustABIType("address")}, // recipient
+	{Type: mustABIType("uint256")}, // deposit
+	{Type: mustABIType("address")}, // tokenAddress
+	{Type: mustABIType("uint256")}, // startTime
+	{Type: mustABIType("uint256")}, // stopTime
+}
+
+func unpackCreateStream(args []byte) (*StreamCreateCall, error) {
+	values, err := createStreamArguments.UnpackValues(args)
+	if err != nil {
+		return nil, fmt.Errorf("invalid createStream: %w", err)
+	}
+	return &StreamCreateCall{
+		Recipient: values[0].(common.Address),
+		Deposit:   values[1].(*big.Int),
+		Token:     values[2].(common.Address),
+		StartTime: values[3].(*big.Int),
+		StopTime:  values[4].(*big.Int),
+	}, nil
+}
+
+var (
+	depositERC20Arguments = abi.Arguments{
+		{Type: mustABIType("address")}, // l1Token
+		{Type: mustABIType("address")}, // l2Token
+		{Type: mustABIType("uint256")}, // amount
+		{Type: mustABIType("uint32")},  // minGasLimit
+		{Type: mustABIType("bytes")},   // extraData
+	}
+	depositERC20ToArguments = abi.Arguments{
+		{Type: mustABIType("address")}, // l1Token
+		{Type: mustABIType("address")}, // l2Token
+		{Type: mustABIType("address")}, // to
+		{Type: mustABIType("uint256")}, // amount
+		{Type: mustABIType("uint32")},  // minGasLimit
+		{Type: mustABIType("bytes")},   // extraData
+	}
+)
+
+// unpackBridgeDeposit decodes a depositERC20 call, or a depositERC20To call
+// if withRecipient is set, made to the bridge at address bridge.
+func unpackBridgeDeposit(bridge common.Address, withRecipient bool, args []byte) (*BridgeDepositCall, error) {
+	arguments := depositERC20Arguments
+	if withRecipient {
+		arguments = depositERC20ToArguments
+	}
+	values, err := arguments.UnpackValues(args)
+	if err != nil {
+		return nil, fmt.Errorf("invalid bridge deposit: %w", err)
+	}
+
+	details := &BridgeDepositCall{
+		L1Token:            values[0].(common.Address),
+		L2Token:            values[1].(common.Address),
+		DestinationChainID: opStackBridges[bridge],
+	}
+	if withRecipient {
+		recipient := values[2].(common.Address)
+		details.Recipient = &recipient
+		values = values[1:]
+	}
+	details.Amount = values[2].(*big.Int)
+	details.MinGasLimit = values[3].(uint32)
+	details.ExtraData = values[4].([]byte)
+	return details, nil
+}
+
+// unpackSafeExecTransaction decodes a Gnosis Safe execTransaction call. If
+// the Safe performs a plain call, the inner transaction is decoded as well so
+// that the resulting call reflects the transfer actually performed by the
+// Safe (e.g. an ETH or ERC-20 transfer).
+func unpackSafeExecTransaction(args []byte) (*ethereumCall, bool, error) {
+	values, err := safeExecTransactionArguments.UnpackValues(args)
+	if err != nil {
+		return nil, false, fmt.Errorf("invalid execTransaction: %w", err)
+	}
+	safeTx := &SafeExecTransactionCall{
+		To:        values[0].(common.Address),
+		Value:     values[1].(*big.Int),
+		Data:      values[2].([]byte),
+		Operation: SafeOperation(values[3].(uint8)),
+	}
+
+	if safeTx.Operation != SafeOperationCall {
+		// a delegate call runs arbitrary code in the context of the Safe,
+		// its effects can't be determined
+		return &ethereumCall{Kind: TxKindContractCall, Details: safeTx}, true, nil
+	}
+
+	inner, err := parseInnerCall(safeTx.To, safeTx.Value, safeTx.Data)
+	if err != nil {
+		return nil, false, fmt.Errorf("invalid execTransaction inner call: %w", err)
+	}
+	safeTx.InnerDetails = inner.Details
+	inner.Details = safeTx
+	return inner, true, nil
+}
+
+var multiSigSubmitTransactionArguments = abi.Arguments{
+	{Type: mustABIType("address")}, // destination
+	{Type: mustABIType("uint256")}, // value
+	{Type: mustABIType("bytes")},   // data
+}
+
+// unpackMultiSigSubmitTransaction decodes a MultiSigWallet submitTransaction
+// call, classifying it by the transaction being submitted.
+func unpackMultiSigSubmitTransaction(args []byte) (*ethereumCall, error) {
+	values, err := multiSigSubmitTransactionArguments.UnpackValues(args)
+	if err != nil {
+		return nil, fmt.Errorf("invalid submitTransaction: %w", err)
+	}
+	submit := &MultiSigSubmitCall{
+		Destination: values[0].(common.Address),
+		Value:       values[1].(*big.Int),
+		Data:        values[2].([]byte),
+	}
+
+	inner, err := parseInnerCall(submit.Destination, submit.Value, submit.Data)
+	if err != nil {
+		return nil, fmt.Errorf("invalid submitTransaction inner call: %w", err)
+	}
+	submit.InnerDetails = inner.Details
+	inner.Details = submit
+	return inner, nil
+}
+
+// parseInnerCall classifies a call that a wallet contract (e.g. a multisig)
+// performs on behalf of the caller, sending value to address to with the
+// given data. The Details of the returned call are the ones of the inner
+// call, if it was recognised.
+func parseInnerCall(to common.Address, value *big.Int, data []byte) (*ethereumCall, error) {
+	if len(data) == 0 {
+		// the wallet contract is sending ETH
+		return &ethereumCall{Kind: TxKindTransfer, To: &to, Amount: value}, nil
+	}
+
+	inner, parsed, err := parseCallData(to, data)
+	if err != nil {
+		return nil, err
+	}
+	if !parsed {
+		return &ethereumCall{Kind: TxKindContractCall}, nil
+	}
+	return inner, nil
+}
+
+func unpackApprovalForAll(args []byte) (*ApprovalForAllCall, error) {
+	operator, err := abiAddress(args, 0)
+	if err != nil {
+		return nil, fmt.Errorf("invalid setApprovalForAll: %w", err)
+	}
+	approved, err := abiBool(args, 1)
+	if err != nil {
+		return nil, fmt.Errorf("invalid setApprovalForAll: %w", err)
+	}
+	return &ApprovalForAllCall{
+		Operator: operator,
+		Approved: approved,
+	}, nil
+}
+
+// The following helpers decode the static ABI types from the arguments of a
+// contract call (i.e. the calldata without the method ID), where i is the
+// index of the 32 bytes word to be decoded.
+
+func abiWord(args []byte, i int) ([]byte, error) {
+	if i < 0 || len(args) < (i+1)*32 {
+		return nil, fmt.Errorf("calldata too short: %d bytes, expected at least %d", len(args), (i+1)*32)
+	}
+	return args[i*32 : (i+1)*32], nil
+}
+
+func abiAddress(args []byte, i int) (common.Address, error) {
+	word, err := abiWord(args, i)
+	if err != nil {
+		return common.Address{}, err
+	}
+	if !isZero(word[:12]) {
+		return common.Address{}, fmt.Errorf("argument %d is not a valid address", i)
+	}
+	return common.BytesToAddress(word[12:]), nil
+}
+
+func abiUint(args []byte, i int) (*big.Int, error) {
+	word, err := abiWord(args, i)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(word), nil
+}
+
+func abiBool(args []byte, i int) (bool, error) {
+	word, err := abiWord(args, i)
+	if err != nil {
+		return false, err
+	}
+	if !isZero(word[:31]) || word[31] > 1 {
+		return false, fmt.Errorf("argument %d is not a valid bool", i)
+	}
+	return word[31] == 1, nil
+}
+
+// abiArrayLength decodes the length of a dynamic array whose offset is the
+// i-th word, checking that the elements (of elemSize bytes each) fit in the
+// arguments.
+func abiArrayLength(args []byte, i int, elemSize int) (int, error) {
+	offset, err := abiUint(args, i)
+	if err != nil {
+		return 0, err
+	}
+	if !offset.IsInt64() || offset.Int64() > int64(len(args)) {
+		return 0, fmt.Errorf("argument %d has an invalid offset", i)
+	}
+	lengthWord, err := abiUint(args[offset.Int64():], 0)
+	if err != nil {
+		return 0, fmt.Errorf("argument %d: %w", i, err)
+	}
+	available := (int64(len(args)) - offset.Int64() - 32) / int64(elemSize)
+	if !lengthWord.IsInt64() || lengthWord.Int64() > available {
+		return 0, fmt.Errorf("argument %d has an invalid length", i)
+	}
+	return int(lengthWord.Int64()), nil
+}
+
+func isZero(b []byte) bool {
+	for _, c := range b {
+		if c != 0 {
+			return false
+		}
+	}
+	return true
+}
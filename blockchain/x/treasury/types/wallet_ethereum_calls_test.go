@@ -0,0 +1,961 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package types
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/stretchr/testify/require"
+)
+
+// unsignedDynamicFeeTx builds an unsigned EIP-1559 transaction calling the
+// contract at the given address with the given calldata.
+func unsignedDynamicFeeTx(t *testing.T, to *common.Address, value *big.Int, data []byte) []byte {
+	t.Helper()
+	b, err := rlp.EncodeToBytes(&DynamicFeeTxWithoutSignature{
+		ChainID:   big.NewInt(1),
+		Nonce:     3,
+		GasTipCap: big.NewInt(1_000_000_000),
+		GasFeeCap: big.NewInt(30_000_000_000),
+		Gas:       100_000,
+		To:        to,
+		Value:     value,
+		Data:      data,
+	})
+	require.NoError(t, err)
+	return append([]byte{types.DynamicFeeTxType}, b...)
+}
+
+func Test_ParseEthereumTransaction_USDTTransfer(t *testing.T) {
+	usdt := common.HexToAddress("0xdAC17F958D2ee523a2206206994597C13D831ec7")
+	recipient := common.HexToAddress("0x48c04ed5691981C42154C6167398f95e8f38a7fF")
+
+	tests := []struct {
+		name       string
+		data       []byte
+		wantAmount *big.Int
+		wantErr    bool
+	}{
+		{
+			name:       "25 USDT",
+			data:       hexutil.MustDecode("0xa9059cbb00000000000000000000000048c04ed5691981c42154c6167398f95e8f38a7ff00000000000000000000000000000000000000000000000000000000017d7840"),
+			wantAmount: big.NewInt(25_000_000),
+		},
+		{
+			// address poisoning scams send zero amounts, see ErrDustTransfer
+			name:    "zero amount",
+			data:    hexutil.MustDecode("0xa9059cbb00000000000000000000000048c04ed5691981c42154c6167398f95e8f38a7ff0000000000000000000000000000000000000000000000000000000000000000"),
+			wantErr: true,
+		},
+		{
+			// some wallets append tracking data, ignored by the token
+			name:       "trailing bytes",
+			data:       hexutil.MustDecode("0xa9059cbb00000000000000000000000048c04ed5691981c42154c6167398f95e8f38a7ff00000000000000000000000000000000000000000000000000000000017d7840c0ffee"),
+			wantAmount: big.NewInt(25_000_000),
+		},
+		{
+			name:    "dirty recipient padding",
+			data:    hexutil.MustDecode("0xa9059cbb00000000000000000000000148c04ed5691981c42154c6167398f95e8f38a7ff00000000000000000000000000000000000000000000000000000000017d7840"),
+			wantErr: true,
+		},
+		{
+			// short address attack: the EVM would pad the amount with a zero byte
+			name:    "short calldata",
+			data:    hexutil.MustDecode("0xa9059cbb00000000000000000000000048c04ed5691981c42154c6167398f95e8f38a700000000000000000000000000000000000000000000000000000000017d7840"),
+			wantErr: true,
+		},
+		{
+			name:    "missing amount",
+			data:    hexutil.MustDecode("0xa9059cbb00000000000000000000000048c04ed5691981c42154c6167398f95e8f38a7ff"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx, err := ParseEthereumTransaction(unsignedDynamicFeeTx(t, &usdt, big.NewInt(0), tt.data), big.NewInt(1))
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, TxKindTransfer, tx.Kind)
+			require.Equal(t, recipient, *tx.To)
+			require.Equal(t, usdt, *tx.Contract)
+			require.Zero(t, tt.wantAmount.Cmp(tx.Amount))
+		})
+	}
+}
+
+func Test_ParseEthereumTransaction_Approval(t *testing.T) {
+	token := common.HexToAddress("0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48")
+
+	tests := []struct {
+		name       string
+		data       []byte
+		wantKind   TxKind
+		wantAmount *big.Int
+		wantErr    bool
+	}{
+		{
+			name:       "grant",
+			data:       hexutil.MustDecode("0x095ea7b3000000000000000000000000000000000022d473030f116ddee9f6b43ac78ba300000000000000000000000000000000000000000000000000000000017d7840"),
+			wantKind:   TxKindApproval,
+			wantAmount: big.NewInt(25000000),
+		},
+		{
+			name:       "revoke",
+			data:       hexutil.MustDecode("0x095ea7b3000000000000000000000000000000000022d473030f116ddee9f6b43ac78ba30000000000000000000000000000000000000000000000000000000000000000"),
+			wantKind:   TxKindApprovalRevoke,
+			wantAmount: big.NewInt(0),
+		},
+		{
+			name:    "truncated calldata",
+			data:    hexutil.MustDecode("0x095ea7b3000000000000000000000000000000000022d473030f116ddee9f6b43ac78ba3"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx, err := ParseEthereumTransaction(unsignedDynamicFeeTx(t, &token, big.NewInt(0), tt.data), big.NewInt(1))
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.wantKind, tx.Kind)
+			require.Equal(t, token, *tx.To)
+			require.Equal(t, token, *tx.Contract)
+
+			details, ok := tx.Details.(*ApprovalCall)
+			require.True(t, ok)
+			require.Equal(t, "0x000000000022D473030F116dDEE9F6B43aC78BA3", details.Spender.Hex())
+			require.Zero(t, tt.wantAmount.Cmp(details.Amount))
+		})
+	}
+}
+
+func Test_ParseEthereumTransaction_Permit2Approval(t *testing.T) {
+	permit2 := common.HexToAddress("0x000000000022D473030F116dDEE9F6B43aC78BA3")
+	maxUint160 := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 160), big.NewInt(1))
+
+	tests := []struct {
+		name    string
+		data    []byte
+		wantErr bool
+	}{
+		{
+			name: "approve",
+			data: hexutil.MustDecode("0x87517c45000000000000000000000000a0b86991c6218b36c1d19d4a2e9eb0ce3606eb480000000000000000000000003fc91a3afd70395cd496c647d5a6cc9d4b2b7fad000000000000000000000000ffffffffffffffffffffffffffffffffffffffff000000000000000000000000000000000000000000000000000000006553f100"),
+		},
+		{
+			name:    "truncated calldata",
+			data:    hexutil.MustDecode("0x87517c45000000000000000000000000a0b86991c6218b36c1d19d4a2e9eb0ce3606eb480000000000000000000000003fc91a3afd70395cd496c647d5a6cc9d4b2b7fad000000000000000000000000ffffffffffffffffffffffffffffffffffffffff"),
+			wantErr: true,
+		},
+		{
+			name:    "trailing calldata",
+			data:    hexutil.MustDecode("0x87517c45000000000000000000000000a0b86991c6218b36c1d19d4a2e9eb0ce3606eb480000000000000000000000003fc91a3afd70395cd496c647d5a6cc9d4b2b7fad000000000000000000000000ffffffffffffffffffffffffffffffffffffffff000000000000000000000000000000000000000000000000000000006553f100000000000000000000000000000000000000000000000000000000006553f100"),
+			wantErr: true,
+		},
+		{
+			name:    "amount overflows uint160",
+			data:    hexutil.MustDecode("0x87517c45000000000000000000000000a0b86991c6218b36c1d19d4a2e9eb0ce3606eb480000000000000000000000003fc91a3afd70395cd496c647d5a6cc9d4b2b7fad0000000000000000000000010000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000006553f100"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx, err := ParseEthereumTransaction(unsignedDynamicFeeTx(t, &permit2, big.NewInt(0), tt.data), big.NewInt(1))
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, TxKindPermit2Approval, tx.Kind)
+			require.Equal(t, permit2, *tx.To)
+
+			details, ok := tx.Details.(*Permit2ApprovalCall)
+			require.True(t, ok)
+			require.Equal(t, "0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48", details.Token.Hex())
+			require.Equal(t, "0x3fC91A3afd70395Cd496C647d5a6CC9D4B2b7FAD", details.Spender.Hex())
+			require.Zero(t, maxUint160.Cmp(details.Amount))
+			require.Equal(t, uint64(1700000000), details.Expiration)
+		})
+	}
+}
+
+func Test_ParseEthereumTransaction_VestingRelease(t *testing.T) {
+	vesting := common.HexToAddress("0x2a1530C4C41db0B0b2bB646CB5Eb1A67b7158667")
+	usdc := common.HexToAddress("0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48")
+
+	tests := []struct {
+		name      string
+		data      []byte
+		wantToken *common.Address
+		wantErr   bool
+	}{
+		{
+			name: "release",
+			data: hexutil.MustDecode("0x86d1a69f"),
+		},
+		{
+			name:      "release token",
+			data:      hexutil.MustDecode("0x19165587000000000000000000000000a0b86991c6218b36c1d19d4a2e9eb0ce3606eb48"),
+			wantToken: &usdc,
+		},
+		{
+			name:    "truncated calldata",
+			data:    hexutil.MustDecode("0x19165587000000000000000000000000a0b86991c6218b36c1d19d4a2e9e"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx, err := ParseEthereumTransaction(unsignedDynamicFeeTx(t, &vesting, big.NewInt(0), tt.data), big.NewInt(1))
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, TxKindVestingRelease, tx.Kind)
+			require.Equal(t, vesting, *tx.To)
+
+			details, ok := tx.Details.(*VestingReleaseCall)
+			require.True(t, ok)
+			require.Equal(t, tt.wantToken, details.Token)
+		})
+	}
+}
+
+func Test_ParseEthereumTransaction_RewardClaim(t *testing.T) {
+	staking := common.HexToAddress("0xA65405e0dD378C65308deAE51dA9e3BcEBb81261")
+
+	tx, err := ParseEthereumTransaction(unsignedDynamicFeeTx(t, &staking, big.NewInt(0), hexutil.MustDecode("0x3d18b912")), big.NewInt(1))
+	require.NoError(t, err)
+	require.Equal(t, TxKindRewardClaim, tx.Kind)
+	require.Equal(t, staking, *tx.To)
+	require.Equal(t, staking, *tx.Contract)
+	require.Zero(t, tx.Amount.Sign())
+
+	details, ok := tx.Details.(*RewardClaimCall)
+	require.True(t, ok)
+	require.Equal(t, staking, details.StakingContract)
+}
+
+func Test_ParseEthereumTransaction_MerkleClaim(t *testing.T) {
+	distributor := common.HexToAddress("0x090D4613473dEE047c3f2706764f49E0821D256e")
+
+	tests := []struct {
+		name    string
+		data    []byte
+		wantErr bool
+	}{
+		{
+			name: "claim",
+			data: hexutil.MustDecode("0x2e7ba6ef000000000000000000000000000000000000000000000000000000000000000500000000000000000000000048c04ed5691981c42154c6167398f95e8f38a7ff0000000000000000000000000000000000000000000000000de0b6b3a76400000000000000000000000000000000000000000000000000000000000000000080000000000000000000000000000000000000000000000000000000000000000211111111111111111111111111111111111111111111111111111111111111112222222222222222222222222222222222222222222222222222222222222222"),
+		},
+		{
+			name:    "truncated proof",
+			data:    hexutil.MustDecode("0x2e7ba6ef000000000000000000000000000000000000000000000000000000000000000500000000000000000000000048c04ed5691981c42154c6167398f95e8f38a7ff0000000000000000000000000000000000000000000000000de0b6b3a7640000000000000000000000000000000000000000000000000000000000000000008000000000000000000000000000000000000000000000000000000000000000021111111111111111111111111111111111111111111111111111111111111111"),
+			wantErr: true,
+		},
+		{
+			name:    "proof offset out of bounds",
+			data:    hexutil.MustDecode("0x2e7ba6ef000000000000000000000000000000000000000000000000000000000000000500000000000000000000000048c04ed5691981c42154c6167398f95e8f38a7ff0000000000000000000000000000000000000000000000000de0b6b3a76400000000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000000000211111111111111111111111111111111111111111111111111111111111111112222222222222222222222222222222222222222222222222222222222222222"),
+			wantErr: true,
+		},
+		{
+			name:    "missing proof",
+			data:    hexutil.MustDecode("0x2e7ba6ef000000000000000000000000000000000000000000000000000000000000000500000000000000000000000048c04ed5691981c42154c6167398f95e8f38a7ff0000000000000000000000000000000000000000000000000de0b6b3a7640000"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx, err := ParseEthereumTransaction(unsignedDynamicFeeTx(t, &distributor, big.NewInt(0), tt.data), big.NewInt(1))
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, TxKindMerkleClaim, tx.Kind)
+			require.Equal(t, distributor, *tx.To)
+
+			details, ok := tx.Details.(*MerkleClaimCall)
+			require.True(t, ok)
+			require.Equal(t, int64(5), details.Index.Int64())
+			require.Equal(t, "0x48c04ed5691981C42154C6167398f95e8f38a7fF", details.Account.Hex())
+			require.Equal(t, "1000000000000000000", details.Amount.String())
+			require.Equal(t, 2, details.ProofLength)
+		})
+	}
+}
+
+func Test_ParseEthereumTransaction_GovernanceVote(t *testing.T) {
+	governor := common.HexToAddress("0x408ED6354d4973f66138C91495F2f2FCbd8724C3")
+
+	tests := []struct {
+		name        string
+		data        []byte
+		wantSupport uint8
+		wantErr     bool
+	}{
+		{
+			name:        "vote for",
+			data:        hexutil.MustDecode("0x567813880000000000000000000000000000000000000000000000000000000000000042" + "0000000000000000000000000000000000000000000000000000000000000001"),
+			wantSupport: 1,
+		},
+		{
+			name:        "abstain",
+			data:        hexutil.MustDecode("0x567813880000000000000000000000000000000000000000000000000000000000000042" + "0000000000000000000000000000000000000000000000000000000000000002"),
+			wantSupport: 2,
+		},
+		{
+			name:    "support overflows uint8",
+			data:    hexutil.MustDecode("0x567813880000000000000000000000000000000000000000000000000000000000000042" + "0000000000000000000000000000000000000000000000000000000000000100"),
+			wantErr: true,
+		},
+		{
+			name:    "truncated calldata",
+			data:    hexutil.MustDecode("0x567813880000000000000000000000000000000000000000000000000000000000000042"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx, err := ParseEthereumTransaction(unsignedDynamicFeeTx(t, &governor, big.NewInt(0), tt.data), big.NewInt(1))
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, TxKindGovernanceVote, tx.Kind)
+			require.Equal(t, governor, *tx.To)
+
+			details, ok := tx.Details.(*GovernanceVoteCall)
+			require.True(t, ok)
+			require.Equal(t, int64(0x42), details.ProposalID.Int64())
+			require.Equal(t, tt.wantSupport, details.Support)
+		})
+	}
+}
+
+const governorABIJSON = `[
+	{"type": "function", "name": "propose", "inputs": [
+		{"name": "targets", "type": "address[]"},
+		{"name": "values", "type": "uint256[]"},
+		{"name": "calldatas", "type": "bytes[]"},
+		{"name": "description", "type": "string"}
+	], "outputs": [{"name": "", "type": "uint256"}]},
+	{"type": "function", "name": "hashProposal", "inputs": [
+		{"name": "targets", "type": "address[]"},
+		{"name": "values", "type": "uint256[]"},
+		{"name": "calldatas", "type": "bytes[]"},
+		{"name": "descriptionHash", "type": "bytes32"}
+	], "outputs": [{"name": "", "type": "uint256"}]}
+]`
+
+func Test_ParseEthereumTransaction_GovernancePropose(t *testing.T) {
+	governor := common.HexToAddress("0x408ED6354d4973f66138C91495F2f2FCbd8724C3")
+	governorABI, err := abi.JSON(strings.NewReader(governorABIJSON))
+	require.NoError(t, err)
+
+	// a proposal to transfer 1000 USDC from the treasury
+	usdc := common.HexToAddress("0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48")
+	targets := []common.Address{usdc}
+	values := []*big.Int{big.NewInt(0)}
+	calldatas := [][]byte{hexutil.MustDecode("0xa9059cbb00000000000000000000000048c04ed5691981c42154c6167398f95e8f38a7ff000000000000000000000000000000000000000000000000000000003b9aca00")}
+	description := "# Grant\nTransfer 1000 USDC to the grants multisig"
+
+	data, err := governorABI.Pack("propose", targets, values, calldatas, description)
+	require.NoError(t, err)
+
+	hashInput, err := governorABI.Methods["hashProposal"].Inputs.Pack(targets, values, calldatas, crypto.Keccak256Hash([]byte(description)))
+	require.NoError(t, err)
+	wantProposalID := new(big.Int).SetBytes(crypto.Keccak256(hashInput))
+
+	tx, err := ParseEthereumTransaction(unsignedDynamicFeeTx(t, &governor, big.NewInt(0), data), big.NewInt(1))
+	require.NoError(t, err)
+	require.Equal(t, TxKindGovernancePropose, tx.Kind)
+	require.Equal(t, governor, *tx.To)
+
+	details, ok := tx.Details.(*GovernanceProposeCall)
+	require.True(t, ok)
+	require.Equal(t, wantProposalID, details.ProposalID)
+	require.Equal(t, targets, details.Targets)
+	require.Equal(t, calldatas, details.Calldatas)
+	require.Equal(t, description, details.Description)
+
+	t.Run("mismatching lengths", func(t *testing.T) {
+		data, err := governorABI.Pack("propose", targets, []*big.Int{}, calldatas, description)
+		require.NoError(t, err)
+		_, err = ParseEthereumTransaction(unsignedDynamicFeeTx(t, &governor, big.NewInt(0), data), big.NewInt(1))
+		require.Error(t, err)
+	})
+
+	t.Run("truncated calldata", func(t *testing.T) {
+		_, err := ParseEthereumTransaction(unsignedDynamicFeeTx(t, &governor, big.NewInt(0), data[:100]), big.NewInt(1))
+		require.Error(t, err)
+	})
+}
+
+func Test_ParseEthereumTransaction_ApprovalForAll(t *testing.T) {
+	collection := common.HexToAddress("0xBC4CA0EdA7647A8aB7C2061c2E118A18a936f13D")
+
+	tests := []struct {
+		name         string
+		data         []byte
+		wantOperator string
+		wantApproved bool
+		wantErr      bool
+	}{
+		{
+			name:         "approve all",
+			data:         hexutil.MustDecode("0xa22cb4650000000000000000000000001e0049783f008a0085193e00003d00cd54003c710000000000000000000000000000000000000000000000000000000000000001"),
+			wantOperator: "0x1E0049783F008A0085193E00003D00cd54003c71",
+			wantApproved: true,
+		},
+		{
+			name:         "revoke all",
+			data:         hexutil.MustDecode("0xa22cb4650000000000000000000000001e0049783f008a0085193e00003d00cd54003c710000000000000000000000000000000000000000000000000000000000000000"),
+			wantOperator: "0x1E0049783F008A0085193E00003D00cd54003c71",
+			wantApproved: false,
+		},
+		{
+			name:    "invalid bool",
+			data:    hexutil.MustDecode("0xa22cb4650000000000000000000000001e0049783f008a0085193e00003d00cd54003c710000000000000000000000000000000000000000000000000000000000000002"),
+			wantErr: true,
+		},
+		{
+			name:    "truncated calldata",
+			data:    hexutil.MustDecode("0xa22cb4650000000000000000000000001e0049783f008a0085193e00003d00cd54003c71"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx, err := ParseEthereumTransaction(unsignedDynamicFeeTx(t, &collection, big.NewInt(0), tt.data), big.NewInt(1))
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, TxKindApprovalForAll, tx.Kind)
+			require.Equal(t, collection, *tx.To)
+			require.Equal(t, collection, *tx.Contract)
+			require.Equal(t, int64(0), tx.Amount.Int64())
+
+			details, ok := tx.Details.(*ApprovalForAllCall)
+			require.True(t, ok)
+			require.Equal(t, tt.wantOperator, details.Operator.Hex())
+			require.Equal(t, tt.wantApproved, details.Approved)
+		})
+	}
+}
+
+const safeABIJSON = `[
+	{"type": "function", "name": "execTransaction", "inputs": [
+		{"name": "to", "type": "address"},
+		{"name": "value", "type": "uint256"},
+		{"name": "data", "type": "bytes"},
+		{"name": "operation", "type": "uint8"},
+		{"name": "safeTxGas", "type": "uint256"},
+		{"name": "baseGas", "type": "uint256"},
+		{"name": "gasPrice", "type": "uint256"},
+		{"name": "gasToken", "type": "address"},
+		{"name": "refundReceiver", "type": "address"},
+		{"name": "signatures", "type": "bytes"}
+	], "outputs": [{"name": "success", "type": "bool"}]}
+]`
+
+func Test_ParseEthereumTransaction_SafeExecTransaction(t *testing.T) {
+	safe := common.HexToAddress("0x849D52316331967b6fF1198e5E32A0eB168D039d")
+	usdc := common.HexToAddress("0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48")
+	recipient := common.HexToAddress("0x48c04ed5691981C42154C6167398f95e8f38a7fF")
+	safeABI, err := abi.JSON(strings.NewReader(safeABIJSON))
+	require.NoError(t, err)
+
+	// approved hash signature of the single owner of the Safe
+	signatures := hexutil.MustDecode("0x000000000000000000000000dd1d3ff09c5edff1be7d466ca614cb1cf3f78738000000000000000000000000000000000000000000000000000000000000000001")
+	execTransaction := func(to common.Address, value *big.Int, data []byte, operation uint8) []byte {
+		b, err := safeABI.Pack("execTransaction", to, value, data, operation, big.NewInt(0), big.NewInt(0), big.NewInt(0), common.Address{}, common.Address{}, signatures)
+		require.NoError(t, err)
+		return b
+	}
+	erc20Transfer := hexutil.MustDecode("0xa9059cbb00000000000000000000000048c04ed5691981c42154c6167398f95e8f38a7ff000000000000000000000000000000000000000000000000000000003b9aca00")
+
+	tests := []struct {
+		name         string
+		data         []byte
+		wantKind     TxKind
+		wantTo       common.Address
+		wantAmount   *big.Int
+		wantContract *common.Address
+	}{
+		{
+			name:       "native transfer",
+			data:       execTransaction(recipient, big.NewInt(1_500_000_000_000_000_000), nil, 0),
+			wantKind:   TxKindTransfer,
+			wantTo:     recipient,
+			wantAmount: big.NewInt(1_500_000_000_000_000_000),
+		},
+		{
+			name:         "erc-20 transfer",
+			data:         execTransaction(usdc, big.NewInt(0), erc20Transfer, 0),
+			wantKind:     TxKindTransfer,
+			wantTo:       recipient,
+			wantAmount:   big.NewInt(1_000_000_000),
+			wantContract: &usdc,
+		},
+		{
+			name:         "unknown inner call",
+			data:         execTransaction(usdc, big.NewInt(0), hexutil.MustDecode("0x12345678"), 0),
+			wantKind:     TxKindContractCall,
+			wantTo:       safe,
+			wantAmount:   big.NewInt(0),
+			wantContract: &safe,
+		},
+		{
+			name:         "delegate call",
+			data:         execTransaction(usdc, big.NewInt(0), erc20Transfer, 1),
+			wantKind:     TxKindContractCall,
+			wantTo:       safe,
+			wantAmount:   big.NewInt(0),
+			wantContract: &safe,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx, err := ParseEthereumTransaction(unsignedDynamicFeeTx(t, &safe, big.NewInt(0), tt.data), big.NewInt(1))
+			require.NoError(t, err)
+			require.Equal(t, tt.wantKind, tx.Kind)
+			require.Equal(t, tt.wantTo, *tx.To)
+			require.Zero(t, tt.wantAmount.Cmp(tx.Amount), "got amount %v", tx.Amount)
+			require.Equal(t, tt.wantContract, tx.Contract)
+
+			details, ok := tx.Details.(*SafeExecTransactionCall)
+			require.True(t, ok)
+			require.Equal(t, tt.data[4:36], common.LeftPadBytes(details.To.Bytes(), 32))
+		})
+	}
+
+	t.Run("erc-20 transfer details", func(t *testing.T) {
+		tx, err := ParseEthereumTransaction(unsignedDynamicFeeTx(t, &safe, big.NewInt(0), execTransaction(usdc, big.NewInt(0), erc20Transfer, 0)), big.NewInt(1))
+		require.NoError(t, err)
+		details := tx.Details.(*SafeExecTransactionCall)
+		require.Equal(t, usdc, details.To)
+		require.Equal(t, SafeOperationCall, details.Operation)
+		require.Equal(t, erc20Transfer, details.Data)
+	})
+
+	t.Run("truncated calldata", func(t *testing.T) {
+		data := execTransaction(recipient, big.NewInt(1), nil, 0)
+		_, err := ParseEthereumTransaction(unsignedDynamicFeeTx(t, &safe, big.NewInt(0), data[:200]), big.NewInt(1))
+		require.Error(t, err)
+	})
+
+	t.Run("invalid inner call", func(t *testing.T) {
+		data := execTransaction(usdc, big.NewInt(0), erc20Transfer[:40], 0)
+		_, err := ParseEthereumTransaction(unsignedDynamicFeeTx(t, &safe, big.NewInt(0), data), big.NewInt(1))
+		require.Error(t, err)
+	})
+}
+
+const l1StandardBridgeABIJSON = `[
+	{"type": "function", "name": "depositERC20", "inputs": [
+		{"name": "_l1Token", "type": "address"},
+		{"name": "_l2Token", "type": "address"},
+		{"name": "_amount", "type": "uint256"},
+		{"name": "_minGasLimit", "type": "uint32"},
+		{"name": "_extraData", "type": "bytes"}
+	], "outputs": []},
+	{"type": "function", "name": "depositERC20To", "inputs": [
+		{"name": "_l1Token", "type": "address"},
+		{"name": "_l2Token", "type": "address"},
+		{"name": "_to", "type": "address"},
+		{"name": "_amount", "type": "uint256"},
+		{"name": "_minGasLimit", "type": "uint32"},
+		{"name": "_extraData", "type": "bytes"}
+	], "outputs": []}
+]`
+
+func Test_ParseEthereumTransaction_BridgeDeposit(t *testing.T) {
+	bridgeABI, err := abi.JSON(strings.NewReader(l1StandardBridgeABIJSON))
+	require.NoError(t, err)
+
+	opBridge := common.HexToAddress("0x99C9fc46f92E8a1c0deC1b1747d010903E884bE1")
+	unknownBridge := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	usdcL1 := common.HexToAddress("0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48")
+	usdcL2 := common.HexToAddress("0x7F5c764cBc14f9669B88837ca1490cCa17c31607")
+	recipient := common.HexToAddress("0x48c04ed5691981C42154C6167398f95e8f38a7fF")
+	amount := big.NewInt(250_000_000)
+
+	depositERC20, err := bridgeABI.Pack("depositERC20", usdcL1, usdcL2, amount, uint32(200_000), []byte{})
+	require.NoError(t, err)
+	require.Equal(t, "0x58a997f6", hexutil.Encode(depositERC20[:4]))
+	depositERC20To, err := bridgeABI.Pack("depositERC20To", usdcL1, usdcL2, recipient, amount, uint32(200_000), []byte{0xca, 0xfe})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name          string
+		bridge        common.Address
+		data          []byte
+		wantRecipient *common.Address
+		wantChainID   uint64
+		wantExtraData []byte
+	}{
+		{name: "depositERC20", bridge: opBridge, data: depositERC20, wantChainID: 10, wantExtraData: []byte{}},
+		{name: "depositERC20To", bridge: opBridge, data: depositERC20To, wantRecipient: &recipient, wantChainID: 10, wantExtraData: []byte{0xca, 0xfe}},
+		{name: "unknown bridge", bridge: unknownBridge, data: depositERC20, wantChainID: 0, wantExtraData: []byte{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx, err := ParseEthereumTransaction(unsignedDynamicFeeTx(t, &tt.bridge, big.NewInt(0), tt.data), big.NewInt(1))
+			require.NoError(t, err)
+			require.Equal(t, TxKindBridgeDeposit, tx.Kind)
+			require.Equal(t, tt.bridge, *tx.To)
+			require.Equal(t, amount, tx.Amount)
+			require.Equal(t, usdcL1, *tx.Contract)
+
+			details, ok := tx.Details.(*BridgeDepositCall)
+			require.True(t, ok)
+			require.Equal(t, usdcL1, details.L1Token)
+			require.Equal(t, usdcL2, details.L2Token)
+			require.Equal(t, amount, details.Amount)
+			require.Equal(t, tt.wantRecipient, details.Recipient)
+			require.Equal(t, tt.wantChainID, details.DestinationChainID)
+			require.Equal(t, uint32(200_000), details.MinGasLimit)
+			require.Equal(t, tt.wantExtraData, details.ExtraData)
+		})
+	}
+
+	t.Run("truncated calldata", func(t *testing.T) {
+		_, err := ParseEthereumTransaction(unsignedDynamicFeeTx(t, &opBridge, big.NewInt(0), depositERC20[:100]), big.NewInt(1))
+		require.Error(t, err)
+	})
+}
+
+const multiSigWalletABIJSON = `[
+	{"type": "function", "name": "submitTransaction", "inputs": [
+		{"name": "destination", "type": "address"},
+		{"name": "value", "type": "uint256"},
+		{"name": "data", "type": "bytes"}
+	], "outputs": [{"name": "transactionId", "type": "uint256"}]},
+	{"type": "function", "name": "confirmTransaction", "inputs": [
+		{"name": "transactionId", "type": "uint256"}
+	], "outputs": []}
+]`
+
+func Test_ParseEthereumTransaction_MultiSig(t *testing.T) {
+	multiSig := common.HexToAddress("0x851b7F3Ab81bd8dF354F0D7640EFcD7288553419")
+	usdc := common.HexToAddress("0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48")
+	recipient := common.HexToAddress("0x48c04ed5691981C42154C6167398f95e8f38a7fF")
+	multiSigABI, err := abi.JSON(strings.NewReader(multiSigWalletABIJSON))
+	require.NoError(t, err)
+
+	submit := func(destination common.Address, value *big.Int, data []byte) []byte {
+		b, err := multiSigABI.Pack("submitTransaction", destination, value, data)
+		require.NoError(t, err)
+		return b
+	}
+	erc20Transfer := hexutil.MustDecode("0xa9059cbb00000000000000000000000048c04ed5691981c42154c6167398f95e8f38a7ff000000000000000000000000000000000000000000000000000000003b9aca00")
+	erc20Approve := hexutil.MustDecode("0x095ea7b300000000000000000000000048c04ed5691981c42154c6167398f95e8f38a7ff000000000000000000000000000000000000000000000000000000003b9aca00")
+
+	tests := []struct {
+		name             string
+		data             []byte
+		wantKind         TxKind
+		wantTo           common.Address
+		wantAmount       *big.Int
+		wantContract     *common.Address
+		wantInnerDetails any
+	}{
+		{
+			name:       "submit native transfer",
+			data:       submit(recipient, big.NewInt(2_000_000_000_000_000_000), nil),
+			wantKind:   TxKindTransfer,
+			wantTo:     recipient,
+			wantAmount: big.NewInt(2_000_000_000_000_000_000),
+		},
+		{
+			name:         "submit erc-20 transfer",
+			data:         submit(usdc, big.NewInt(0), erc20Transfer),
+			wantKind:     TxKindTransfer,
+			wantTo:       recipient,
+			wantAmount:   big.NewInt(1_000_000_000),
+			wantContract: &usdc,
+		},
+		{
+			name:             "submit erc-20 approval",
+			data:             submit(usdc, big.NewInt(0), erc20Approve),
+			wantKind:         TxKindApproval,
+			wantTo:           multiSig,
+			wantAmount:       big.NewInt(0),
+			wantContract:     &multiSig,
+			wantInnerDetails: &ApprovalCall{Spender: recipient, Amount: big.NewInt(1_000_000_000)},
+		},
+		{
+			name:         "submit unknown call",
+			data:         submit(usdc, big.NewInt(0), hexutil.MustDecode("0x12345678")),
+			wantKind:     TxKindContractCall,
+			wantTo:       multiSig,
+			wantAmount:   big.NewInt(0),
+			wantContract: &multiSig,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx, err := ParseEthereumTransaction(unsignedDynamicFeeTx(t, &multiSig, big.NewInt(0), tt.data), big.NewInt(1))
+			require.NoError(t, err)
+			require.Equal(t, tt.wantKind, tx.Kind)
+			require.Equal(t, tt.wantTo, *tx.To)
+			require.Zero(t, tt.wantAmount.Cmp(tx.Amount), "got amount %v", tx.Amount)
+			require.Equal(t, tt.wantContract, tx.Contract)
+
+			details, ok := tx.Details.(*MultiSigSubmitCall)
+			require.True(t, ok)
+			if tt.wantInnerDetails != nil {
+				require.Equal(t, tt.wantInnerDetails, details.InnerDetails)
+			}
+		})
+	}
+
+	t.Run("confirm", func(t *testing.T) {
+		data, err := multiSigABI.Pack("confirmTransaction", big.NewInt(117))
+		require.NoError(t, err)
+
+		tx, err := ParseEthereumTransaction(unsignedDynamicFeeTx(t, &multiSig, big.NewInt(0), data), big.NewInt(1))
+		require.NoError(t, err)
+		require.Equal(t, TxKindMultiSigConfirm, tx.Kind)
+		require.Equal(t, multiSig, *tx.To)
+
+		details, ok := tx.Details.(*MultiSigConfirmCall)
+		require.True(t, ok)
+		require.Equal(t, int64(117), details.TransactionID.Int64())
+	})
+
+	t.Run("truncated confirm", func(t *testing.T) {
+		data, err := multiSigABI.Pack("confirmTransaction", big.NewInt(117))
+		require.NoError(t, err)
+		_, err = ParseEthereumTransaction(unsignedDynamicFeeTx(t, &multiSig, big.NewInt(0), data[:20]), big.NewInt(1))
+		require.Error(t, err)
+	})
+
+	t.Run("truncated submit", func(t *testing.T) {
+		data := submit(usdc, big.NewInt(0), erc20Transfer)
+		_, err := ParseEthereumTransaction(unsignedDynamicFeeTx(t, &multiSig, big.NewInt(0), data[:90]), big.NewInt(1))
+		require.Error(t, err)
+	})
+}
+
+const aavePoolABIJSON = `[
+	{"type": "function", "name": "supply", "inputs": [
+		{"name": "asset", "type": "address"},
+		{"name": "amount", "type": "uint256"},
+		{"name": "onBehalfOf", "type": "address"},
+		{"name": "referralCode", "type": "uint16"}
+	], "outputs": []},
+	{"type": "function", "name": "borrow", "inputs": [
+		{"name": "asset", "type": "address"},
+		{"name": "amount", "type": "uint256"},
+		{"name": "interestRateMode", "type": "uint256"},
+		{"name": "referralCode", "type": "uint16"},
+		{"name": "onBehalfOf", "type": "address"}
+	], "outputs": []},
+	{"type": "function", "name": "repay", "inputs": [
+		{"name": "asset", "type": "address"},
+		{"name": "amount", "type": "uint256"},
+		{"name": "interestRateMode", "type": "uint256"},
+		{"name": "onBehalfOf", "type": "address"}
+	], "outputs": [{"name": "", "type": "uint256"}]},
+	{"type": "function", "name": "withdraw", "inputs": [
+		{"name": "asset", "type": "address"},
+		{"name": "amount", "type": "uint256"},
+		{"name": "to", "type": "address"}
+	], "outputs": [{"name": "", "type": "uint256"}]}
+]`
+
+func Test_ParseEthereumTransaction_Lending(t *testing.T) {
+	poolABI, err := abi.JSON(strings.NewReader(aavePoolABIJSON))
+	require.NoError(t, err)
+
+	pool := common.HexToAddress("0x87870Bca3F3fD6335C3F4ce8392D69350B4fA4E2")
+	usdc := common.HexToAddress("0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48")
+	account := common.HexToAddress("0x48c04ed5691981C42154C6167398f95e8f38a7fF")
+	amount := big.NewInt(1_000_000_000)
+	variableRate := big.NewInt(2)
+
+	pack := func(method string, args ...interface{}) []byte {
+		b, err := poolABI.Pack(method, args...)
+		require.NoError(t, err)
+		return b
+	}
+	supply := pack("supply", usdc, amount, account, uint16(0))
+	require.Equal(t, "0x617ba037", hexutil.Encode(supply[:4]))
+	borrow := pack("borrow", usdc, amount, variableRate, uint16(0), account)
+	require.Equal(t, "0xa415bcad", hexutil.Encode(borrow[:4]))
+
+	tests := []struct {
+		name         string
+		data         []byte
+		wantKind     TxKind
+		wantTo       *common.Address
+		wantAmount   *big.Int
+		wantContract *common.Address
+		wantDetails  *LendingCall
+	}{
+		{
+			name:         "supply",
+			data:         supply,
+			wantKind:     TxKindLendingSupply,
+			wantTo:       &pool,
+			wantAmount:   amount,
+			wantContract: &usdc,
+			wantDetails:  &LendingCall{Asset: usdc, Amount: amount, OnBehalfOf: &account},
+		},
+		{
+			name:         "borrow",
+			data:         borrow,
+			wantKind:     TxKindLendingBorrow,
+			wantTo:       &pool,
+			wantAmount:   big.NewInt(0),
+			wantContract: &pool,
+			wantDetails:  &LendingCall{Asset: usdc, Amount: amount, OnBehalfOf: &account, InterestRateMode: variableRate},
+		},
+		{
+			name:         "repay",
+			data:         pack("repay", usdc, amount, variableRate, account),
+			wantKind:     TxKindLendingRepay,
+			wantTo:       &pool,
+			wantAmount:   amount,
+			wantContract: &usdc,
+			wantDetails:  &LendingCall{Asset: usdc, Amount: amount, OnBehalfOf: &account, InterestRateMode: variableRate},
+		},
+		{
+			name:         "withdraw",
+			data:         pack("withdraw", usdc, amount, account),
+			wantKind:     TxKindLendingWithdraw,
+			wantTo:       &account,
+			wantAmount:   amount,
+			wantContract: &usdc,
+			wantDetails:  &LendingCall{Asset: usdc, Amount: amount, Recipient: &account},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx, err := ParseEthereumTransaction(unsignedDynamicFeeTx(t, &pool, big.NewInt(0), tt.data), big.NewInt(1))
+			require.NoError(t, err)
+			require.Equal(t, tt.wantKind, tx.Kind)
+			require.Equal(t, tt.wantTo, tx.To)
+			require.Equal(t, tt.wantAmount, tx.Amount)
+			require.Equal(t, tt.wantContract, tx.Contract)
+			require.Equal(t, tt.wantDetails, tx.Details)
+		})
+	}
+
+	t.Run("truncated calldata", func(t *testing.T) {
+		_, err := ParseEthereumTransaction(unsignedDynamicFeeTx(t, &pool, big.NewInt(0), borrow[:100]), big.NewInt(1))
+		require.Error(t, err)
+	})
+}
+
+const sablierABIJSON = `[
+	{"type": "function", "name": "createStream", "inputs": [
+		{"name": "recipient", "type": "address"},
+		{"name": "deposit", "type": "uint256"},
+		{"name": "tokenAddress", "type": "address"},
+		{"name": "startTime", "type": "uint256"},
+		{"name": "stopTime", "type": "uint256"}
+	], "outputs": [{"name": "", "type": "uint256"}]}
+]`
+
+func Test_ParseEthereumTransaction_StreamCreate(t *testing.T) {
+	sablierABI, err := abi.JSON(strings.NewReader(sablierABIJSON))
+	require.NoError(t, err)
+
+	sablier := common.HexToAddress("0xCD18eAa163733Da39c232722cBC4E8940b1D8888")
+	usdc := common.HexToAddress("0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48")
+	recipient := common.HexToAddress("0x48c04ed5691981C42154C6167398f95e8f38a7fF")
+	deposit := big.NewInt(2_592_000_000_000) // 30 days at 1 USDC per second
+	start := big.NewInt(1_700_000_000)
+	stop := big.NewInt(1_702_592_000)
+
+	data, err := sablierABI.Pack("createStream", recipient, deposit, usdc, start, stop)
+	require.NoError(t, err)
+
+	tx, err := ParseEthereumTransaction(unsignedDynamicFeeTx(t, &sablier, big.NewInt(0), data), big.NewInt(1))
+	require.NoError(t, err)
+	require.Equal(t, TxKindStreamCreate, tx.Kind)
+	require.Equal(t, recipient, *tx.To)
+	require.Equal(t, deposit, tx.Amount)
+	require.Equal(t, usdc, *tx.Contract)
+
+	details, ok := tx.Details.(*StreamCreateCall)
+	require.True(t, ok)
+	require.Equal(t, &StreamCreateCall{Recipient: recipient, Deposit: deposit, Token: usdc, StartTime: start, StopTime: stop}, details)
+	require.Equal(t, big.NewInt(1_000_000), details.FlowRate())
+
+	t.Run("no duration", func(t *testing.T) {
+		c := &StreamCreateCall{Deposit: deposit, StartTime: stop, StopTime: stop}
+		require.Equal(t, 0, c.FlowRate().Sign())
+	})
+
+	t.Run("truncated calldata", func(t *testing.T) {
+		_, err := ParseEthereumTransaction(unsignedDynamicFeeTx(t, &sablier, big.NewInt(0), data[:100]), big.NewInt(1))
+		require.Error(t, err)
+	})
+}
+
+func Test_ParseEthereumTransaction_Mint(t *testing.T) {
+	mintABI, err := abi.JSON(strings.NewReader(`[
+		{"type": "function", "name": "mint", "inputs": [
+			{"name": "to", "type": "address"},
+			{"name": "amount", "type": "uint256"}
+		], "outputs": []}
+	]`))
+	require.NoError(t, err)
+
+	token := common.HexToAddress("0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48")
+	recipient := common.HexToAddress("0x48c04ed5691981C42154C6167398f95e8f38a7fF")
+	amount := big.NewInt(1_000_000_000)
+
+	data, err := mintABI.Pack("mint", recipient, amount)
+	require.NoError(t, err)
+
+	tx, err := ParseEthereumTransaction(unsignedDynamicFeeTx(t, &token, big.NewInt(0), data), big.NewInt(1))
+	require.NoError(t, err)
+	require.Equal(t, TxKindMint, tx.Kind)
+	require.Equal(t, recipient, *tx.To)
+	require.Equal(t, amount, tx.Amount)
+	require.Equal(t, token, *tx.Contract)
+	require.Equal(t, TokenCoin(ethereumSymbol, token.Bytes()).Bytes(), tx.Transfer().CoinIdentifier)
+
+	t.Run("truncated calldata", func(t *testing.T) {
+		_, err := ParseEthereumTransaction(unsignedDynamicFeeTx(t, &token, big.NewInt(0), data[:len(data)-1]), big.NewInt(1))
+		require.Error(t, err)
+	})
+
+	t.Run("trailing calldata", func(t *testing.T) {
+		_, err := ParseEthereumTransaction(unsignedDynamicFeeTx(t, &token, big.NewInt(0), append(data, 0x00)), big.NewInt(1))
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,185 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package types
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// EVMChain is an EVM compatible chain supported by EthereumWallet.
+type EVMChain struct {
+	// Name is the human readable name of the chain.
+	Name string
+
+	// ID is the EIP-155 chain ID.
+	ID uint64
+
+	// Symbol is the ticker of the native currency, used in coin
+	// identifiers.
+	Symbol string
+
+	// Decimals is the number of decimals of the native currency.
+	Decimals uint8
+}
+
+// Supported EVM chains.
+var (
+	EVMChainEthereum  = EVMChain{Name: "Ethereum", ID: 1, Symbol: ethereumSymbol, Decimals: 18}
+	EVMChainPolygon   = EVMChain{Name: "Polygon", ID: 137, Symbol: "MATIC", Decimals: 18}
+	EVMChainAvalanche = EVMChain{Name: "Avalanche C-Chain", ID: 43114, Symbol: "AVAX", Decimals: 18}
+	EVMChainArbitrum  = EVMChain{Name: "Arbitrum One", ID: 42161, Symbol: ethereumSymbol, Decimals: 18}
+	EVMChainBSC       = EVMChain{Name: "BNB Smart Chain", ID: 56, Symbol: "BNB", Decimals: 18}
+)
+
+// EVMChains lists the EVM chains supported by the default genesis.
+var EVMChains = []EVMChain{
+	EVMChainEthereum,
+	EVMChainPolygon,
+	EVMChainAvalanche,
+	EVMChainArbitrum,
+	EVMChainBSC,
+}
+
+// ChainID returns the EIP-155 chain ID.
+func (c EVMChain) ChainID() *big.Int {
+	return new(big.Int).SetUint64(c.ID)
+}
+
+// Signer returns the latest transaction signer for the chain.
+func (c EVMChain) Signer() types.Signer {
+	return latestSigner(c.ChainID())
+}
+
+// Metadata returns the metadata for parsing transactions of the chain.
+func (c EVMChain) Metadata() *MetadataEthereum {
+	return &MetadataEthereum{ChainId: c.ID}
+}
+
+// SupportedChain returns the genesis representation of the chain.
+func (c EVMChain) SupportedChain() SupportedChain {
+	return SupportedChain{
+		ChainId:      c.ID,
+		Name:         c.Name,
+		NativeSymbol: c.Symbol,
+		Decimals:     uint32(c.Decimals),
+	}
+}
+
+// EVMChain returns the chain described by the genesis entry. The entry is
+// expected to be valid, see Validate.
+func (c SupportedChain) EVMChain() EVMChain {
+	return EVMChain{
+		Name:     c.Name,
+		ID:       c.ChainId,
+		Symbol:   c.NativeSymbol,
+		Decimals: uint8(c.Decimals),
+	}
+}
+
+// Validate returns an error if the chain can't be used by Ethereum wallets.
+func (c SupportedChain) Validate() error {
+	if c.ChainId == 0 {
+		return fmt.Errorf("missing chain ID")
+	}
+	if c.Name == "" {
+		return fmt.Errorf("chain %d: missing name", c.ChainId)
+	}
+	if c.NativeSymbol == "" || strings.Contains(c.NativeSymbol, coinIdentifierSeparator) {
+		return fmt.Errorf("chain %d: invalid native symbol %q", c.ChainId, c.NativeSymbol)
+	}
+	if c.Decimals > math.MaxUint8 {
+		return fmt.Errorf("chain %d: decimals out of range: %d", c.ChainId, c.Decimals)
+	}
+	return nil
+}
+
+// DefaultSupportedChains returns the genesis entries of EVMChains.
+func DefaultSupportedChains() []SupportedChain {
+	chains := make([]SupportedChain, len(EVMChains))
+	for i, c := range EVMChains {
+		chains[i] = c.SupportedChain()
+	}
+	return chains
+}
+
+// ValidateSupportedChains validates every chain, returning an error if the
+// same chain ID is listed more than once.
+func ValidateSupportedChains(chains []SupportedChain) error {
+	seen := make(map[uint64]bool, len(chains))
+	for _, c := range chains {
+		if err := c.Validate(); err != nil {
+			return err
+		}
+		if seen[c.ChainId] {
+			return fmt.Errorf("duplicate chain ID %d", c.ChainId)
+		}
+		seen[c.ChainId] = true
+	}
+	return nil
+}
+
+// ErrUnsupportedChain is returned when an Ethereum wallet is requested for a
+// chain ID that isn't in the list of supported chains.
+var ErrUnsupportedChain = errors.New("unsupported chain")
+
+// NewEthereumWalletForChainID returns an EthereumWallet bound to the chain
+// with the given ID, which must be one of chains.
+func NewEthereumWalletForChainID(k *Key, chains []SupportedChain, chainID uint64) (*EthereumWallet, error) {
+	for _, c := range chains {
+		if c.ChainId == chainID {
+			return NewEthereumWalletForChain(k, c.EVMChain())
+		}
+	}
+	return nil, fmt.Errorf("%w: %d", ErrUnsupportedChain, chainID)
+}
+
+// NewEthereumWalletForChain returns an EthereumWallet bound to the given
+// chain. The wallet rejects transactions whose metadata refers to a
+// different chain.
+func NewEthereumWalletForChain(k *Key, chain EVMChain) (*EthereumWallet, error) {
+	w, err := NewEthereumWallet(k)
+	if err != nil {
+		return nil, err
+	}
+	w.chain = &chain
+	return w, nil
+}
+
+// symbol returns the symbol of the coin identifiers of the wallet's chain,
+// ETH if the wallet isn't bound to a chain.
+func (w *EthereumWallet) symbol() string {
+	if w.chain != nil && w.chain.Symbol != "" {
+		return w.chain.Symbol
+	}
+	return ethereumSymbol
+}
+
+// OwnsCoinIdentifier reports whether the serialized coin identifier refers
+// to a coin of the wallet's chain, i.e. its symbol is the native symbol of
+// the chain. It can be used to check that a Transfer was produced by the
+// wallet before applying a policy to it.
+//
+// ERC-721 and ERC-1155 identifiers don't carry the chain and are never
+// owned.
+func (w *EthereumWallet) OwnsCoinIdentifier(coinID []byte) bool {
+	coin, err := ParseCoinIdentifier(coinID)
+	if err != nil {
+		return false
+	}
+	return coin.Symbol == w.symbol()
+}
+
+// checkChain returns an error if the wallet is bound to a chain different
+// from the one in the metadata.
+func (w *EthereumWallet) checkChain(meta *MetadataEthereum) error {
+	if w.chain != nil && w.chain.ID != meta.ChainId {
+		return fmt.Errorf("%w: metadata chain ID %d doesn't match wallet chain %s (%d)", ErrChainIDMismatch, meta.ChainId, w.chain.Name, w.chain.ID)
+	}
+	return nil
+}
@@ -0,0 +1,187 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_EVMChain_ChainID(t *testing.T) {
+	tests := []struct {
+		chain EVMChain
+		want  *big.Int
+	}{
+		{chain: EVMChainEthereum, want: big.NewInt(1)},
+		{chain: EVMChainPolygon, want: big.NewInt(137)},
+		{chain: EVMChainAvalanche, want: big.NewInt(43114)},
+		{chain: EVMChainArbitrum, want: big.NewInt(42161)},
+		{chain: EVMChainBSC, want: big.NewInt(56)},
+	}
+	require.Len(t, EVMChains, len(tests))
+
+	for _, tt := range tests {
+		t.Run(tt.chain.Name, func(t *testing.T) {
+			require.Equal(t, tt.want, tt.chain.ChainID())
+			require.Equal(t, tt.want, tt.chain.Signer().ChainID())
+			require.True(t, tt.chain.Signer().Equal(types.LatestSignerForChainID(tt.want)))
+		})
+	}
+}
+
+func Test_NewEthereumWalletForChain(t *testing.T) {
+	privKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	k := &Key{
+		Type:      KeyType_KEY_TYPE_ECDSA_SECP256K1,
+		PublicKey: crypto.CompressPubkey(&privKey.PublicKey),
+	}
+	to := common.HexToAddress("0xea223ca8968ca59e0bc79ba331c2f6f636a3fb82")
+	unsignedTx, err := rlp.EncodeToBytes(&types.LegacyTx{
+		Nonce:    1,
+		GasPrice: big.NewInt(30_000_000_000),
+		Gas:      21_000,
+		To:       &to,
+		Value:    big.NewInt(1_000),
+	})
+	require.NoError(t, err)
+
+	for _, chain := range EVMChains {
+		t.Run(chain.Name, func(t *testing.T) {
+			wallet, err := NewEthereumWalletForChain(k, chain)
+			require.NoError(t, err)
+
+			transfer, err := wallet.ParseTx(unsignedTx, chain.Metadata())
+			require.NoError(t, err)
+
+			// the signature must be valid for the chain
+			sig, err := crypto.Sign(transfer.DataForSigning, privKey)
+			require.NoError(t, err)
+			signed, err := wallet.BuildSignedTx(unsignedTx, sig, chain.Metadata())
+			require.NoError(t, err)
+
+			var signedTx types.Transaction
+			require.NoError(t, signedTx.UnmarshalBinary(signed))
+			require.Equal(t, chain.ChainID(), signedTx.ChainId())
+			sender, err := types.Sender(chain.Signer(), &signedTx)
+			require.NoError(t, err)
+			require.Equal(t, wallet.Address(), sender.Hex())
+		})
+	}
+
+	t.Run("mismatching chain", func(t *testing.T) {
+		wallet, err := NewEthereumWalletForChain(k, EVMChainPolygon)
+		require.NoError(t, err)
+
+		_, err = wallet.ParseTx(unsignedTx, EVMChainEthereum.Metadata())
+		require.Error(t, err)
+		_, err = wallet.BuildSignedTx(unsignedTx, make([]byte, 65), EVMChainEthereum.Metadata())
+		require.Error(t, err)
+	})
+
+	t.Run("invalid key", func(t *testing.T) {
+		_, err := NewEthereumWalletForChain(&Key{
+			Type:      KeyType_KEY_TYPE_EDDSA_ED25519,
+			PublicKey: hexutil.MustDecode("0x01"),
+		}, EVMChainEthereum)
+		require.Error(t, err)
+	})
+}
+
+func Test_SupportedChain_EVMChain(t *testing.T) {
+	for _, chain := range EVMChains {
+		supported := chain.SupportedChain()
+		require.NoError(t, supported.Validate())
+		require.Equal(t, chain, supported.EVMChain())
+	}
+	require.NoError(t, ValidateSupportedChains(DefaultSupportedChains()))
+}
+
+func Test_NewEthereumWalletForChainID(t *testing.T) {
+	privKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	k := &Key{
+		Type:      KeyType_KEY_TYPE_ECDSA_SECP256K1,
+		PublicKey: crypto.CompressPubkey(&privKey.PublicKey),
+	}
+	chains := []SupportedChain{EVMChainEthereum.SupportedChain(), EVMChainPolygon.SupportedChain()}
+	to := common.HexToAddress("0xea223ca8968ca59e0bc79ba331c2f6f636a3fb82")
+	token := common.HexToAddress("0x2791bca1f2de4661ed88a30c99a7a9449aa84174")
+	legacyTx := func(to common.Address, value *big.Int, data []byte) []byte {
+		b, err := rlp.EncodeToBytes(&types.LegacyTx{
+			Nonce:    1,
+			GasPrice: big.NewInt(30_000_000_000),
+			Gas:      100_000,
+			To:       &to,
+			Value:    value,
+			Data:     data,
+		})
+		require.NoError(t, err)
+		return b
+	}
+
+	t.Run("native symbol of the chain", func(t *testing.T) {
+		wallet, err := NewEthereumWalletForChainID(k, chains, EVMChainPolygon.ID)
+		require.NoError(t, err)
+
+		unsignedTx := legacyTx(to, big.NewInt(1_000), nil)
+		transfer, err := wallet.ParseTx(unsignedTx, EVMChainPolygon.Metadata())
+		require.NoError(t, err)
+		require.Equal(t, "MATIC", string(transfer.CoinIdentifier))
+
+		unsignedTx = legacyTx(token, big.NewInt(0), hexutil.MustDecode(
+			"0xa9059cbb000000000000000000000000ea223ca8968ca59e0bc79ba331c2f6f636a3fb8200000000000000000000000000000000000000000000000000000000000f4240"))
+		transfer, err = wallet.ParseTx(unsignedTx, EVMChainPolygon.Metadata())
+		require.NoError(t, err)
+		require.Equal(t, TokenCoin("MATIC", token.Bytes()).Bytes(), transfer.CoinIdentifier)
+	})
+
+	t.Run("unknown chain", func(t *testing.T) {
+		_, err := NewEthereumWalletForChainID(k, chains, EVMChainBSC.ID)
+		require.ErrorIs(t, err, ErrUnsupportedChain)
+	})
+}
+
+func Test_EthereumWallet_OwnsCoinIdentifier(t *testing.T) {
+	privKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	k := &Key{
+		Type:      KeyType_KEY_TYPE_ECDSA_SECP256K1,
+		PublicKey: crypto.CompressPubkey(&privKey.PublicKey),
+	}
+	usdc := common.HexToAddress("0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48")
+
+	ethWallet, err := NewEthereumWallet(k)
+	require.NoError(t, err)
+	polygonWallet, err := NewEthereumWalletForChain(k, EVMChainPolygon)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		wallet  *EthereumWallet
+		coinID  []byte
+		wantOwn bool
+	}{
+		{name: "ETH", wallet: ethWallet, coinID: NativeCoin("ETH").Bytes(), wantOwn: true},
+		{name: "ERC-20", wallet: ethWallet, coinID: TokenCoin("ETH", usdc.Bytes()).Bytes(), wantOwn: true},
+		{name: "ERC-20 with token info", wallet: ethWallet, coinID: []byte(TokenCoin("ETH", usdc.Bytes()).String() + "/USDC:6")},
+		{name: "BTC", wallet: ethWallet, coinID: NativeCoin("BTC").Bytes()},
+		{name: "ERC-721", wallet: ethWallet, coinID: ERC721Coin(usdc).Bytes()},
+		{name: "invalid", wallet: ethWallet, coinID: []byte("ETH/abcd")},
+		{name: "empty", wallet: ethWallet},
+		{name: "native of chain", wallet: polygonWallet, coinID: NativeCoin("MATIC").Bytes(), wantOwn: true},
+		{name: "ETH on other chain", wallet: polygonWallet, coinID: NativeCoin("ETH").Bytes()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.wantOwn, tt.wallet.OwnsCoinIdentifier(tt.coinID))
+		})
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package types
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// ErrDustTransfer is returned for transfers of a zero amount, often sent by
+// address poisoning scams, or of an amount below the minimum configured with
+// WithMinTransferAmount.
+var ErrDustTransfer = errors.New("transfer amount below minimum")
+
+// WithMinTransferAmount makes ParseTx reject transfers of less than min,
+// of the native currency if token is nil or of the given ERC-20 token
+// otherwise. Amounts are in the smallest unit (e.g. wei). A nil min
+// removes the minimum. It returns the wallet itself.
+//
+// Transfers of a zero amount are always rejected, see ErrDustTransfer, except
+// for the self-sends cancelling a pending transaction.
+func (w *EthereumWallet) WithMinTransferAmount(token *common.Address, min *big.Int) *EthereumWallet {
+	var key common.Address
+	if token != nil {
+		key = *token
+	}
+	if min == nil {
+		delete(w.minAmounts, key)
+		return w
+	}
+	if w.minAmounts == nil {
+		w.minAmounts = make(map[common.Address]*big.Int)
+	}
+	w.minAmounts[key] = new(big.Int).Set(min)
+	return w
+}
+
+// checkMinAmount returns ErrDustTransfer if tx transfers less than the
+// minimum configured for its currency. Self-sends are allowed, as by
+// checkNonZeroAmount.
+func (w *EthereumWallet) checkMinAmount(tx *EthereumTransfer) error {
+	self := crypto.PubkeyToAddress(*w.key)
+	if !isValueTransfer(tx.Kind) || isSelfSend(tx, &self) {
+		return nil
+	}
+	var key common.Address
+	if tx.Contract != nil {
+		key = *tx.Contract
+	}
+	if min, ok := w.minAmounts[key]; ok && tx.Amount.Cmp(min) < 0 {
+		return fmt.Errorf("%w: %v is less than %v", ErrDustTransfer, tx.Amount, min)
+	}
+	return nil
+}
+
+// checkNonZeroAmount returns ErrDustTransfer for transfers of a zero amount,
+// of the native currency or of a token. Other calls (e.g. approve(0) to
+// revoke an allowance) are not affected.
+//
+// Transfers of zero ETH from the sender to itself, if from is known, are
+// allowed: they're the usual way to cancel a pending transaction, by
+// replacing it with one of the same nonce and a higher fee. Unlike the
+// replacements of IsReplacement, they must be approved as new transfers.
+func checkNonZeroAmount(tx *EthereumTransfer, from *common.Address) error {
+	if isSelfSend(tx, from) {
+		return nil
+	}
+	if isValueTransfer(tx.Kind) && tx.Amount.Sign() == 0 {
+		return fmt.Errorf("%w: zero amount", ErrDustTransfer)
+	}
+	return nil
+}
+
+// isValueTransfer returns true for the kinds of transactions that move an
+// amount of a currency to a recipient.
+func isValueTransfer(kind TxKind) bool {
+	return kind == TxKindTransfer || kind == TxKindTransferFrom
+}
+
+// isSelfSend returns true if tx transfers ETH from the sender from to itself.
+func isSelfSend(tx *EthereumTransfer, from *common.Address) bool {
+	return from != nil && tx.Kind == TxKindTransfer && tx.Contract == nil && tx.To != nil && *tx.To == *from
+}
@@ -0,0 +1,106 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+// erc20TransferData returns the calldata of transfer(to, amount).
+func erc20TransferData(to common.Address, amount int64) []byte {
+	data := append([]byte{}, transferMethodID...)
+	data = append(data, common.LeftPadBytes(to.Bytes(), 32)...)
+	return append(data, common.LeftPadBytes(big.NewInt(amount).Bytes(), 32)...)
+}
+
+func Test_ParseEthereumTransaction_ZeroAmount(t *testing.T) {
+	token := common.HexToAddress("0xdAC17F958D2ee523a2206206994597C13D831ec7")
+	recipient := common.HexToAddress("0x48c04ed5691981C42154C6167398f95e8f38a7fF")
+	approveZero := append(append([]byte{}, approveMethodID...), common.LeftPadBytes(recipient.Bytes(), 32)...)
+	approveZero = append(approveZero, make([]byte, 32)...)
+
+	tests := []struct {
+		name     string
+		to       common.Address
+		value    int64
+		data     []byte
+		wantKind TxKind
+		wantErr  error
+	}{
+		{name: "zero ERC-20 transfer", to: token, data: erc20TransferData(recipient, 0), wantErr: ErrDustTransfer},
+		{name: "zero ETH transfer", to: recipient, wantErr: ErrDustTransfer},
+		{name: "small ERC-20 transfer", to: token, data: erc20TransferData(recipient, 1), wantKind: TxKindTransfer},
+		{name: "small ETH transfer", to: recipient, value: 1, wantKind: TxKindTransfer},
+		{name: "approve zero", to: token, data: approveZero, wantKind: TxKindApprovalRevoke},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx, err := ParseEthereumTransaction(unsignedDynamicFeeTx(t, &tt.to, big.NewInt(tt.value), tt.data), big.NewInt(1))
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.wantKind, tx.Kind)
+		})
+	}
+}
+
+func Test_EthereumWallet_WithMinTransferAmount(t *testing.T) {
+	token := common.HexToAddress("0xdAC17F958D2ee523a2206206994597C13D831ec7")
+	otherToken := common.HexToAddress("0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48")
+	recipient := common.HexToAddress("0x48c04ed5691981C42154C6167398f95e8f38a7fF")
+	meta := &MetadataEthereum{ChainId: 1}
+
+	wallet := ethereumWallet(t).
+		WithMinTransferAmount(nil, big.NewInt(1_000)).
+		WithMinTransferAmount(&token, big.NewInt(10))
+
+	parse := func(to common.Address, value int64, data []byte) error {
+		_, err := wallet.ParseTx(unsignedDynamicFeeTx(t, &to, big.NewInt(value), data), meta)
+		return err
+	}
+
+	require.ErrorIs(t, parse(recipient, 999, nil), ErrDustTransfer)
+	require.NoError(t, parse(recipient, 1_000, nil))
+	require.ErrorIs(t, parse(token, 0, erc20TransferData(recipient, 9)), ErrDustTransfer)
+	require.NoError(t, parse(token, 0, erc20TransferData(recipient, 10)))
+
+	// tokens without a minimum only reject zero amounts
+	require.NoError(t, parse(otherToken, 0, erc20TransferData(recipient, 1)))
+	require.ErrorIs(t, parse(otherToken, 0, erc20TransferData(recipient, 0)), ErrDustTransfer)
+
+	// a nil minimum removes it
+	wallet.WithMinTransferAmount(nil, nil)
+	require.NoError(t, parse(recipient, 1, nil))
+}
+
+func Test_EthereumWallet_ParseTx_CancelSelfSend(t *testing.T) {
+	token := common.HexToAddress("0xdAC17F958D2ee523a2206206994597C13D831ec7")
+	recipient := common.HexToAddress("0x48c04ed5691981C42154C6167398f95e8f38a7fF")
+	meta := &MetadataEthereum{ChainId: 1}
+	wallet := ethereumWallet(t).WithMinTransferAmount(nil, big.NewInt(1_000))
+	self := common.HexToAddress(wallet.Address())
+
+	// a transfer of zero ETH to the wallet itself, with the nonce of a
+	// pending transaction, cancels it
+	cancel := unsignedDynamicFeeTx(t, &self, big.NewInt(0), nil)
+	transfer, err := wallet.ParseTx(cancel, meta)
+	require.NoError(t, err)
+	require.Equal(t, TxKindTransfer, transfer.Kind)
+
+	// the sender isn't known without the wallet
+	_, err = ParseEthereumTransaction(cancel, big.NewInt(1))
+	require.ErrorIs(t, err, ErrDustTransfer)
+
+	// zero amounts to others, or of tokens, are still rejected
+	_, err = wallet.ParseTx(unsignedDynamicFeeTx(t, &recipient, big.NewInt(0), nil), meta)
+	require.ErrorIs(t, err, ErrDustTransfer)
+	_, err = wallet.ParseTx(unsignedDynamicFeeTx(t, &token, big.NewInt(0), erc20TransferData(self, 0)), meta)
+	require.ErrorIs(t, err, ErrDustTransfer)
+}
@@ -0,0 +1,154 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const (
+	// TxKindERC1155Transfer is an ERC-1155 safeTransferFrom call, moving an
+	// amount of a single token.
+	TxKindERC1155Transfer TxKind = "erc1155_transfer"
+
+	// TxKindERC1155BatchTransfer is an ERC-1155 safeBatchTransferFrom call,
+	// moving amounts of several tokens at once.
+	TxKindERC1155BatchTransfer TxKind = "erc1155_batch_transfer"
+)
+
+// erc1155Symbol is the symbol of the identifiers of ERC-1155 tokens, see
+// ERC1155Coin.
+const erc1155Symbol = "ERC1155"
+
+// ERC1155Coin returns the identifier of a token of an ERC-1155 contract,
+// serialized as "ERC1155/<contract>/<token id>". Tokens with the same id
+// are fungible.
+func ERC1155Coin(contract common.Address, tokenID *big.Int) CoinIdentifier {
+	return CoinIdentifier{Symbol: erc1155Symbol, Contract: contract.Bytes(), TokenID: tokenID}
+}
+
+// ERC1155TransferCall contains the arguments of a safeTransferFrom or
+// safeBatchTransferFrom call of an ERC-1155 contract.
+type ERC1155TransferCall struct {
+	From common.Address
+	To   common.Address
+
+	// Data is passed to the recipient if it's a contract.
+	Data []byte
+
+	// Transfers contains a Transfer for each (token id, amount) pair, in
+	// order, with Kind TxKindTransferFrom, the CoinIdentifier of the token
+	// (see ERC1155Coin), and an ERC1155TransferDetails as Details.
+	Transfers []Transfer
+}
+
+// ERC1155TransferDetails identifies the account a Transfer of an ERC-1155
+// token moves it from.
+type ERC1155TransferDetails struct {
+	From    common.Address
+	TokenID *big.Int
+}
+
+var (
+	erc1155SafeTransferFromMethodID  = crypto.Keccak256Hash([]byte("safeTransferFrom(address,address,uint256,uint256,bytes)")).Bytes()[0:4]
+	erc1155SafeTransferFromArguments = abi.Arguments{
+		{Type: mustABIType("address")}, // from
+		{Type: mustABIType("address")}, // to
+		{Type: mustABIType("uint256")}, // id
+		{Type: mustABIType("uint256")}, // amount
+		{Type: mustABIType("bytes")},   // data
+	}
+
+	erc1155SafeBatchTransferFromMethodID  = crypto.Keccak256Hash([]byte("safeBatchTransferFrom(address,address,uint256[],uint256[],bytes)")).Bytes()[0:4]
+	erc1155SafeBatchTransferFromArguments = abi.Arguments{
+		{Type: mustABIType("address")},   // from
+		{Type: mustABIType("address")},   // to
+		{Type: mustABIType("uint256[]")}, // ids
+		{Type: mustABIType("uint256[]")}, // amounts
+		{Type: mustABIType("bytes")},     // data
+	}
+)
+
+// unpackERC1155SafeTransferFrom decodes the arguments of a safeTransferFrom
+// call to the ERC-1155 contract at address contract. The arguments must be
+// canonically encoded.
+func unpackERC1155SafeTransferFrom(contract common.Address, args []byte) (*ERC1155TransferCall, error) {
+	values, err := erc1155SafeTransferFromArguments.UnpackValues(args)
+	if err != nil {
+		return nil, fmt.Errorf("invalid safeTransferFrom: %w", err)
+	}
+	encoded, err := erc1155SafeTransferFromArguments.Pack(values...)
+	if err != nil {
+		return nil, fmt.Errorf("invalid safeTransferFrom: %w", err)
+	}
+	if !bytes.Equal(encoded, args) {
+		return nil, fmt.Errorf("invalid safeTransferFrom: non-canonical encoding")
+	}
+
+	call := &ERC1155TransferCall{
+		From: values[0].(common.Address),
+		To:   values[1].(common.Address),
+		Data: values[4].([]byte),
+	}
+	call.Transfers = []Transfer{call.transfer(contract, values[2].(*big.Int), values[3].(*big.Int))}
+	return call, nil
+}
+
+// unpackERC1155SafeBatchTransferFrom decodes the arguments of a
+// safeBatchTransferFrom call to the ERC-1155 contract at address contract.
+// The arguments must be canonically encoded, and there must be an amount
+// for each token id.
+func unpackERC1155SafeBatchTransferFrom(contract common.Address, args []byte) (*ERC1155TransferCall, error) {
+	values, err := erc1155SafeBatchTransferFromArguments.UnpackValues(args)
+	if err != nil {
+		return nil, fmt.Errorf("invalid safeBatchTransferFrom: %w", err)
+	}
+
+	// offsets and lengths that don't match the canonical encoding (e.g.
+	// overlapping arrays or trailing data) could be decoded differently by
+	// the contract
+	encoded, err := erc1155SafeBatchTransferFromArguments.Pack(values...)
+	if err != nil {
+		return nil, fmt.Errorf("invalid safeBatchTransferFrom: %w", err)
+	}
+	if !bytes.Equal(encoded, args) {
+		return nil, fmt.Errorf("invalid safeBatchTransferFrom: non-canonical encoding")
+	}
+
+	ids := values[2].([]*big.Int)
+	amounts := values[3].([]*big.Int)
+	if len(ids) != len(amounts) {
+		return nil, fmt.Errorf("invalid safeBatchTransferFrom: %d token ids but %d amounts", len(ids), len(amounts))
+	}
+	if len(ids) == 0 {
+		return nil, fmt.Errorf("invalid safeBatchTransferFrom: no token ids")
+	}
+
+	call := &ERC1155TransferCall{
+		From:      values[0].(common.Address),
+		To:        values[1].(common.Address),
+		Data:      values[4].([]byte),
+		Transfers: make([]Transfer, len(ids)),
+	}
+	for i, id := range ids {
+		call.Transfers[i] = call.transfer(contract, id, amounts[i])
+	}
+	return call, nil
+}
+
+// transfer returns the Transfer of amount tokens with the given id.
+func (call *ERC1155TransferCall) transfer(contract common.Address, tokenID, amount *big.Int) Transfer {
+	return Transfer{
+		Kind:           TxKindTransferFrom,
+		To:             call.To.Bytes(),
+		Amount:         amount,
+		CoinIdentifier: ERC1155Coin(contract, tokenID).Bytes(),
+		Details:        &ERC1155TransferDetails{From: call.From, TokenID: tokenID},
+	}
+}
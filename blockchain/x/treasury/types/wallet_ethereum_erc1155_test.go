@@ -0,0 +1,101 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ParseEthereumTransaction_ERC1155(t *testing.T) {
+	contract := common.HexToAddress("0x76BE3b62873462d2142405439777e971754E8E77")
+	from := common.HexToAddress("0x48c04ed5691981C42154C6167398f95e8f38a7fF")
+	to := common.HexToAddress("0xea223ca8968ca59e0bc79ba331c2f6f636a3fb82")
+
+	require.Equal(t, hexutil.MustDecode("0xf242432a"), erc1155SafeTransferFromMethodID)
+	require.Equal(t, hexutil.MustDecode("0x2eb2c2d6"), erc1155SafeBatchTransferFromMethodID)
+
+	parse := func(t *testing.T, data []byte) (*EthereumTransfer, error) {
+		return ParseEthereumTransaction(unsignedDynamicFeeTx(t, &contract, big.NewInt(0), data), big.NewInt(1))
+	}
+
+	t.Run("safeTransferFrom", func(t *testing.T) {
+		args, err := erc1155SafeTransferFromArguments.Pack(from, to, big.NewInt(10_324), big.NewInt(5), []byte{})
+		require.NoError(t, err)
+		tx, err := parse(t, append(append([]byte{}, erc1155SafeTransferFromMethodID...), args...))
+		require.NoError(t, err)
+		require.Equal(t, TxKindERC1155Transfer, tx.Kind)
+		require.Equal(t, contract, *tx.To)
+
+		details, ok := tx.Details.(*ERC1155TransferCall)
+		require.True(t, ok)
+		require.Equal(t, from, details.From)
+		require.Equal(t, to, details.To)
+		require.Empty(t, details.Data)
+		require.Equal(t, []Transfer{{
+			Kind:           TxKindTransferFrom,
+			To:             to.Bytes(),
+			Amount:         big.NewInt(5),
+			CoinIdentifier: []byte("ERC1155/0x76be3b62873462d2142405439777e971754e8e77/10324"),
+			Details:        &ERC1155TransferDetails{From: from, TokenID: big.NewInt(10_324)},
+		}}, details.Transfers)
+
+		// truncated data
+		_, err = parse(t, append(append([]byte{}, erc1155SafeTransferFromMethodID...), args[:4*32]...))
+		require.Error(t, err)
+	})
+
+	batch := func(ids, amounts []*big.Int, data []byte) []byte {
+		args, err := erc1155SafeBatchTransferFromArguments.Pack(from, to, ids, amounts, data)
+		require.NoError(t, err)
+		return append(append([]byte{}, erc1155SafeBatchTransferFromMethodID...), args...)
+	}
+
+	t.Run("safeBatchTransferFrom", func(t *testing.T) {
+		ids := []*big.Int{big.NewInt(1), big.NewInt(2), big.NewInt(1)}
+		amounts := []*big.Int{big.NewInt(100), big.NewInt(1), big.NewInt(50)}
+		tx, err := parse(t, batch(ids, amounts, []byte("memo")))
+		require.NoError(t, err)
+		require.Equal(t, TxKindERC1155BatchTransfer, tx.Kind)
+
+		details, ok := tx.Details.(*ERC1155TransferCall)
+		require.True(t, ok)
+		require.Equal(t, []byte("memo"), details.Data)
+		require.Len(t, details.Transfers, len(ids))
+		for i, transfer := range details.Transfers {
+			require.Equal(t, TxKindTransferFrom, transfer.Kind)
+			require.Equal(t, to.Bytes(), transfer.To)
+			require.Equal(t, amounts[i], transfer.Amount)
+			require.Equal(t, ERC1155Coin(contract, ids[i]).Bytes(), transfer.CoinIdentifier)
+			require.Equal(t, &ERC1155TransferDetails{From: from, TokenID: ids[i]}, transfer.Details)
+
+			coin, err := ParseCoinIdentifier(transfer.CoinIdentifier)
+			require.NoError(t, err)
+			require.Equal(t, ERC1155Coin(contract, ids[i]), coin)
+		}
+	})
+
+	t.Run("mismatching lengths", func(t *testing.T) {
+		_, err := parse(t, batch([]*big.Int{big.NewInt(1), big.NewInt(2)}, []*big.Int{big.NewInt(1)}, nil))
+		require.ErrorContains(t, err, "2 token ids but 1 amounts")
+	})
+
+	invalid := []struct {
+		name string
+		data []byte
+	}{
+		{name: "empty batch", data: batch(nil, nil, nil)},
+		{name: "trailing bytes", data: append(batch([]*big.Int{big.NewInt(1)}, []*big.Int{big.NewInt(1)}, nil), 0)},
+		{name: "truncated", data: batch([]*big.Int{big.NewInt(1)}, []*big.Int{big.NewInt(1)}, nil)[:200]},
+	}
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parse(t, tt.data)
+			require.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package types
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// TxKindEscrowDeposit is a payment into an escrow contract, identified by a
+// reference id. The amount deposited is the value of the transaction.
+const TxKindEscrowDeposit TxKind = "escrow_deposit"
+
+// EscrowDepositCall contains the arguments of an escrow deposit call.
+type EscrowDepositCall struct {
+	// Escrow is the address of the escrow contract.
+	Escrow common.Address
+
+	// Reference is the id of the payment, as chosen by the payer.
+	Reference common.Hash
+}
+
+// escrowDepositMethodIDs are the method IDs of the recognised escrow
+// deposits, see RegisterEscrowDepositMethod.
+var escrowDepositMethodIDs = map[string]string{}
+
+// escrowDepositSignature matches the signature of a method taking a single
+// bytes32 argument.
+var escrowDepositSignature = regexp.MustCompile(`^[A-Za-z_$][A-Za-z0-9_$]*\(bytes32\)$`)
+
+func init() {
+	if err := RegisterEscrowDepositMethod("deposit(bytes32)"); err != nil {
+		panic(err)
+	}
+}
+
+// RegisterEscrowDepositMethod adds the method with the given signature (e.g.
+// "deposit(bytes32)") to the escrow deposits recognised when parsing
+// Ethereum transactions. The method must take a single bytes32 reference id.
+//
+// It must be called before parsing any transaction (e.g. in an init
+// function), and it's not safe for concurrent use.
+func RegisterEscrowDepositMethod(signature string) error {
+	if !escrowDepositSignature.MatchString(signature) {
+		return fmt.Errorf("invalid escrow deposit signature %q: expected a single bytes32 argument", signature)
+	}
+	methodID := string(crypto.Keccak256([]byte(signature))[0:4])
+	if registered, ok := escrowDepositMethodIDs[methodID]; ok && registered != signature {
+		return fmt.Errorf("escrow deposit signature %q clashes with %q", signature, registered)
+	}
+	escrowDepositMethodIDs[methodID] = signature
+	return nil
+}
+
+// isEscrowDepositMethod returns true if method is a registered escrow
+// deposit.
+func isEscrowDepositMethod(method []byte) bool {
+	_, ok := escrowDepositMethodIDs[string(method)]
+	return ok
+}
+
+// unpackEscrowDeposit decodes the arguments of an escrow deposit to the
+// contract at address escrow.
+func unpackEscrowDeposit(escrow common.Address, args []byte) (*EscrowDepositCall, error) {
+	if len(args) != 32 {
+		return nil, fmt.Errorf("invalid escrow deposit: expected 32 bytes of arguments, got %d", len(args))
+	}
+	return &EscrowDepositCall{
+		Escrow:    escrow,
+		Reference: common.BytesToHash(args),
+	}, nil
+}
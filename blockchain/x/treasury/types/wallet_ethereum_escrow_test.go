@@ -0,0 +1,79 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ParseEthereumTransaction_EscrowDeposit(t *testing.T) {
+	escrow := common.HexToAddress("0x7a250d5630B4cF539739dF2C5dAcb4c659F2488D")
+	reference := common.HexToHash("0x6f7264657220233132333435000000000000000000000000000000000000000a")
+	value := big.NewInt(250_000_000_000_000_000)
+
+	tests := []struct {
+		name    string
+		data    []byte
+		wantErr bool
+	}{
+		{
+			name: "deposit",
+			data: hexutil.MustDecode("0xb214faa56f7264657220233132333435000000000000000000000000000000000000000a"),
+		},
+		{
+			name:    "truncated reference",
+			data:    hexutil.MustDecode("0xb214faa56f72646572202331323334350000000000000000000000000000"),
+			wantErr: true,
+		},
+		{
+			name:    "trailing bytes",
+			data:    hexutil.MustDecode("0xb214faa56f7264657220233132333435000000000000000000000000000000000000000a00"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx, err := ParseEthereumTransaction(unsignedDynamicFeeTx(t, &escrow, value, tt.data), big.NewInt(1))
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, TxKindEscrowDeposit, tx.Kind)
+			require.Equal(t, escrow, *tx.To)
+			require.Equal(t, value, tx.Amount)
+
+			details, ok := tx.Details.(*EscrowDepositCall)
+			require.True(t, ok)
+			require.Equal(t, escrow, details.Escrow)
+			require.Equal(t, reference, details.Reference)
+		})
+	}
+}
+
+func Test_RegisterEscrowDepositMethod(t *testing.T) {
+	escrow := common.HexToAddress("0x7a250d5630B4cF539739dF2C5dAcb4c659F2488D")
+	data := hexutil.MustDecode("0x9bf77fb46f7264657220233132333435000000000000000000000000000000000000000a")
+
+	// depositFor(bytes32) isn't recognised until registered
+	tx, err := ParseEthereumTransaction(unsignedDynamicFeeTx(t, &escrow, big.NewInt(1), data), big.NewInt(1))
+	require.NoError(t, err)
+	require.Equal(t, TxKindContractCall, tx.Kind)
+
+	require.NoError(t, RegisterEscrowDepositMethod("depositFor(bytes32)"))
+	t.Cleanup(func() { delete(escrowDepositMethodIDs, string(data[:4])) })
+
+	tx, err = ParseEthereumTransaction(unsignedDynamicFeeTx(t, &escrow, big.NewInt(1), data), big.NewInt(1))
+	require.NoError(t, err)
+	require.Equal(t, TxKindEscrowDeposit, tx.Kind)
+
+	require.Error(t, RegisterEscrowDepositMethod("deposit(bytes32,uint256)"))
+	require.Error(t, RegisterEscrowDepositMethod("deposit(bytes)"))
+	require.Error(t, RegisterEscrowDepositMethod(""))
+}
@@ -0,0 +1,130 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// TxKindFlashLoan is a flash loan from an Aave lending pool: the assets are
+// lent to a receiver contract and must be repaid, with a premium, within
+// the same transaction.
+const TxKindFlashLoan TxKind = "flash_loan"
+
+// FlashLoanCall contains the arguments of a flashLoan or flashLoanSimple
+// call to an Aave V2 or V3 lending pool.
+type FlashLoanCall struct {
+	// Pool is the address of the lending pool.
+	Pool common.Address
+
+	// Receiver is the contract receiving the assets and executing the
+	// operation.
+	Receiver common.Address
+
+	// Assets and Amounts are the tokens borrowed and their amounts, in the
+	// same order.
+	Assets  []common.Address
+	Amounts []*big.Int
+
+	// InterestRateModes are the modes of the debt opened for each asset if
+	// the loan isn't repaid (0 reverts), nil for flashLoanSimple.
+	InterestRateModes []*big.Int
+
+	// OnBehalfOf is the address receiving the debt for non-zero interest
+	// rate modes. It's the zero address for flashLoanSimple.
+	OnBehalfOf common.Address
+
+	// Params are passed to the receiver.
+	Params []byte
+
+	ReferralCode uint16
+}
+
+var (
+	flashLoanMethodID  = crypto.Keccak256Hash([]byte("flashLoan(address,address[],uint256[],uint256[],address,bytes,uint16)")).Bytes()[0:4]
+	flashLoanArguments = abi.Arguments{
+		{Type: mustABIType("address")},   // receiverAddress
+		{Type: mustABIType("address[]")}, // assets
+		{Type: mustABIType("uint256[]")}, // amounts
+		{Type: mustABIType("uint256[]")}, // interestRateModes
+		{Type: mustABIType("address")},   // onBehalfOf
+		{Type: mustABIType("bytes")},     // params
+		{Type: mustABIType("uint16")},    // referralCode
+	}
+
+	flashLoanSimpleMethodID  = crypto.Keccak256Hash([]byte("flashLoanSimple(address,address,uint256,bytes,uint16)")).Bytes()[0:4]
+	flashLoanSimpleArguments = abi.Arguments{
+		{Type: mustABIType("address")}, // receiverAddress
+		{Type: mustABIType("address")}, // asset
+		{Type: mustABIType("uint256")}, // amount
+		{Type: mustABIType("bytes")},   // params
+		{Type: mustABIType("uint16")},  // referralCode
+	}
+)
+
+// unpackFlashLoan decodes the arguments of a flashLoan call to the pool at
+// address pool. The arguments must be canonically encoded, and every asset
+// must have an amount and an interest rate mode.
+func unpackFlashLoan(pool common.Address, args []byte) (*FlashLoanCall, error) {
+	values, err := flashLoanArguments.UnpackValues(args)
+	if err != nil {
+		return nil, fmt.Errorf("invalid flashLoan: %w", err)
+	}
+	encoded, err := flashLoanArguments.Pack(values...)
+	if err != nil {
+		return nil, fmt.Errorf("invalid flashLoan: %w", err)
+	}
+	if !bytes.Equal(encoded, args) {
+		return nil, fmt.Errorf("invalid flashLoan: non-canonical encoding")
+	}
+
+	call := &FlashLoanCall{
+		Pool:              pool,
+		Receiver:          values[0].(common.Address),
+		Assets:            values[1].([]common.Address),
+		Amounts:           values[2].([]*big.Int),
+		InterestRateModes: values[3].([]*big.Int),
+		OnBehalfOf:        values[4].(common.Address),
+		Params:            values[5].([]byte),
+		ReferralCode:      values[6].(uint16),
+	}
+	if len(call.Assets) == 0 {
+		return nil, fmt.Errorf("invalid flashLoan: no assets")
+	}
+	if len(call.Amounts) != len(call.Assets) || len(call.InterestRateModes) != len(call.Assets) {
+		return nil, fmt.Errorf("invalid flashLoan: %d assets, %d amounts and %d interest rate modes",
+			len(call.Assets), len(call.Amounts), len(call.InterestRateModes))
+	}
+	return call, nil
+}
+
+// unpackFlashLoanSimple decodes the arguments of a flashLoanSimple call to
+// the pool at address pool, borrowing a single asset.
+func unpackFlashLoanSimple(pool common.Address, args []byte) (*FlashLoanCall, error) {
+	values, err := flashLoanSimpleArguments.UnpackValues(args)
+	if err != nil {
+		return nil, fmt.Errorf("invalid flashLoanSimple: %w", err)
+	}
+	encoded, err := flashLoanSimpleArguments.Pack(values...)
+	if err != nil {
+		return nil, fmt.Errorf("invalid flashLoanSimple: %w", err)
+	}
+	if !bytes.Equal(encoded, args) {
+		return nil, fmt.Errorf("invalid flashLoanSimple: non-canonical encoding")
+	}
+
+	return &FlashLoanCall{
+		Pool:         pool,
+		Receiver:     values[0].(common.Address),
+		Assets:       []common.Address{values[1].(common.Address)},
+		Amounts:      []*big.Int{values[2].(*big.Int)},
+		Params:       values[3].([]byte),
+		ReferralCode: values[4].(uint16),
+	}, nil
+}
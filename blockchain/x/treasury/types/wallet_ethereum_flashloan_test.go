@@ -0,0 +1,89 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ParseEthereumTransaction_FlashLoan(t *testing.T) {
+	pool := common.HexToAddress("0x87870Bca3F3fD6335C3F4ce8392D69350B4fA4E2")
+	receiver := common.HexToAddress("0x48c04ed5691981C42154C6167398f95e8f38a7fF")
+	usdc := common.HexToAddress("0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48")
+	weth := common.HexToAddress("0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2")
+	params := []byte("arbitrage")
+
+	flashLoan := func(assets []common.Address, amounts, modes []*big.Int) []byte {
+		args, err := flashLoanArguments.Pack(receiver, assets, amounts, modes, receiver, params, uint16(0))
+		require.NoError(t, err)
+		return append(append([]byte{}, flashLoanMethodID...), args...)
+	}
+	amounts := []*big.Int{big.NewInt(5_000_000_000_000), new(big.Int).Mul(big.NewInt(1_000), big.NewInt(1e18))}
+	modes := []*big.Int{big.NewInt(0), big.NewInt(0)}
+
+	t.Run("flashLoan", func(t *testing.T) {
+		tx, err := ParseEthereumTransaction(unsignedDynamicFeeTx(t, &pool, big.NewInt(0), flashLoan([]common.Address{usdc, weth}, amounts, modes)), big.NewInt(1))
+		require.NoError(t, err)
+		require.Equal(t, TxKindFlashLoan, tx.Kind)
+		require.Equal(t, pool, *tx.To)
+
+		details, ok := tx.Details.(*FlashLoanCall)
+		require.True(t, ok)
+		require.Equal(t, pool, details.Pool)
+		require.Equal(t, receiver, details.Receiver)
+		require.Equal(t, []common.Address{usdc, weth}, details.Assets)
+		require.Equal(t, amounts, details.Amounts)
+		require.Len(t, details.InterestRateModes, 2)
+		for _, mode := range details.InterestRateModes {
+			require.Zero(t, mode.Sign())
+		}
+		require.Equal(t, receiver, details.OnBehalfOf)
+		require.Equal(t, params, details.Params)
+		require.Zero(t, details.ReferralCode)
+	})
+
+	t.Run("flashLoanSimple", func(t *testing.T) {
+		args, err := flashLoanSimpleArguments.Pack(receiver, usdc, amounts[0], params, uint16(7))
+		require.NoError(t, err)
+		data := append(append([]byte{}, flashLoanSimpleMethodID...), args...)
+
+		tx, err := ParseEthereumTransaction(unsignedDynamicFeeTx(t, &pool, big.NewInt(0), data), big.NewInt(1))
+		require.NoError(t, err)
+		require.Equal(t, TxKindFlashLoan, tx.Kind)
+
+		details, ok := tx.Details.(*FlashLoanCall)
+		require.True(t, ok)
+		require.Equal(t, &FlashLoanCall{
+			Pool:         pool,
+			Receiver:     receiver,
+			Assets:       []common.Address{usdc},
+			Amounts:      []*big.Int{amounts[0]},
+			Params:       params,
+			ReferralCode: 7,
+		}, details)
+
+		_, err = ParseEthereumTransaction(unsignedDynamicFeeTx(t, &pool, big.NewInt(0), data[:len(data)-32]), big.NewInt(1))
+		require.Error(t, err)
+	})
+
+	invalid := []struct {
+		name string
+		data []byte
+	}{
+		{name: "no assets", data: flashLoan(nil, nil, nil)},
+		{name: "missing amount", data: flashLoan([]common.Address{usdc, weth}, amounts[:1], modes)},
+		{name: "missing interest rate mode", data: flashLoan([]common.Address{usdc, weth}, amounts, modes[:1])},
+		{name: "trailing bytes", data: append(flashLoan([]common.Address{usdc}, amounts[:1], modes[:1]), 0)},
+		{name: "truncated", data: flashLoan([]common.Address{usdc}, amounts[:1], modes[:1])[:200]},
+	}
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseEthereumTransaction(unsignedDynamicFeeTx(t, &pool, big.NewInt(0), tt.data), big.NewInt(1))
+			require.Error(t, err)
+		})
+	}
+}
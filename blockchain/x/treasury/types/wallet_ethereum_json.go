@@ -0,0 +1,184 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// EthereumJSONTx is the transaction object passed to eth_sendTransaction
+// (e.g. by WalletConnect), with quantities and data as hex strings.
+type EthereumJSONTx struct {
+	From                 *common.Address  `json:"from"`
+	To                   *common.Address  `json:"to"`
+	Gas                  *hexutil.Uint64  `json:"gas"`
+	GasPrice             *hexutil.Big     `json:"gasPrice"`
+	MaxFeePerGas         *hexutil.Big     `json:"maxFeePerGas"`
+	MaxPriorityFeePerGas *hexutil.Big     `json:"maxPriorityFeePerGas"`
+	Value                *hexutil.Big     `json:"value"`
+	Nonce                *hexutil.Uint64  `json:"nonce"`
+	Data                 *hexutil.Bytes   `json:"data"`
+	Input                *hexutil.Bytes   `json:"input"`
+	ChainID              *hexutil.Big     `json:"chainId"`
+	AccessList           types.AccessList `json:"accessList"`
+}
+
+// ParseEthereumJSONTx parses an eth_sendTransaction params object, see
+// EthereumJSONTx, as ParseEthereumTransaction does for RLP encoded
+// transactions.
+//
+// Nonce, gas and fees are optional for eth_sendTransaction, as the wallet
+// can fill them in, but they're part of the signing hash so they're required
+// here. If maxFeePerGas is set an EIP-1559 transaction is built, otherwise a
+// legacy one.
+func ParseEthereumJSONTx(chainID *big.Int, b []byte) (Transfer, error) {
+	var jsonTx EthereumJSONTx
+	if err := json.Unmarshal(b, &jsonTx); err != nil {
+		return Transfer{}, fmt.Errorf("invalid transaction JSON: %w", err)
+	}
+
+	tx, err := jsonTx.toTransaction(chainID)
+	if err != nil {
+		return Transfer{}, err
+	}
+
+	parsed, err := parseEthereumTx(tx, latestSigner(chainID).Hash(tx), nil)
+	if err != nil {
+		return Transfer{}, err
+	}
+	return parsed.Transfer(), nil
+}
+
+func (t *EthereumJSONTx) toTransaction(chainID *big.Int) (*types.Transaction, error) {
+	if t.Nonce == nil {
+		return nil, fmt.Errorf("missing nonce")
+	}
+	if t.Gas == nil {
+		return nil, fmt.Errorf("missing gas")
+	}
+	if t.ChainID != nil && t.ChainID.ToInt().Cmp(chainID) != 0 {
+		return nil, fmt.Errorf("transaction chain ID %v doesn't match chain ID %v", t.ChainID.ToInt(), chainID)
+	}
+	if t.Data != nil && t.Input != nil && !bytes.Equal(*t.Data, *t.Input) {
+		return nil, fmt.Errorf("both data and input are set, with different values")
+	}
+
+	var data []byte
+	switch {
+	case t.Input != nil:
+		data = *t.Input
+	case t.Data != nil:
+		data = *t.Data
+	}
+
+	value := new(big.Int)
+	if t.Value != nil {
+		value = t.Value.ToInt()
+	}
+
+	switch {
+	case t.MaxFeePerGas != nil:
+		if t.GasPrice != nil {
+			return nil, fmt.Errorf("both gasPrice and maxFeePerGas are set")
+		}
+		if t.MaxPriorityFeePerGas == nil {
+			return nil, fmt.Errorf("missing maxPriorityFeePerGas")
+		}
+		return types.NewTx(&types.DynamicFeeTx{
+			ChainID:    chainID,
+			Nonce:      uint64(*t.Nonce),
+			GasTipCap:  t.MaxPriorityFeePerGas.ToInt(),
+			GasFeeCap:  t.MaxFeePerGas.ToInt(),
+			Gas:        uint64(*t.Gas),
+			To:         t.To,
+			Value:      value,
+			Data:       data,
+			AccessList: t.AccessList,
+		}), nil
+	case t.GasPrice != nil:
+		if t.MaxPriorityFeePerGas != nil {
+			return nil, fmt.Errorf("both gasPrice and maxPriorityFeePerGas are set")
+		}
+		if len(t.AccessList) > 0 {
+			return types.NewTx(&types.AccessListTx{
+				ChainID:    chainID,
+				Nonce:      uint64(*t.Nonce),
+				GasPrice:   t.GasPrice.ToInt(),
+				Gas:        uint64(*t.Gas),
+				To:         t.To,
+				Value:      value,
+				Data:       data,
+				AccessList: t.AccessList,
+			}), nil
+		}
+		return types.NewTx(&types.LegacyTx{
+			Nonce:    uint64(*t.Nonce),
+			GasPrice: t.GasPrice.ToInt(),
+			Gas:      uint64(*t.Gas),
+			To:       t.To,
+			Value:    value,
+			Data:     data,
+		}), nil
+	default:
+		return nil, fmt.Errorf("missing fees: either gasPrice or maxFeePerGas must be set")
+	}
+}
+
+// ethereumTransferJSON is the JSON encoding of an EthereumTransfer.
+type ethereumTransferJSON struct {
+	To             *common.Address `json:"to,omitempty"`
+	Amount         string          `json:"amount,omitempty"`
+	Contract       *common.Address `json:"contract,omitempty"`
+	DataForSigning hexutil.Bytes   `json:"data_for_signing,omitempty"`
+	Kind           TxKind          `json:"kind,omitempty"`
+	Details        json.RawMessage `json:"details,omitempty"`
+}
+
+// MarshalJSON encodes the amount as a decimal string and the addresses as
+// 0x-prefixed hex strings, see Transfer.MarshalJSON.
+func (tx EthereumTransfer) MarshalJSON() ([]byte, error) {
+	details, err := marshalDetailsJSON(tx.Details)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(ethereumTransferJSON{
+		To:             tx.To,
+		Amount:         amountToJSON(tx.Amount),
+		Contract:       tx.Contract,
+		DataForSigning: tx.DataForSigning,
+		Kind:           tx.Kind,
+		Details:        details,
+	})
+}
+
+// UnmarshalJSON decodes an EthereumTransfer encoded by MarshalJSON. As for
+// Transfer, Details is set to the json.RawMessage of the encoded details.
+func (tx *EthereumTransfer) UnmarshalJSON(b []byte) error {
+	var dec ethereumTransferJSON
+	if err := json.Unmarshal(b, &dec); err != nil {
+		return err
+	}
+	amount, err := amountFromJSON(dec.Amount)
+	if err != nil {
+		return err
+	}
+
+	*tx = EthereumTransfer{
+		To:             dec.To,
+		Amount:         amount,
+		Contract:       dec.Contract,
+		DataForSigning: dec.DataForSigning,
+		Kind:           dec.Kind,
+	}
+	if len(dec.Details) > 0 {
+		tx.Details = dec.Details
+	}
+	return nil
+}
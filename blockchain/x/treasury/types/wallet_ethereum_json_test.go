@@ -0,0 +1,148 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package types
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/stretchr/testify/require"
+)
+
+// erc20TransferJSONTx is an eth_sendTransaction params object transferring
+// 1000 USDC, as sent by a dApp through WalletConnect.
+const erc20TransferJSONTx = `{
+  "from": "0xdD1d3fF09C5EdfF1bE7d466cA614cB1cF3f78738",
+  "to": "0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48",
+  "gas": "0x186a0",
+  "maxFeePerGas": "0x6fc23ac00",
+  "maxPriorityFeePerGas": "0x3b9aca00",
+  "value": "0x0",
+  "nonce": "0x3",
+  "data": "0xa9059cbb00000000000000000000000048c04ed5691981c42154c6167398f95e8f38a7ff000000000000000000000000000000000000000000000000000000003b9aca00"
+}`
+
+func Test_ParseEthereumJSONTx(t *testing.T) {
+	chainID := big.NewInt(1)
+
+	t.Run("erc-20 transfer", func(t *testing.T) {
+		transfer, err := ParseEthereumJSONTx(chainID, []byte(erc20TransferJSONTx))
+		require.NoError(t, err)
+
+		// same transaction, RLP encoded
+		usdc := common.HexToAddress("0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48")
+		data := hexutil.MustDecode("0xa9059cbb00000000000000000000000048c04ed5691981c42154c6167398f95e8f38a7ff000000000000000000000000000000000000000000000000000000003b9aca00")
+		want, err := ethereumWallet(t).ParseTx(unsignedDynamicFeeTx(t, &usdc, big.NewInt(0), data), &MetadataEthereum{ChainId: 1})
+		require.NoError(t, err)
+
+		require.Equal(t, want, transfer)
+		require.Equal(t, TxKindTransfer, transfer.Kind)
+		require.Equal(t, "0x48c04ed5691981C42154C6167398f95e8f38a7fF", common.BytesToAddress(transfer.To).Hex())
+		require.Equal(t, big.NewInt(1_000_000_000), transfer.Amount)
+	})
+
+	t.Run("legacy native transfer", func(t *testing.T) {
+		transfer, err := ParseEthereumJSONTx(chainID, []byte(`{
+			"to": "0x48c04ed5691981C42154C6167398f95e8f38a7fF",
+			"gas": "0x5208",
+			"gasPrice": "0x4a817c800",
+			"value": "0xde0b6b3a7640000",
+			"nonce": "0x0",
+			"chainId": "0x1"
+		}`))
+		require.NoError(t, err)
+
+		to := common.HexToAddress("0x48c04ed5691981C42154C6167398f95e8f38a7fF")
+		b, err := rlp.EncodeToBytes(&types.LegacyTx{
+			Nonce:    0,
+			GasPrice: big.NewInt(20_000_000_000),
+			Gas:      21_000,
+			To:       &to,
+			Value:    big.NewInt(1_000_000_000_000_000_000),
+		})
+		require.NoError(t, err)
+		want, err := ParseEthereumTransaction(b, chainID)
+		require.NoError(t, err)
+
+		require.Equal(t, want.Transfer(), transfer)
+		require.Nil(t, transfer.Details)
+	})
+
+	t.Run("input field", func(t *testing.T) {
+		transfer, err := ParseEthereumJSONTx(chainID, []byte(`{
+			"to": "0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48",
+			"gas": "0x186a0", "maxFeePerGas": "0x6fc23ac00", "maxPriorityFeePerGas": "0x3b9aca00", "nonce": "0x3",
+			"input": "0xa9059cbb00000000000000000000000048c04ed5691981c42154c6167398f95e8f38a7ff000000000000000000000000000000000000000000000000000000003b9aca00"
+		}`))
+		require.NoError(t, err)
+		require.Equal(t, big.NewInt(1_000_000_000), transfer.Amount)
+	})
+
+	errorTests := []struct {
+		name string
+		json string
+	}{
+		{name: "not json", json: `eth_sendTransaction`},
+		{name: "missing nonce", json: `{"to": "0x48c04ed5691981C42154C6167398f95e8f38a7fF", "gas": "0x5208", "gasPrice": "0x1"}`},
+		{name: "missing gas", json: `{"to": "0x48c04ed5691981C42154C6167398f95e8f38a7fF", "nonce": "0x0", "gasPrice": "0x1"}`},
+		{name: "missing fees", json: `{"to": "0x48c04ed5691981C42154C6167398f95e8f38a7fF", "nonce": "0x0", "gas": "0x5208"}`},
+		{name: "missing priority fee", json: `{"to": "0x48c04ed5691981C42154C6167398f95e8f38a7fF", "nonce": "0x0", "gas": "0x5208", "maxFeePerGas": "0x1"}`},
+		{name: "mixed fees", json: `{"to": "0x48c04ed5691981C42154C6167398f95e8f38a7fF", "nonce": "0x0", "gas": "0x5208", "gasPrice": "0x1", "maxFeePerGas": "0x1", "maxPriorityFeePerGas": "0x1"}`},
+		{name: "wrong chain", json: `{"to": "0x48c04ed5691981C42154C6167398f95e8f38a7fF", "nonce": "0x0", "gas": "0x5208", "gasPrice": "0x1", "chainId": "0x89"}`},
+		{name: "conflicting data and input", json: `{"to": "0x48c04ed5691981C42154C6167398f95e8f38a7fF", "nonce": "0x0", "gas": "0x5208", "gasPrice": "0x1", "data": "0x01", "input": "0x02"}`},
+		{name: "decimal quantity", json: `{"to": "0x48c04ed5691981C42154C6167398f95e8f38a7fF", "nonce": "0", "gas": "0x5208", "gasPrice": "0x1"}`},
+	}
+
+	for _, tt := range errorTests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseEthereumJSONTx(chainID, []byte(tt.json))
+			require.Error(t, err)
+		})
+	}
+}
+
+func Test_EthereumTransfer_JSON(t *testing.T) {
+	amount, ok := new(big.Int).SetString("1000000000000000000000000000001", 10)
+	require.True(t, ok)
+	usdc := common.HexToAddress("0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48")
+	recipient := common.HexToAddress("0x48c04ed5691981C42154C6167398f95e8f38a7fF")
+
+	tx := EthereumTransfer{
+		To:             &recipient,
+		Amount:         amount,
+		Contract:       &usdc,
+		DataForSigning: hexutil.MustDecode("0xdeadbeef"),
+		Kind:           TxKindTransfer,
+	}
+
+	b, err := json.Marshal(tx)
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"to": "0x48c04ed5691981c42154c6167398f95e8f38a7ff",
+		"amount": "1000000000000000000000000000001",
+		"contract": "0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48",
+		"data_for_signing": "0xdeadbeef",
+		"kind": "transfer"
+	}`, string(b))
+
+	var decoded EthereumTransfer
+	require.NoError(t, json.Unmarshal(b, &decoded))
+	require.Equal(t, tx, decoded)
+	require.Equal(t, tx.Transfer(), decoded.Transfer())
+
+	t.Run("contract creation", func(t *testing.T) {
+		deploy := EthereumTransfer{Amount: big.NewInt(0), DataForSigning: []byte{1}, Kind: TxKindDeploy}
+		b, err := json.Marshal(deploy)
+		require.NoError(t, err)
+		require.JSONEq(t, `{"amount": "0", "data_for_signing": "0x01", "kind": "deploy"}`, string(b))
+
+		var decoded EthereumTransfer
+		require.NoError(t, json.Unmarshal(b, &decoded))
+		require.Equal(t, deploy, decoded)
+	})
+}
@@ -0,0 +1,103 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// TxKindBatchTransfer is a batchTransferFrom call of an ERC-721 collection
+// (or of a batch helper), moving several tokens at once.
+const TxKindBatchTransfer TxKind = "batch_transfer"
+
+// erc721Symbol is the symbol of the identifiers of ERC-721 tokens, see
+// ERC721Coin.
+const erc721Symbol = "ERC721"
+
+// ERC721Coin returns the identifier of the tokens of an ERC-721 collection,
+// serialized as "ERC721/<contract>". The tokens aren't fungible, the id of
+// each token is in the Details of its Transfer.
+func ERC721Coin(contract common.Address) CoinIdentifier {
+	return CoinIdentifier{Symbol: erc721Symbol, Contract: contract.Bytes()}
+}
+
+// BatchTransferCall contains the arguments of a batchTransferFrom call.
+type BatchTransferCall struct {
+	From     common.Address
+	To       common.Address
+	TokenIDs []*big.Int
+
+	// Transfers contains a Transfer for each token, in order, with Kind
+	// TxKindTransferFrom, an Amount of 1, and an ERC721TransferDetails as
+	// Details.
+	Transfers []Transfer
+}
+
+// ERC721TransferDetails identifies the token moved by a Transfer of an
+// ERC-721 token.
+type ERC721TransferDetails struct {
+	From    common.Address
+	TokenID *big.Int
+}
+
+var (
+	batchTransferFromMethodID  = crypto.Keccak256Hash([]byte("batchTransferFrom(address,address,uint256[])")).Bytes()[0:4]
+	batchTransferFromArguments = abi.Arguments{
+		{Type: mustABIType("address")},   // from
+		{Type: mustABIType("address")},   // to
+		{Type: mustABIType("uint256[]")}, // tokenIds
+	}
+)
+
+// unpackBatchTransferFrom decodes the arguments of a batchTransferFrom call
+// to the collection at address contract. The arguments must be canonically
+// encoded, and the token ids must be distinct.
+func unpackBatchTransferFrom(contract common.Address, args []byte) (*BatchTransferCall, error) {
+	values, err := batchTransferFromArguments.UnpackValues(args)
+	if err != nil {
+		return nil, fmt.Errorf("invalid batchTransferFrom: %w", err)
+	}
+	call := &BatchTransferCall{
+		From:     values[0].(common.Address),
+		To:       values[1].(common.Address),
+		TokenIDs: values[2].([]*big.Int),
+	}
+
+	// offsets and lengths that don't match the canonical encoding (e.g.
+	// overlapping or trailing data) could be decoded differently by the
+	// contract
+	encoded, err := batchTransferFromArguments.Pack(call.From, call.To, call.TokenIDs)
+	if err != nil {
+		return nil, fmt.Errorf("invalid batchTransferFrom: %w", err)
+	}
+	if !bytes.Equal(encoded, args) {
+		return nil, fmt.Errorf("invalid batchTransferFrom: non-canonical encoding")
+	}
+	if len(call.TokenIDs) == 0 {
+		return nil, fmt.Errorf("invalid batchTransferFrom: no token ids")
+	}
+
+	coinIdentifier := ERC721Coin(contract).Bytes()
+	seen := make(map[string]bool, len(call.TokenIDs))
+	call.Transfers = make([]Transfer, len(call.TokenIDs))
+	for i, id := range call.TokenIDs {
+		if seen[id.String()] {
+			return nil, fmt.Errorf("invalid batchTransferFrom: duplicate token id %s", id)
+		}
+		seen[id.String()] = true
+		call.Transfers[i] = Transfer{
+			Kind:           TxKindTransferFrom,
+			To:             call.To.Bytes(),
+			Amount:         big.NewInt(1),
+			CoinIdentifier: coinIdentifier,
+			Details:        &ERC721TransferDetails{From: call.From, TokenID: id},
+		}
+	}
+	return call, nil
+}
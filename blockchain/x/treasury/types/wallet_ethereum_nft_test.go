@@ -0,0 +1,71 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ParseEthereumTransaction_BatchTransfer(t *testing.T) {
+	collection := common.HexToAddress("0xBC4CA0EdA7647A8aB7C2061c2E118A18a936f13D")
+	from := common.HexToAddress("0xdD1d3fF09C5EdfF1bE7d466cA614cB1cF3f78738")
+	to := common.HexToAddress("0x48c04ed5691981C42154C6167398f95e8f38a7fF")
+
+	pack := func(ids ...int64) []byte {
+		tokenIDs := make([]*big.Int, len(ids))
+		for i, id := range ids {
+			tokenIDs[i] = big.NewInt(id)
+		}
+		args, err := batchTransferFromArguments.Pack(from, to, tokenIDs)
+		require.NoError(t, err)
+		return append(append([]byte{}, batchTransferFromMethodID...), args...)
+	}
+
+	t.Run("three tokens", func(t *testing.T) {
+		tx, err := ParseEthereumTransaction(unsignedDynamicFeeTx(t, &collection, big.NewInt(0), pack(7, 42, 9999)), big.NewInt(1))
+		require.NoError(t, err)
+		require.Equal(t, TxKindBatchTransfer, tx.Kind)
+		require.Equal(t, collection, *tx.To)
+
+		details, ok := tx.Details.(*BatchTransferCall)
+		require.True(t, ok)
+		require.Equal(t, from, details.From)
+		require.Equal(t, to, details.To)
+		require.Equal(t, []*big.Int{big.NewInt(7), big.NewInt(42), big.NewInt(9999)}, details.TokenIDs)
+		require.Len(t, details.Transfers, 3)
+		for i, transfer := range details.Transfers {
+			require.Equal(t, TxKindTransferFrom, transfer.Kind)
+			require.Equal(t, to.Bytes(), transfer.To)
+			require.Equal(t, big.NewInt(1), transfer.Amount)
+			require.Equal(t, "ERC721/0xbc4ca0eda7647a8ab7c2061c2e118a18a936f13d", string(transfer.CoinIdentifier))
+			require.Equal(t, &ERC721TransferDetails{From: from, TokenID: details.TokenIDs[i]}, transfer.Details)
+		}
+
+		coin, err := ParseCoinIdentifier(details.Transfers[0].CoinIdentifier)
+		require.NoError(t, err)
+		require.Equal(t, ERC721Coin(collection), coin)
+	})
+
+	malformed := map[string][]byte{
+		"no tokens":       pack(),
+		"duplicate token": pack(7, 42, 7),
+		// the array claims 3 elements but only 2 are present
+		"truncated array": pack(7, 42, 9999)[:4+32*6],
+		"trailing bytes":  append(pack(7, 42, 9999), 0x00),
+	}
+	// array offset pointing past the length word of the array
+	badOffset := pack(7, 42, 9999)
+	badOffset[4+32*3-1] = 0x80
+	malformed["bad array offset"] = badOffset
+
+	for name, data := range malformed {
+		t.Run(name, func(t *testing.T) {
+			_, err := ParseEthereumTransaction(unsignedDynamicFeeTx(t, &collection, big.NewInt(0), data), big.NewInt(1))
+			require.Error(t, err)
+		})
+	}
+}
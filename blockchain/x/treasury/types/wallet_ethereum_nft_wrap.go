@@ -0,0 +1,75 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package types
+
+import (
+	"fmt"
+	"math/big"
+	"regexp"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// TxKindNFTWrap is the wrapping of an NFT into a wrapper contract (e.g.
+// WrappedPunks), which takes custody of the NFT and mints a wrapped token
+// with the same id.
+const TxKindNFTWrap TxKind = "nft_wrap"
+
+// NFTWrapCall contains the arguments of a wrapped-NFT deposit call.
+type NFTWrapCall struct {
+	// Wrapper is the address of the wrapper contract.
+	Wrapper common.Address
+
+	// TokenID is the id of the NFT being wrapped.
+	TokenID *big.Int
+}
+
+// nftWrapMethodIDs are the method IDs of the recognised wrapped-NFT
+// deposits, see RegisterNFTWrapMethod.
+var nftWrapMethodIDs = map[string]string{}
+
+// nftWrapSignature matches the signature of a method taking a single uint256
+// argument.
+var nftWrapSignature = regexp.MustCompile(`^[A-Za-z_$][A-Za-z0-9_$]*\(uint256\)$`)
+
+// RegisterNFTWrapMethod adds the method with the given signature (e.g.
+// "mint(uint256)" for WrappedPunks) to the wrapped-NFT deposits recognised
+// when parsing Ethereum transactions. The method must take a single uint256
+// token id.
+//
+// None is registered by default: the same signatures are commonly used for
+// other purposes, e.g. deposit(uint256) by vaults taking an amount.
+//
+// It must be called before parsing any transaction (e.g. in an init
+// function), and it's not safe for concurrent use.
+func RegisterNFTWrapMethod(signature string) error {
+	if !nftWrapSignature.MatchString(signature) {
+		return fmt.Errorf("invalid NFT wrap signature %q: expected a single uint256 argument", signature)
+	}
+	methodID := string(crypto.Keccak256([]byte(signature))[0:4])
+	if registered, ok := nftWrapMethodIDs[methodID]; ok && registered != signature {
+		return fmt.Errorf("NFT wrap signature %q clashes with %q", signature, registered)
+	}
+	nftWrapMethodIDs[methodID] = signature
+	return nil
+}
+
+// isNFTWrapMethod returns true if method is a registered wrapped-NFT
+// deposit.
+func isNFTWrapMethod(method []byte) bool {
+	_, ok := nftWrapMethodIDs[string(method)]
+	return ok
+}
+
+// unpackNFTWrap decodes the arguments of a wrapped-NFT deposit to the
+// contract at address wrapper.
+func unpackNFTWrap(wrapper common.Address, args []byte) (*NFTWrapCall, error) {
+	if len(args) != 32 {
+		return nil, fmt.Errorf("invalid NFT wrap: expected 32 bytes of arguments, got %d", len(args))
+	}
+	return &NFTWrapCall{
+		Wrapper: wrapper,
+		TokenID: new(big.Int).SetBytes(args),
+	}, nil
+}
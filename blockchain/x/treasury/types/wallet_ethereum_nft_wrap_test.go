@@ -0,0 +1,77 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ParseEthereumTransaction_NFTWrap(t *testing.T) {
+	wrappedPunks := common.HexToAddress("0xb7F7F6C52F2e2fdb1963Eab30438024864c313F6")
+	mint := hexutil.MustDecode("0xa0712d680000000000000000000000000000000000000000000000000000000000001e7c")
+
+	// mint(uint256) isn't recognised until registered
+	tx, err := ParseEthereumTransaction(unsignedDynamicFeeTx(t, &wrappedPunks, big.NewInt(0), mint), big.NewInt(1))
+	require.NoError(t, err)
+	require.Equal(t, TxKindContractCall, tx.Kind)
+
+	require.NoError(t, RegisterNFTWrapMethod("mint(uint256)"))
+	t.Cleanup(func() { delete(nftWrapMethodIDs, string(mint[:4])) })
+
+	tests := []struct {
+		name    string
+		data    []byte
+		wantErr bool
+	}{
+		{
+			name: "mint",
+			data: mint,
+		},
+		{
+			name:    "truncated token id",
+			data:    hexutil.MustDecode("0xa0712d6800000000000000000000000000000000000000000000000000000000001e"),
+			wantErr: true,
+		},
+		{
+			name:    "trailing bytes",
+			data:    hexutil.MustDecode("0xa0712d680000000000000000000000000000000000000000000000000000000000001e7c00"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx, err := ParseEthereumTransaction(unsignedDynamicFeeTx(t, &wrappedPunks, big.NewInt(0), tt.data), big.NewInt(1))
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, TxKindNFTWrap, tx.Kind)
+			require.Equal(t, wrappedPunks, *tx.To)
+
+			details, ok := tx.Details.(*NFTWrapCall)
+			require.True(t, ok)
+			require.Equal(t, wrappedPunks, details.Wrapper)
+			require.Equal(t, big.NewInt(7804), details.TokenID)
+		})
+	}
+}
+
+func Test_RegisterNFTWrapMethod(t *testing.T) {
+	require.NoError(t, RegisterNFTWrapMethod("deposit(uint256)"))
+	t.Cleanup(func() { delete(nftWrapMethodIDs, string(hexutil.MustDecode("0xb6b55f25"))) })
+	require.True(t, isNFTWrapMethod(hexutil.MustDecode("0xb6b55f25")))
+
+	// registering the same signature again is a no-op
+	require.NoError(t, RegisterNFTWrapMethod("deposit(uint256)"))
+
+	require.Error(t, RegisterNFTWrapMethod("deposit(uint256,address)"))
+	require.Error(t, RegisterNFTWrapMethod("deposit(uint128)"))
+	require.Error(t, RegisterNFTWrapMethod(""))
+}
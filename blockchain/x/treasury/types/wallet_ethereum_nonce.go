@@ -0,0 +1,64 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+var (
+	// ErrNonceGap is returned for transactions whose nonce is past the
+	// next expected one. They can't be mined until the missing nonces are
+	// used, and would be mined unexpectedly once they are.
+	ErrNonceGap = errors.New("nonce gap")
+
+	// ErrNonceReused is returned for transactions reusing the nonce of a
+	// transaction already signed, that aren't a replacement of it.
+	ErrNonceReused = errors.New("nonce already used")
+)
+
+// CheckNextNonce checks that the unsigned transaction next can be signed
+// after last, the last transaction signed by the same wallet on the same
+// chain, or nil if there is none. The nonce of next must be:
+//   - the one following the nonce of last, or
+//   - the nonce of last, if next replaces last by paying a higher fee (see
+//     IsReplacement). It returns true in this case.
+//
+// Any nonce is accepted if last is nil, as the nonce of the account on the
+// chain isn't known. Only the last transaction can be replaced, as the
+// earlier ones aren't kept.
+func CheckNextNonce(last, next []byte) (replacement bool, err error) {
+	nextData, err := DecodeUnsignedPayload(next)
+	if err != nil {
+		return false, err
+	}
+	if last == nil {
+		return false, nil
+	}
+	lastData, err := DecodeUnsignedPayload(last)
+	if err != nil {
+		return false, fmt.Errorf("invalid last transaction: %w", err)
+	}
+
+	lastNonce, nonce := types.NewTx(lastData).Nonce(), types.NewTx(nextData).Nonce()
+	switch {
+	case nonce == lastNonce+1:
+		return false, nil
+	case nonce > lastNonce:
+		return false, fmt.Errorf("%w: nonce %d, expected %d", ErrNonceGap, nonce, lastNonce+1)
+	case nonce < lastNonce:
+		return false, fmt.Errorf("%w: nonce %d, expected %d", ErrNonceReused, nonce, lastNonce+1)
+	}
+
+	ok, err := IsReplacement(last, next)
+	if err != nil {
+		return false, err
+	}
+	if !ok {
+		return false, fmt.Errorf("%w: nonce %d isn't a replacement of the last transaction", ErrNonceReused, nonce)
+	}
+	return true, nil
+}
@@ -0,0 +1,96 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CheckNextNonce(t *testing.T) {
+	to := common.HexToAddress("0x2c7536E3605D9C16a7a3D7b1898e529396a65c23")
+	tx := func(nonce uint64, feeCap int64, value int64) []byte {
+		b, err := rlp.EncodeToBytes(&DynamicFeeTxWithoutSignature{
+			ChainID:   big.NewInt(1),
+			Nonce:     nonce,
+			GasTipCap: big.NewInt(feeCap / 30),
+			GasFeeCap: big.NewInt(feeCap),
+			Gas:       21_000,
+			To:        &to,
+			Value:     big.NewInt(value),
+		})
+		require.NoError(t, err)
+		return append([]byte{types.DynamicFeeTxType}, b...)
+	}
+	last := tx(3, 30_000_000_000, 1_000)
+
+	tests := []struct {
+		name            string
+		last            []byte
+		next            []byte
+		wantReplacement bool
+		wantErr         error
+	}{
+		{
+			name: "first transaction",
+			next: tx(42, 30_000_000_000, 1_000),
+		},
+		{
+			name: "in order",
+			last: last,
+			next: tx(4, 30_000_000_000, 2_000),
+		},
+		{
+			name:    "gap",
+			last:    last,
+			next:    tx(5, 30_000_000_000, 2_000),
+			wantErr: ErrNonceGap,
+		},
+		{
+			name:            "replacement",
+			last:            last,
+			next:            tx(3, 40_000_000_000, 1_000),
+			wantReplacement: true,
+		},
+		{
+			name:    "same nonce without fee bump",
+			last:    last,
+			next:    tx(3, 30_000_000_000, 1_000),
+			wantErr: ErrNonceReused,
+		},
+		{
+			name:    "same nonce, different transfer",
+			last:    last,
+			next:    tx(3, 40_000_000_000, 2_000),
+			wantErr: ErrNonceReused,
+		},
+		{
+			name:    "earlier nonce",
+			last:    last,
+			next:    tx(2, 40_000_000_000, 1_000),
+			wantErr: ErrNonceReused,
+		},
+		{
+			name:    "malformed transaction",
+			last:    last,
+			next:    []byte{types.DynamicFeeTxType, 0xc0},
+			wantErr: ErrMalformedTx,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			replacement, err := CheckNextNonce(tt.last, tt.next)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.wantReplacement, replacement)
+		})
+	}
+}
@@ -0,0 +1,246 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// PermitAndCallDetails contains the arguments of a permitAndCall call (as
+// implemented by the 1inch Aggregation Router V6), which applies an EIP-2612
+// permit and then calls the contract itself with the action calldata, in a
+// single transaction.
+type PermitAndCallDetails struct {
+	// Token is the token the permit is applied to.
+	Token common.Address
+
+	// Permit contains the arguments of the permit. Its Nonce is nil, as the
+	// nonce isn't part of the permit call.
+	Permit *PermitDetails
+
+	// V, R and S are the signature of the permit by its owner.
+	V uint8
+	R common.Hash
+	S common.Hash
+
+	// Action is the calldata of the call following the permit.
+	Action []byte
+
+	// ActionDetails contains the decoded arguments of the action, if it was
+	// recognised.
+	ActionDetails any
+}
+
+var (
+	permitAndCallMethodID  = crypto.Keccak256Hash([]byte("permitAndCall(bytes,bytes)")).Bytes()[0:4]
+	permitAndCallArguments = abi.Arguments{
+		{Type: mustABIType("bytes")}, // permit
+		{Type: mustABIType("bytes")}, // action
+	}
+)
+
+// eip2612PermitLength is the length of the permit argument of permitAndCall
+// for EIP-2612 permits: the token address followed by the arguments of
+// permit(address,address,uint256,uint256,uint8,bytes32,bytes32).
+const eip2612PermitLength = common.AddressLength + 7*32
+
+// unpackPermitAndCall decodes a permitAndCall call to the contract at
+// address to, classifying it by its action. The arguments must be
+// canonically encoded, and the permit must be an EIP-2612 permit; the
+// compact and DAI-style permits also accepted by the router are rejected.
+func unpackPermitAndCall(to common.Address, args []byte) (*ethereumCall, error) {
+	values, err := permitAndCallArguments.UnpackValues(args)
+	if err != nil {
+		return nil, fmt.Errorf("invalid permitAndCall: %w", err)
+	}
+	permit := values[0].([]byte)
+	action := values[1].([]byte)
+
+	// offsets and lengths that don't match the canonical encoding could be
+	// decoded differently by the contract
+	encoded, err := permitAndCallArguments.Pack(permit, action)
+	if err != nil {
+		return nil, fmt.Errorf("invalid permitAndCall: %w", err)
+	}
+	if !bytes.Equal(encoded, args) {
+		return nil, fmt.Errorf("invalid permitAndCall: non-canonical encoding")
+	}
+
+	details, err := unpackEIP2612Permit(permit)
+	if err != nil {
+		return nil, fmt.Errorf("invalid permitAndCall: %w", err)
+	}
+	if len(action) == 0 {
+		return nil, fmt.Errorf("invalid permitAndCall: empty action")
+	}
+	details.Action = action
+
+	inner, parsed, err := parseCallData(to, action)
+	if err != nil {
+		return nil, fmt.Errorf("invalid permitAndCall action: %w", err)
+	}
+	if !parsed {
+		inner = &ethereumCall{Kind: TxKindContractCall}
+	}
+	details.ActionDetails = inner.Details
+	inner.Details = details
+	return inner, nil
+}
+
+// unpackEIP2612Permit decodes the permit argument of permitAndCall.
+func unpackEIP2612Permit(b []byte) (*PermitAndCallDetails, error) {
+	if len(b) != eip2612PermitLength {
+		return nil, fmt.Errorf("permit is %d bytes, expected %d", len(b), eip2612PermitLength)
+	}
+	token := common.BytesToAddress(b[:common.AddressLength])
+	args := b[common.AddressLength:]
+
+	owner, err := abiAddress(args, 0)
+	if err != nil {
+		return nil, fmt.Errorf("invalid permit: %w", err)
+	}
+	spender, err := abiAddress(args, 1)
+	if err != nil {
+		return nil, fmt.Errorf("invalid permit: %w", err)
+	}
+	value, err := abiUint(args, 2)
+	if err != nil {
+		return nil, fmt.Errorf("invalid permit: %w", err)
+	}
+	deadline, err := abiUint(args, 3)
+	if err != nil {
+		return nil, fmt.Errorf("invalid permit: %w", err)
+	}
+	v, err := abiUint(args, 4)
+	if err != nil {
+		return nil, fmt.Errorf("invalid permit: %w", err)
+	}
+	if !v.IsUint64() || v.Uint64() > 0xff {
+		return nil, fmt.Errorf("invalid permit: v overflows uint8")
+	}
+	r, err := abiWord(args, 5)
+	if err != nil {
+		return nil, fmt.Errorf("invalid permit: %w", err)
+	}
+	s, err := abiWord(args, 6)
+	if err != nil {
+		return nil, fmt.Errorf("invalid permit: %w", err)
+	}
+
+	return &PermitAndCallDetails{
+		Token: token,
+		Permit: &PermitDetails{
+			Owner:    owner,
+			Spender:  spender,
+			Value:    value,
+			Deadline: deadline,
+		},
+		V: uint8(v.Uint64()),
+		R: common.BytesToHash(r),
+		S: common.BytesToHash(s),
+	}, nil
+}
+
+var (
+	eip712DomainTypeHash               = crypto.Keccak256([]byte("EIP712Domain(string name,string version,uint256 chainId,address verifyingContract)"))
+	eip712DomainWithoutVersionTypeHash = crypto.Keccak256([]byte("EIP712Domain(string name,uint256 chainId,address verifyingContract)"))
+	permitTypeHash                     = crypto.Keccak256([]byte("Permit(address owner,address spender,uint256 value,uint256 nonce,uint256 deadline)"))
+)
+
+// PermitDomain is the EIP-712 domain of the permits of an EIP-2612 token.
+// The name and version are chosen by each token, e.g. "USD Coin" and "2"
+// for USDC, and must match the ones used by the token contract for its
+// DOMAIN_SEPARATOR.
+type PermitDomain struct {
+	Name string
+
+	// Version is empty for tokens whose domain has no version field, e.g.
+	// UNI.
+	Version string
+
+	ChainID *big.Int
+
+	// Token is the address of the token contract, the verifying contract
+	// of the domain.
+	Token common.Address
+}
+
+// Separator returns the EIP-712 domain separator of d.
+func (d PermitDomain) Separator() (common.Hash, error) {
+	chainID, err := abiEncodeUint(d.ChainID)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("invalid chain ID: %w", err)
+	}
+	if d.Version == "" {
+		return crypto.Keccak256Hash(
+			eip712DomainWithoutVersionTypeHash,
+			crypto.Keccak256([]byte(d.Name)),
+			chainID,
+			common.LeftPadBytes(d.Token.Bytes(), 32),
+		), nil
+	}
+	return crypto.Keccak256Hash(
+		eip712DomainTypeHash,
+		crypto.Keccak256([]byte(d.Name)),
+		crypto.Keccak256([]byte(d.Version)),
+		chainID,
+		common.LeftPadBytes(d.Token.Bytes(), 32),
+	), nil
+}
+
+// BuildEIP2612Permit returns the Transfer describing permit for the token of
+// domain, as ParseEIP712TypedData would for the equivalent typed data:
+// DataForSigning is the EIP-712 hash of the permit, to be signed by its
+// owner.
+func BuildEIP2612Permit(domain PermitDomain, permit *PermitDetails) (Transfer, error) {
+	if permit == nil {
+		return Transfer{}, fmt.Errorf("invalid permit: missing permit")
+	}
+	separator, err := domain.Separator()
+	if err != nil {
+		return Transfer{}, fmt.Errorf("invalid permit domain: %w", err)
+	}
+
+	words := [][]byte{permitTypeHash, common.LeftPadBytes(permit.Owner.Bytes(), 32), common.LeftPadBytes(permit.Spender.Bytes(), 32)}
+	for _, field := range []struct {
+		name  string
+		value *big.Int
+	}{
+		{"value", permit.Value},
+		{"nonce", permit.Nonce},
+		{"deadline", permit.Deadline},
+	} {
+		word, err := abiEncodeUint(field.value)
+		if err != nil {
+			return Transfer{}, fmt.Errorf("invalid permit: %s: %w", field.name, err)
+		}
+		words = append(words, word)
+	}
+	structHash := crypto.Keccak256(words...)
+
+	return Transfer{
+		To:             permit.Spender.Bytes(),
+		Amount:         permit.Value,
+		CoinIdentifier: TokenCoin(ethereumSymbol, domain.Token.Bytes()).Bytes(),
+		DataForSigning: crypto.Keccak256([]byte{0x19, 0x01}, separator.Bytes(), structHash),
+		Kind:           TxKindPermit,
+		Details:        permit,
+	}, nil
+}
+
+// abiEncodeUint encodes n as a uint256 ABI word.
+func abiEncodeUint(n *big.Int) ([]byte, error) {
+	if n == nil {
+		return nil, fmt.Errorf("missing value")
+	}
+	if n.Sign() < 0 || n.BitLen() > 256 {
+		return nil, fmt.Errorf("%v is not a valid uint256", n)
+	}
+	return common.LeftPadBytes(n.Bytes(), 32), nil
+}
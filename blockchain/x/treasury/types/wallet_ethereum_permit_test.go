@@ -0,0 +1,176 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ParseEthereumTransaction_PermitAndCall(t *testing.T) {
+	router := common.HexToAddress("0x111111125421cA6dc452d289314280a0f8842A65")
+	token := common.HexToAddress("0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48")
+	owner := common.HexToAddress("0x48c04ed5691981C42154C6167398f95e8f38a7fF")
+	recipient := common.HexToAddress("0xea223ca8968ca59e0bc79ba331c2f6f636a3fb82")
+	r := common.HexToHash("0x1b5a8e63f6a4c7b2b3c1e0d9f8a7b6c5d4e3f2a1b0c9d8e7f6a5b4c3d2e1f0a9")
+	s := common.HexToHash("0x2c6b9f74a7b5d8c3c4d2f1e0a9b8c7d6e5f4a3b2c1d0e9f8a7b6c5d4e3f2a1b0")
+
+	permit := func(v uint64) []byte {
+		return append(token.Bytes(), common.FromHex(
+			"000000000000000000000000"+owner.Hex()[2:]+
+				"000000000000000000000000"+router.Hex()[2:]+
+				"00000000000000000000000000000000000000000000000000000000000f4240"+ // 1000000
+				"0000000000000000000000000000000000000000000000000000000065a0b1c0"+
+				common.BigToHash(new(big.Int).SetUint64(v)).Hex()[2:]+
+				r.Hex()[2:]+
+				s.Hex()[2:])...)
+	}
+	erc20Transfer := hexutil.MustDecode("0xa9059cbb000000000000000000000000ea223ca8968ca59e0bc79ba331c2f6f636a3fb8200000000000000000000000000000000000000000000000000000000000f4240")
+	calldata := func(permit, action []byte) []byte {
+		args, err := permitAndCallArguments.Pack(permit, action)
+		require.NoError(t, err)
+		return append(append([]byte{}, permitAndCallMethodID...), args...)
+	}
+
+	t.Run("ERC-20 transfer", func(t *testing.T) {
+		tx, err := ParseEthereumTransaction(unsignedDynamicFeeTx(t, &router, big.NewInt(0), calldata(permit(27), erc20Transfer)), big.NewInt(1))
+		require.NoError(t, err)
+		require.Equal(t, TxKindTransfer, tx.Kind)
+		require.Equal(t, recipient, *tx.To)
+		require.Equal(t, big.NewInt(1_000_000), tx.Amount)
+		require.Equal(t, router, *tx.Contract)
+
+		details, ok := tx.Details.(*PermitAndCallDetails)
+		require.True(t, ok)
+		require.Equal(t, token, details.Token)
+		require.Equal(t, owner, details.Permit.Owner)
+		require.Equal(t, router, details.Permit.Spender)
+		require.Equal(t, big.NewInt(1_000_000), details.Permit.Value)
+		require.Equal(t, big.NewInt(0x65a0b1c0), details.Permit.Deadline)
+		require.Nil(t, details.Permit.Nonce)
+		require.Equal(t, uint8(27), details.V)
+		require.Equal(t, r, details.R)
+		require.Equal(t, s, details.S)
+		require.Equal(t, erc20Transfer, details.Action)
+		require.Nil(t, details.ActionDetails)
+	})
+
+	t.Run("unknown action", func(t *testing.T) {
+		action := hexutil.MustDecode("0x12345678")
+		tx, err := ParseEthereumTransaction(unsignedDynamicFeeTx(t, &router, big.NewInt(0), calldata(permit(28), action)), big.NewInt(1))
+		require.NoError(t, err)
+		require.Equal(t, TxKindContractCall, tx.Kind)
+		require.Equal(t, router, *tx.To)
+
+		details, ok := tx.Details.(*PermitAndCallDetails)
+		require.True(t, ok)
+		require.Equal(t, action, details.Action)
+	})
+
+	invalid := []struct {
+		name string
+		data []byte
+	}{
+		{name: "empty action", data: calldata(permit(27), nil)},
+		{name: "truncated action", data: calldata(permit(27), erc20Transfer[:40])},
+		{name: "short permit", data: calldata(permit(27)[:eip2612PermitLength-1], erc20Transfer)},
+		{name: "compact permit", data: calldata(permit(27)[:common.AddressLength+100], erc20Transfer)},
+		{name: "v overflows uint8", data: calldata(permit(256), erc20Transfer)},
+		{name: "trailing bytes", data: append(calldata(permit(27), erc20Transfer), 0)},
+		{name: "truncated calldata", data: calldata(permit(27), erc20Transfer)[:100]},
+	}
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseEthereumTransaction(unsignedDynamicFeeTx(t, &router, big.NewInt(0), tt.data), big.NewInt(1))
+			require.Error(t, err)
+		})
+	}
+}
+
+// usdcPermitDomain is the permit domain of USDC on Ethereum mainnet.
+var usdcPermitDomain = PermitDomain{
+	Name:    "USD Coin",
+	Version: "2",
+	ChainID: big.NewInt(1),
+	Token:   common.HexToAddress("0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48"),
+}
+
+func Test_PermitDomain_Separator(t *testing.T) {
+	// DOMAIN_SEPARATOR() of the USDC contract on Ethereum mainnet
+	separator, err := usdcPermitDomain.Separator()
+	require.NoError(t, err)
+	require.Equal(t, "0x06c37168a7db5138defc7866392bb87a741f9b3d104deb5094588ce041cae335", separator.Hex())
+
+	// domains without a version, as computed by go-ethereum
+	uni := PermitDomain{Name: "Uniswap", ChainID: big.NewInt(1), Token: common.HexToAddress("0x1f9840a85d5aF5bf1D1762F925BDADdC4201F984")}
+	typedData := apitypes.TypedData{
+		Types: apitypes.Types{"EIP712Domain": {
+			{Name: "name", Type: "string"},
+			{Name: "chainId", Type: "uint256"},
+			{Name: "verifyingContract", Type: "address"},
+		}},
+		Domain: apitypes.TypedDataDomain{Name: uni.Name, ChainId: math.NewHexOrDecimal256(1), VerifyingContract: uni.Token.Hex()},
+	}
+	want, err := typedData.HashStruct("EIP712Domain", typedData.Domain.Map())
+	require.NoError(t, err)
+	separator, err = uni.Separator()
+	require.NoError(t, err)
+	require.Equal(t, common.BytesToHash(want), separator)
+
+	_, err = PermitDomain{Name: "USD Coin", Version: "2"}.Separator()
+	require.Error(t, err)
+}
+
+func Test_BuildEIP2612Permit(t *testing.T) {
+	permit := &PermitDetails{
+		Owner:    common.HexToAddress("0xdD1d3fF09C5EdfF1bE7d466cA614cB1cF3f78738"),
+		Spender:  common.HexToAddress("0x000000000022D473030F116dDEE9F6B43aC78BA3"),
+		Value:    big.NewInt(25000000),
+		Nonce:    big.NewInt(0),
+		Deadline: big.NewInt(1893456000),
+	}
+
+	transfer, err := BuildEIP2612Permit(usdcPermitDomain, permit)
+	require.NoError(t, err)
+	require.Equal(t, TxKindPermit, transfer.Kind)
+	require.Equal(t, expectedPermitHash(t), transfer.DataForSigning)
+	require.Equal(t, permit, transfer.Details)
+
+	// the same permit as typed data
+	parsed, err := ParseEIP712TypedData([]byte(permitTypedData))
+	require.NoError(t, err)
+	require.Equal(t, parsed.DataForSigning, transfer.DataForSigning)
+	require.Equal(t, parsed.To, transfer.To)
+	require.Equal(t, parsed.Amount, transfer.Amount)
+	require.Equal(t, parsed.CoinIdentifier, transfer.CoinIdentifier)
+}
+
+func Test_BuildEIP2612Permit_Invalid(t *testing.T) {
+	valid := func() *PermitDetails {
+		return &PermitDetails{Value: big.NewInt(1), Nonce: big.NewInt(0), Deadline: big.NewInt(1893456000)}
+	}
+
+	_, err := BuildEIP2612Permit(usdcPermitDomain, nil)
+	require.Error(t, err)
+
+	missingNonce := valid()
+	missingNonce.Nonce = nil
+	_, err = BuildEIP2612Permit(usdcPermitDomain, missingNonce)
+	require.Error(t, err)
+
+	negative := valid()
+	negative.Value = big.NewInt(-1)
+	_, err = BuildEIP2612Permit(usdcPermitDomain, negative)
+	require.Error(t, err)
+
+	overflow := valid()
+	overflow.Deadline = new(big.Int).Lsh(big.NewInt(1), 256)
+	_, err = BuildEIP2612Permit(usdcPermitDomain, overflow)
+	require.Error(t, err)
+}
@@ -0,0 +1,88 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package types
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// MetadataProxyContract is the key of Transfer.Metadata holding the address
+// of the proxy contract called by a token transfer, when its
+// CoinIdentifier identifies the token the proxy was resolved to.
+const MetadataProxyContract = "proxy_contract"
+
+// ProxyResolver maps upgradeable token proxies to the canonical address
+// identifying their token, e.g. from a registry in genesis or from the
+// EIP-1967 slots read by the caller. It's optional: without it, or for
+// proxies it doesn't know, transfers are identified by the proxy address.
+type ProxyResolver interface {
+	// ResolveProxy returns the canonical address of the token behind the
+	// proxy, or ok false if proxy isn't a known proxy.
+	ResolveProxy(proxy common.Address) (token common.Address, ok bool)
+}
+
+// ProxyRegistry is a ProxyResolver mapping proxy addresses to token
+// addresses.
+type ProxyRegistry map[common.Address]common.Address
+
+func (r ProxyRegistry) ResolveProxy(proxy common.Address) (common.Address, bool) {
+	token, ok := r[proxy]
+	return token, ok
+}
+
+// EIP1967ImplementationSlot is the storage slot holding the address of the
+// implementation of EIP-1967 proxies, keccak256("eip1967.proxy.implementation") - 1.
+var EIP1967ImplementationSlot = common.HexToHash("0x360894a13ba1a3210667c828492db98dca3e2076cc3735a920a3ca505d382bbc")
+
+// EIP1967Implementation returns the implementation address stored in the
+// EIP-1967 implementation slot of a proxy, as read by the caller (e.g. with
+// eth_getStorageAt). It returns an error if the slot doesn't hold an
+// address.
+func EIP1967Implementation(slot common.Hash) (common.Address, error) {
+	if common.BytesToHash(slot[:12]) != (common.Hash{}) {
+		return common.Address{}, fmt.Errorf("invalid EIP-1967 implementation slot %s", slot.Hex())
+	}
+	impl := common.BytesToAddress(slot[12:])
+	if impl == (common.Address{}) {
+		return common.Address{}, fmt.Errorf("empty EIP-1967 implementation slot")
+	}
+	return impl, nil
+}
+
+// SetProxyResolver sets the resolver used by ParseTx to map the proxies
+// called by token transfers to their token. A nil resolver disables the
+// mapping.
+func (w *EthereumWallet) SetProxyResolver(r ProxyResolver) {
+	w.proxies = r
+}
+
+// resolveProxy returns a copy of tx whose contract is the token behind the
+// proxy called by tx and the proxy address, if the resolver knows it.
+// Otherwise it returns tx unchanged and a nil proxy.
+func (w *EthereumWallet) resolveProxy(tx *EthereumTransfer) (*EthereumTransfer, *common.Address) {
+	if w.proxies == nil || tx.Contract == nil {
+		return tx, nil
+	}
+	token, ok := w.proxies.ResolveProxy(*tx.Contract)
+	if !ok || token == *tx.Contract || token == (common.Address{}) {
+		return tx, nil
+	}
+	proxy := *tx.Contract
+	resolved := *tx
+	resolved.Contract = &token
+	return &resolved, &proxy
+}
+
+// withProxyContract returns a copy of t whose Metadata records the proxy
+// called by the transfer.
+func (t Transfer) withProxyContract(proxy common.Address) Transfer {
+	metadata := make(map[string]string, len(t.Metadata)+1)
+	for k, v := range t.Metadata {
+		metadata[k] = v
+	}
+	metadata[MetadataProxyContract] = proxy.Hex()
+	t.Metadata = metadata
+	return t
+}
@@ -0,0 +1,104 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_EthereumWallet_ParseTx_ProxyResolver(t *testing.T) {
+	proxy := common.HexToAddress("0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48")
+	token := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	other := common.HexToAddress("0x2222222222222222222222222222222222222222")
+	recipient := common.HexToAddress("0x48c04ed5691981C42154C6167398f95e8f38a7fF")
+
+	tests := []struct {
+		name         string
+		proxies      ProxyResolver
+		tokens       TokenMetadataResolver
+		to           common.Address
+		wantCoin     string
+		wantToken    *CoinInfo
+		wantMetadata map[string]string
+	}{
+		{
+			name:         "known proxy",
+			proxies:      ProxyRegistry{proxy: token},
+			to:           proxy,
+			wantCoin:     "ETH/0x1111111111111111111111111111111111111111",
+			wantMetadata: map[string]string{MetadataProxyContract: proxy.Hex()},
+		},
+		{
+			name:         "known proxy, token metadata of the canonical token",
+			proxies:      ProxyRegistry{proxy: token},
+			tokens:       fakeTokenResolver{token: {Ticker: "USDC", Decimals: 6}},
+			to:           proxy,
+			wantCoin:     "ETH/0x1111111111111111111111111111111111111111",
+			wantToken:    &CoinInfo{Ticker: "USDC", Decimals: 6},
+			wantMetadata: map[string]string{MetadataProxyContract: proxy.Hex()},
+		},
+		{
+			name:     "unknown proxy",
+			proxies:  ProxyRegistry{proxy: token},
+			to:       other,
+			wantCoin: "ETH/0x2222222222222222222222222222222222222222",
+		},
+		{
+			name:     "no resolver",
+			to:       proxy,
+			wantCoin: "ETH/0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wallet := ethereumWallet(t)
+			wallet.SetProxyResolver(tt.proxies)
+			wallet.SetTokenMetadataResolver(tt.tokens)
+
+			transfer, err := wallet.ParseTx(unsignedDynamicFeeTx(t, &tt.to, big.NewInt(0), erc20TransferData(recipient, 1_000)), &MetadataEthereum{ChainId: 1})
+			require.NoError(t, err)
+			require.Equal(t, recipient.Bytes(), transfer.To)
+			require.Equal(t, tt.wantCoin, string(transfer.CoinIdentifier))
+			require.Equal(t, tt.wantToken, transfer.Token)
+			require.Equal(t, tt.wantMetadata, transfer.Metadata)
+		})
+	}
+
+	// native transfers aren't affected
+	wallet := ethereumWallet(t)
+	wallet.SetProxyResolver(ProxyRegistry{proxy: token})
+	transfer, err := wallet.ParseTx(unsignedDynamicFeeTx(t, &proxy, big.NewInt(1_000), nil), &MetadataEthereum{ChainId: 1})
+	require.NoError(t, err)
+	require.Equal(t, "ETH", string(transfer.CoinIdentifier))
+	require.Nil(t, transfer.Metadata)
+}
+
+func Test_EIP1967Implementation(t *testing.T) {
+	slot := new(big.Int).Sub(new(big.Int).SetBytes(crypto.Keccak256([]byte("eip1967.proxy.implementation"))), big.NewInt(1))
+	require.Equal(t, common.BigToHash(slot), EIP1967ImplementationSlot)
+
+	impl := common.HexToAddress("0x43506849D7C04F9138D1A2050bbF3A0c054402dd")
+	got, err := EIP1967Implementation(common.BytesToHash(impl.Bytes()))
+	require.NoError(t, err)
+	require.Equal(t, impl, got)
+
+	_, err = EIP1967Implementation(common.Hash{})
+	require.Error(t, err)
+	_, err = EIP1967Implementation(common.HexToHash("0x0100000000000000000000000000000000000000000000000000000000000001"))
+	require.Error(t, err)
+
+	// slot data can populate a ProxyRegistry
+	proxy := common.HexToAddress("0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48")
+	registry := ProxyRegistry{proxy: got}
+	token, ok := registry.ResolveProxy(proxy)
+	require.True(t, ok)
+	require.Equal(t, impl, token)
+	_, ok = registry.ResolveProxy(impl)
+	require.False(t, ok)
+}
@@ -0,0 +1,71 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package types
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrRateLimited is returned by ParseTx when the wallet rate limit is
+// exceeded, see WithRateLimit.
+var ErrRateLimited = errors.New("rate limited: too many transactions parsed")
+
+// WithRateLimit limits ParseTx to perSecond calls per second on average,
+// with bursts of up to perSecond calls. Calls exceeding the limit return
+// ErrRateLimited without parsing the transaction. A perSecond of zero or
+// less removes the limit. It returns the wallet itself.
+//
+// The limit depends on the wall clock, so it must not be used when parsing
+// transactions on chain, where every node must reach the same result.
+func (w *EthereumWallet) WithRateLimit(perSecond int) *EthereumWallet {
+	w.limiter = nil
+	if perSecond > 0 {
+		w.limiter = newTokenBucket(perSecond, time.Now)
+	}
+	return w
+}
+
+// tokenBucket is a token bucket rate limiter, refilled continuously at rate
+// tokens per second up to its capacity.
+type tokenBucket struct {
+	mu       sync.Mutex
+	rate     float64
+	capacity float64
+	tokens   float64
+	last     time.Time
+	now      func() time.Time
+}
+
+// newTokenBucket returns a full bucket holding perSecond tokens.
+func newTokenBucket(perSecond int, now func() time.Time) *tokenBucket {
+	return &tokenBucket{
+		rate:     float64(perSecond),
+		capacity: float64(perSecond),
+		tokens:   float64(perSecond),
+		last:     now(),
+		now:      now,
+	}
+}
+
+// allow takes a token from the bucket, returning false if it's empty.
+func (b *tokenBucket) allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := b.now()
+	if elapsed := now.Sub(b.last); elapsed > 0 {
+		b.tokens += elapsed.Seconds() * b.rate
+		if b.tokens > b.capacity {
+			b.tokens = b.capacity
+		}
+	}
+	b.last = now
+
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
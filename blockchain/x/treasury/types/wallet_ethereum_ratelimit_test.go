@@ -0,0 +1,52 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package types
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_EthereumWallet_WithRateLimit(t *testing.T) {
+	to := common.HexToAddress("0xea223ca8968ca59e0bc79ba331c2f6f636a3fb82")
+	unsignedTx := unsignedDynamicFeeTx(t, &to, big.NewInt(1_000), nil)
+	meta := &MetadataEthereum{ChainId: 1}
+
+	now := time.Unix(1_700_000_000, 0)
+	wallet := ethereumWallet(t).WithRateLimit(4)
+	wallet.limiter = newTokenBucket(4, func() time.Time { return now })
+
+	for i := 0; i < 4; i++ {
+		_, err := wallet.ParseTx(unsignedTx, meta)
+		require.NoError(t, err)
+	}
+	_, err := wallet.ParseTx(unsignedTx, meta)
+	require.ErrorIs(t, err, ErrRateLimited)
+
+	// a quarter of a second refills one token
+	now = now.Add(time.Second / 4)
+	_, err = wallet.ParseTx(unsignedTx, meta)
+	require.NoError(t, err)
+	_, err = wallet.ParseTx(unsignedTx, meta)
+	require.ErrorIs(t, err, ErrRateLimited)
+
+	// after the window the bucket is full again, but doesn't overflow
+	now = now.Add(10 * time.Second)
+	for i := 0; i < 4; i++ {
+		_, err := wallet.ParseTx(unsignedTx, meta)
+		require.NoError(t, err)
+	}
+	_, err = wallet.ParseTx(unsignedTx, meta)
+	require.ErrorIs(t, err, ErrRateLimited)
+
+	// removing the limit
+	wallet.WithRateLimit(0)
+	for i := 0; i < 10; i++ {
+		_, err := wallet.ParseTx(unsignedTx, meta)
+		require.NoError(t, err)
+	}
+}
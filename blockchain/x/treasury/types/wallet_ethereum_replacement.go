@@ -0,0 +1,78 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// replacementPriceBump is the minimum increase of the gas price, in
+// percent, for a transaction to replace a pending one with the same nonce.
+// It matches the default of the go-ethereum transaction pool, which rejects
+// replacements below it.
+const replacementPriceBump = 10
+
+// IsReplacement returns true if the unsigned transaction next replaces prev
+// by paying a higher fee (replace-by-fee), e.g. to unblock prev while it's
+// pending: both transactions have the same chain ID, nonce, destination,
+// value and data, and next raises the gas price by at least 10%. For
+// dynamic fee transactions both the fee cap and the tip cap must be raised.
+//
+// A replacement doesn't move any funds other than the ones of prev, so it
+// can be approved without re-approving the whole transfer. The gas limit
+// isn't compared, as a replacement may need a different one.
+func IsReplacement(prev, next []byte) (bool, error) {
+	prevData, err := DecodeUnsignedPayload(prev)
+	if err != nil {
+		return false, fmt.Errorf("invalid previous transaction: %w", err)
+	}
+	nextData, err := DecodeUnsignedPayload(next)
+	if err != nil {
+		return false, fmt.Errorf("invalid replacement transaction: %w", err)
+	}
+	prevTx, nextTx := types.NewTx(prevData), types.NewTx(nextData)
+
+	if prevTx.Nonce() != nextTx.Nonce() ||
+		!equalBigInts(unsignedChainID(prevData), unsignedChainID(nextData)) ||
+		prevTx.Value().Cmp(nextTx.Value()) != 0 ||
+		!bytes.Equal(prevTx.Data(), nextTx.Data()) {
+		return false, nil
+	}
+	switch prevTo, nextTo := prevTx.To(), nextTx.To(); {
+	case prevTo == nil && nextTo == nil:
+	case prevTo == nil || nextTo == nil || *prevTo != *nextTo:
+		return false, nil
+	}
+
+	return isPriceBump(prevTx.GasFeeCap(), nextTx.GasFeeCap()) &&
+		isPriceBump(prevTx.GasTipCap(), nextTx.GasTipCap()), nil
+}
+
+// isPriceBump returns true if next is at least replacementPriceBump percent
+// higher than prev.
+func isPriceBump(prev, next *big.Int) bool {
+	threshold := new(big.Int).Mul(prev, big.NewInt(100+replacementPriceBump))
+	threshold.Div(threshold, big.NewInt(100))
+	return next.Cmp(threshold) >= 0 && next.Cmp(prev) > 0
+}
+
+// unsignedChainID returns the chain ID of an unsigned transaction decoded by
+// DecodeUnsignedPayload, nil for legacy transactions without replay
+// protection.
+func unsignedChainID(data types.TxData) *big.Int {
+	switch d := data.(type) {
+	case *types.LegacyTx:
+		// EIP-155 transactions are signed with the chain ID in place of V
+		return d.V
+	case *types.AccessListTx:
+		return d.ChainID
+	case *types.DynamicFeeTx:
+		return d.ChainID
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,158 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_IsReplacement(t *testing.T) {
+	to := common.HexToAddress("0x2c7536E3605D9C16a7a3D7b1898e529396a65c23")
+	other := common.HexToAddress("0x1111111111111111111111111111111111111111")
+
+	dynamicFeeTx := func(tx DynamicFeeTxWithoutSignature) []byte {
+		b, err := rlp.EncodeToBytes(&tx)
+		require.NoError(t, err)
+		return append([]byte{types.DynamicFeeTxType}, b...)
+	}
+	legacyTx := func(tx types.LegacyTx) []byte {
+		b, err := rlp.EncodeToBytes(&tx)
+		require.NoError(t, err)
+		return b
+	}
+
+	pending := DynamicFeeTxWithoutSignature{
+		ChainID:   big.NewInt(1),
+		Nonce:     3,
+		GasTipCap: big.NewInt(1_000_000_000),
+		GasFeeCap: big.NewInt(30_000_000_000),
+		Gas:       21_000,
+		To:        &to,
+		Value:     big.NewInt(1_000_000),
+	}
+	bumped := func(modify func(tx *DynamicFeeTxWithoutSignature)) []byte {
+		tx := pending
+		tx.GasTipCap = big.NewInt(2_000_000_000)
+		tx.GasFeeCap = big.NewInt(40_000_000_000)
+		if modify != nil {
+			modify(&tx)
+		}
+		return dynamicFeeTx(tx)
+	}
+
+	pendingLegacy := types.LegacyTx{
+		Nonce:    7,
+		GasPrice: big.NewInt(20_000_000_000),
+		Gas:      21_000,
+		To:       &to,
+		Value:    big.NewInt(1_000_000),
+		V:        big.NewInt(1),
+		R:        big.NewInt(0),
+		S:        big.NewInt(0),
+	}
+	bumpedLegacy := pendingLegacy
+	bumpedLegacy.GasPrice = big.NewInt(22_000_000_000)
+	underpricedLegacy := pendingLegacy
+	underpricedLegacy.GasPrice = big.NewInt(21_000_000_000)
+
+	tests := []struct {
+		name    string
+		prev    []byte
+		next    []byte
+		want    bool
+		wantErr bool
+	}{
+		{
+			name: "higher fee",
+			prev: dynamicFeeTx(pending),
+			next: bumped(nil),
+			want: true,
+		},
+		{
+			name: "higher fee and gas limit",
+			prev: dynamicFeeTx(pending),
+			next: bumped(func(tx *DynamicFeeTxWithoutSignature) { tx.Gas = 30_000 }),
+			want: true,
+		},
+		{
+			name: "legacy, 10% higher gas price",
+			prev: legacyTx(pendingLegacy),
+			next: legacyTx(bumpedLegacy),
+			want: true,
+		},
+		{
+			name: "legacy, less than 10% higher gas price",
+			prev: legacyTx(pendingLegacy),
+			next: legacyTx(underpricedLegacy),
+		},
+		{
+			name: "same fee",
+			prev: dynamicFeeTx(pending),
+			next: dynamicFeeTx(pending),
+		},
+		{
+			name: "lower fee",
+			prev: bumped(nil),
+			next: dynamicFeeTx(pending),
+		},
+		{
+			name: "only fee cap raised",
+			prev: dynamicFeeTx(pending),
+			next: bumped(func(tx *DynamicFeeTxWithoutSignature) { tx.GasTipCap = pending.GasTipCap }),
+		},
+		{
+			name: "different destination",
+			prev: dynamicFeeTx(pending),
+			next: bumped(func(tx *DynamicFeeTxWithoutSignature) { tx.To = &other }),
+		},
+		{
+			name: "contract creation",
+			prev: dynamicFeeTx(pending),
+			next: bumped(func(tx *DynamicFeeTxWithoutSignature) { tx.To = nil }),
+		},
+		{
+			name: "different nonce",
+			prev: dynamicFeeTx(pending),
+			next: bumped(func(tx *DynamicFeeTxWithoutSignature) { tx.Nonce = 4 }),
+		},
+		{
+			name: "different value",
+			prev: dynamicFeeTx(pending),
+			next: bumped(func(tx *DynamicFeeTxWithoutSignature) { tx.Value = big.NewInt(2_000_000) }),
+		},
+		{
+			name: "different data",
+			prev: dynamicFeeTx(pending),
+			next: bumped(func(tx *DynamicFeeTxWithoutSignature) { tx.Data = []byte{0x01} }),
+		},
+		{
+			name: "different chain",
+			prev: dynamicFeeTx(pending),
+			next: bumped(func(tx *DynamicFeeTxWithoutSignature) { tx.ChainID = big.NewInt(5) }),
+		},
+		{
+			name:    "invalid transaction",
+			prev:    dynamicFeeTx(pending),
+			next:    []byte{0x05},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := IsReplacement(tt.prev, tt.next)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package types
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// DetectApprovalRisk looks for approvals granted to an address that also
+// receives funds in another of the given transfers, e.g. an approve
+// followed by a transferFrom to the same spender. This is a common pattern
+// of approval drains, where the user is tricked into approving an
+// attacker that then moves the allowance to itself.
+//
+// The check is a heuristic: it returns a human readable warning for each
+// suspicious pair, and no warnings doesn't mean the transfers are safe.
+func DetectApprovalRisk(transfers []Transfer) []string {
+	var warnings []string
+	for i, approval := range transfers {
+		spender, ok := approvalSpender(approval)
+		if !ok {
+			continue
+		}
+		for j, transfer := range transfers {
+			if i == j {
+				continue
+			}
+			if transfer.Kind != TxKindTransfer && transfer.Kind != TxKindTransferFrom {
+				continue
+			}
+			if !bytes.Equal(transfer.To, spender.Bytes()) {
+				continue
+			}
+			warnings = append(warnings, fmt.Sprintf(
+				"transfer %d approves %s as spender, and transfer %d sends funds to the same address",
+				i, spender.Hex(), j,
+			))
+		}
+	}
+	return warnings
+}
+
+// approvalSpender returns the address being granted an allowance by t, if
+// any. Revocations don't grant anything and are ignored.
+func approvalSpender(t Transfer) (common.Address, bool) {
+	switch d := t.Details.(type) {
+	case *ApprovalCall:
+		return d.Spender, t.Kind == TxKindApproval
+	case *Permit2ApprovalCall:
+		return d.Spender, true
+	case *ApprovalForAllCall:
+		return d.Operator, d.Approved
+	case *PermitDetails:
+		return d.Spender, true
+	default:
+		return common.Address{}, false
+	}
+}
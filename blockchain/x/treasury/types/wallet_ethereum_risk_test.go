@@ -0,0 +1,59 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_DetectApprovalRisk(t *testing.T) {
+	usdc := common.HexToAddress("0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48")
+	owner := common.HexToAddress("0x851b7F3Ab81bd8dF354F0D7640EFcD7288553419")
+	spender := common.HexToAddress("0x48c04ed5691981C42154C6167398f95e8f38a7fF")
+	router := common.HexToAddress("0x68b3465833fb72A70ecDF485E0e4C7bD8665Fc45")
+	recipient := common.HexToAddress("0x1111111111111111111111111111111111111111")
+
+	parse := func(data string) Transfer {
+		tx, err := ParseEthereumTransaction(unsignedDynamicFeeTx(t, &usdc, big.NewInt(0), hexutil.MustDecode(data)), big.NewInt(1))
+		require.NoError(t, err)
+		return tx.Transfer()
+	}
+	approveSpender := parse("0x095ea7b300000000000000000000000048c04ed5691981c42154c6167398f95e8f38a7ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff")
+	approveRouter := parse("0x095ea7b300000000000000000000000068b3465833fb72a70ecdf485e0e4c7bd8665fc45000000000000000000000000000000000000000000000000000000003b9aca00")
+	revokeSpender := parse("0x095ea7b300000000000000000000000048c04ed5691981c42154c6167398f95e8f38a7ff0000000000000000000000000000000000000000000000000000000000000000")
+	transferFromToSpender := parse("0x23b872dd000000000000000000000000851b7f3ab81bd8df354f0d7640efcd728855341900000000000000000000000048c04ed5691981c42154c6167398f95e8f38a7ff000000000000000000000000000000000000000000000000000000003b9aca00")
+	transferToRecipient := parse("0xa9059cbb0000000000000000000000001111111111111111111111111111111111111111000000000000000000000000000000000000000000000000000000003b9aca00")
+
+	require.Equal(t, TxKindTransferFrom, transferFromToSpender.Kind)
+	require.Equal(t, spender.Bytes(), transferFromToSpender.To)
+	require.Equal(t, &TransferFromCall{From: owner}, transferFromToSpender.Details)
+	require.Equal(t, recipient.Bytes(), transferToRecipient.To)
+	require.Equal(t, &ApprovalCall{Spender: router, Amount: big.NewInt(1_000_000_000)}, approveRouter.Details)
+
+	tests := []struct {
+		name      string
+		transfers []Transfer
+		wantLen   int
+	}{
+		{name: "approve then transferFrom to spender", transfers: []Transfer{approveSpender, transferFromToSpender}, wantLen: 1},
+		{name: "transferFrom before approve", transfers: []Transfer{transferFromToSpender, approveSpender}, wantLen: 1},
+		{name: "approve router, transfer elsewhere", transfers: []Transfer{approveRouter, transferToRecipient}, wantLen: 0},
+		{name: "revocation", transfers: []Transfer{revokeSpender, transferFromToSpender}, wantLen: 0},
+		{name: "empty", transfers: nil, wantLen: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			warnings := DetectApprovalRisk(tt.transfers)
+			require.Len(t, warnings, tt.wantLen)
+			for _, w := range warnings {
+				require.Contains(t, w, spender.Hex())
+			}
+		})
+	}
+}
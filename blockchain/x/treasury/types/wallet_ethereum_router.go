@@ -0,0 +1,258 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package types
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const (
+	// TxKindRouterExecute is a Uniswap Universal Router execute call,
+	// running a batch of commands.
+	TxKindRouterExecute TxKind = "router_execute"
+
+	// TxKindSwap is a swap of a token for another.
+	TxKindSwap TxKind = "swap"
+
+	// TxKindWrap is the wrapping of the native currency into its ERC-20
+	// representation (e.g. ETH into WETH).
+	TxKindWrap TxKind = "wrap"
+
+	// TxKindUnwrap is the unwrapping of WETH into the native currency.
+	TxKindUnwrap TxKind = "unwrap"
+)
+
+// RouterExecuteCall contains the arguments of a Universal Router execute
+// call.
+type RouterExecuteCall struct {
+	// Commands are the command bytes, one per command, including their
+	// flags (e.g. 0x80 to allow the command to revert).
+	Commands []byte
+
+	// Transfers contains a Transfer for each command, in order. Transfers,
+	// wraps, unwraps and swaps are classified, the other commands have
+	// Kind TxKindContractCall and no other field set.
+	//
+	// Recipients are as encoded in the command: the router uses address(1)
+	// for the caller and address(2) for the router itself.
+	Transfers []Transfer
+
+	// Deadline is the unix timestamp after which the call reverts, or nil
+	// if the call has no deadline.
+	Deadline *big.Int
+}
+
+// RouterSwap contains the arguments of a Universal Router V2 or V3 swap
+// command.
+type RouterSwap struct {
+	// ExactIn is true if AmountIn is exact and AmountOut is the minimum
+	// received, false if AmountOut is exact and AmountIn is the maximum
+	// spent.
+	ExactIn bool
+
+	TokenIn   common.Address
+	TokenOut  common.Address
+	AmountIn  *big.Int
+	AmountOut *big.Int
+
+	// Recipient is the address receiving TokenOut.
+	Recipient common.Address
+}
+
+// Universal Router command types.
+const (
+	routerCommandV3SwapExactIn  byte = 0x00
+	routerCommandV3SwapExactOut byte = 0x01
+	routerCommandTransfer       byte = 0x05
+	routerCommandV2SwapExactIn  byte = 0x08
+	routerCommandV2SwapExactOut byte = 0x09
+	routerCommandWrapETH        byte = 0x0b
+	routerCommandUnwrapWETH     byte = 0x0c
+
+	// routerCommandTypeMask selects the command type from a command byte,
+	// the other bits are flags.
+	routerCommandTypeMask byte = 0x3f
+)
+
+var (
+	routerExecuteMethodID            = crypto.Keccak256Hash([]byte("execute(bytes,bytes[],uint256)")).Bytes()[0:4]
+	routerExecuteNoDeadlineMethodID  = crypto.Keccak256Hash([]byte("execute(bytes,bytes[])")).Bytes()[0:4]
+	routerExecuteArguments           = abi.Arguments{{Type: mustABIType("bytes")}, {Type: mustABIType("bytes[]")}, {Type: mustABIType("uint256")}}
+	routerExecuteNoDeadlineArguments = abi.Arguments{{Type: mustABIType("bytes")}, {Type: mustABIType("bytes[]")}}
+
+	routerV3SwapArguments = abi.Arguments{
+		{Type: mustABIType("address")}, // recipient
+		{Type: mustABIType("uint256")}, // amountIn (exact in) or amountOut (exact out)
+		{Type: mustABIType("uint256")}, // amountOutMin (exact in) or amountInMax (exact out)
+		{Type: mustABIType("bytes")},   // path
+		{Type: mustABIType("bool")},    // payerIsUser
+	}
+	routerV2SwapArguments = abi.Arguments{
+		{Type: mustABIType("address")},   // recipient
+		{Type: mustABIType("uint256")},   // amountIn (exact in) or amountOut (exact out)
+		{Type: mustABIType("uint256")},   // amountOutMin (exact in) or amountInMax (exact out)
+		{Type: mustABIType("address[]")}, // path
+		{Type: mustABIType("bool")},      // payerIsUser
+	}
+	routerTransferArguments = abi.Arguments{
+		{Type: mustABIType("address")}, // token
+		{Type: mustABIType("address")}, // recipient
+		{Type: mustABIType("uint256")}, // value
+	}
+	routerWrapArguments = abi.Arguments{
+		{Type: mustABIType("address")}, // recipient
+		{Type: mustABIType("uint256")}, // amountMin
+	}
+)
+
+// unpackRouterExecute decodes the arguments of an execute call, with or
+// without deadline.
+func unpackRouterExecute(args []byte, withDeadline bool) (*RouterExecuteCall, error) {
+	arguments := routerExecuteNoDeadlineArguments
+	if withDeadline {
+		arguments = routerExecuteArguments
+	}
+	values, err := arguments.UnpackValues(args)
+	if err != nil {
+		return nil, fmt.Errorf("invalid execute: %w", err)
+	}
+
+	commands := values[0].([]byte)
+	inputs := values[1].([][]byte)
+	if len(commands) != len(inputs) {
+		return nil, fmt.Errorf("invalid execute: %d commands but %d inputs", len(commands), len(inputs))
+	}
+
+	call := &RouterExecuteCall{
+		Commands:  commands,
+		Transfers: make([]Transfer, len(commands)),
+	}
+	if withDeadline {
+		call.Deadline = values[2].(*big.Int)
+	}
+	for i, command := range commands {
+		transfer, err := unpackRouterCommand(command&routerCommandTypeMask, inputs[i])
+		if err != nil {
+			return nil, fmt.Errorf("invalid execute: command %d (0x%02x): %w", i, command, err)
+		}
+		call.Transfers[i] = transfer
+	}
+	return call, nil
+}
+
+// unpackRouterCommand classifies a single command of an execute call.
+func unpackRouterCommand(commandType byte, input []byte) (Transfer, error) {
+	switch commandType {
+	case routerCommandTransfer:
+		values, err := routerTransferArguments.UnpackValues(input)
+		if err != nil {
+			return Transfer{}, err
+		}
+		recipient := values[1].(common.Address)
+		return Transfer{
+			Kind:           TxKindTransfer,
+			To:             recipient.Bytes(),
+			Amount:         values[2].(*big.Int),
+			CoinIdentifier: routerCoin(values[0].(common.Address)).Bytes(),
+		}, nil
+	case routerCommandWrapETH, routerCommandUnwrapWETH:
+		values, err := routerWrapArguments.UnpackValues(input)
+		if err != nil {
+			return Transfer{}, err
+		}
+		kind := TxKindWrap
+		if commandType == routerCommandUnwrapWETH {
+			kind = TxKindUnwrap
+		}
+		recipient := values[0].(common.Address)
+		return Transfer{
+			Kind:           kind,
+			To:             recipient.Bytes(),
+			Amount:         values[1].(*big.Int),
+			CoinIdentifier: NativeCoin(ethereumSymbol).Bytes(),
+		}, nil
+	case routerCommandV3SwapExactIn, routerCommandV3SwapExactOut:
+		values, err := routerV3SwapArguments.UnpackValues(input)
+		if err != nil {
+			return Transfer{}, err
+		}
+		first, last, err := parseV3Path(values[3].([]byte))
+		if err != nil {
+			return Transfer{}, err
+		}
+		swap := &RouterSwap{ExactIn: commandType == routerCommandV3SwapExactIn, Recipient: values[0].(common.Address)}
+		if swap.ExactIn {
+			swap.TokenIn, swap.TokenOut = first, last
+			swap.AmountIn, swap.AmountOut = values[1].(*big.Int), values[2].(*big.Int)
+		} else {
+			// exact output paths are encoded in reverse
+			swap.TokenIn, swap.TokenOut = last, first
+			swap.AmountIn, swap.AmountOut = values[2].(*big.Int), values[1].(*big.Int)
+		}
+		return swapTransfer(swap), nil
+	case routerCommandV2SwapExactIn, routerCommandV2SwapExactOut:
+		values, err := routerV2SwapArguments.UnpackValues(input)
+		if err != nil {
+			return Transfer{}, err
+		}
+		path := values[3].([]common.Address)
+		if len(path) < 2 {
+			return Transfer{}, fmt.Errorf("invalid swap path: %d tokens", len(path))
+		}
+		swap := &RouterSwap{
+			ExactIn:   commandType == routerCommandV2SwapExactIn,
+			TokenIn:   path[0],
+			TokenOut:  path[len(path)-1],
+			Recipient: values[0].(common.Address),
+		}
+		if swap.ExactIn {
+			swap.AmountIn, swap.AmountOut = values[1].(*big.Int), values[2].(*big.Int)
+		} else {
+			swap.AmountIn, swap.AmountOut = values[2].(*big.Int), values[1].(*big.Int)
+		}
+		return swapTransfer(swap), nil
+	default:
+		return Transfer{Kind: TxKindContractCall}, nil
+	}
+}
+
+// swapTransfer returns the Transfer of the tokens spent by the swap.
+func swapTransfer(swap *RouterSwap) Transfer {
+	return Transfer{
+		Kind:           TxKindSwap,
+		To:             swap.Recipient.Bytes(),
+		Amount:         swap.AmountIn,
+		CoinIdentifier: routerCoin(swap.TokenIn).Bytes(),
+		Details:        swap,
+	}
+}
+
+// routerCoin returns the identifier of a token as encoded in router
+// commands, where the zero address is the native currency.
+func routerCoin(token common.Address) CoinIdentifier {
+	if token == (common.Address{}) {
+		return NativeCoin(ethereumSymbol)
+	}
+	return TokenCoin(ethereumSymbol, token.Bytes())
+}
+
+// parseV3Path returns the first and the last token of a Uniswap V3 path,
+// encoded as token (20 bytes) followed by any number of fee (3 bytes) and
+// token pairs.
+func parseV3Path(path []byte) (first, last common.Address, err error) {
+	const (
+		addrSize = common.AddressLength
+		hopSize  = 3 + common.AddressLength
+	)
+	if len(path) < addrSize+hopSize || (len(path)-addrSize)%hopSize != 0 {
+		return first, last, fmt.Errorf("invalid swap path: %d bytes", len(path))
+	}
+	first = common.BytesToAddress(path[:addrSize])
+	last = common.BytesToAddress(path[len(path)-addrSize:])
+	return first, last, nil
+}
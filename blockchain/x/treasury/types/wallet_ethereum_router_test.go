@@ -0,0 +1,139 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package types
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+)
+
+const universalRouterABIJSON = `[
+	{"type": "function", "name": "execute", "inputs": [
+		{"name": "commands", "type": "bytes"},
+		{"name": "inputs", "type": "bytes[]"},
+		{"name": "deadline", "type": "uint256"}
+	], "outputs": []}
+]`
+
+func Test_ParseEthereumTransaction_RouterExecute(t *testing.T) {
+	routerABI, err := abi.JSON(strings.NewReader(universalRouterABIJSON))
+	require.NoError(t, err)
+
+	router := common.HexToAddress("0x3fC91A3afd70395Cd496C647d5a6CC9D4B2b7FAD")
+	weth := common.HexToAddress("0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2")
+	usdc := common.HexToAddress("0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48")
+	recipient := common.HexToAddress("0x48c04ed5691981C42154C6167398f95e8f38a7fF")
+	routerItself := common.HexToAddress("0x0000000000000000000000000000000000000002")
+	deadline := big.NewInt(1_700_000_000)
+	oneETH := big.NewInt(1_000_000_000_000_000_000)
+
+	pack := func(types []string, values ...interface{}) []byte {
+		var args abi.Arguments
+		for _, typ := range types {
+			args = append(args, abi.Argument{Type: mustABIType(typ)})
+		}
+		b, err := args.Pack(values...)
+		require.NoError(t, err)
+		return b
+	}
+	execute := func(commands []byte, inputs [][]byte) []byte {
+		b, err := routerABI.Pack("execute", commands, inputs, deadline)
+		require.NoError(t, err)
+		return b
+	}
+	parse := func(data []byte) (*EthereumTransfer, error) {
+		return ParseEthereumTransaction(unsignedDynamicFeeTx(t, &router, oneETH, data), big.NewInt(1))
+	}
+
+	wrapInput := pack([]string{"address", "uint256"}, routerItself, oneETH)
+	transferInput := pack([]string{"address", "address", "uint256"}, weth, recipient, oneETH)
+
+	t.Run("wrap and transfer", func(t *testing.T) {
+		data := execute([]byte{0x0b, 0x05}, [][]byte{wrapInput, transferInput})
+		require.Equal(t, "0x3593564c", hexutil.Encode(data[:4]))
+
+		tx, err := parse(data)
+		require.NoError(t, err)
+		require.Equal(t, TxKindRouterExecute, tx.Kind)
+		require.Equal(t, router, *tx.To)
+		require.Equal(t, oneETH, tx.Amount)
+
+		details, ok := tx.Details.(*RouterExecuteCall)
+		require.True(t, ok)
+		require.Equal(t, []byte{0x0b, 0x05}, details.Commands)
+		require.Equal(t, deadline, details.Deadline)
+		require.Equal(t, []Transfer{
+			{Kind: TxKindWrap, To: routerItself.Bytes(), Amount: oneETH, CoinIdentifier: []byte("ETH")},
+			{Kind: TxKindTransfer, To: recipient.Bytes(), Amount: oneETH, CoinIdentifier: TokenCoin("ETH", weth.Bytes()).Bytes()},
+		}, details.Transfers)
+	})
+
+	t.Run("swaps and unknown commands", func(t *testing.T) {
+		amountIn := big.NewInt(1_000_000_000)
+		amountOut := big.NewInt(500_000_000_000_000_000)
+		v2Input := pack([]string{"address", "uint256", "uint256", "address[]", "bool"}, recipient, amountIn, amountOut, []common.Address{usdc, weth}, true)
+		// exact output paths start from the output token: WETH, fee 500, USDC
+		v3Path := append(append(weth.Bytes(), 0x00, 0x01, 0xf4), usdc.Bytes()...)
+		v3Input := pack([]string{"address", "uint256", "uint256", "bytes", "bool"}, recipient, amountOut, amountIn, v3Path, true)
+		sweepInput := pack([]string{"address", "address", "uint256"}, weth, recipient, big.NewInt(0))
+
+		// 0x84 is a sweep allowed to revert
+		tx, err := parse(execute([]byte{0x08, 0x01, 0x84}, [][]byte{v2Input, v3Input, sweepInput}))
+		require.NoError(t, err)
+
+		details := tx.Details.(*RouterExecuteCall)
+		wantSwap := &RouterSwap{TokenIn: usdc, TokenOut: weth, AmountIn: amountIn, AmountOut: amountOut, Recipient: recipient}
+		require.Len(t, details.Transfers, 3)
+		for i, exactIn := range []bool{true, false} {
+			transfer := details.Transfers[i]
+			require.Equal(t, TxKindSwap, transfer.Kind)
+			require.Equal(t, recipient.Bytes(), transfer.To)
+			require.Equal(t, amountIn, transfer.Amount)
+			require.Equal(t, TokenCoin("ETH", usdc.Bytes()).Bytes(), transfer.CoinIdentifier)
+			swap := *wantSwap
+			swap.ExactIn = exactIn
+			require.Equal(t, &swap, transfer.Details)
+		}
+		require.Equal(t, Transfer{Kind: TxKindContractCall}, details.Transfers[2])
+	})
+
+	t.Run("sepolia wrap and swap", func(t *testing.T) {
+		b := hexutil.MustDecode("0x02f902b583aa36a7040385042d03bb3d8302a43b943fc91a3afd70395cd496c647d5a6cc9d4b2b7fad872386f26fc10000b902843593564c000000000000000000000000000000000000000000000000000000000000006000000000000000000000000000000000000000000000000000000000000000a0000000000000000000000000000000000000000000000000000000006595a2b000000000000000000000000000000000000000000000000000000000000000020b000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000002000000000000000000000000000000000000000000000000000000000000004000000000000000000000000000000000000000000000000000000000000000a000000000000000000000000000000000000000000000000000000000000000400000000000000000000000000000000000000000000000000000000000000002000000000000000000000000000000000000000000000000002386f26fc1000000000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000002386f26fc10000000000000000000000000000000000000000000000000000001925fd93f197ab00000000000000000000000000000000000000000000000000000000000000a00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000002bfff9976782d46cc05630d1f6ebab18b2324d6b14000bb81f9840a85d5af5bf1d1762f925bdaddc4201f984000000000000000000000000000000000000000000c0")
+		tx, err := ParseEthereumTransaction(b, big.NewInt(11155111))
+		require.NoError(t, err)
+
+		details := tx.Details.(*RouterExecuteCall)
+		require.Equal(t, []byte{0x0b, 0x00}, details.Commands)
+		require.Len(t, details.Transfers, 2)
+		require.Equal(t, TxKindWrap, details.Transfers[0].Kind)
+		require.Equal(t, big.NewInt(10_000_000_000_000_000), details.Transfers[0].Amount)
+		require.Equal(t, TxKindSwap, details.Transfers[1].Kind)
+		swap := details.Transfers[1].Details.(*RouterSwap)
+		require.True(t, swap.ExactIn)
+		require.Equal(t, common.HexToAddress("0xfFf9976782d46CC05630D1f6eBAb18b2324d6B14"), swap.TokenIn)
+		require.Equal(t, common.HexToAddress("0x1f9840a85d5aF5bf1D1762F925BDADdC4201F984"), swap.TokenOut)
+		require.Equal(t, big.NewInt(10_000_000_000_000_000), swap.AmountIn)
+	})
+
+	t.Run("commands and inputs length mismatch", func(t *testing.T) {
+		_, err := parse(execute([]byte{0x0b, 0x05}, [][]byte{wrapInput}))
+		require.ErrorContains(t, err, "2 commands but 1 inputs")
+	})
+
+	t.Run("malformed input", func(t *testing.T) {
+		_, err := parse(execute([]byte{0x05}, [][]byte{wrapInput}))
+		require.Error(t, err)
+	})
+
+	t.Run("invalid v3 path", func(t *testing.T) {
+		input := pack([]string{"address", "uint256", "uint256", "bytes", "bool"}, recipient, oneETH, oneETH, weth.Bytes(), true)
+		_, err := parse(execute([]byte{0x00}, [][]byte{input}))
+		require.ErrorContains(t, err, "invalid swap path")
+	})
+}
@@ -0,0 +1,189 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// setCodeTxType is the type of EIP-7702 set-code transactions, which the
+// go-ethereum version in use doesn't know about.
+const setCodeTxType = 0x04
+
+// setCodeAuthorizationMagic prefixes the RLP encoding of the authorizations
+// of set-code transactions to compute the hash signed by their authority.
+const setCodeAuthorizationMagic = 0x05
+
+// TxKindSetCode is a set-code transaction (EIP-7702) only delegating the
+// code of accounts, without value or calldata.
+const TxKindSetCode TxKind = "set_code"
+
+// SetCodeAuthorization is an authorization of an EIP-7702 set-code
+// transaction, delegating the code of its signer (the authority) to
+// Address.
+type SetCodeAuthorization struct {
+	ChainID *big.Int
+	Address common.Address
+	Nonce   uint64
+	V       uint8
+	R       *big.Int
+	S       *big.Int
+}
+
+// Authority returns the account signing the authorization, or false if
+// the signature is invalid, in which case the authorization is skipped by
+// the chain. As for transactions, signatures with high S values are
+// invalid.
+func (a SetCodeAuthorization) Authority() (common.Address, bool) {
+	if a.R == nil || a.S == nil || !crypto.ValidateSignatureValues(a.V, a.R, a.S, true) {
+		return common.Address{}, false
+	}
+	payload, err := rlp.EncodeToBytes([]any{a.ChainID, a.Address, a.Nonce})
+	if err != nil {
+		return common.Address{}, false
+	}
+	hash := crypto.Keccak256(append([]byte{setCodeAuthorizationMagic}, payload...))
+
+	sig := make([]byte, crypto.SignatureLength)
+	a.R.FillBytes(sig[:32])
+	a.S.FillBytes(sig[32:64])
+	sig[crypto.RecoveryIDOffset] = a.V
+	pubkey, err := crypto.SigToPub(hash, sig)
+	if err != nil {
+		return common.Address{}, false
+	}
+	return crypto.PubkeyToAddress(*pubkey), true
+}
+
+// SetCodeTxWithoutSignature is an unsigned EIP-7702 set-code transaction.
+type SetCodeTxWithoutSignature struct {
+	ChainID    *big.Int
+	Nonce      uint64
+	GasTipCap  *big.Int
+	GasFeeCap  *big.Int
+	Gas        uint64
+	To         common.Address // set-code transactions can't create contracts
+	Value      *big.Int
+	Data       []byte
+	AccessList types.AccessList
+	AuthList   []SetCodeAuthorization
+}
+
+// decodeSetCodePayload decodes an unsigned set-code transaction. The
+// payload must be canonically encoded, so that the signing hash commits to
+// the decoded fields.
+func decodeSetCodePayload(msg []byte) (*SetCodeTxWithoutSignature, error) {
+	var tx SetCodeTxWithoutSignature
+	if err := rlp.DecodeBytes(msg[1:], &tx); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrMalformedTx, err)
+	}
+	encoded, err := rlp.EncodeToBytes(&tx)
+	if err != nil || !bytes.Equal(encoded, msg[1:]) {
+		return nil, fmt.Errorf("%w: non-canonical set-code transaction", ErrMalformedTx)
+	}
+	if len(tx.AuthList) == 0 {
+		return nil, fmt.Errorf("%w: set-code transaction without authorizations", ErrMalformedTx)
+	}
+	return &tx, nil
+}
+
+// parseSetCodeTransaction parses an unsigned EIP-7702 set-code transaction
+// for the chain. Its signing hash is the Keccak-256 hash of the payload,
+// i.e. of the type byte followed by the RLP encoding of the fields.
+//
+// The call made by the transaction is parsed as for EIP-1559 transactions.
+// Transactions only delegating code, without value or calldata, are
+// classified as TxKindSetCode.
+func parseSetCodeTransaction(msg []byte, chainID *big.Int) (*EthereumTransfer, error) {
+	tx, err := decodeSetCodePayload(msg)
+	if err != nil {
+		return nil, err
+	}
+	if tx.ChainID.Cmp(chainID) != 0 {
+		return nil, fmt.Errorf("%w: transaction chain ID %v doesn't match chain ID %v", ErrChainIDMismatch, tx.ChainID, chainID)
+	}
+	hash := crypto.Keccak256Hash(msg)
+
+	to := tx.To
+	var transfer *EthereumTransfer
+	if len(tx.Data) == 0 && tx.Value.Sign() == 0 {
+		transfer = &EthereumTransfer{
+			To:             &to,
+			Amount:         new(big.Int),
+			DataForSigning: hash.Bytes(),
+			Kind:           TxKindSetCode,
+			MaxFee:         new(big.Int).Mul(new(big.Int).SetUint64(tx.Gas), tx.GasFeeCap),
+			Nonce:          tx.Nonce,
+		}
+	} else {
+		transfer, err = parseEthereumTx(types.NewTx(&types.DynamicFeeTx{
+			ChainID:    tx.ChainID,
+			Nonce:      tx.Nonce,
+			GasTipCap:  tx.GasTipCap,
+			GasFeeCap:  tx.GasFeeCap,
+			Gas:        tx.Gas,
+			To:         &to,
+			Value:      tx.Value,
+			Data:       tx.Data,
+			AccessList: tx.AccessList,
+		}), hash, nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+	transfer.Authorizations = tx.AuthList
+	return transfer, nil
+}
+
+// buildSignedSetCodeTx applies the signature, in the 65 bytes
+// [R || S || V] format with V the recovery ID, to an unsigned set-code
+// transaction and returns the signed transaction and its signer.
+func buildSignedSetCodeTx(msg []byte, sig []byte, chainID *big.Int) ([]byte, common.Address, error) {
+	tx, err := decodeSetCodePayload(msg)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+	if tx.ChainID.Cmp(chainID) != 0 {
+		return nil, common.Address{}, fmt.Errorf("%w: transaction chain ID %v doesn't match metadata chain ID %v", ErrChainIDMismatch, tx.ChainID, chainID)
+	}
+	pubkey, err := crypto.SigToPub(crypto.Keccak256(msg), sig)
+	if err != nil {
+		return nil, common.Address{}, fmt.Errorf("invalid signature: %w", err)
+	}
+
+	signed, err := rlp.EncodeToBytes([]any{
+		tx.ChainID, tx.Nonce, tx.GasTipCap, tx.GasFeeCap, tx.Gas, tx.To, tx.Value, tx.Data, tx.AccessList, tx.AuthList,
+		sig[crypto.RecoveryIDOffset], new(big.Int).SetBytes(sig[:32]), new(big.Int).SetBytes(sig[32:64]),
+	})
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+	return append([]byte{setCodeTxType}, signed...), crypto.PubkeyToAddress(*pubkey), nil
+}
+
+// codeDelegations returns the delegations of the authorizations of a
+// set-code transaction.
+func codeDelegations(authorizations []SetCodeAuthorization) []CodeDelegation {
+	if len(authorizations) == 0 {
+		return nil
+	}
+	delegations := make([]CodeDelegation, len(authorizations))
+	for i, auth := range authorizations {
+		delegations[i] = CodeDelegation{
+			Delegate: auth.Address.Bytes(),
+			ChainID:  auth.ChainID,
+			Nonce:    auth.Nonce,
+		}
+		if authority, ok := auth.Authority(); ok {
+			delegations[i].Authority = authority.Bytes()
+		}
+	}
+	return delegations
+}
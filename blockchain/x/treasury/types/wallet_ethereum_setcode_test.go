@@ -0,0 +1,168 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package types
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/stretchr/testify/require"
+)
+
+// signedAuthorization returns an authorization delegating the code of the
+// account of key to delegate.
+func signedAuthorization(t *testing.T, key *ecdsa.PrivateKey, delegate common.Address, nonce uint64) SetCodeAuthorization {
+	t.Helper()
+	payload, err := rlp.EncodeToBytes([]any{big.NewInt(1), delegate, nonce})
+	require.NoError(t, err)
+	sig, err := crypto.Sign(crypto.Keccak256(append([]byte{setCodeAuthorizationMagic}, payload...)), key)
+	require.NoError(t, err)
+	return SetCodeAuthorization{
+		ChainID: big.NewInt(1),
+		Address: delegate,
+		Nonce:   nonce,
+		V:       sig[crypto.RecoveryIDOffset],
+		R:       new(big.Int).SetBytes(sig[:32]),
+		S:       new(big.Int).SetBytes(sig[32:64]),
+	}
+}
+
+// unsignedSetCodeTx builds an unsigned set-code transaction on chain 1.
+func unsignedSetCodeTx(t *testing.T, to common.Address, value *big.Int, data []byte, auths []SetCodeAuthorization) []byte {
+	t.Helper()
+	b, err := rlp.EncodeToBytes(&SetCodeTxWithoutSignature{
+		ChainID:   big.NewInt(1),
+		Nonce:     3,
+		GasTipCap: big.NewInt(1_000_000_000),
+		GasFeeCap: big.NewInt(30_000_000_000),
+		Gas:       100_000,
+		To:        to,
+		Value:     value,
+		Data:      data,
+		AuthList:  auths,
+	})
+	require.NoError(t, err)
+	return append([]byte{setCodeTxType}, b...)
+}
+
+func Test_ParseEthereumTransaction_SetCode(t *testing.T) {
+	authorityKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	authority := crypto.PubkeyToAddress(authorityKey.PublicKey)
+	delegate := common.HexToAddress("0x63c0c19a282a1B52b07dD5a65b58948A07DAE32B")
+	usdt := common.HexToAddress("0xdAC17F958D2ee523a2206206994597C13D831ec7")
+	recipient := common.HexToAddress("0x48c04ed5691981C42154C6167398f95e8f38a7fF")
+	auth := signedAuthorization(t, authorityKey, delegate, 7)
+
+	t.Run("delegation only", func(t *testing.T) {
+		b := unsignedSetCodeTx(t, authority, big.NewInt(0), nil, []SetCodeAuthorization{auth})
+		tx, err := ParseEthereumTransaction(b, big.NewInt(1))
+		require.NoError(t, err)
+		require.Equal(t, TxKindSetCode, tx.Kind)
+		require.Equal(t, authority, *tx.To)
+		require.Equal(t, int64(0), tx.Amount.Int64())
+		require.Equal(t, crypto.Keccak256(b), tx.DataForSigning)
+		require.Equal(t, uint64(3), tx.Nonce)
+		require.Len(t, tx.Authorizations, 1)
+
+		transfer, err := ethereumWallet(t).ParseTx(b, &MetadataEthereum{ChainId: 1})
+		require.NoError(t, err)
+		require.Equal(t, []CodeDelegation{{
+			Authority: authority.Bytes(),
+			Delegate:  delegate.Bytes(),
+			ChainID:   big.NewInt(1),
+			Nonce:     7,
+		}}, transfer.Delegations)
+	})
+
+	t.Run("token transfer", func(t *testing.T) {
+		b := unsignedSetCodeTx(t, usdt, big.NewInt(0), erc20TransferData(recipient, 1_000_000), []SetCodeAuthorization{auth})
+		tx, err := ParseEthereumTransaction(b, big.NewInt(1))
+		require.NoError(t, err)
+		require.Equal(t, TxKindTransfer, tx.Kind)
+		require.Equal(t, recipient, *tx.To)
+		require.Equal(t, usdt, *tx.Contract)
+		require.Equal(t, int64(1_000_000), tx.Amount.Int64())
+		require.Equal(t, crypto.Keccak256(b), tx.DataForSigning)
+		require.Len(t, tx.Authorizations, 1)
+	})
+
+	t.Run("invalid authorization signature", func(t *testing.T) {
+		highS := auth
+		highS.S = new(big.Int).Sub(crypto.S256().Params().N, auth.S)
+		highS.V ^= 1
+		b := unsignedSetCodeTx(t, authority, big.NewInt(0), nil, []SetCodeAuthorization{highS})
+
+		transfer, err := ethereumWallet(t).ParseTx(b, &MetadataEthereum{ChainId: 1})
+		require.NoError(t, err)
+		require.Len(t, transfer.Delegations, 1)
+		require.Nil(t, transfer.Delegations[0].Authority)
+		require.Equal(t, delegate.Bytes(), transfer.Delegations[0].Delegate)
+	})
+
+	t.Run("without authorizations", func(t *testing.T) {
+		b := unsignedSetCodeTx(t, authority, big.NewInt(0), nil, nil)
+		_, err := ParseEthereumTransaction(b, big.NewInt(1))
+		require.ErrorIs(t, err, ErrMalformedTx)
+	})
+
+	t.Run("chain mismatch", func(t *testing.T) {
+		b := unsignedSetCodeTx(t, authority, big.NewInt(0), nil, []SetCodeAuthorization{auth})
+		_, err := ParseEthereumTransaction(b, big.NewInt(137))
+		require.ErrorIs(t, err, ErrChainIDMismatch)
+	})
+
+	t.Run("trailing bytes", func(t *testing.T) {
+		b := unsignedSetCodeTx(t, authority, big.NewInt(0), nil, []SetCodeAuthorization{auth})
+		_, err := ParseEthereumTransaction(append(b, 0x00), big.NewInt(1))
+		require.ErrorIs(t, err, ErrMalformedTx)
+	})
+}
+
+func Test_EthereumWallet_BuildSignedTx_SetCode(t *testing.T) {
+	privKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	wallet, err := NewEthereumWallet(&Key{
+		Type:      KeyType_KEY_TYPE_ECDSA_SECP256K1,
+		PublicKey: crypto.CompressPubkey(&privKey.PublicKey),
+	})
+	require.NoError(t, err)
+	sender := crypto.PubkeyToAddress(privKey.PublicKey)
+	auth := signedAuthorization(t, privKey, common.HexToAddress("0x63c0c19a282a1B52b07dD5a65b58948A07DAE32B"), 4)
+	b := unsignedSetCodeTx(t, sender, big.NewInt(0), nil, []SetCodeAuthorization{auth})
+	meta := &MetadataEthereum{ChainId: 1}
+
+	transfer, err := wallet.ParseTx(b, meta)
+	require.NoError(t, err)
+	sig, err := crypto.Sign(transfer.DataForSigning, privKey)
+	require.NoError(t, err)
+
+	signed, err := wallet.BuildSignedTx(b, sig, meta)
+	require.NoError(t, err)
+	require.Equal(t, byte(setCodeTxType), signed[0])
+
+	var fields []rlp.RawValue
+	require.NoError(t, rlp.DecodeBytes(signed[1:], &fields))
+	require.Len(t, fields, 13)
+	var v uint8
+	var r, s big.Int
+	require.NoError(t, rlp.DecodeBytes(fields[10], &v))
+	require.NoError(t, rlp.DecodeBytes(fields[11], &r))
+	require.NoError(t, rlp.DecodeBytes(fields[12], &s))
+	require.Equal(t, sig[crypto.RecoveryIDOffset], v)
+	require.Equal(t, new(big.Int).SetBytes(sig[:32]), &r)
+	require.Equal(t, new(big.Int).SetBytes(sig[32:64]), &s)
+
+	t.Run("not from the wallet key", func(t *testing.T) {
+		otherKey, err := crypto.GenerateKey()
+		require.NoError(t, err)
+		sig, err := crypto.Sign(transfer.DataForSigning, otherKey)
+		require.NoError(t, err)
+		_, err = wallet.BuildSignedTx(b, sig, meta)
+		require.Error(t, err)
+	})
+}
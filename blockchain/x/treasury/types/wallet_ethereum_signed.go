@@ -0,0 +1,74 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package types
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// ErrNotSigned is returned by ParseSignedEthereumTransaction for unsigned
+// transactions, which can be parsed with ParseEthereumTransaction instead.
+var ErrNotSigned = errors.New("transaction is not signed")
+
+// Signature is the signature of a signed Ethereum transaction.
+type Signature struct {
+	// V, R and S are the raw signature values of the transaction. V
+	// includes the chain ID for legacy transactions with replay protection
+	// (EIP-155), and is the recovery ID (0 or 1) for typed transactions.
+	V, R, S *big.Int
+
+	// Sender is the address recovered from the signature.
+	Sender common.Address
+}
+
+// ParseSignedEthereumTransaction parses a signed transaction, e.g. received
+// for reconciliation, like ParseEthereumTransaction parses unsigned ones.
+// It also returns the signature of the transaction and the sender recovered
+// from it.
+//
+// The chain ID is taken from the transaction itself. Legacy transactions
+// without replay protection are accepted, as they're already signed.
+func ParseSignedEthereumTransaction(b []byte) (*EthereumTransfer, *Signature, error) {
+	if len(b) > 0 && b[0] == blobTxType {
+		return nil, nil, ErrBlobTxNotSupported
+	}
+	var tx types.Transaction
+	if err := tx.UnmarshalBinary(b); err != nil {
+		if _, unsignedErr := DecodeUnsignedPayload(b); unsignedErr == nil {
+			return nil, nil, ErrNotSigned
+		}
+		return nil, nil, fmt.Errorf("%w: %v", ErrMalformedTx, err)
+	}
+	v, r, s := tx.RawSignatureValues()
+	if r.Sign() == 0 || s.Sign() == 0 {
+		// unsigned legacy transactions decode as signed ones with a zero
+		// signature
+		return nil, nil, ErrNotSigned
+	}
+
+	signer := signedTxSigner(&tx)
+	sender, err := types.Sender(signer, &tx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid signature: %w", err)
+	}
+	transfer, err := parseEthereumTx(&tx, signer.Hash(&tx), &sender)
+	if err != nil {
+		return nil, nil, err
+	}
+	return transfer, &Signature{V: v, R: r, S: s, Sender: sender}, nil
+}
+
+// signedTxSigner returns the signer of the signed transaction tx. Legacy
+// transactions without replay protection (EIP-155) don't carry the chain
+// ID.
+func signedTxSigner(tx *types.Transaction) types.Signer {
+	if !tx.Protected() {
+		return types.HomesteadSigner{}
+	}
+	return latestSigner(tx.ChainId())
+}
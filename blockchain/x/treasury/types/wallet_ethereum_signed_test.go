@@ -0,0 +1,89 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ParseSignedEthereumTransaction(t *testing.T) {
+	// example transaction of EIP-155, signed by the private key 0x4646...46
+	signed := hexutil.MustDecode("0xf86c098504a817c800825208943535353535353535353535353535353535353535880de0b6b3a76400008025a028ef61340bd939bc2195fe537567866003e1a15d3c71ff63e1590620aa636276a067cbe9d8997f761aecb703304b3800ccf555c9f3dc64214b297fb1966a3b6d83")
+
+	transfer, sig, err := ParseSignedEthereumTransaction(signed)
+	require.NoError(t, err)
+	require.Equal(t, common.HexToAddress("0x9d8A62f656a8d1615C1294fd71e9CFb3E4855A4F"), sig.Sender)
+	require.Zero(t, sig.V.Cmp(big.NewInt(37)))
+	require.Zero(t, sig.R.Cmp(hexutil.MustDecodeBig("0x28ef61340bd939bc2195fe537567866003e1a15d3c71ff63e1590620aa636276")))
+	require.Zero(t, sig.S.Cmp(hexutil.MustDecodeBig("0x67cbe9d8997f761aecb703304b3800ccf555c9f3dc64214b297fb1966a3b6d83")))
+
+	require.Equal(t, common.HexToAddress("0x3535353535353535353535353535353535353535"), *transfer.To)
+	require.Zero(t, transfer.Amount.Cmp(big.NewInt(1_000_000_000_000_000_000)))
+	require.Equal(t, TxKindTransfer, transfer.Kind)
+	require.Equal(t, uint64(9), transfer.Nonce)
+	require.Equal(t, hexutil.MustDecode("0xdaf5a779ae972f972197303d7b574746c7ef83eadac0f2791ad23db92e4c8e53"), transfer.DataForSigning)
+}
+
+func Test_ParseSignedEthereumTransaction_SignedByWallet(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	usdt := common.HexToAddress("0xdAC17F958D2ee523a2206206994597C13D831ec7")
+	recipient := common.HexToAddress("0x48c04ed5691981C42154C6167398f95e8f38a7fF")
+
+	unsigned := unsignedDynamicFeeTx(t, &usdt, big.NewInt(0), erc20TransferData(recipient, 1_000_000))
+	want, err := ParseEthereumTransaction(unsigned, big.NewInt(1))
+	require.NoError(t, err)
+
+	txData, err := DecodeUnsignedPayload(unsigned)
+	require.NoError(t, err)
+	signedTx, err := types.SignNewTx(key, types.LatestSignerForChainID(big.NewInt(1)), txData)
+	require.NoError(t, err)
+	signed, err := signedTx.MarshalBinary()
+	require.NoError(t, err)
+
+	transfer, sig, err := ParseSignedEthereumTransaction(signed)
+	require.NoError(t, err)
+	require.Equal(t, crypto.PubkeyToAddress(key.PublicKey), sig.Sender)
+	require.True(t, want.Equal(transfer))
+}
+
+func Test_ParseSignedEthereumTransaction_Errors(t *testing.T) {
+	to := common.HexToAddress("0x48c04ed5691981C42154C6167398f95e8f38a7fF")
+	unsignedLegacy, err := rlp.EncodeToBytes(&types.LegacyTx{
+		Nonce:    7,
+		GasPrice: big.NewInt(20_000_000_000),
+		Gas:      21_000,
+		To:       &to,
+		Value:    big.NewInt(5_000),
+		V:        big.NewInt(1),
+		R:        big.NewInt(0),
+		S:        big.NewInt(0),
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		b       []byte
+		wantErr error
+	}{
+		{name: "unsigned dynamic fee transaction", b: unsignedDynamicFeeTx(t, &to, big.NewInt(1_000), nil), wantErr: ErrNotSigned},
+		{name: "unsigned legacy transaction", b: unsignedLegacy, wantErr: ErrNotSigned},
+		{name: "malformed transaction", b: []byte{types.DynamicFeeTxType, 0xc0}, wantErr: ErrMalformedTx},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transfer, sig, err := ParseSignedEthereumTransaction(tt.b)
+			require.ErrorIs(t, err, tt.wantErr)
+			require.Nil(t, transfer)
+			require.Nil(t, sig)
+		})
+	}
+}
@@ -0,0 +1,32 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package types
+
+import (
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// signers caches the latest signer of each chain ID, by uint64 chain ID.
+// The latest signer handles every transaction type supported on the chain,
+// so a single signer per chain is enough.
+var signers sync.Map
+
+// latestSigner returns the latest signer for the chain, as
+// types.LatestSignerForChainID, reusing the signers of previously seen
+// chains. It's safe for concurrent use.
+func latestSigner(chainID *big.Int) types.Signer {
+	if chainID == nil || !chainID.IsUint64() {
+		return types.LatestSignerForChainID(chainID)
+	}
+	id := chainID.Uint64()
+	if signer, ok := signers.Load(id); ok {
+		return signer.(types.Signer)
+	}
+	// signers keep a reference to the chain ID, which must not be the one
+	// of the caller as it could be modified afterwards
+	signer, _ := signers.LoadOrStore(id, types.LatestSignerForChainID(new(big.Int).SetUint64(id)))
+	return signer.(types.Signer)
+}
@@ -0,0 +1,73 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package types
+
+import (
+	"math/big"
+	"sync"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_latestSigner(t *testing.T) {
+	to := common.HexToAddress("0x48c04ed5691981C42154C6167398f95e8f38a7fF")
+	txs := func(chainID *big.Int) []*types.Transaction {
+		return []*types.Transaction{
+			types.NewTx(&types.LegacyTx{Nonce: 1, GasPrice: big.NewInt(1), Gas: 21_000, To: &to, Value: big.NewInt(1)}),
+			types.NewTx(&types.AccessListTx{ChainID: chainID, Nonce: 2, GasPrice: big.NewInt(1), Gas: 21_000, To: &to, Value: big.NewInt(1)}),
+			types.NewTx(&types.DynamicFeeTx{ChainID: chainID, Nonce: 3, GasTipCap: big.NewInt(1), GasFeeCap: big.NewInt(2), Gas: 21_000, To: &to, Value: big.NewInt(1)}),
+		}
+	}
+
+	for _, id := range []int64{1, 137, 42161} {
+		chainID := big.NewInt(id)
+		fresh := types.LatestSignerForChainID(big.NewInt(id))
+		for i := 0; i < 2; i++ {
+			cached := latestSigner(chainID)
+			require.True(t, fresh.Equal(cached))
+			for _, tx := range txs(chainID) {
+				require.Equal(t, fresh.Hash(tx), cached.Hash(tx))
+			}
+		}
+	}
+
+	t.Run("caller chain ID modified afterwards", func(t *testing.T) {
+		chainID := big.NewInt(10)
+		signer := latestSigner(chainID)
+		chainID.SetInt64(11)
+		require.Zero(t, signer.ChainID().Cmp(big.NewInt(10)))
+		require.Zero(t, latestSigner(big.NewInt(10)).ChainID().Cmp(big.NewInt(10)))
+	})
+
+	t.Run("concurrent use", func(t *testing.T) {
+		var wg sync.WaitGroup
+		for i := 0; i < 16; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				chainID := big.NewInt(int64(1_000 + i%4))
+				require.True(t, types.LatestSignerForChainID(chainID).Equal(latestSigner(chainID)))
+			}(i)
+		}
+		wg.Wait()
+	})
+}
+
+func Benchmark_latestSigner(b *testing.B) {
+	chainID := big.NewInt(1)
+	b.Run("cached", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = latestSigner(chainID)
+		}
+	})
+	b.Run("fresh", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = types.LatestSignerForChainID(chainID)
+		}
+	})
+}
@@ -0,0 +1,95 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package types
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// EthereumSigningDetails are the fields of an unsigned transaction an
+// approver must see before approving it, bound to the hash being signed.
+type EthereumSigningDetails struct {
+	// To is the recipient of the transfer: the recipient of the tokens for
+	// ERC-20 transfers, nil for contract creations.
+	To *common.Address
+
+	// Amount is the amount being transferred, in the smallest unit of the
+	// native currency or of the token.
+	Amount *big.Int
+
+	// Contract is the token contract for ERC-20 transfers, nil for
+	// transfers of the native currency.
+	Contract *common.Address
+
+	// ChainID is the chain the transaction is valid on, nil for legacy
+	// transactions without replay protection.
+	ChainID *big.Int
+
+	Nonce uint64
+
+	// SigningHash is the hash signed by the key, equal to the
+	// DataForSigning of the parsed transaction.
+	SigningHash []byte
+}
+
+// SigningHashDetails decodes the unsigned transaction b and returns its
+// signing hash together with the fields it commits to, so that approvers
+// can display them and check that they match what they approve.
+//
+// All the fields come from the same decoding of b. The chain ID is taken
+// from the transaction itself, and must match the wallet's chain if the
+// wallet is bound to one.
+func (w *EthereumWallet) SigningHashDetails(b []byte) (*EthereumSigningDetails, error) {
+	txData, err := DecodeUnsignedPayload(b)
+	if err != nil {
+		return nil, err
+	}
+	tx := types.NewTx(txData)
+
+	chainID := unsignedPayloadChainID(b, tx)
+	var signer types.Signer
+	if chainID == nil {
+		if signer, err = unsignedPayloadSigner(b, nil, w.allowUnprotected); err != nil {
+			return nil, err
+		}
+	} else {
+		if w.chain != nil && (!chainID.IsUint64() || chainID.Uint64() != w.chain.ID) {
+			return nil, fmt.Errorf("%w: transaction chain ID %v doesn't match wallet chain %s (%d)", ErrChainIDMismatch, chainID, w.chain.Name, w.chain.ID)
+		}
+		signer = latestSigner(chainID)
+	}
+
+	self := crypto.PubkeyToAddress(*w.key)
+	transfer, err := parseEthereumTx(tx, signer.Hash(tx), &self)
+	if err != nil {
+		return nil, err
+	}
+	return &EthereumSigningDetails{
+		To:          transfer.To,
+		Amount:      transfer.Amount,
+		Contract:    transfer.Contract,
+		ChainID:     chainID,
+		Nonce:       transfer.Nonce,
+		SigningHash: transfer.DataForSigning,
+	}, nil
+}
+
+// unsignedPayloadChainID returns the chain ID of the unsigned transaction
+// msg, decoded into tx, or nil for legacy transactions without replay
+// protection. Unsigned EIP-155 transactions carry the chain ID in place of
+// V.
+func unsignedPayloadChainID(msg []byte, tx *types.Transaction) *big.Int {
+	if isUnprotectedPayload(msg) {
+		return nil
+	}
+	if tx.Type() == types.LegacyTxType {
+		v, _, _ := tx.RawSignatureValues()
+		return v
+	}
+	return tx.ChainId()
+}
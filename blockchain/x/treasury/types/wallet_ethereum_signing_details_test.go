@@ -0,0 +1,157 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_EthereumWallet_SigningHashDetails(t *testing.T) {
+	token := common.HexToAddress("0xdAC17F958D2ee523a2206206994597C13D831ec7")
+	recipient := common.HexToAddress("0x48c04ed5691981C42154C6167398f95e8f38a7fF")
+
+	// decoded transactions, hashed independently of the parser
+	ethTx := types.NewTx(&types.DynamicFeeTx{
+		ChainID:   big.NewInt(1),
+		Nonce:     3,
+		GasTipCap: big.NewInt(1_000_000_000),
+		GasFeeCap: big.NewInt(30_000_000_000),
+		Gas:       100_000,
+		To:        &recipient,
+		Value:     big.NewInt(1_000),
+	})
+	erc20Data := erc20TransferData(recipient, 25_000_000)
+	erc20Tx := types.NewTx(&types.DynamicFeeTx{
+		ChainID:   big.NewInt(1),
+		Nonce:     3,
+		GasTipCap: big.NewInt(1_000_000_000),
+		GasFeeCap: big.NewInt(30_000_000_000),
+		Gas:       100_000,
+		To:        &token,
+		Value:     big.NewInt(0),
+		Data:      erc20Data,
+	})
+	legacyTx := types.NewTx(&types.LegacyTx{
+		Nonce:    7,
+		GasPrice: big.NewInt(20_000_000_000),
+		Gas:      21_000,
+		To:       &recipient,
+		Value:    big.NewInt(5_000),
+	})
+	legacyPayload, err := rlp.EncodeToBytes(&types.LegacyTx{
+		Nonce:    7,
+		GasPrice: big.NewInt(20_000_000_000),
+		Gas:      21_000,
+		To:       &recipient,
+		Value:    big.NewInt(5_000),
+		V:        big.NewInt(137),
+		R:        big.NewInt(0),
+		S:        big.NewInt(0),
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name         string
+		b            []byte
+		meta         *MetadataEthereum
+		want         *types.Transaction
+		wantSigner   types.Signer
+		wantTo       common.Address
+		wantAmount   *big.Int
+		wantContract *common.Address
+	}{
+		{
+			name:       "ETH transfer",
+			b:          unsignedDynamicFeeTx(t, &recipient, big.NewInt(1_000), nil),
+			meta:       &MetadataEthereum{ChainId: 1},
+			want:       ethTx,
+			wantSigner: types.LatestSignerForChainID(big.NewInt(1)),
+			wantTo:     recipient,
+			wantAmount: big.NewInt(1_000),
+		},
+		{
+			name:         "ERC-20 transfer",
+			b:            unsignedDynamicFeeTx(t, &token, big.NewInt(0), erc20Data),
+			meta:         &MetadataEthereum{ChainId: 1},
+			want:         erc20Tx,
+			wantSigner:   types.LatestSignerForChainID(big.NewInt(1)),
+			wantTo:       recipient,
+			wantAmount:   big.NewInt(25_000_000),
+			wantContract: &token,
+		},
+		{
+			name:       "EIP-155 legacy transaction",
+			b:          legacyPayload,
+			meta:       &MetadataEthereum{ChainId: 137},
+			want:       legacyTx,
+			wantSigner: types.NewEIP155Signer(big.NewInt(137)),
+			wantTo:     recipient,
+			wantAmount: big.NewInt(5_000),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wallet := ethereumWallet(t)
+			details, err := wallet.SigningHashDetails(tt.b)
+			require.NoError(t, err)
+
+			require.Equal(t, tt.wantSigner.Hash(tt.want).Bytes(), details.SigningHash)
+			require.Equal(t, tt.wantTo, *details.To)
+			require.Zero(t, tt.wantAmount.Cmp(details.Amount))
+			require.Equal(t, tt.wantContract, details.Contract)
+			require.Zero(t, tt.wantSigner.ChainID().Cmp(details.ChainID))
+			require.Equal(t, tt.want.Nonce(), details.Nonce)
+
+			// the hash is the one signed for the parsed transaction
+			transfer, err := wallet.ParseTx(tt.b, tt.meta)
+			require.NoError(t, err)
+			require.Equal(t, transfer.DataForSigning, details.SigningHash)
+		})
+	}
+}
+
+func Test_EthereumWallet_SigningHashDetails_Errors(t *testing.T) {
+	to := common.HexToAddress("0x48c04ed5691981C42154C6167398f95e8f38a7fF")
+	unprotectedTx, err := rlp.EncodeToBytes(&HomesteadTxWithoutSignature{
+		Nonce:    1,
+		GasPrice: big.NewInt(20_000_000_000),
+		Gas:      21_000,
+		To:       &to,
+		Value:    big.NewInt(1_000),
+	})
+	require.NoError(t, err)
+
+	t.Run("unprotected transaction", func(t *testing.T) {
+		wallet := ethereumWallet(t)
+		_, err := wallet.SigningHashDetails(unprotectedTx)
+		require.ErrorIs(t, err, ErrUnprotectedTx)
+
+		wallet.SetAllowUnprotectedTxs(true)
+		details, err := wallet.SigningHashDetails(unprotectedTx)
+		require.NoError(t, err)
+		require.Nil(t, details.ChainID)
+		require.Equal(t, uint64(1), details.Nonce)
+		transfer, err := wallet.ParseTx(unprotectedTx, &MetadataEthereum{ChainId: 1})
+		require.NoError(t, err)
+		require.Equal(t, transfer.DataForSigning, details.SigningHash)
+	})
+
+	t.Run("chain of another wallet", func(t *testing.T) {
+		wallet := ethereumWallet(t)
+		wallet.chain = &EVMChain{Name: "Polygon", ID: 137, Symbol: "MATIC"}
+		_, err := wallet.SigningHashDetails(unsignedDynamicFeeTx(t, &to, big.NewInt(1_000), nil))
+		require.ErrorIs(t, err, ErrChainIDMismatch)
+	})
+
+	t.Run("malformed transaction", func(t *testing.T) {
+		_, err := ethereumWallet(t).SigningHashDetails([]byte{types.DynamicFeeTxType, 0xc0})
+		require.ErrorIs(t, err, ErrMalformedTx)
+	})
+}
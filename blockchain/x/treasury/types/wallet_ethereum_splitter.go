@@ -0,0 +1,84 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package types
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// TxKindSplitterRelease is a release call of an OpenZeppelin PaymentSplitter,
+// paying a payee its share of the funds held by the splitter. The amount is
+// computed by the contract.
+const TxKindSplitterRelease TxKind = "splitter_release"
+
+// SplitterReleaseCall contains the arguments of a PaymentSplitter release
+// call.
+type SplitterReleaseCall struct {
+	// Splitter is the address of the PaymentSplitter contract.
+	Splitter common.Address
+
+	// Payee is the account receiving its share.
+	Payee common.Address
+
+	// Token is the ERC-20 token being released, or nil for the native
+	// currency (i.e. release(address)).
+	Token *common.Address
+}
+
+// splitterReleaseTokenMethodID is release(address,address), releasing the
+// share of an ERC-20 token. The selector of release(address), releasing the
+// native currency, is shared with the vesting contracts: it's only decoded
+// as a splitter release for the contracts registered with
+// RegisterPaymentSplitter.
+var splitterReleaseTokenMethodID = crypto.Keccak256Hash([]byte("release(address,address)")).Bytes()[0:4]
+
+// paymentSplitters are the addresses of the contracts registered with
+// RegisterPaymentSplitter.
+var paymentSplitters = map[common.Address]bool{}
+
+// RegisterPaymentSplitter marks the contract at address splitter as an
+// OpenZeppelin PaymentSplitter, so that its release(address) calls are
+// parsed as TxKindSplitterRelease rather than TxKindVestingRelease.
+//
+// It must be called before parsing any transaction (e.g. in an init
+// function), and it's not safe for concurrent use.
+func RegisterPaymentSplitter(splitter common.Address) {
+	paymentSplitters[splitter] = true
+}
+
+// isPaymentSplitter returns true if the contract at address to is a
+// registered PaymentSplitter.
+func isPaymentSplitter(to common.Address) bool {
+	return paymentSplitters[to]
+}
+
+// unpackSplitterRelease decodes the arguments of a release call to the
+// splitter at address splitter, releasing the native currency (payee only)
+// or a token (token and payee).
+func unpackSplitterRelease(splitter common.Address, withToken bool, args []byte) (*SplitterReleaseCall, error) {
+	n := 1
+	if withToken {
+		n = 2
+	}
+	if len(args) != n*32 {
+		return nil, fmt.Errorf("invalid release: calldata is %d bytes, expected %d", len(args), n*32)
+	}
+
+	call := &SplitterReleaseCall{Splitter: splitter}
+	if withToken {
+		token, err := abiAddress(args, 0)
+		if err != nil {
+			return nil, fmt.Errorf("invalid release: %w", err)
+		}
+		call.Token = &token
+	}
+	payee, err := abiAddress(args, n-1)
+	if err != nil {
+		return nil, fmt.Errorf("invalid release: %w", err)
+	}
+	call.Payee = payee
+	return call, nil
+}
@@ -0,0 +1,78 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ParseEthereumTransaction_SplitterRelease(t *testing.T) {
+	splitter := common.HexToAddress("0x7a250d5630B4cF539739dF2C5dAcb4c659F2488D")
+	payee := common.HexToAddress("0x48c04ed5691981C42154C6167398f95e8f38a7fF")
+	usdt := common.HexToAddress("0xdAC17F958D2ee523a2206206994597C13D831ec7")
+	release := hexutil.MustDecode("0x1916558700000000000000000000000048c04ed5691981c42154c6167398f95e8f38a7ff")
+
+	// release(address) is a vesting release until the splitter is registered
+	tx, err := ParseEthereumTransaction(unsignedDynamicFeeTx(t, &splitter, big.NewInt(0), release), big.NewInt(1))
+	require.NoError(t, err)
+	require.Equal(t, TxKindVestingRelease, tx.Kind)
+
+	RegisterPaymentSplitter(splitter)
+	t.Cleanup(func() { delete(paymentSplitters, splitter) })
+
+	tests := []struct {
+		name      string
+		data      []byte
+		wantToken *common.Address
+		wantErr   bool
+	}{
+		{
+			name: "release",
+			data: release,
+		},
+		{
+			name:      "release token",
+			data:      hexutil.MustDecode("0x48b75044000000000000000000000000dac17f958d2ee523a2206206994597c13d831ec700000000000000000000000048c04ed5691981c42154c6167398f95e8f38a7ff"),
+			wantToken: &usdt,
+		},
+		{
+			name:    "truncated payee",
+			data:    release[:30],
+			wantErr: true,
+		},
+		{
+			name:    "trailing bytes",
+			data:    append(append([]byte{}, release...), 0),
+			wantErr: true,
+		},
+		{
+			name:    "dirty address padding",
+			data:    hexutil.MustDecode("0x1916558700000000000000000000000148c04ed5691981c42154c6167398f95e8f38a7ff"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx, err := ParseEthereumTransaction(unsignedDynamicFeeTx(t, &splitter, big.NewInt(0), tt.data), big.NewInt(1))
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, TxKindSplitterRelease, tx.Kind)
+			require.Equal(t, splitter, *tx.To)
+
+			details, ok := tx.Details.(*SplitterReleaseCall)
+			require.True(t, ok)
+			require.Equal(t, splitter, details.Splitter)
+			require.Equal(t, payee, details.Payee)
+			require.Equal(t, tt.wantToken, details.Token)
+		})
+	}
+}
@@ -4,10 +4,15 @@
 package types
 
 import (
+	"crypto/ecdsa"
 	"math/big"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/stretchr/testify/require"
 )
 
@@ -16,6 +21,36 @@ func Test_EthereumWallet_Address(t *testing.T) {
 	require.Equal(t, "0xdD1d3fF09C5EdfF1bE7d466cA614cB1cF3f78738", wallet.Address())
 }
 
+func Test_EthereumWallet_ValidateAddress(t *testing.T) {
+	tests := []struct {
+		name    string
+		addr    string
+		wantErr bool
+	}{
+		{name: "checksummed", addr: "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"},
+		{name: "all lowercase", addr: "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed"},
+		{name: "all uppercase", addr: "0x5AAEB6053F3E94C9B9A09F33669435E7EF1BEAED"},
+		{name: "without prefix", addr: "5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"},
+		{name: "wrong checksum", addr: "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAeD", wantErr: true},
+		{name: "too short", addr: "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeA", wantErr: true},
+		{name: "too long", addr: "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed00", wantErr: true},
+		{name: "not hex", addr: "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAeg", wantErr: true},
+		{name: "empty", addr: "", wantErr: true},
+	}
+
+	wallet := ethereumWallet(t)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := wallet.ValidateAddress(tt.addr)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
 func ethereumWallet(t *testing.T) *EthereumWallet {
 	t.Helper()
 	k := &Key{
@@ -38,6 +73,7 @@ func Test_ParseEthereumTransaction(t *testing.T) {
 		wantTo       string
 		wantAmount   *big.Int
 		wantContract string
+		wantKind     TxKind
 		wantErr      bool
 	}{
 		// The following two txs are LegacyTxs that are no longer supported. Leaving the test cases here in case we want to support them again in the future.
@@ -47,6 +83,7 @@ func Test_ParseEthereumTransaction(t *testing.T) {
 			wantTo:       "0xeA223Ca8968Ca59e0Bc79Ba331c2F6f636A3fB82",
 			wantAmount:   big.NewInt(1000000000000000000),
 			wantContract: "",
+			wantKind:     TxKindTransfer,
 			wantErr:      false,
 		},
 		{
@@ -55,6 +92,7 @@ func Test_ParseEthereumTransaction(t *testing.T) {
 			wantTo:       "0x48c04ed5691981C42154C6167398f95e8f38a7fF",
 			wantAmount:   big.NewInt(25000000),
 			wantContract: "0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48",
+			wantKind:     TxKindTransfer,
 			wantErr:      false,
 		},
 		{
@@ -64,6 +102,7 @@ func Test_ParseEthereumTransaction(t *testing.T) {
 			wantTo:       "0x3fC91A3afd70395Cd496C647d5a6CC9D4B2b7FAD",
 			wantAmount:   big.NewInt(10000000000000000),
 			wantContract: "0x3fC91A3afd70395Cd496C647d5a6CC9D4B2b7FAD",
+			wantKind:     TxKindRouterExecute,
 			wantErr:      false,
 		},
 		{
@@ -73,6 +112,7 @@ func Test_ParseEthereumTransaction(t *testing.T) {
 			wantTo:       "0x1f9840a85d5aF5bf1D1762F925BDADdC4201F984",
 			wantAmount:   big.NewInt(0),
 			wantContract: "0x1f9840a85d5aF5bf1D1762F925BDADdC4201F984",
+			wantKind:     TxKindApproval,
 			wantErr:      false,
 		},
 	}
@@ -87,6 +127,7 @@ func Test_ParseEthereumTransaction(t *testing.T) {
 			require.NoError(t, err)
 			require.Equal(t, tt.wantTo, tx.To.Hex())
 			require.Equal(t, tt.wantAmount, tx.Amount)
+			require.Equal(t, tt.wantKind, tx.Kind)
 			if len(tt.wantContract) == 0 {
 				require.Nil(t, tx.Contract)
 			} else {
@@ -95,3 +136,614 @@ func Test_ParseEthereumTransaction(t *testing.T) {
 		})
 	}
 }
+
+func Test_EthereumWallet_ParseTx_CoinIdentifier(t *testing.T) {
+	wallet := ethereumWallet(t)
+
+	tests := []struct {
+		name         string
+		b            []byte
+		wantContract string
+	}{
+		{
+			name: "ETH transfer",
+			b:    hexutil.MustDecode("0xeb80843b9aca0082520894ea223ca8968ca59e0bc79ba331c2f6f636a3fb82880de0b6b3a764000080808080"),
+		},
+		{
+			name:         "ERC-20 transfer",
+			b:            hexutil.MustDecode("0xf8aa80850b68a0aa0083010d6b94a0b86991c6218b36c1d19d4a2e9eb0ce3606eb4880b844a9059cbb00000000000000000000000048c04ed5691981c42154c6167398f95e8f38a7ff00000000000000000000000000000000000000000000000000000000017d784026a01ad4a933da06f76b08a20784661b2ccc55a8f25492bbc6b66d4b84ef97e1db47a01ab2ff0cd0fb01e2990dd4196412baf173484d91c7f836727d554cdf1cd70c64"),
+			wantContract: "0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transfer, err := wallet.ParseTx(tt.b, &MetadataEthereum{ChainId: 1})
+			require.NoError(t, err)
+
+			coin, err := ParseCoinIdentifier(transfer.CoinIdentifier)
+			require.NoError(t, err)
+			require.Equal(t, "ETH", coin.Symbol)
+			if len(tt.wantContract) == 0 {
+				require.True(t, coin.IsNative())
+			} else {
+				require.Equal(t, tt.wantContract, common.BytesToAddress(coin.Contract).Hex())
+			}
+		})
+	}
+}
+
+func Test_EthereumWallet_ParseTx_MaxFee(t *testing.T) {
+	wallet := ethereumWallet(t)
+	to := common.HexToAddress("0xeA223Ca8968Ca59e0Bc79Ba331c2F6f636A3fB82")
+
+	tests := []struct {
+		name       string
+		b          []byte
+		wantMaxFee *big.Int
+	}{
+		{
+			// 21000 gas at 1 gwei
+			name:       "legacy",
+			b:          hexutil.MustDecode("0xeb80843b9aca0082520894ea223ca8968ca59e0bc79ba331c2f6f636a3fb82880de0b6b3a764000080808080"),
+			wantMaxFee: big.NewInt(21_000_000_000_000),
+		},
+		{
+			// 100000 gas at a max fee of 30 gwei
+			name:       "dynamic fee",
+			b:          unsignedDynamicFeeTx(t, &to, big.NewInt(1_000), nil),
+			wantMaxFee: big.NewInt(3_000_000_000_000_000),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transfer, err := wallet.ParseTx(tt.b, &MetadataEthereum{ChainId: 1})
+			require.NoError(t, err)
+			require.Equal(t, tt.wantMaxFee, transfer.MaxFee)
+		})
+	}
+}
+
+func Test_ParseEthereumTransaction_Deploy(t *testing.T) {
+	// init code of an empty contract, as compiled by solc 0.8.20
+	initCode := hexutil.MustDecode("0x6080604052348015600f57600080fd5b50603f80601d6000396000f3fe6080604052600080fdfea164736f6c6343000814000a")
+
+	legacyTx, err := rlp.EncodeToBytes(&types.LegacyTx{
+		Nonce:    7,
+		GasPrice: big.NewInt(20_000_000_000),
+		Gas:      100_000,
+		Value:    big.NewInt(0),
+		Data:     initCode,
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		b       []byte
+		wantErr bool
+	}{
+		{name: "legacy", b: legacyTx},
+		{name: "dynamic fee", b: unsignedDynamicFeeTx(t, nil, big.NewInt(0), initCode)},
+		{name: "dynamic fee with value", b: unsignedDynamicFeeTx(t, nil, big.NewInt(1_000), initCode)},
+		{name: "empty init code", b: unsignedDynamicFeeTx(t, nil, big.NewInt(0), nil), wantErr: true},
+	}
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	chainID := big.NewInt(1)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx, err := ParseEthereumTransaction(tt.b, chainID)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, TxKindDeploy, tx.Kind)
+			require.Nil(t, tx.To)
+			require.Nil(t, tx.Contract)
+
+			// a signature over DataForSigning must make a valid transaction
+			// for go-ethereum
+			txData, err := DecodeUnsignedPayload(tt.b)
+			require.NoError(t, err)
+			require.Equal(t, types.NewTx(txData).Nonce(), tx.Nonce)
+			sig, err := crypto.Sign(tx.DataForSigning, key)
+			require.NoError(t, err)
+			signer := types.LatestSignerForChainID(chainID)
+			signedTx, err := types.NewTx(txData).WithSignature(signer, sig)
+			require.NoError(t, err)
+			sender, err := types.Sender(signer, signedTx)
+			require.NoError(t, err)
+			require.Equal(t, crypto.PubkeyToAddress(key.PublicKey), sender)
+			require.Equal(t, signer.Hash(signedTx).Bytes(), tx.DataForSigning)
+		})
+	}
+}
+
+func Test_ParseEthereumTransaction_CloneDeploy(t *testing.T) {
+	// init code of a minimal proxy to 0xbEbc44782C7dB0a1A60Cb6fe97d0b483032FF1C7,
+	// as deployed by OpenZeppelin Clones.clone
+	initCode := hexutil.MustDecode("0x3d602d80600a3d3981f3363d3d373d3d3d363d73bebc44782c7db0a1a60cb6fe97d0b483032ff1c75af43d82803e903d91602b57fd5bf3")
+
+	tx, err := ParseEthereumTransaction(unsignedDynamicFeeTx(t, nil, big.NewInt(0), initCode), big.NewInt(1))
+	require.NoError(t, err)
+	require.Equal(t, TxKindCloneDeploy, tx.Kind)
+	require.Nil(t, tx.To)
+
+	details, ok := tx.Details.(*CloneDeployDetails)
+	require.True(t, ok)
+	require.Equal(t, "0xbEbc44782C7dB0a1A60Cb6fe97d0b483032FF1C7", details.Implementation.Hex())
+
+	t.Run("trailing bytes", func(t *testing.T) {
+		tx, err := ParseEthereumTransaction(unsignedDynamicFeeTx(t, nil, big.NewInt(0), append(initCode, 0x00)), big.NewInt(1))
+		require.NoError(t, err)
+		require.Equal(t, TxKindDeploy, tx.Kind)
+		require.Nil(t, tx.Details)
+	})
+
+	t.Run("modified runtime code", func(t *testing.T) {
+		modified := append([]byte{}, initCode...)
+		modified[len(modified)-1] = 0xfe
+		tx, err := ParseEthereumTransaction(unsignedDynamicFeeTx(t, nil, big.NewInt(0), modified), big.NewInt(1))
+		require.NoError(t, err)
+		require.Equal(t, TxKindDeploy, tx.Kind)
+	})
+}
+
+func Test_EthereumWallet_ParseTx_Deploy(t *testing.T) {
+	initCode := hexutil.MustDecode("0x6080604052348015600f57600080fd5b50603f80601d6000396000f3fe6080604052600080fdfea164736f6c6343000814000a")
+
+	transfer, err := ethereumWallet(t).ParseTx(unsignedDynamicFeeTx(t, nil, big.NewInt(0), initCode), &MetadataEthereum{ChainId: 1})
+	require.NoError(t, err)
+	require.Equal(t, TxKindDeploy, transfer.Kind)
+	require.Nil(t, transfer.To)
+}
+
+func Test_EthereumWallet_BuildSignedTx(t *testing.T) {
+	privKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	otherKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	wallet, err := NewEthereumWallet(&Key{
+		Type:      KeyType_KEY_TYPE_ECDSA_SECP256K1,
+		PublicKey: crypto.CompressPubkey(&privKey.PublicKey),
+	})
+	require.NoError(t, err)
+
+	to := common.HexToAddress("0xea223ca8968ca59e0bc79ba331c2f6f636a3fb82")
+	legacyTx, err := rlp.EncodeToBytes(&types.LegacyTx{
+		Nonce:    1,
+		GasPrice: big.NewInt(20_000_000_000),
+		Gas:      21_000,
+		To:       &to,
+		Value:    big.NewInt(1_000_000_000_000_000_000),
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		b        []byte
+		chainID  uint64
+		key      *ecdsa.PrivateKey
+		addToV   byte
+		wantType uint8
+		wantErr  bool
+	}{
+		{name: "legacy", b: legacyTx, chainID: 1, key: privKey, wantType: types.LegacyTxType},
+		{name: "legacy, other chain", b: legacyTx, chainID: 11155111, key: privKey, wantType: types.LegacyTxType},
+		{name: "dynamic fee", b: unsignedDynamicFeeTx(t, &to, big.NewInt(1_000), nil), chainID: 1, key: privKey, wantType: types.DynamicFeeTxType},
+		{name: "V as 27/28", b: unsignedDynamicFeeTx(t, &to, big.NewInt(1_000), nil), chainID: 1, key: privKey, addToV: 27, wantType: types.DynamicFeeTxType},
+		{name: "signed by another key", b: unsignedDynamicFeeTx(t, &to, big.NewInt(1_000), nil), chainID: 1, key: otherKey, wantErr: true},
+		{name: "chain ID mismatch", b: unsignedDynamicFeeTx(t, &to, big.NewInt(1_000), nil), chainID: 5, key: privKey, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			meta := &MetadataEthereum{ChainId: tt.chainID}
+			transfer, err := wallet.ParseTx(tt.b, meta)
+			require.NoError(t, err)
+
+			sig, err := crypto.Sign(transfer.DataForSigning, tt.key)
+			require.NoError(t, err)
+			sig[crypto.RecoveryIDOffset] += tt.addToV
+
+			signed, err := wallet.BuildSignedTx(tt.b, sig, meta)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			var signedTx types.Transaction
+			require.NoError(t, signedTx.UnmarshalBinary(signed))
+			require.Equal(t, tt.wantType, signedTx.Type())
+
+			sender, err := types.Sender(types.LatestSignerForChainID(new(big.Int).SetUint64(tt.chainID)), &signedTx)
+			require.NoError(t, err)
+			require.Equal(t, wallet.Address(), sender.Hex())
+		})
+	}
+}
+
+func Test_EthereumWallet_UnprotectedTx(t *testing.T) {
+	privKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	wallet, err := NewEthereumWallet(&Key{
+		Type:      KeyType_KEY_TYPE_ECDSA_SECP256K1,
+		PublicKey: crypto.CompressPubkey(&privKey.PublicKey),
+	})
+	require.NoError(t, err)
+	meta := &MetadataEthereum{ChainId: 1}
+
+	// [nonce, gasPrice, gas, to, value, data], without the EIP-155 chain ID
+	homesteadTx := hexutil.MustDecode("0xe9018504a817c80082520894ea223ca8968ca59e0bc79ba331c2f6f636a3fb82880de0b6b3a764000080")
+	to := common.HexToAddress("0xea223ca8968ca59e0bc79ba331c2f6f636a3fb82")
+	encoded, err := rlp.EncodeToBytes(&HomesteadTxWithoutSignature{
+		Nonce:    1,
+		GasPrice: big.NewInt(20_000_000_000),
+		Gas:      21_000,
+		To:       &to,
+		Value:    big.NewInt(1_000_000_000_000_000_000),
+	})
+	require.NoError(t, err)
+	require.Equal(t, homesteadTx, encoded)
+
+	t.Run("rejected by default", func(t *testing.T) {
+		_, err := ParseEthereumTransaction(homesteadTx, big.NewInt(1))
+		require.ErrorIs(t, err, ErrUnprotectedTx)
+		_, err = wallet.ParseTx(homesteadTx, meta)
+		require.ErrorIs(t, err, ErrUnprotectedTx)
+		_, err = wallet.BuildSignedTx(homesteadTx, make([]byte, 65), meta)
+		require.ErrorIs(t, err, ErrUnprotectedTx)
+	})
+
+	wallet.SetAllowUnprotectedTxs(true)
+
+	t.Run("homestead signing hash", func(t *testing.T) {
+		transfer, err := wallet.ParseTx(homesteadTx, meta)
+		require.NoError(t, err)
+		require.Equal(t, TxKindTransfer, transfer.Kind)
+		require.Equal(t, to.Bytes(), transfer.To)
+		require.Equal(t, big.NewInt(1_000_000_000_000_000_000), transfer.Amount)
+		// the Homestead signing hash is the hash of the unsigned payload
+		require.Equal(t, crypto.Keccak256(homesteadTx), transfer.DataForSigning)
+
+		sig, err := crypto.Sign(transfer.DataForSigning, privKey)
+		require.NoError(t, err)
+		signed, err := wallet.BuildSignedTx(homesteadTx, sig, meta)
+		require.NoError(t, err)
+
+		var signedTx types.Transaction
+		require.NoError(t, signedTx.UnmarshalBinary(signed))
+		require.False(t, signedTx.Protected())
+		sender, err := types.Sender(types.HomesteadSigner{}, &signedTx)
+		require.NoError(t, err)
+		require.Equal(t, wallet.Address(), sender.Hex())
+		require.NoError(t, wallet.VerifySignature(signed))
+	})
+
+	t.Run("protected legacy transactions keep EIP-155", func(t *testing.T) {
+		protectedTx, err := rlp.EncodeToBytes(&types.LegacyTx{
+			Nonce:    1,
+			GasPrice: big.NewInt(20_000_000_000),
+			Gas:      21_000,
+			To:       &to,
+			Value:    big.NewInt(1_000_000_000_000_000_000),
+		})
+		require.NoError(t, err)
+
+		transfer, err := wallet.ParseTx(protectedTx, meta)
+		require.NoError(t, err)
+		tx := types.NewTx(&types.LegacyTx{Nonce: 1, GasPrice: big.NewInt(20_000_000_000), Gas: 21_000, To: &to, Value: big.NewInt(1_000_000_000_000_000_000)})
+		require.Equal(t, types.NewEIP155Signer(big.NewInt(1)).Hash(tx).Bytes(), transfer.DataForSigning)
+		require.NotEqual(t, crypto.Keccak256(homesteadTx), transfer.DataForSigning)
+	})
+}
+
+func Test_EthereumWallet_BuildSignedTx_InvalidSignature(t *testing.T) {
+	wallet := ethereumWallet(t)
+	b := hexutil.MustDecode("0xeb80843b9aca0082520894ea223ca8968ca59e0bc79ba331c2f6f636a3fb82880de0b6b3a764000080808080")
+
+	_, err := wallet.BuildSignedTx(b, make([]byte, 64), &MetadataEthereum{ChainId: 1})
+	require.Error(t, err)
+	_, err = wallet.BuildSignedTx(b, make([]byte, 65), nil)
+	require.Error(t, err)
+}
+
+func Test_EthereumWallet_VerifySignature(t *testing.T) {
+	privKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	otherKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	wallet, err := NewEthereumWallet(&Key{
+		Type:      KeyType_KEY_TYPE_ECDSA_SECP256K1,
+		PublicKey: crypto.CompressPubkey(&privKey.PublicKey),
+	})
+	require.NoError(t, err)
+
+	to := common.HexToAddress("0xea223ca8968ca59e0bc79ba331c2f6f636a3fb82")
+	chainID := big.NewInt(1)
+	legacyTx := types.NewTx(&types.LegacyTx{
+		Nonce:    1,
+		GasPrice: big.NewInt(20_000_000_000),
+		Gas:      21_000,
+		To:       &to,
+		Value:    big.NewInt(1_000),
+	})
+	dynamicFeeTx := types.NewTx(&types.DynamicFeeTx{
+		ChainID:   chainID,
+		Nonce:     1,
+		GasTipCap: big.NewInt(1_000_000_000),
+		GasFeeCap: big.NewInt(30_000_000_000),
+		Gas:       21_000,
+		To:        &to,
+		Value:     big.NewInt(1_000),
+	})
+
+	tests := []struct {
+		name    string
+		tx      *types.Transaction
+		signer  types.Signer
+		key     *ecdsa.PrivateKey
+		wantErr bool
+	}{
+		{name: "legacy", tx: legacyTx, signer: types.HomesteadSigner{}, key: privKey},
+		{name: "EIP-155", tx: legacyTx, signer: types.NewEIP155Signer(chainID), key: privKey},
+		{name: "EIP-1559", tx: dynamicFeeTx, signer: types.NewLondonSigner(chainID), key: privKey},
+		{name: "EIP-1559 signed by another key", tx: dynamicFeeTx, signer: types.NewLondonSigner(chainID), key: otherKey, wantErr: true},
+		{name: "legacy signed by another key", tx: legacyTx, signer: types.HomesteadSigner{}, key: otherKey, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			signedTx, err := types.SignTx(tt.tx, tt.signer, tt.key)
+			require.NoError(t, err)
+			b, err := signedTx.MarshalBinary()
+			require.NoError(t, err)
+
+			err = wallet.VerifySignature(b)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	require.Error(t, wallet.VerifySignature([]byte{0x02, 0x01}))
+}
+
+func Test_EthereumWallet_TxHash(t *testing.T) {
+	privKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	wallet := ethereumWallet(t)
+
+	to := common.HexToAddress("0xea223ca8968ca59e0bc79ba331c2f6f636a3fb82")
+	chainID := big.NewInt(1)
+	legacyTx := types.NewTx(&types.LegacyTx{
+		Nonce:    1,
+		GasPrice: big.NewInt(20_000_000_000),
+		Gas:      21_000,
+		To:       &to,
+		Value:    big.NewInt(1_000),
+	})
+	dynamicFeeTx := types.NewTx(&types.DynamicFeeTx{
+		ChainID:   chainID,
+		Nonce:     1,
+		GasTipCap: big.NewInt(1_000_000_000),
+		GasFeeCap: big.NewInt(30_000_000_000),
+		Gas:       21_000,
+		To:        &to,
+		Value:     big.NewInt(1_000),
+	})
+
+	tests := []struct {
+		name   string
+		tx     *types.Transaction
+		signer types.Signer
+	}{
+		{name: "legacy", tx: legacyTx, signer: types.HomesteadSigner{}},
+		{name: "EIP-155", tx: legacyTx, signer: types.NewEIP155Signer(chainID)},
+		{name: "EIP-1559", tx: dynamicFeeTx, signer: types.NewLondonSigner(chainID)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			signedTx, err := types.SignTx(tt.tx, tt.signer, privKey)
+			require.NoError(t, err)
+			b, err := signedTx.MarshalBinary()
+			require.NoError(t, err)
+
+			hash, err := wallet.TxHash(b)
+			require.NoError(t, err)
+			require.Equal(t, signedTx.Hash(), hash)
+			require.Equal(t, crypto.Keccak256Hash(b), hash)
+			require.NotEqual(t, tt.signer.Hash(tt.tx), hash)
+		})
+	}
+
+	t.Run("unsigned", func(t *testing.T) {
+		b, err := dynamicFeeTx.MarshalBinary()
+		require.NoError(t, err)
+		_, err = wallet.TxHash(b)
+		require.Error(t, err)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := wallet.TxHash([]byte{0x02, 0x01})
+		require.Error(t, err)
+	})
+}
+
+func Test_EthereumTransfer_Equal(t *testing.T) {
+	usdt := common.HexToAddress("0xdAC17F958D2ee523a2206206994597C13D831ec7")
+	data := hexutil.MustDecode("0xa9059cbb00000000000000000000000048c04ed5691981c42154c6167398f95e8f38a7ff00000000000000000000000000000000000000000000000000000000000f4240")
+	parse := func() *EthereumTransfer {
+		tx, err := ParseEthereumTransaction(unsignedDynamicFeeTx(t, &usdt, big.NewInt(0), data), big.NewInt(1))
+		require.NoError(t, err)
+		return tx
+	}
+
+	// separately parsed transactions don't share any pointer
+	a, b := parse(), parse()
+	require.NotSame(t, a.To, b.To)
+	require.NotSame(t, a.Amount, b.Amount)
+	require.True(t, a.Equal(b))
+
+	b.Amount = new(big.Int).SetBytes(append([]byte{0}, a.Amount.Bytes()...))
+	require.True(t, a.Equal(b))
+
+	recipient := common.HexToAddress("0x48c04ed5691981C42154C6167398f95e8f38a7fF")
+	b.To = &recipient
+	require.True(t, a.Equal(b))
+
+	other := common.HexToAddress("0x0000000000000000000000000000000000000001")
+	b.Contract = &other
+	require.False(t, a.Equal(b))
+
+	b = parse()
+	b.MaxFee = new(big.Int).Add(a.MaxFee, big.NewInt(1))
+	require.False(t, a.Equal(b))
+
+	b = parse()
+	b.Nonce++
+	require.False(t, a.Equal(b))
+
+	b = parse()
+	b.To = nil
+	require.False(t, a.Equal(b))
+	require.False(t, b.Equal(a))
+
+	require.False(t, a.Equal(nil))
+	require.True(t, (*EthereumTransfer)(nil).Equal(nil))
+}
+
+func Test_ParseEthereumTransaction_Errors(t *testing.T) {
+	usdt := common.HexToAddress("0xdAC17F958D2ee523a2206206994597C13D831ec7")
+	erc20Transfer := hexutil.MustDecode("0xa9059cbb00000000000000000000000048c04ed5691981c42154c6167398f95e8f38a7ff00000000000000000000000000000000000000000000000000000000000f4240")
+	unprotectedTx, err := rlp.EncodeToBytes(&HomesteadTxWithoutSignature{
+		GasPrice: big.NewInt(20_000_000_000),
+		Gas:      21_000,
+		To:       &usdt,
+		Value:    big.NewInt(1),
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		b       []byte
+		wantErr error
+	}{
+		{name: "empty payload", b: nil, wantErr: ErrMalformedTx},
+		{name: "invalid RLP", b: []byte{types.DynamicFeeTxType, 0xc1}, wantErr: ErrMalformedTx},
+		{name: "unsupported transaction type", b: []byte{0x05, 0xc0}, wantErr: ErrUnsupportedMethod},
+		{name: "calldata shorter than a method id", b: unsignedDynamicFeeTx(t, &usdt, big.NewInt(0), []byte{0xa9, 0x05}), wantErr: ErrMalformedTx},
+		{name: "truncated ERC-20 transfer", b: unsignedDynamicFeeTx(t, &usdt, big.NewInt(0), erc20Transfer[:40]), wantErr: ErrMalformedTx},
+		{name: "empty init code", b: unsignedDynamicFeeTx(t, nil, big.NewInt(0), nil), wantErr: ErrMalformedTx},
+		{name: "both value and data set", b: unsignedDynamicFeeTx(t, &usdt, big.NewInt(1), erc20Transfer), wantErr: ErrAmbiguousTx},
+		{name: "without replay protection", b: unprotectedTx, wantErr: ErrUnprotectedTx},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseEthereumTransaction(tt.b, big.NewInt(1))
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+
+	_, _, err = rawUnpackERC20Transfer(hexutil.MustDecode("0x23b872dd"))
+	require.ErrorIs(t, err, ErrUnsupportedMethod)
+}
+
+func Test_ParseEthereumTransaction_LargeValue(t *testing.T) {
+	to := common.HexToAddress("0xea223ca8968ca59e0bc79ba331c2f6f636a3fb82")
+	usdt := common.HexToAddress("0xdAC17F958D2ee523a2206206994597C13D831ec7")
+	erc20Transfer := hexutil.MustDecode("0xa9059cbb00000000000000000000000048c04ed5691981c42154c6167398f95e8f38a7ff00000000000000000000000000000000000000000000000000000000000f4240")
+
+	// 2^64 truncates to zero when converted with Uint64
+	value := new(big.Int).Lsh(big.NewInt(1), 64)
+
+	tx, err := ParseEthereumTransaction(unsignedDynamicFeeTx(t, &to, value, nil), big.NewInt(1))
+	require.NoError(t, err)
+	require.Equal(t, TxKindTransfer, tx.Kind)
+	require.Equal(t, to, *tx.To)
+	require.Nil(t, tx.Contract)
+	require.Equal(t, 0, value.Cmp(tx.Amount))
+
+	_, err = ParseEthereumTransaction(unsignedDynamicFeeTx(t, &usdt, value, erc20Transfer), big.NewInt(1))
+	require.ErrorIs(t, err, ErrAmbiguousTx)
+}
+
+func Test_EthereumWallet_ChainIDMismatch(t *testing.T) {
+	to := common.HexToAddress("0xea223ca8968ca59e0bc79ba331c2f6f636a3fb82")
+	unsignedTx := unsignedDynamicFeeTx(t, &to, big.NewInt(1_000), nil)
+
+	// the metadata doesn't match the chain of the wallet
+	wallet, err := NewEthereumWalletForChain(&Key{
+		Type:      KeyType_KEY_TYPE_ECDSA_SECP256K1,
+		PublicKey: hexutil.MustDecode("0x025cd45a6614df5348692ea4d0f7c16255b75a6b6f67bea5013621fe84af8031f0"),
+	}, EVMChainEthereum)
+	require.NoError(t, err)
+	_, err = wallet.ParseTx(unsignedTx, &MetadataEthereum{ChainId: 137})
+	require.ErrorIs(t, err, ErrChainIDMismatch)
+
+	// the metadata doesn't match the chain of the transaction
+	_, err = ethereumWallet(t).BuildSignedTx(unsignedTx, make([]byte, crypto.SignatureLength), &MetadataEthereum{ChainId: 5})
+	require.ErrorIs(t, err, ErrChainIDMismatch)
+}
+
+// blobTxWithoutSignature is an unsigned EIP-4844 blob transaction, without
+// the blobs, as signed by the sender.
+type blobTxWithoutSignature struct {
+	ChainID             *big.Int
+	Nonce               uint64
+	GasTipCap           *big.Int
+	GasFeeCap           *big.Int
+	Gas                 uint64
+	To                  common.Address
+	Value               *big.Int
+	Data                []byte
+	AccessList          types.AccessList
+	BlobFeeCap          *big.Int
+	BlobVersionedHashes []common.Hash
+}
+
+func Test_ParseEthereumTransaction_BlobTx(t *testing.T) {
+	to := common.HexToAddress("0xea223ca8968ca59e0bc79ba331c2f6f636a3fb82")
+	unsigned := blobTxWithoutSignature{
+		ChainID:             big.NewInt(1),
+		Nonce:               3,
+		GasTipCap:           big.NewInt(1_000_000_000),
+		GasFeeCap:           big.NewInt(30_000_000_000),
+		Gas:                 21_000,
+		To:                  to,
+		Value:               big.NewInt(1_000),
+		BlobFeeCap:          big.NewInt(1),
+		BlobVersionedHashes: []common.Hash{common.HexToHash("0x01b0761f87b081d5cf10757ccc89f12be355c70e2e29df288b65b30710dcbcd1")},
+	}
+	b, err := rlp.EncodeToBytes(&unsigned)
+	require.NoError(t, err)
+	unsignedTx := append([]byte{blobTxType}, b...)
+
+	_, err = ParseEthereumTransaction(unsignedTx, big.NewInt(1))
+	require.ErrorIs(t, err, ErrBlobTxNotSupported)
+	require.ErrorIs(t, err, ErrUnsupportedMethod)
+
+	_, err = ethereumWallet(t).ParseTx(unsignedTx, &MetadataEthereum{ChainId: 1})
+	require.ErrorIs(t, err, ErrBlobTxNotSupported)
+
+	// signed blob transactions are rejected as well
+	signed, err := rlp.EncodeToBytes([]any{
+		unsigned.ChainID, unsigned.Nonce, unsigned.GasTipCap, unsigned.GasFeeCap, unsigned.Gas, unsigned.To,
+		unsigned.Value, unsigned.Data, unsigned.AccessList, unsigned.BlobFeeCap, unsigned.BlobVersionedHashes,
+		uint64(1), big.NewInt(1), big.NewInt(1),
+	})
+	require.NoError(t, err)
+	_, _, err = ParseSignedEthereumTransaction(append([]byte{blobTxType}, signed...))
+	require.ErrorIs(t, err, ErrBlobTxNotSupported)
+}
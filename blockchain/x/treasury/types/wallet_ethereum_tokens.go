@@ -0,0 +1,95 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+var (
+	// ErrInvalidTokenContract is returned for token transfers whose
+	// contract can't be a token, e.g. the zero address or the address of
+	// the wallet itself.
+	ErrInvalidTokenContract = errors.New("invalid token contract")
+
+	// ErrUnknownToken is returned for transfers of tokens unknown to the
+	// TokenMetadataResolver of a wallet in strict mode, see
+	// SetStrictTokens.
+	ErrUnknownToken = errors.New("unknown token")
+)
+
+// TokenMetadataResolver returns the metadata of ERC-20 tokens, e.g. from a
+// registry of known tokens. It's optional: transactions are parsed the same
+// way without it, but the Token of the transfers of tokens won't be set, so
+// their amounts are displayed in base units unless LookupCoinInfo knows
+// them.
+type TokenMetadataResolver interface {
+	// Resolve returns the symbol and decimals of the token deployed at the
+	// contract address, or ok false if the token is unknown.
+	Resolve(contract common.Address) (symbol string, decimals uint8, ok bool)
+}
+
+// FeeOnTransferResolver can be implemented by a TokenMetadataResolver whose
+// registry flags the tokens taking a fee on transfers, whose recipient
+// receives less than the amount in the calldata.
+type FeeOnTransferResolver interface {
+	// IsFeeOnTransfer returns true if the token deployed at the contract
+	// address takes a fee on transfers.
+	IsFeeOnTransfer(contract common.Address) bool
+}
+
+// SetTokenMetadataResolver sets the resolver used by ParseTx to look up
+// the tokens moved by transactions. A nil resolver disables the lookup.
+func (w *EthereumWallet) SetTokenMetadataResolver(r TokenMetadataResolver) {
+	w.tokens = r
+}
+
+// SetStrictTokens makes ParseTx reject transfers of ERC-20 tokens that the
+// TokenMetadataResolver doesn't know, with ErrUnknownToken. Without a
+// resolver, all token transfers are rejected. Other contract calls are not
+// affected.
+func (w *EthereumWallet) SetStrictTokens(strict bool) {
+	w.strictTokens = strict
+}
+
+// checkTokenContract returns ErrInvalidTokenContract if tx transfers a
+// token whose contract is the wallet's own address, and ErrUnknownToken if it transfers a token
+// unknown to the resolver in strict mode.
+func (w *EthereumWallet) checkTokenContract(tx *EthereumTransfer) error {
+	if tx.Contract == nil || !isValueTransfer(tx.Kind) {
+		return nil
+	}
+	if *tx.Contract == crypto.PubkeyToAddress(*w.key) {
+		return fmt.Errorf("%w: %s is the wallet address", ErrInvalidTokenContract, tx.Contract.Hex())
+	}
+	if w.strictTokens {
+		if _, _, ok := resolveTokenMetadata(w.tokens, *tx.Contract); !ok {
+			return fmt.Errorf("%w: %s", ErrUnknownToken, tx.Contract.Hex())
+		}
+	}
+	return nil
+}
+
+// resolveTokenMetadata looks up the contract using r, if set. Empty symbols
+// are discarded.
+func resolveTokenMetadata(r TokenMetadataResolver, contract common.Address) (string, uint8, bool) {
+	if r == nil {
+		return "", 0, false
+	}
+	symbol, decimals, ok := r.Resolve(contract)
+	if !ok || len(symbol) == 0 {
+		return "", 0, false
+	}
+	return symbol, decimals, true
+}
+
+// isFeeOnTransfer reports whether r flags the contract as a fee-on-transfer
+// token. Resolvers not implementing FeeOnTransferResolver flag no token.
+func isFeeOnTransfer(r TokenMetadataResolver, contract common.Address) bool {
+	feeResolver, ok := r.(FeeOnTransferResolver)
+	return ok && feeResolver.IsFeeOnTransfer(contract)
+}
@@ -0,0 +1,181 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeTokenResolver resolves the tokens in the map.
+type fakeTokenResolver map[common.Address]CoinInfo
+
+func (r fakeTokenResolver) Resolve(contract common.Address) (string, uint8, bool) {
+	info, ok := r[contract]
+	return info.Ticker, info.Decimals, ok
+}
+
+func Test_EthereumWallet_ParseTx_TokenMetadata(t *testing.T) {
+	link := common.HexToAddress("0x514910771AF9Ca656af840dff83E8264EcF986CA")
+	unknown := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	weird := common.HexToAddress("0x2222222222222222222222222222222222222222")
+	recipient := common.HexToAddress("0x48c04ed5691981C42154C6167398f95e8f38a7fF")
+	// transfer(0x48c0…a7ff, 25 * 10^17)
+	transferData := hexutil.MustDecode("0xa9059cbb00000000000000000000000048c04ed5691981c42154c6167398f95e8f38a7ff00000000000000000000000000000000000000000000000022b1c8c1227a0000")
+
+	resolver := fakeTokenResolver{
+		link:  {Ticker: "LINK", Decimals: 18},
+		weird: {Ticker: "A/B", Decimals: 18},
+	}
+
+	tests := []struct {
+		name        string
+		resolver    TokenMetadataResolver
+		contract    common.Address
+		wantCoin    string
+		wantDisplay string
+	}{
+		{
+			name:        "known token",
+			resolver:    resolver,
+			contract:    link,
+			wantCoin:    "ETH/0x514910771af9ca656af840dff83e8264ecf986ca",
+			wantDisplay: "2.5 LINK",
+		},
+		{
+			name:        "unknown token",
+			resolver:    resolver,
+			contract:    unknown,
+			wantCoin:    "ETH/0x1111111111111111111111111111111111111111",
+			wantDisplay: "2500000000000000000 ETH/0x1111111111111111111111111111111111111111",
+		},
+		{
+			name:        "symbol with separator",
+			resolver:    resolver,
+			contract:    weird,
+			wantCoin:    "ETH/0x2222222222222222222222222222222222222222",
+			wantDisplay: "2.5 A/B",
+		},
+		{
+			name:        "no resolver",
+			resolver:    nil,
+			contract:    link,
+			wantCoin:    "ETH/0x514910771af9ca656af840dff83e8264ecf986ca",
+			wantDisplay: "2500000000000000000 ETH/0x514910771af9ca656af840dff83e8264ecf986ca",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wallet := ethereumWallet(t)
+			wallet.SetTokenMetadataResolver(tt.resolver)
+
+			transfer, err := wallet.ParseTx(unsignedDynamicFeeTx(t, &tt.contract, big.NewInt(0), transferData), &MetadataEthereum{ChainId: 1})
+			require.NoError(t, err)
+			require.Equal(t, recipient.Bytes(), transfer.To)
+			require.Equal(t, tt.wantCoin, string(transfer.CoinIdentifier))
+			require.Equal(t, tt.wantDisplay, transfer.DisplayAmount())
+
+			coin, err := ParseCoinIdentifier(transfer.CoinIdentifier)
+			require.NoError(t, err)
+			require.Equal(t, tt.contract.Bytes(), coin.Contract)
+		})
+	}
+}
+
+// fakeFeeTokenResolver is a fakeTokenResolver that also flags
+// fee-on-transfer tokens.
+type fakeFeeTokenResolver struct {
+	fakeTokenResolver
+	feeOnTransfer map[common.Address]bool
+}
+
+func (r fakeFeeTokenResolver) IsFeeOnTransfer(contract common.Address) bool {
+	return r.feeOnTransfer[contract]
+}
+
+func Test_EthereumWallet_ParseTx_FeeOnTransfer(t *testing.T) {
+	feeToken := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	plainToken := common.HexToAddress("0x514910771AF9Ca656af840dff83E8264EcF986CA")
+	recipient := common.HexToAddress("0x48c04ed5691981C42154C6167398f95e8f38a7fF")
+	approveData := append(append([]byte{}, approveMethodID...), common.LeftPadBytes(recipient.Bytes(), 32)...)
+	approveData = append(approveData, common.LeftPadBytes(big.NewInt(100).Bytes(), 32)...)
+
+	resolver := fakeFeeTokenResolver{
+		fakeTokenResolver: fakeTokenResolver{feeToken: {Ticker: "FEE", Decimals: 18}},
+		feeOnTransfer:     map[common.Address]bool{feeToken: true},
+	}
+
+	tests := []struct {
+		name        string
+		resolver    TokenMetadataResolver
+		to          common.Address
+		value       int64
+		data        []byte
+		wantNominal bool
+	}{
+		{name: "flagged token", resolver: resolver, to: feeToken, data: erc20TransferData(recipient, 1_000), wantNominal: true},
+		{name: "token not flagged", resolver: resolver, to: plainToken, data: erc20TransferData(recipient, 1_000)},
+		{name: "approval of a flagged token", resolver: resolver, to: feeToken, data: approveData},
+		{name: "native transfer", resolver: resolver, to: recipient, value: 1_000},
+		{name: "resolver without flags", resolver: resolver.fakeTokenResolver, to: feeToken, data: erc20TransferData(recipient, 1_000)},
+		{name: "no resolver", to: feeToken, data: erc20TransferData(recipient, 1_000)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wallet := ethereumWallet(t)
+			wallet.SetTokenMetadataResolver(tt.resolver)
+
+			transfer, err := wallet.ParseTx(unsignedDynamicFeeTx(t, &tt.to, big.NewInt(tt.value), tt.data), &MetadataEthereum{ChainId: 1})
+			require.NoError(t, err)
+			require.Equal(t, tt.wantNominal, transfer.AmountIsNominal)
+		})
+	}
+}
+
+func Test_EthereumWallet_ParseTx_TokenContract(t *testing.T) {
+	link := common.HexToAddress("0x514910771AF9Ca656af840dff83E8264EcF986CA")
+	unknown := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	recipient := common.HexToAddress("0x48c04ed5691981C42154C6167398f95e8f38a7fF")
+	resolver := fakeTokenResolver{link: {Ticker: "LINK", Decimals: 18}}
+	wallet := ethereumWallet(t)
+	self := common.HexToAddress(wallet.Address())
+
+	tests := []struct {
+		name     string
+		strict   bool
+		resolver TokenMetadataResolver
+		to       common.Address
+		value    int64
+		data     []byte
+		wantErr  error
+	}{
+		{name: "zero contract", to: common.Address{}, data: erc20TransferData(recipient, 1_000), wantErr: ErrInvalidTokenContract},
+		{name: "wallet contract", to: self, data: erc20TransferData(recipient, 1_000), wantErr: ErrInvalidTokenContract},
+		{name: "unknown token", to: unknown, data: erc20TransferData(recipient, 1_000)},
+		{name: "strict, known token", strict: true, resolver: resolver, to: link, data: erc20TransferData(recipient, 1_000)},
+		{name: "strict, unknown token", strict: true, resolver: resolver, to: unknown, data: erc20TransferData(recipient, 1_000), wantErr: ErrUnknownToken},
+		{name: "strict, no resolver", strict: true, to: link, data: erc20TransferData(recipient, 1_000), wantErr: ErrUnknownToken},
+		{name: "strict, native transfer", strict: true, resolver: resolver, to: recipient, value: 1_000},
+		{name: "strict, contract call", strict: true, resolver: resolver, to: unknown, data: []byte{1, 2, 3, 4}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wallet.SetTokenMetadataResolver(tt.resolver)
+			wallet.SetStrictTokens(tt.strict)
+
+			_, err := wallet.ParseTx(unsignedDynamicFeeTx(t, &tt.to, big.NewInt(tt.value), tt.data), &MetadataEthereum{ChainId: 1})
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,197 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+const (
+	// TxKindPermit is an EIP-2612 permit, an off-chain signed approval
+	// allowing a spender to move tokens of the signer.
+	TxKindPermit TxKind = "permit"
+
+	// TxKindOpaqueTypedData is EIP-712 typed data that doesn't match any
+	// known schema. The data for signing is still valid, but the meaning of
+	// the message is unknown.
+	TxKindOpaqueTypedData TxKind = "opaque_typed_data"
+)
+
+// PermitDetails contains the fields of an EIP-2612 permit message.
+type PermitDetails struct {
+	Owner    common.Address
+	Spender  common.Address
+	Value    *big.Int
+	Nonce    *big.Int
+	Deadline *big.Int
+}
+
+// ParseTypedData parses EIP-712 typed data to be signed by the wallet.
+func (*EthereumWallet) ParseTypedData(b []byte) (Transfer, error) {
+	return ParseEIP712TypedData(b)
+}
+
+// ParseEIP712TypedData parses the JSON encoding of EIP-712 typed data (as
+// passed to eth_signTypedData_v4) into a Transfer.
+//
+// DataForSigning is always set to the EIP-712 signing hash. When the message
+// matches a known schema (e.g. an EIP-2612 permit) the other fields of the
+// Transfer are filled accordingly, otherwise they're left empty and Kind is
+// set to TxKindOpaqueTypedData.
+func ParseEIP712TypedData(b []byte) (Transfer, error) {
+	typedData, err := unmarshalTypedData(b)
+	if err != nil {
+		return Transfer{}, fmt.Errorf("invalid typed data: %w", err)
+	}
+
+	hash, _, err := apitypes.TypedDataAndHash(typedData)
+	if err != nil {
+		return Transfer{}, fmt.Errorf("invalid typed data: %w", err)
+	}
+
+	transfer := Transfer{
+		DataForSigning: hash,
+		Kind:           TxKindOpaqueTypedData,
+	}
+
+	if isPermitTypedData(typedData) {
+		permit, err := unpackPermitTypedData(typedData.Message)
+		if err != nil {
+			return Transfer{}, fmt.Errorf("invalid permit: %w", err)
+		}
+		contract := common.HexToAddress(typedData.Domain.VerifyingContract)
+
+		transfer.To = permit.Spender.Bytes()
+		transfer.Amount = permit.Value
+		transfer.CoinIdentifier = TokenCoin(ethereumSymbol, contract.Bytes()).Bytes()
+		transfer.Kind = TxKindPermit
+		transfer.Details = permit
+	}
+
+	return transfer, nil
+}
+
+// unmarshalTypedData decodes typed data JSON. go-ethereum expects the domain
+// chainId to be a string, while most wallets (including MetaMask) send it as
+// a number, so both are accepted.
+func unmarshalTypedData(b []byte) (apitypes.TypedData, error) {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return apitypes.TypedData{}, err
+	}
+
+	if domainJSON, ok := raw["domain"]; ok {
+		var domain map[string]json.RawMessage
+		if err := json.Unmarshal(domainJSON, &domain); err != nil {
+			return apitypes.TypedData{}, err
+		}
+		var chainID json.Number
+		if err := json.Unmarshal(domain["chainId"], &chainID); err == nil {
+			domain["chainId"], _ = json.Marshal(chainID.String())
+			domainJSON, err = json.Marshal(domain)
+			if err != nil {
+				return apitypes.TypedData{}, err
+			}
+			raw["domain"] = domainJSON
+		}
+	}
+
+	normalized, err := json.Marshal(raw)
+	if err != nil {
+		return apitypes.TypedData{}, err
+	}
+
+	var typedData apitypes.TypedData
+	err = json.Unmarshal(normalized, &typedData)
+	return typedData, err
+}
+
+// permitTypes is the EIP-2612 Permit struct definition.
+var permitTypes = []apitypes.Type{
+	{Name: "owner", Type: "address"},
+	{Name: "spender", Type: "address"},
+	{Name: "value", Type: "uint256"},
+	{Name: "nonce", Type: "uint256"},
+	{Name: "deadline", Type: "uint256"},
+}
+
+func isPermitTypedData(typedData apitypes.TypedData) bool {
+	if typedData.PrimaryType != "Permit" || !common.IsHexAddress(typedData.Domain.VerifyingContract) {
+		return false
+	}
+	fields := typedData.Types["Permit"]
+	if len(fields) != len(permitTypes) {
+		return false
+	}
+	for i, f := range fields {
+		if f != permitTypes[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func unpackPermitTypedData(msg apitypes.TypedDataMessage) (*PermitDetails, error) {
+	owner, err := typedDataAddress(msg, "owner")
+	if err != nil {
+		return nil, err
+	}
+	spender, err := typedDataAddress(msg, "spender")
+	if err != nil {
+		return nil, err
+	}
+	value, err := typedDataUint(msg, "value")
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := typedDataUint(msg, "nonce")
+	if err != nil {
+		return nil, err
+	}
+	deadline, err := typedDataUint(msg, "deadline")
+	if err != nil {
+		return nil, err
+	}
+	return &PermitDetails{
+		Owner:    owner,
+		Spender:  spender,
+		Value:    value,
+		Nonce:    nonce,
+		Deadline: deadline,
+	}, nil
+}
+
+func typedDataAddress(msg apitypes.TypedDataMessage, field string) (common.Address, error) {
+	s, ok := msg[field].(string)
+	if !ok || !common.IsHexAddress(s) {
+		return common.Address{}, fmt.Errorf("field %s is not a valid address", field)
+	}
+	return common.HexToAddress(s), nil
+}
+
+// typedDataUint parses an unsigned integer field, that can either be a
+// decimal or 0x-prefixed hex string, or a JSON number small enough not to
+// lose precision.
+func typedDataUint(msg apitypes.TypedDataMessage, field string) (*big.Int, error) {
+	switch v := msg[field].(type) {
+	case string:
+		n, ok := new(big.Int).SetString(v, 0)
+		if !ok || n.Sign() < 0 {
+			return nil, fmt.Errorf("field %s is not a valid unsigned integer", field)
+		}
+		return n, nil
+	case float64:
+		if v < 0 || v != math.Trunc(v) || v > 1<<53 {
+			return nil, fmt.Errorf("field %s is not a valid unsigned integer", field)
+		}
+		return new(big.Int).SetUint64(uint64(v)), nil
+	default:
+		return nil, fmt.Errorf("field %s is not a valid unsigned integer", field)
+	}
+}
@@ -0,0 +1,165 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+const permitTypedData = `{
+  "types": {
+    "EIP712Domain": [
+      {"name": "name", "type": "string"},
+      {"name": "version", "type": "string"},
+      {"name": "chainId", "type": "uint256"},
+      {"name": "verifyingContract", "type": "address"}
+    ],
+    "Permit": [
+      {"name": "owner", "type": "address"},
+      {"name": "spender", "type": "address"},
+      {"name": "value", "type": "uint256"},
+      {"name": "nonce", "type": "uint256"},
+      {"name": "deadline", "type": "uint256"}
+    ]
+  },
+  "primaryType": "Permit",
+  "domain": {
+    "name": "USD Coin",
+    "version": "2",
+    "chainId": 1,
+    "verifyingContract": "0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48"
+  },
+  "message": {
+    "owner": "0xdD1d3fF09C5EdfF1bE7d466cA614cB1cF3f78738",
+    "spender": "0x000000000022D473030F116dDEE9F6B43aC78BA3",
+    "value": "25000000",
+    "nonce": 0,
+    "deadline": "1893456000"
+  }
+}`
+
+// mailTypedData is the example from the EIP-712 specification, also used as
+// reference by MetaMask's eth-sig-util.
+const mailTypedData = `{
+  "types": {
+    "EIP712Domain": [
+      {"name": "name", "type": "string"},
+      {"name": "version", "type": "string"},
+      {"name": "chainId", "type": "uint256"},
+      {"name": "verifyingContract", "type": "address"}
+    ],
+    "Person": [
+      {"name": "name", "type": "string"},
+      {"name": "wallet", "type": "address"}
+    ],
+    "Mail": [
+      {"name": "from", "type": "Person"},
+      {"name": "to", "type": "Person"},
+      {"name": "contents", "type": "string"}
+    ]
+  },
+  "primaryType": "Mail",
+  "domain": {
+    "name": "Ether Mail",
+    "version": "1",
+    "chainId": 1,
+    "verifyingContract": "0xCcCCccccCCCCcCCCCCCcCcCccCcCCCcCcccccccC"
+  },
+  "message": {
+    "from": {"name": "Cow", "wallet": "0xCD2a3d9F938E13CD947Ec05AbC7FE734Df8DD826"},
+    "to": {"name": "Bob", "wallet": "0xbBbBBBBbbBBBbbbBbbBbbbbBBbBbbbbBbBbbBBbB"},
+    "contents": "Hello, Bob!"
+  }
+}`
+
+func Test_ParseEIP712TypedData_Permit(t *testing.T) {
+	transfer, err := ParseEIP712TypedData([]byte(permitTypedData))
+	require.NoError(t, err)
+
+	require.Equal(t, TxKindPermit, transfer.Kind)
+	require.Equal(t, expectedPermitHash(t), transfer.DataForSigning)
+	require.Equal(t, "0x000000000022D473030F116dDEE9F6B43aC78BA3", common.BytesToAddress(transfer.To).Hex())
+	require.Equal(t, big.NewInt(25000000), transfer.Amount)
+
+	coin, err := ParseCoinIdentifier(transfer.CoinIdentifier)
+	require.NoError(t, err)
+	require.Equal(t, "0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48", common.BytesToAddress(coin.Contract).Hex())
+
+	permit, ok := transfer.Details.(*PermitDetails)
+	require.True(t, ok)
+	require.Equal(t, "0xdD1d3fF09C5EdfF1bE7d466cA614cB1cF3f78738", permit.Owner.Hex())
+	require.Equal(t, big.NewInt(0), permit.Nonce)
+	require.Equal(t, big.NewInt(1893456000), permit.Deadline)
+}
+
+func Test_ParseEIP712TypedData_Opaque(t *testing.T) {
+	wallet := ethereumWallet(t)
+	transfer, err := wallet.ParseTypedData([]byte(mailTypedData))
+	require.NoError(t, err)
+
+	require.Equal(t, TxKindOpaqueTypedData, transfer.Kind)
+	require.Equal(t, hexutil.MustDecode("0xbe609aee343fb3c4b28e1df9e632fca64fcfaede20f02e86244efddf30957bd2"), transfer.DataForSigning)
+	require.Empty(t, transfer.To)
+	require.Nil(t, transfer.Amount)
+	require.Empty(t, transfer.CoinIdentifier)
+}
+
+func Test_ParseEIP712TypedData_Invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		b    string
+	}{
+		{name: "not json", b: "permit"},
+		{name: "missing primary type", b: `{"types": {"EIP712Domain": []}, "domain": {}, "message": {}}`},
+		{
+			name: "permit with negative value",
+			b: `{"types": {"EIP712Domain": [{"name": "verifyingContract", "type": "address"}], "Permit": [{"name": "owner", "type": "address"}, {"name": "spender", "type": "address"}, {"name": "value", "type": "uint256"}, {"name": "nonce", "type": "uint256"}, {"name": "deadline", "type": "uint256"}]},
+				"primaryType": "Permit", "domain": {"verifyingContract": "0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48"},
+				"message": {"owner": "0xdD1d3fF09C5EdfF1bE7d466cA614cB1cF3f78738", "spender": "0x000000000022D473030F116dDEE9F6B43aC78BA3", "value": "-1", "nonce": 0, "deadline": 0}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseEIP712TypedData([]byte(tt.b))
+			require.Error(t, err)
+		})
+	}
+}
+
+// expectedPermitHash computes the EIP-712 hash of permitTypedData by hand,
+// following the specification.
+func expectedPermitHash(t *testing.T) []byte {
+	t.Helper()
+	word := func(b []byte) []byte { return common.LeftPadBytes(b, 32) }
+	concat := func(parts ...[]byte) []byte {
+		var res []byte
+		for _, p := range parts {
+			res = append(res, p...)
+		}
+		return res
+	}
+
+	domainSeparator := crypto.Keccak256(concat(
+		crypto.Keccak256([]byte("EIP712Domain(string name,string version,uint256 chainId,address verifyingContract)")),
+		crypto.Keccak256([]byte("USD Coin")),
+		crypto.Keccak256([]byte("2")),
+		word(big.NewInt(1).Bytes()),
+		word(common.HexToAddress("0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48").Bytes()),
+	))
+	structHash := crypto.Keccak256(concat(
+		crypto.Keccak256([]byte("Permit(address owner,address spender,uint256 value,uint256 nonce,uint256 deadline)")),
+		word(common.HexToAddress("0xdD1d3fF09C5EdfF1bE7d466cA614cB1cF3f78738").Bytes()),
+		word(common.HexToAddress("0x000000000022D473030F116dDEE9F6B43aC78BA3").Bytes()),
+		word(big.NewInt(25000000).Bytes()),
+		word(big.NewInt(0).Bytes()),
+		word(big.NewInt(1893456000).Bytes()),
+	))
+	return crypto.Keccak256(concat([]byte{0x19, 0x01}, domainSeparator, structHash))
+}
@@ -0,0 +1,83 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package types
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// WrapCall is a deposit (TxKindWrap) or withdraw (TxKindUnwrap) call of a
+// wrapped native token contract with the WETH9 interface.
+type WrapCall struct {
+	// Token is the address of the wrapped native token contract.
+	Token common.Address
+}
+
+var (
+	wrapDepositMethodID  = crypto.Keccak256Hash([]byte("deposit()")).Bytes()[0:4]
+	wrapWithdrawMethodID = crypto.Keccak256Hash([]byte("withdraw(uint256)")).Bytes()[0:4]
+)
+
+// wrappedNativeTokens are the addresses of the contracts registered with
+// RegisterWrappedNativeToken.
+var wrappedNativeTokens = map[common.Address]bool{}
+
+func init() {
+	for _, token := range []string{
+		"0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2", // WETH, Ethereum
+		"0x82aF49447D8a07e3bd95BD0d56f35241523fBab1", // WETH, Arbitrum One
+		"0x0d500B1d8E8eF31E21C99d1Db9A6444d3ADf1270", // WMATIC, Polygon
+		"0xB31f66AA3C1e785363F0875A1B74E27b85FD66c7", // WAVAX, Avalanche C-Chain
+		"0xbb4CdB9CBd36B01bD1cBaEBF2De08d9173bc095c", // WBNB, BNB Smart Chain
+	} {
+		RegisterWrappedNativeToken(common.HexToAddress(token))
+	}
+}
+
+// RegisterWrappedNativeToken marks the contract at address token as a
+// wrapped native token with the WETH9 interface, so that its deposit() and
+// withdraw(uint256) calls are parsed as TxKindWrap and TxKindUnwrap. The
+// wrapped tokens of the supported chains are registered by default.
+//
+// It must be called before parsing any transaction (e.g. in an init
+// function), and it's not safe for concurrent use.
+func RegisterWrappedNativeToken(token common.Address) {
+	wrappedNativeTokens[token] = true
+}
+
+// isWrappedNativeToken returns true if the contract at address to is a
+// registered wrapped native token.
+func isWrappedNativeToken(to common.Address) bool {
+	return wrappedNativeTokens[to]
+}
+
+// unpackWrapCall decodes a deposit or withdraw call to the wrapped native
+// token at address token.
+//
+// A deposit has no arguments, the native currency wrapped is the value of
+// the transaction: the call moves it to the token contract, see
+// parseEthereumTx. A withdraw moves the given amount of tokens from the
+// caller to the token contract, which burns them.
+func unpackWrapCall(token common.Address, method []byte, args []byte) (*ethereumCall, error) {
+	details := &WrapCall{Token: token}
+	if bytes.Equal(method, wrapDepositMethodID) {
+		if len(args) != 0 {
+			return nil, fmt.Errorf("invalid deposit: expected no arguments, got %d bytes", len(args))
+		}
+		return &ethereumCall{Kind: TxKindWrap, Details: details}, nil
+	}
+
+	// 32 bytes - amount
+	if len(args) != 32 {
+		return nil, fmt.Errorf("invalid withdraw: expected 32 bytes of arguments, got %d", len(args))
+	}
+	amount, err := abiUint(args, 0)
+	if err != nil {
+		return nil, fmt.Errorf("invalid withdraw: %w", err)
+	}
+	return &ethereumCall{Kind: TxKindUnwrap, To: &token, Amount: amount, Contract: &token, Details: details}, nil
+}
@@ -0,0 +1,68 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ParseEthereumTransaction_WETH(t *testing.T) {
+	weth := common.HexToAddress("0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2")
+	oneEther := big.NewInt(1_000_000_000_000_000_000)
+
+	// deposit()
+	deposit := hexutil.MustDecode("0xd0e30db0")
+	// withdraw(1 ether)
+	withdraw := hexutil.MustDecode("0x2e1a7d4d0000000000000000000000000000000000000000000000000de0b6b3a7640000")
+
+	t.Run("deposit", func(t *testing.T) {
+		tx, err := ParseEthereumTransaction(unsignedDynamicFeeTx(t, &weth, oneEther, deposit), big.NewInt(1))
+		require.NoError(t, err)
+		require.Equal(t, TxKindWrap, tx.Kind)
+		require.Equal(t, weth, *tx.To)
+		require.Nil(t, tx.Contract)
+		require.Equal(t, 0, oneEther.Cmp(tx.Amount))
+		require.Equal(t, &WrapCall{Token: weth}, tx.Details)
+		require.Equal(t, NativeCoin("ETH").Bytes(), tx.Transfer().CoinIdentifier)
+	})
+
+	t.Run("withdraw", func(t *testing.T) {
+		tx, err := ParseEthereumTransaction(unsignedDynamicFeeTx(t, &weth, big.NewInt(0), withdraw), big.NewInt(1))
+		require.NoError(t, err)
+		require.Equal(t, TxKindUnwrap, tx.Kind)
+		require.Equal(t, weth, *tx.To)
+		require.Equal(t, weth, *tx.Contract)
+		require.Equal(t, 0, oneEther.Cmp(tx.Amount))
+		require.Equal(t, &WrapCall{Token: weth}, tx.Details)
+	})
+
+	t.Run("withdraw with value", func(t *testing.T) {
+		_, err := ParseEthereumTransaction(unsignedDynamicFeeTx(t, &weth, oneEther, withdraw), big.NewInt(1))
+		require.ErrorIs(t, err, ErrAmbiguousTx)
+	})
+
+	t.Run("malformed", func(t *testing.T) {
+		_, err := ParseEthereumTransaction(unsignedDynamicFeeTx(t, &weth, oneEther, append(append([]byte{}, deposit...), 0)), big.NewInt(1))
+		require.ErrorIs(t, err, ErrMalformedTx)
+		_, err = ParseEthereumTransaction(unsignedDynamicFeeTx(t, &weth, big.NewInt(0), withdraw[:20]), big.NewInt(1))
+		require.ErrorIs(t, err, ErrMalformedTx)
+	})
+
+	t.Run("unregistered contract", func(t *testing.T) {
+		other := common.HexToAddress("0x7a250d5630B4cF539739dF2C5dAcb4c659F2488D")
+		tx, err := ParseEthereumTransaction(unsignedDynamicFeeTx(t, &other, oneEther, deposit), big.NewInt(1))
+		require.NoError(t, err)
+		require.Equal(t, TxKindContractCall, tx.Kind)
+
+		RegisterWrappedNativeToken(other)
+		t.Cleanup(func() { delete(wrappedNativeTokens, other) })
+		tx, err = ParseEthereumTransaction(unsignedDynamicFeeTx(t, &other, oneEther, deposit), big.NewInt(1))
+		require.NoError(t, err)
+		require.Equal(t, TxKindWrap, tx.Kind)
+	})
+}
@@ -4,6 +4,7 @@ package types
 
 import (
 	"crypto/ecdsa"
+	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -30,3 +31,11 @@ func (w *FusionWallet) Address() string {
 	bech32Address := sdk.AccAddress(pubkey.Address().Bytes()).String()
 	return bech32Address
 }
+
+// ValidateAddress implements Wallet.
+func (*FusionWallet) ValidateAddress(addr string) error {
+	if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+		return fmt.Errorf("invalid Fusion address %s: %w", addr, err)
+	}
+	return nil
+}
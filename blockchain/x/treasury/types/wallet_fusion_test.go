@@ -16,6 +16,13 @@ func Test_FusionWallet_Address(t *testing.T) {
 	require.Equal(t, "qredo1egz60et40xxzm5rhtlj7caskpvqmqujrdrfqt7", wallet.Address())
 }
 
+func Test_FusionWallet_ValidateAddress(t *testing.T) {
+	wallet := fusionWallet(t)
+	require.NoError(t, wallet.ValidateAddress(wallet.Address()))
+	require.Error(t, wallet.ValidateAddress("qredo1egz60et40xxzm5rhtlj7caskpvqmqujrdrfqt8"))
+	require.Error(t, wallet.ValidateAddress("celestia1egz60et40xxzm5rhtlj7caskpvqmqujr7ly0ne"))
+}
+
 func fusionWallet(t *testing.T) *FusionWallet {
 	t.Helper()
 	hashedSeed := sha256.Sum256([]byte("example seed"))
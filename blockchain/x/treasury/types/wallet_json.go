@@ -0,0 +1,112 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// transferJSON is the JSON encoding of a Transfer.
+type transferJSON struct {
+	To             hexutil.Bytes    `json:"to,omitempty"`
+	Amount         string           `json:"amount,omitempty"`
+	CoinIdentifier string           `json:"coin_identifier,omitempty"`
+	DataForSigning hexutil.Bytes    `json:"data_for_signing,omitempty"`
+	Kind           TxKind           `json:"kind,omitempty"`
+	Details        json.RawMessage  `json:"details,omitempty"`
+	Timeout        *TransferTimeout `json:"timeout,omitempty"`
+	MaxFee         string           `json:"max_fee,omitempty"`
+}
+
+// MarshalJSON encodes the amount and the max fee as decimal strings, as JSON
+// numbers can't represent big amounts precisely in most clients, and byte
+// fields as 0x-prefixed hex strings. The coin identifier is encoded in its serialized
+// form (e.g. "ETH/0xa0b8…").
+func (t Transfer) MarshalJSON() ([]byte, error) {
+	details, err := marshalDetailsJSON(t.Details)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(transferJSON{
+		To:             t.To,
+		Amount:         amountToJSON(t.Amount),
+		CoinIdentifier: string(t.CoinIdentifier),
+		DataForSigning: t.DataForSigning,
+		Kind:           t.Kind,
+		Details:        details,
+		Timeout:        t.Timeout,
+		MaxFee:         amountToJSON(t.MaxFee),
+	})
+}
+
+// UnmarshalJSON decodes a Transfer encoded by MarshalJSON. The concrete type
+// of Details can't be recovered, it's set to the json.RawMessage of the
+// encoded details.
+func (t *Transfer) UnmarshalJSON(b []byte) error {
+	var dec transferJSON
+	if err := json.Unmarshal(b, &dec); err != nil {
+		return err
+	}
+	amount, err := amountFromJSON(dec.Amount)
+	if err != nil {
+		return err
+	}
+	maxFee, err := amountFromJSON(dec.MaxFee)
+	if err != nil {
+		return err
+	}
+
+	*t = Transfer{
+		To:             dec.To,
+		Amount:         amount,
+		DataForSigning: dec.DataForSigning,
+		Kind:           dec.Kind,
+		Timeout:        dec.Timeout,
+		MaxFee:         maxFee,
+	}
+	if len(dec.CoinIdentifier) > 0 {
+		t.CoinIdentifier = []byte(dec.CoinIdentifier)
+	}
+	if len(dec.Details) > 0 {
+		t.Details = dec.Details
+	}
+	return nil
+}
+
+// amountToJSON returns the decimal representation of the amount, or an
+// empty string if it's nil.
+func amountToJSON(amount *big.Int) string {
+	if amount == nil {
+		return ""
+	}
+	return amount.String()
+}
+
+// amountFromJSON parses an amount encoded by amountToJSON.
+func amountFromJSON(s string) (*big.Int, error) {
+	if len(s) == 0 {
+		return nil, nil
+	}
+	amount, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		return nil, fmt.Errorf("invalid amount %q: not a decimal integer", s)
+	}
+	return amount, nil
+}
+
+// marshalDetailsJSON encodes the details of a transfer, nil details are
+// omitted.
+func marshalDetailsJSON(details any) (json.RawMessage, error) {
+	if details == nil {
+		return nil, nil
+	}
+	b, err := json.Marshal(details)
+	if err != nil {
+		return nil, fmt.Errorf("encoding details: %w", err)
+	}
+	return b, nil
+}
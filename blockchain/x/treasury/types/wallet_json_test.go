@@ -0,0 +1,89 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package types
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Transfer_JSON(t *testing.T) {
+	// 10^30, more than fits in a uint64 or a float64 mantissa
+	amount, ok := new(big.Int).SetString("1000000000000000000000000000001", 10)
+	require.True(t, ok)
+	usdc := common.HexToAddress("0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48")
+	recipient := common.HexToAddress("0x48c04ed5691981C42154C6167398f95e8f38a7fF")
+
+	transfer := Transfer{
+		To:             recipient.Bytes(),
+		Amount:         amount,
+		CoinIdentifier: TokenCoin("ETH", usdc.Bytes()).Bytes(),
+		DataForSigning: hexutil.MustDecode("0xdeadbeef"),
+		Kind:           TxKindTransfer,
+	}
+
+	b, err := json.Marshal(transfer)
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"to": "0x48c04ed5691981c42154c6167398f95e8f38a7ff",
+		"amount": "1000000000000000000000000000001",
+		"coin_identifier": "ETH/0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48",
+		"data_for_signing": "0xdeadbeef",
+		"kind": "transfer"
+	}`, string(b))
+
+	var decoded Transfer
+	require.NoError(t, json.Unmarshal(b, &decoded))
+	require.Equal(t, transfer, decoded)
+
+	t.Run("details", func(t *testing.T) {
+		withDetails := transfer
+		withDetails.Kind = TxKindApproval
+		withDetails.Details = &ApprovalCall{Spender: recipient, Amount: big.NewInt(1)}
+
+		b, err := json.Marshal(withDetails)
+		require.NoError(t, err)
+
+		var decoded Transfer
+		require.NoError(t, json.Unmarshal(b, &decoded))
+		require.JSONEq(t, `{"Spender": "0x48c04ed5691981c42154c6167398f95e8f38a7ff", "Amount": 1}`, string(decoded.Details.(json.RawMessage)))
+
+		// re-encoding the raw details gives the same JSON
+		again, err := json.Marshal(decoded)
+		require.NoError(t, err)
+		require.JSONEq(t, string(b), string(again))
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		withTimeout := transfer
+		withTimeout.Timeout = &TransferTimeout{RevisionNumber: 1, RevisionHeight: 100, Timestamp: 1_700_000_000_000_000_001}
+
+		b, err := json.Marshal(withTimeout)
+		require.NoError(t, err)
+		require.Contains(t, string(b), `"timeout":{"revision_number":1,"revision_height":100,"timestamp":"1700000000000000001"}`)
+
+		var decoded Transfer
+		require.NoError(t, json.Unmarshal(b, &decoded))
+		require.Equal(t, withTimeout, decoded)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		b, err := json.Marshal(Transfer{})
+		require.NoError(t, err)
+		require.JSONEq(t, `{}`, string(b))
+
+		var decoded Transfer
+		require.NoError(t, json.Unmarshal(b, &decoded))
+		require.Equal(t, Transfer{}, decoded)
+	})
+
+	t.Run("invalid amount", func(t *testing.T) {
+		var decoded Transfer
+		require.Error(t, json.Unmarshal([]byte(`{"amount": "1e18"}`), &decoded))
+	})
+}
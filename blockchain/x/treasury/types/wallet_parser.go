@@ -0,0 +1,101 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package types
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ChainFamily identifies a family of chains sharing the same transaction
+// format, e.g. the EVM compatible chains.
+type ChainFamily string
+
+// ChainFamilyEVM is the family of the EVM compatible chains, parsed by
+// EthereumWallet.
+const ChainFamilyEVM ChainFamily = "evm"
+
+// ErrUnknownChainFamily is returned by NewWalletParser for chain families
+// without a registered parser.
+var ErrUnknownChainFamily = errors.New("unknown chain family")
+
+// TxParserFactory returns the TxParser of the wallet w of key k. chain is
+// the supported chain the parser is bound to, or nil if the parser can be
+// used on any chain of the family.
+type TxParserFactory func(w Wallet, k *Key, chain *SupportedChain) (TxParser, error)
+
+// ParserRegistry maps chain families to the factories of their TxParser.
+// Adding support for a chain family is a matter of registering its factory.
+//
+// A ParserRegistry is not safe for concurrent use: the factories must be
+// registered before any parser is created (e.g. in an init function).
+type ParserRegistry struct {
+	factories map[ChainFamily]TxParserFactory
+}
+
+// NewParserRegistry returns an empty ParserRegistry.
+func NewParserRegistry() *ParserRegistry {
+	return &ParserRegistry{factories: make(map[ChainFamily]TxParserFactory)}
+}
+
+// Register adds the factory of the parsers of family. Each family can be
+// registered once.
+func (r *ParserRegistry) Register(family ChainFamily, factory TxParserFactory) error {
+	if family == "" {
+		return fmt.Errorf("empty chain family")
+	}
+	if factory == nil {
+		return fmt.Errorf("nil parser factory for chain family %s", family)
+	}
+	if _, ok := r.factories[family]; ok {
+		return fmt.Errorf("chain family %s already registered", family)
+	}
+	r.factories[family] = factory
+	return nil
+}
+
+// NewParser returns the TxParser of family for the wallet w of key k, see
+// TxParserFactory. Unregistered families fail with ErrUnknownChainFamily.
+func (r *ParserRegistry) NewParser(family ChainFamily, w Wallet, k *Key, chain *SupportedChain) (TxParser, error) {
+	factory, ok := r.factories[family]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownChainFamily, family)
+	}
+	return factory(w, k, chain)
+}
+
+// parsers is the registry used by RegisterTxParser and NewWalletParser.
+var parsers = NewParserRegistry()
+
+func init() {
+	if err := RegisterTxParser(ChainFamilyEVM, newEthereumParser); err != nil {
+		panic(err)
+	}
+}
+
+// RegisterTxParser registers the factory of the parsers of family in the
+// default registry, see ParserRegistry.Register.
+//
+// It must be called before parsing any transaction (e.g. in an init
+// function), and it's not safe for concurrent use.
+func RegisterTxParser(family ChainFamily, factory TxParserFactory) error {
+	return parsers.Register(family, factory)
+}
+
+// NewWalletParser returns the TxParser of family for the wallet w of key k,
+// using the factories registered with RegisterTxParser.
+func NewWalletParser(family ChainFamily, w Wallet, k *Key, chain *SupportedChain) (TxParser, error) {
+	return parsers.NewParser(family, w, k, chain)
+}
+
+// newEthereumParser is the TxParserFactory of ChainFamilyEVM. The parser is
+// the EthereumWallet of k, bound to chain if not nil.
+func newEthereumParser(w Wallet, k *Key, chain *SupportedChain) (TxParser, error) {
+	if chain != nil {
+		return NewEthereumWalletForChain(k, chain.EVMChain())
+	}
+	if ethWallet, ok := w.(*EthereumWallet); ok {
+		return ethWallet, nil
+	}
+	return NewEthereumWallet(k)
+}
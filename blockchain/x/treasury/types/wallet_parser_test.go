@@ -0,0 +1,85 @@
+// Copyright (c) Fusion Laboratories LTD
+// SPDX-License-Identifier: BUSL-1.1
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeParser parses every transaction into a transfer of its length.
+type fakeParser struct {
+	chain *SupportedChain
+}
+
+func (p *fakeParser) ParseTx(b []byte, _ Metadata) (Transfer, error) {
+	return Transfer{Amount: big.NewInt(int64(len(b))), CoinIdentifier: NativeCoin(p.chain.NativeSymbol).Bytes()}, nil
+}
+
+func Test_ParserRegistry(t *testing.T) {
+	const family ChainFamily = "fake"
+	r := NewParserRegistry()
+
+	_, err := r.NewParser(family, nil, nil, nil)
+	require.ErrorIs(t, err, ErrUnknownChainFamily)
+
+	factory := func(_ Wallet, _ *Key, chain *SupportedChain) (TxParser, error) {
+		return &fakeParser{chain: chain}, nil
+	}
+	require.NoError(t, r.Register(family, factory))
+	require.Error(t, r.Register(family, factory))
+	require.Error(t, r.Register("", factory))
+	require.Error(t, r.Register("other", nil))
+
+	parser, err := r.NewParser(family, nil, nil, &SupportedChain{NativeSymbol: "FAKE"})
+	require.NoError(t, err)
+	transfer, err := parser.ParseTx([]byte{1, 2, 3}, nil)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(3), transfer.Amount)
+	require.Equal(t, NativeCoin("FAKE").Bytes(), transfer.CoinIdentifier)
+
+	// the default registry is not affected
+	_, err = NewWalletParser(family, nil, nil, nil)
+	require.ErrorIs(t, err, ErrUnknownChainFamily)
+
+	require.NoError(t, RegisterTxParser(family, factory))
+	t.Cleanup(func() { delete(parsers.factories, family) })
+	_, err = NewWalletParser(family, nil, nil, &SupportedChain{NativeSymbol: "FAKE"})
+	require.NoError(t, err)
+}
+
+func Test_NewWalletParser_EVM(t *testing.T) {
+	k := &Key{
+		Type:      KeyType_KEY_TYPE_ECDSA_SECP256K1,
+		PublicKey: hexutil.MustDecode("0x025cd45a6614df5348692ea4d0f7c16255b75a6b6f67bea5013621fe84af8031f0"),
+	}
+	w, err := NewWallet(k, WalletType_WALLET_TYPE_ETH)
+	require.NoError(t, err)
+
+	to := common.HexToAddress("0xea223ca8968ca59e0bc79ba331c2f6f636a3fb82")
+	unsignedTx := unsignedDynamicFeeTx(t, &to, big.NewInt(1_000), nil)
+
+	// unbound parser
+	parser, err := NewWalletParser(ChainFamilyEVM, w, k, nil)
+	require.NoError(t, err)
+	transfer, err := parser.ParseTx(unsignedTx, &MetadataEthereum{ChainId: 1})
+	require.NoError(t, err)
+	require.Equal(t, to.Bytes(), transfer.To)
+	require.Equal(t, big.NewInt(1_000), transfer.Amount)
+	require.Equal(t, NativeCoin("ETH").Bytes(), transfer.CoinIdentifier)
+
+	// parser bound to a supported chain
+	polygon := EVMChainPolygon.SupportedChain()
+	parser, err = NewWalletParser(ChainFamilyEVM, w, k, &polygon)
+	require.NoError(t, err)
+	_, err = parser.ParseTx(unsignedTx, &MetadataEthereum{ChainId: 1})
+	require.ErrorIs(t, err, ErrChainIDMismatch)
+
+	// the parser builds the transactions it parses
+	_, ok := parser.(TxBuilder)
+	require.True(t, ok)
+}
@@ -6,6 +6,8 @@ package types
 import (
 	"crypto/ed25519"
 	"encoding/hex"
+	"fmt"
+	"strings"
 
 	"golang.org/x/crypto/blake2b"
 )
@@ -37,3 +39,15 @@ func (w *SuiWallet) Address() string {
 	suiAddress := "0x" + hex.EncodeToString(addrBytes[:])[:addressLength]
 	return suiAddress
 }
+
+// ValidateAddress implements Wallet.
+func (*SuiWallet) ValidateAddress(addr string) error {
+	hexAddr, ok := strings.CutPrefix(addr, "0x")
+	if !ok || len(hexAddr) != addressLength {
+		return fmt.Errorf("invalid Sui address: %s", addr)
+	}
+	if _, err := hex.DecodeString(hexAddr); err != nil {
+		return fmt.Errorf("invalid Sui address %s: %w", addr, err)
+	}
+	return nil
+}
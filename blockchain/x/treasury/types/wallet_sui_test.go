@@ -17,6 +17,14 @@ func Test_SuiWallet_Address(t *testing.T) {
 	require.Equal(t, "0xa698fe128e021304c14101e955838e37a86ca097fa856025d5f5de49c295a6e9", wallet.Address())
 }
 
+func Test_SuiWallet_ValidateAddress(t *testing.T) {
+	wallet := suiWallet(t)
+	require.NoError(t, wallet.ValidateAddress(wallet.Address()))
+	require.Error(t, wallet.ValidateAddress("0xa698fe128e021304c14101e955838e37a86ca097fa856025d5f5de49c295a6"))
+	require.Error(t, wallet.ValidateAddress("a698fe128e021304c14101e955838e37a86ca097fa856025d5f5de49c295a6e9"))
+	require.Error(t, wallet.ValidateAddress("0xz698fe128e021304c14101e955838e37a86ca097fa856025d5f5de49c295a6e9"))
+}
+
 func suiWallet(t *testing.T) *SuiWallet {
 	t.Helper()
 	hashedSeed := sha256.Sum256([]byte("example seed"))
@@ -3,6 +3,7 @@
 package types
 
 import (
+	"math/big"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -30,3 +31,112 @@ func Test_NewWalletI_Exhaustive(t *testing.T) {
 		})
 	}
 }
+
+func Test_Transfer_IsDustInHumanUnits(t *testing.T) {
+	tests := []struct {
+		name     string
+		amount   *big.Int
+		decimals uint8
+		want     bool
+	}{
+		{name: "sub-cent stablecoin amount", amount: big.NewInt(4_000), decimals: 6, want: true},
+		{name: "half a cent rounds up", amount: big.NewInt(5_000), decimals: 6, want: false},
+		{name: "normal stablecoin amount", amount: big.NewInt(25_000_000), decimals: 6, want: false},
+		{name: "one wei", amount: big.NewInt(1), decimals: 18, want: true},
+		{name: "zero amount", amount: big.NewInt(0), decimals: 6, want: false},
+		{name: "nil amount", amount: nil, decimals: 6, want: false},
+		{name: "no decimals", amount: big.NewInt(1), decimals: 0, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, Transfer{Amount: tt.amount}.IsDustInHumanUnits(tt.decimals))
+		})
+	}
+}
+
+func Test_Transfer_Equal(t *testing.T) {
+	transfer := func() Transfer {
+		return Transfer{
+			To:             []byte("qredo1recipient"),
+			Amount:         big.NewInt(1_000),
+			CoinIdentifier: NativeCoin("ETH").Bytes(),
+			DataForSigning: []byte{1, 2, 3},
+			Kind:           TxKindTransfer,
+			Timeout:        &TransferTimeout{RevisionNumber: 1, RevisionHeight: 100},
+			MaxFee:         big.NewInt(21_000),
+		}
+	}
+
+	tests := []struct {
+		name   string
+		modify func(*Transfer)
+		want   bool
+	}{
+		{name: "different instances", modify: func(*Transfer) {}, want: true},
+		{name: "amount from bytes", modify: func(tr *Transfer) { tr.Amount = new(big.Int).SetBytes([]byte{0, 0x03, 0xe8}) }, want: true},
+		{name: "different amount", modify: func(tr *Transfer) { tr.Amount = big.NewInt(1_001) }, want: false},
+		{name: "nil amount", modify: func(tr *Transfer) { tr.Amount = nil }, want: false},
+		{name: "different recipient", modify: func(tr *Transfer) { tr.To = []byte("qredo1other") }, want: false},
+		{name: "different coin", modify: func(tr *Transfer) { tr.CoinIdentifier = NativeCoin("MATIC").Bytes() }, want: false},
+		{name: "different kind", modify: func(tr *Transfer) { tr.Kind = TxKindContractCall }, want: false},
+		{name: "different timeout", modify: func(tr *Transfer) { tr.Timeout = &TransferTimeout{RevisionNumber: 1, RevisionHeight: 101} }, want: false},
+		{name: "missing timeout", modify: func(tr *Transfer) { tr.Timeout = nil }, want: false},
+		{name: "different max fee", modify: func(tr *Transfer) { tr.MaxFee = big.NewInt(42_000) }, want: false},
+		{name: "nominal amount", modify: func(tr *Transfer) { tr.AmountIsNominal = true }, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			other := transfer()
+			tt.modify(&other)
+			require.Equal(t, tt.want, transfer().Equal(other))
+			require.Equal(t, tt.want, other.Equal(transfer()))
+		})
+	}
+
+	// numerically equal zero amounts with different internal representations
+	zero := Transfer{Amount: big.NewInt(0)}
+	require.True(t, zero.Equal(Transfer{Amount: new(big.Int).SetBytes([]byte{0})}))
+}
+
+func Test_Transfer_Validate(t *testing.T) {
+	transfer := func() Transfer {
+		return Transfer{
+			To:             []byte("qredo1recipient"),
+			Amount:         big.NewInt(1_000),
+			CoinIdentifier: NativeCoin("ETH").Bytes(),
+			Kind:           TxKindTransfer,
+		}
+	}
+	maxUint256 := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+
+	tests := []struct {
+		name    string
+		modify  func(*Transfer)
+		wantErr bool
+	}{
+		{name: "valid", modify: func(*Transfer) {}},
+		{name: "zero amount", modify: func(tr *Transfer) { tr.Amount = big.NewInt(0) }},
+		{name: "max uint256 amount", modify: func(tr *Transfer) { tr.Amount = maxUint256 }},
+		{name: "contract creation", modify: func(tr *Transfer) { tr.To, tr.Kind = nil, TxKindDeploy }},
+		{name: "nil amount", modify: func(tr *Transfer) { tr.Amount = nil }, wantErr: true},
+		{name: "negative amount", modify: func(tr *Transfer) { tr.Amount = big.NewInt(-1) }, wantErr: true},
+		{name: "amount above 256 bits", modify: func(tr *Transfer) { tr.Amount = new(big.Int).Add(maxUint256, big.NewInt(1)) }, wantErr: true},
+		{name: "empty destination", modify: func(tr *Transfer) { tr.To = nil }, wantErr: true},
+		{name: "empty coin identifier", modify: func(tr *Transfer) { tr.CoinIdentifier = []byte{} }, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tr := transfer()
+			tt.modify(&tr)
+			err := tr.Validate()
+			if tt.wantErr {
+				require.ErrorIs(t, err, ErrInvalidTransfer)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}